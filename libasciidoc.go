@@ -3,6 +3,7 @@ package libasciidoc
 import (
 	"context"
 	"io"
+	"os"
 
 	"github.com/bytesparadise/libasciidoc/parser"
 	"github.com/bytesparadise/libasciidoc/renderer"
@@ -15,26 +16,45 @@ import (
 // ConvertFileToHTML converts the content of the given filename into an HTML document.
 // The conversion result is written in the given writer `output`, whereas the document metadata (title, etc.) (or an error if a problem occurred) is returned
 // as the result of the function call.
+// The files included with the `include::` directive and the images embedded as data URIs are resolved relative to the given filename.
+// Only the files in the directory of the given filename (or in its subdirectories) are included, unless another resolver is set with
+// the `renderer.IncludeResolver` option.
 func ConvertFileToHTML(ctx context.Context, filename string, output io.Writer, options ...renderer.Option) (map[string]interface{}, error) {
-	doc, err := parser.ParseFile(filename)
+	f, err := os.Open(filename)
 	if err != nil {
-		return nil, errors.Wrapf(err, "error while parsing the document")
+		return nil, errors.Wrapf(err, "error while opening the document")
 	}
-	return convertToHTML(ctx, doc, output, options...)
+	defer f.Close()
+	return convertToHTML(ctx, filename, f, output, options...)
 }
 
 // ConvertToHTML converts the content of the given reader `r` into a full HTML document, written in the given writer `output`.
+// The files included with the `include::` directive are resolved relative to the current directory, and only the files in
+// this directory (or in its subdirectories) are included unless another resolver is set with the `renderer.IncludeResolver` option.
 // Returns an error if a problem occurred
 func ConvertToHTML(ctx context.Context, r io.Reader, output io.Writer, options ...renderer.Option) (map[string]interface{}, error) {
-	doc, err := parser.ParseReader("", r)
+	return convertToHTML(ctx, "", r, output, options...)
+}
+
+func convertToHTML(ctx context.Context, filename string, r io.Reader, output io.Writer, options ...renderer.Option) (map[string]interface{}, error) {
+	rendererCtx := renderer.Wrap(ctx, types.Document{}, append([]renderer.Option{renderer.Filename(filename)}, options...)...)
+	definedAttributes := rendererCtx.DefinedDocumentAttributes()
+	source, err := parser.Preprocess(filename, r, rendererCtx.IncludeResolver(), definedAttributes)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error while preprocessing the document")
+	}
+	doc, err := parser.Parse(filename, source, parser.DocumentAttributes(definedAttributes))
 	if err != nil {
 		return nil, errors.Wrapf(err, "error while parsing the document")
 	}
-	return convertToHTML(ctx, doc, output, options...)
-}
-
-func convertToHTML(ctx context.Context, doc interface{}, output io.Writer, options ...renderer.Option) (map[string]interface{}, error) {
-	metadata, err := htmlrenderer.Render(renderer.Wrap(ctx, doc.(types.Document), options...), output)
+	rendererCtx.Document = doc.(types.Document)
+	// attributes defined via the API can be overridden by the attributes declared in the document header
+	for k, v := range definedAttributes {
+		if _, found := rendererCtx.Document.Attributes[k]; !found {
+			rendererCtx.Document.Attributes[k] = v
+		}
+	}
+	metadata, err := htmlrenderer.Render(rendererCtx, output)
 	if err != nil {
 		return nil, errors.Wrapf(err, "error while rendering the document")
 	}
@@ -3,6 +3,7 @@ package libasciidoc_test
 import (
 	"bytes"
 	"context"
+	"os"
 	"strings"
 	"time"
 
@@ -168,13 +169,289 @@ Last updated {{.LastUpdated}}
 </div>
 </div>
 </body>
+</html>`
+			verifyCompleteDocument(GinkgoT(), expectedContent, source)
+		})
+
+		It("book with parts", func() {
+			source := `= a book title
+:doctype: book
+
+= Part 1
+
+== Chapter A`
+			expectedContent := `<!DOCTYPE html>
+<html lang="en">
+<head>
+<meta charset="UTF-8">
+<!--[if IE]><meta http-equiv="X-UA-Compatible" content="IE=edge"><![endif]-->
+<meta name="viewport" content="width=device-width, initial-scale=1.0">
+<meta name="generator" content="libasciidoc">
+<title>a book title</title>
+<body class="book">
+<div id="header">
+<h1>a book title</h1>
+</div>
+<div id="content">
+<h1 id="_part_1" class="sect0">Part 1</h1>
+<div class="sect1">
+<h2 id="_chapter_a">Chapter A</h2>
+<div class="sectionbody">
+</div>
+</div>
+</div>
+<div id="footer">
+<div id="footer-text">
+Last updated {{.LastUpdated}}
+</div>
+</div>
+</body>
+</html>`
+			verifyCompleteDocument(GinkgoT(), expectedContent, source)
+		})
+
+		It("document with footnotes", func() {
+			source := `= a document title
+
+a paragraph with a footnote:[a footnote]`
+			expectedContent := `<!DOCTYPE html>
+<html lang="en">
+<head>
+<meta charset="UTF-8">
+<!--[if IE]><meta http-equiv="X-UA-Compatible" content="IE=edge"><![endif]-->
+<meta name="viewport" content="width=device-width, initial-scale=1.0">
+<meta name="generator" content="libasciidoc">
+<title>a document title</title>
+<body class="article">
+<div id="header">
+<h1>a document title</h1>
+</div>
+<div id="content">
+<div class="paragraph">
+<p>a paragraph with a <sup class="footnote">[<a id="_footnoteref_1" class="footnote" href="#_footnotedef_1" title="View footnote.">1</a>]</sup></p>
+</div>
+</div>
+<div id="footnotes">
+<hr>
+<div class="footnote" id="_footnotedef_1">
+<a href="#_footnoteref_1">1</a>. a footnote
+</div>
+</div>
+<div id="footer">
+<div id="footer-text">
+Last updated {{.LastUpdated}}
+</div>
+</div>
+</body>
 </html>`
 			verifyCompleteDocument(GinkgoT(), expectedContent, source)
 		})
 	})
 
+	Context("Document title", func() {
+
+		It("title with subtitle", func() {
+			source := `= The Main Title: A Subtitle: And More
+
+a paragraph`
+			metadata := convertToHTMLMetadata(GinkgoT(), source)
+			assert.Equal(GinkgoT(), "The Main Title: A Subtitle: And More", metadata["doctitle"])
+			assert.Equal(GinkgoT(), "The Main Title: A Subtitle", metadata["title"])
+			assert.Equal(GinkgoT(), "And More", metadata["subtitle"])
+		})
+
+		It("title with custom separator", func() {
+			source := `= The Main Title: A Subtitle | And More
+:title-separator: |
+
+a paragraph`
+			metadata := convertToHTMLMetadata(GinkgoT(), source)
+			assert.Equal(GinkgoT(), "The Main Title: A Subtitle", metadata["title"])
+			assert.Equal(GinkgoT(), "And More", metadata["subtitle"])
+		})
+
+		It("title without subtitle", func() {
+			source := `= The Main Title`
+			metadata := convertToHTMLMetadata(GinkgoT(), source)
+			assert.Equal(GinkgoT(), "The Main Title", metadata["title"])
+			assert.NotContains(GinkgoT(), metadata, "subtitle")
+		})
+
+		It("title overridden with doctitle attribute", func() {
+			source := `= The Main Title
+:doctitle: Another Title: With a Subtitle`
+			metadata := convertToHTMLMetadata(GinkgoT(), source)
+			assert.Equal(GinkgoT(), "Another Title: With a Subtitle", metadata["doctitle"])
+			assert.Equal(GinkgoT(), "Another Title", metadata["title"])
+			assert.Equal(GinkgoT(), "With a Subtitle", metadata["subtitle"])
+		})
+
+		It("title with title attribute", func() {
+			source := `= Main: Sub
+:title: Custom
+
+a paragraph`
+			for i := 0; i < 10; i++ {
+				metadata := convertToHTMLMetadata(GinkgoT(), source)
+				assert.Equal(GinkgoT(), "Main", metadata["title"])
+				assert.Equal(GinkgoT(), "Sub", metadata["subtitle"])
+			}
+		})
+
+		It("title shown in embedded document", func() {
+			source := `= a document title
+:showtitle:
+
+a paragraph`
+			expectedTitle := "a document title"
+			expectedContent := `<h1>a document title</h1>
+<div class="paragraph">
+<p>a paragraph</p>
+</div>`
+			verifyDocumentBody(GinkgoT(), &expectedTitle, expectedContent, source)
+		})
+
+		It("title hidden in complete document", func() {
+			source := `= a document title
+:notitle:
+
+a paragraph`
+			expectedContent := `<!DOCTYPE html>
+<html lang="en">
+<head>
+<meta charset="UTF-8">
+<!--[if IE]><meta http-equiv="X-UA-Compatible" content="IE=edge"><![endif]-->
+<meta name="viewport" content="width=device-width, initial-scale=1.0">
+<meta name="generator" content="libasciidoc">
+<title>a document title</title>
+<body class="article">
+<div id="header">
+</div>
+<div id="content">
+<div class="paragraph">
+<p>a paragraph</p>
+</div>
+</div>
+<div id="footer">
+<div id="footer-text">
+Last updated {{.LastUpdated}}
+</div>
+</div>
+</body>
+</html>`
+			verifyCompleteDocument(GinkgoT(), expectedContent, source)
+		})
+	})
+
+	Context("Document with conditional content", func() {
+
+		It("content with attribute defined via the API", func() {
+			source := `ifdef::edition[]
+{edition} content
+endif::[]
+ifndef::edition[]
+community content
+endif::[]`
+			expectedContent := `<div class="paragraph">
+<p>enterprise content</p>
+</div>`
+			resultWriter := bytes.NewBuffer(nil)
+			_, err := ConvertToHTML(context.Background(), strings.NewReader(source), resultWriter, renderer.IncludeHeaderFooter(false), renderer.DefineDocumentAttributes(map[string]string{"edition": "enterprise"}))
+			require.NoError(GinkgoT(), err)
+			assert.Equal(GinkgoT(), expectedContent, resultWriter.String())
+		})
+	})
+
+	Context("Document with the doctype defined via the API", func() {
+
+		It("level 0 section in a book", func() {
+			source := `= A book
+
+= Part 1
+
+== Chapter A`
+			expectedContent := `<h1 id="_part_1" class="sect0">Part 1</h1>
+<div class="sect1">
+<h2 id="_chapter_a">Chapter A</h2>
+<div class="sectionbody">
+</div>
+</div>`
+			resultWriter := bytes.NewBuffer(nil)
+			_, err := ConvertToHTML(context.Background(), strings.NewReader(source), resultWriter, renderer.IncludeHeaderFooter(false), renderer.DefineDocumentAttributes(map[string]string{"doctype": "book"}))
+			require.NoError(GinkgoT(), err)
+			assert.Equal(GinkgoT(), expectedContent, resultWriter.String())
+		})
+	})
+
+	Context("Document with STEM content", func() {
+
+		It("MathJax script included when the stem attribute is set", func() {
+			source := `:stem: latexmath
+
+stem:[\sqrt{4} = 2]`
+			resultWriter := bytes.NewBuffer(nil)
+			_, err := ConvertToHTML(context.Background(), strings.NewReader(source), resultWriter, renderer.IncludeHeaderFooter(true), renderer.IncludeMathJax(true))
+			require.NoError(GinkgoT(), err)
+			assert.Contains(GinkgoT(), resultWriter.String(), `<script type="text/x-mathjax-config">`)
+			assert.Contains(GinkgoT(), resultWriter.String(), `<script src="https://cdnjs.cloudflare.com/ajax/libs/mathjax/2.7.4/MathJax.js?config=TeX-MML-AM_HTMLorMML"></script>`)
+			assert.Contains(GinkgoT(), resultWriter.String(), `<p>\(\sqrt{4} = 2\)</p>`)
+		})
+
+		It("MathJax script not included when the stem attribute is not set", func() {
+			source := `latexmath:[\sqrt{4} = 2]`
+			resultWriter := bytes.NewBuffer(nil)
+			_, err := ConvertToHTML(context.Background(), strings.NewReader(source), resultWriter, renderer.IncludeHeaderFooter(true), renderer.IncludeMathJax(true))
+			require.NoError(GinkgoT(), err)
+			assert.NotContains(GinkgoT(), resultWriter.String(), "MathJax")
+		})
+
+		It("MathJax script not included without the option", func() {
+			source := `:stem:
+
+stem:[sqrt(4) = 2]`
+			resultWriter := bytes.NewBuffer(nil)
+			_, err := ConvertToHTML(context.Background(), strings.NewReader(source), resultWriter, renderer.IncludeHeaderFooter(true))
+			require.NoError(GinkgoT(), err)
+			assert.NotContains(GinkgoT(), resultWriter.String(), "MathJax")
+		})
+	})
+
+	Context("Document with inclusions", func() {
+
+		It("include file with custom resolver", func() {
+			source := `include::chapters/chapter1.adoc[leveloffset=+1]`
+			resolver := mapIncludeResolver{
+				"chapters/chapter1.adoc": `= Chapter 1
+
+a paragraph`,
+			}
+			expectedContent := `<div class="sect1">
+<h2 id="_chapter_1">Chapter 1</h2>
+<div class="sectionbody">
+<div class="paragraph">
+<p>a paragraph</p>
+</div>
+</div>
+</div>`
+			resultWriter := bytes.NewBuffer(nil)
+			_, err := ConvertToHTML(context.Background(), strings.NewReader(source), resultWriter, renderer.IncludeHeaderFooter(false), renderer.IncludeResolver(resolver))
+			require.NoError(GinkgoT(), err)
+			assert.Equal(GinkgoT(), expectedContent, resultWriter.String())
+		})
+	})
+
 })
 
+// mapIncludeResolver an include resolver which serves the content of the included files from a map
+type mapIncludeResolver map[string]string
+
+func (r mapIncludeResolver) Resolve(path string) ([]byte, error) {
+	if content, found := r[path]; found {
+		return []byte(content), nil
+	}
+	return nil, os.ErrNotExist
+}
+
 func verifyDocumentBody(t GinkgoTInterface, expectedRenderedTitle *string, expectedContent, source string) {
 	t.Logf("processing '%s'", source)
 	sourceReader := strings.NewReader(source)
@@ -197,6 +474,12 @@ func verifyDocumentBody(t GinkgoTInterface, expectedRenderedTitle *string, expec
 	}
 }
 
+func convertToHTMLMetadata(t GinkgoTInterface, source string) map[string]interface{} {
+	metadata, err := ConvertToHTML(context.Background(), strings.NewReader(source), bytes.NewBuffer(nil), renderer.IncludeHeaderFooter(false))
+	require.NoError(t, err)
+	return metadata
+}
+
 func verifyCompleteDocument(t GinkgoTInterface, expectedContent, source string) {
 	t.Logf("processing '%s'", source)
 	sourceReader := strings.NewReader(source)
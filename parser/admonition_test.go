@@ -193,4 +193,82 @@ And no space after [CAUTION] either.`
 		})
 	})
 
+	Context("admonitions on delimited blocks", func() {
+
+		It("tip admonition on example block with title", func() {
+			actualContent := `[TIP]
+.a title
+====
+a tip
+
+* an item
+====`
+			expectedResult := types.AdmonitionBlock{
+				Kind: types.Tip,
+				Attributes: map[string]interface{}{
+					types.AttrTitle: "a title",
+				},
+				Elements: []types.DocElement{
+					types.Paragraph{
+						Attributes: map[string]interface{}{},
+						Lines: []types.InlineContent{
+							{
+								Elements: []types.InlineElement{
+									types.StringElement{Content: "a tip"},
+								},
+							},
+						},
+					},
+					types.UnorderedList{
+						Attributes: map[string]interface{}{},
+						Items: []types.UnorderedListItem{
+							{
+								Level:       1,
+								BulletStyle: types.OneAsterisk,
+								Elements: []types.DocElement{
+									types.ListParagraph{
+										Lines: []types.InlineContent{
+											{
+												Elements: []types.InlineElement{
+													types.StringElement{Content: "an item"},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			}
+			verify(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("BlockElement"))
+		})
+
+		It("important admonition on open block with ID", func() {
+			actualContent := `[[foo]]
+[IMPORTANT]
+--
+important content
+--`
+			expectedResult := types.AdmonitionBlock{
+				Kind: types.Important,
+				Attributes: map[string]interface{}{
+					types.AttrID: "foo",
+				},
+				Elements: []types.DocElement{
+					types.Paragraph{
+						Attributes: map[string]interface{}{},
+						Lines: []types.InlineContent{
+							{
+								Elements: []types.InlineElement{
+									types.StringElement{Content: "important content"},
+								},
+							},
+						},
+					},
+				},
+			}
+			verify(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("BlockElement"))
+		})
+	})
 })
@@ -134,43 +134,75 @@ var g = &grammar{
 					},
 					&ruleRefExpr{
 						pos:  position{line: 27, col: 96, offset: 881},
+						name: "ThematicBreak",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 27, col: 112, offset: 897},
+						name: "PageBreak",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 27, col: 124, offset: 909},
+						name: "DiscreteHeading",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 27, col: 142, offset: 927},
 						name: "BlockImage",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 27, col: 109, offset: 894},
+						pos:  position{line: 27, col: 155, offset: 940},
+						name: "VideoBlock",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 27, col: 168, offset: 953},
+						name: "AudioBlock",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 27, col: 181, offset: 966},
 						name: "List",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 27, col: 116, offset: 901},
+						pos:  position{line: 27, col: 188, offset: 973},
+						name: "CalloutList",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 27, col: 202, offset: 987},
 						name: "LiteralBlock",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 27, col: 131, offset: 916},
+						pos:  position{line: 27, col: 217, offset: 1002},
 						name: "DelimitedBlock",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 27, col: 148, offset: 933},
+						pos:  position{line: 27, col: 234, offset: 1019},
+						name: "Table",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 27, col: 242, offset: 1027},
+						name: "Comment",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 27, col: 252, offset: 1037},
 						name: "Admonition",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 27, col: 161, offset: 946},
+						pos:  position{line: 27, col: 265, offset: 1050},
 						name: "Paragraph",
 					},
 					&seqExpr{
-						pos: position{line: 27, col: 174, offset: 959},
+						pos: position{line: 27, col: 278, offset: 1063},
 						exprs: []interface{}{
 							&ruleRefExpr{
-								pos:  position{line: 27, col: 174, offset: 959},
+								pos:  position{line: 27, col: 278, offset: 1063},
 								name: "ElementAttribute",
 							},
 							&ruleRefExpr{
-								pos:  position{line: 27, col: 191, offset: 976},
+								pos:  position{line: 27, col: 295, offset: 1080},
 								name: "EOL",
 							},
 						},
 					},
 					&ruleRefExpr{
-						pos:  position{line: 27, col: 198, offset: 983},
+						pos:  position{line: 27, col: 302, offset: 1087},
 						name: "BlankLine",
 					},
 				},
@@ -178,17 +210,17 @@ var g = &grammar{
 		},
 		{
 			name: "Preamble",
-			pos:  position{line: 29, col: 1, offset: 1038},
+			pos:  position{line: 29, col: 1, offset: 1142},
 			expr: &actionExpr{
-				pos: position{line: 29, col: 13, offset: 1050},
+				pos: position{line: 29, col: 13, offset: 1154},
 				run: (*parser).callonPreamble1,
 				expr: &labeledExpr{
-					pos:   position{line: 29, col: 13, offset: 1050},
+					pos:   position{line: 29, col: 13, offset: 1154},
 					label: "elements",
 					expr: &zeroOrMoreExpr{
-						pos: position{line: 29, col: 23, offset: 1060},
+						pos: position{line: 29, col: 23, offset: 1164},
 						expr: &ruleRefExpr{
-							pos:  position{line: 29, col: 23, offset: 1060},
+							pos:  position{line: 29, col: 23, offset: 1164},
 							name: "BlockElement",
 						},
 					},
@@ -197,35 +229,35 @@ var g = &grammar{
 		},
 		{
 			name: "FrontMatter",
-			pos:  position{line: 36, col: 1, offset: 1243},
+			pos:  position{line: 36, col: 1, offset: 1347},
 			expr: &ruleRefExpr{
-				pos:  position{line: 36, col: 16, offset: 1258},
+				pos:  position{line: 36, col: 16, offset: 1362},
 				name: "YamlFrontMatter",
 			},
 		},
 		{
 			name: "FrontMatter",
-			pos:  position{line: 38, col: 1, offset: 1276},
+			pos:  position{line: 38, col: 1, offset: 1380},
 			expr: &actionExpr{
-				pos: position{line: 38, col: 16, offset: 1291},
+				pos: position{line: 38, col: 16, offset: 1395},
 				run: (*parser).callonFrontMatter1,
 				expr: &seqExpr{
-					pos: position{line: 38, col: 16, offset: 1291},
+					pos: position{line: 38, col: 16, offset: 1395},
 					exprs: []interface{}{
 						&ruleRefExpr{
-							pos:  position{line: 38, col: 16, offset: 1291},
+							pos:  position{line: 38, col: 16, offset: 1395},
 							name: "YamlFrontMatterToken",
 						},
 						&labeledExpr{
-							pos:   position{line: 38, col: 37, offset: 1312},
+							pos:   position{line: 38, col: 37, offset: 1416},
 							label: "content",
 							expr: &ruleRefExpr{
-								pos:  position{line: 38, col: 46, offset: 1321},
+								pos:  position{line: 38, col: 46, offset: 1425},
 								name: "YamlFrontMatterContent",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 38, col: 70, offset: 1345},
+							pos:  position{line: 38, col: 70, offset: 1449},
 							name: "YamlFrontMatterToken",
 						},
 					},
@@ -234,17 +266,17 @@ var g = &grammar{
 		},
 		{
 			name: "YamlFrontMatterToken",
-			pos:  position{line: 42, col: 1, offset: 1425},
+			pos:  position{line: 42, col: 1, offset: 1529},
 			expr: &seqExpr{
-				pos: position{line: 42, col: 26, offset: 1450},
+				pos: position{line: 42, col: 26, offset: 1554},
 				exprs: []interface{}{
 					&litMatcher{
-						pos:        position{line: 42, col: 26, offset: 1450},
+						pos:        position{line: 42, col: 26, offset: 1554},
 						val:        "---",
 						ignoreCase: false,
 					},
 					&ruleRefExpr{
-						pos:  position{line: 42, col: 32, offset: 1456},
+						pos:  position{line: 42, col: 32, offset: 1560},
 						name: "EOL",
 					},
 				},
@@ -252,24 +284,24 @@ var g = &grammar{
 		},
 		{
 			name: "YamlFrontMatterContent",
-			pos:  position{line: 44, col: 1, offset: 1461},
+			pos:  position{line: 44, col: 1, offset: 1565},
 			expr: &actionExpr{
-				pos: position{line: 44, col: 27, offset: 1487},
+				pos: position{line: 44, col: 27, offset: 1591},
 				run: (*parser).callonYamlFrontMatterContent1,
 				expr: &zeroOrMoreExpr{
-					pos: position{line: 44, col: 27, offset: 1487},
+					pos: position{line: 44, col: 27, offset: 1591},
 					expr: &seqExpr{
-						pos: position{line: 44, col: 28, offset: 1488},
+						pos: position{line: 44, col: 28, offset: 1592},
 						exprs: []interface{}{
 							&notExpr{
-								pos: position{line: 44, col: 28, offset: 1488},
+								pos: position{line: 44, col: 28, offset: 1592},
 								expr: &ruleRefExpr{
-									pos:  position{line: 44, col: 29, offset: 1489},
+									pos:  position{line: 44, col: 29, offset: 1593},
 									name: "YamlFrontMatterToken",
 								},
 							},
 							&anyMatcher{
-								line: 44, col: 50, offset: 1510,
+								line: 44, col: 50, offset: 1614,
 							},
 						},
 					},
@@ -278,50 +310,50 @@ var g = &grammar{
 		},
 		{
 			name: "DocumentHeader",
-			pos:  position{line: 52, col: 1, offset: 1734},
+			pos:  position{line: 52, col: 1, offset: 1838},
 			expr: &actionExpr{
-				pos: position{line: 52, col: 19, offset: 1752},
+				pos: position{line: 52, col: 19, offset: 1856},
 				run: (*parser).callonDocumentHeader1,
 				expr: &seqExpr{
-					pos: position{line: 52, col: 19, offset: 1752},
+					pos: position{line: 52, col: 19, offset: 1856},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 52, col: 19, offset: 1752},
+							pos:   position{line: 52, col: 19, offset: 1856},
 							label: "header",
 							expr: &ruleRefExpr{
-								pos:  position{line: 52, col: 27, offset: 1760},
+								pos:  position{line: 52, col: 27, offset: 1864},
 								name: "DocumentTitle",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 52, col: 42, offset: 1775},
+							pos:   position{line: 52, col: 42, offset: 1879},
 							label: "authors",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 52, col: 51, offset: 1784},
+								pos: position{line: 52, col: 51, offset: 1888},
 								expr: &ruleRefExpr{
-									pos:  position{line: 52, col: 51, offset: 1784},
+									pos:  position{line: 52, col: 51, offset: 1888},
 									name: "DocumentAuthors",
 								},
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 52, col: 69, offset: 1802},
+							pos:   position{line: 52, col: 69, offset: 1906},
 							label: "revision",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 52, col: 79, offset: 1812},
+								pos: position{line: 52, col: 79, offset: 1916},
 								expr: &ruleRefExpr{
-									pos:  position{line: 52, col: 79, offset: 1812},
+									pos:  position{line: 52, col: 79, offset: 1916},
 									name: "DocumentRevision",
 								},
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 52, col: 98, offset: 1831},
+							pos:   position{line: 52, col: 98, offset: 1935},
 							label: "otherAttributes",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 52, col: 115, offset: 1848},
+								pos: position{line: 52, col: 115, offset: 1952},
 								expr: &ruleRefExpr{
-									pos:  position{line: 52, col: 115, offset: 1848},
+									pos:  position{line: 52, col: 115, offset: 1952},
 									name: "DocumentAttributeDeclaration",
 								},
 							},
@@ -332,68 +364,85 @@ var g = &grammar{
 		},
 		{
 			name: "DocumentTitle",
-			pos:  position{line: 56, col: 1, offset: 1979},
+			pos:  position{line: 62, col: 1, offset: 2306},
 			expr: &actionExpr{
-				pos: position{line: 56, col: 18, offset: 1996},
+				pos: position{line: 62, col: 18, offset: 2323},
 				run: (*parser).callonDocumentTitle1,
 				expr: &seqExpr{
-					pos: position{line: 56, col: 18, offset: 1996},
+					pos: position{line: 62, col: 18, offset: 2323},
 					exprs: []interface{}{
+						&notExpr{
+							pos: position{line: 62, col: 18, offset: 2323},
+							expr: &ruleRefExpr{
+								pos:  position{line: 62, col: 19, offset: 2324},
+								name: "DiscreteHeading",
+							},
+						},
 						&labeledExpr{
-							pos:   position{line: 56, col: 18, offset: 1996},
+							pos:   position{line: 62, col: 35, offset: 2340},
 							label: "attributes",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 56, col: 29, offset: 2007},
+								pos: position{line: 62, col: 46, offset: 2351},
 								expr: &ruleRefExpr{
-									pos:  position{line: 56, col: 30, offset: 2008},
+									pos:  position{line: 62, col: 47, offset: 2352},
 									name: "ElementAttribute",
 								},
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 56, col: 49, offset: 2027},
+							pos:   position{line: 62, col: 66, offset: 2371},
 							label: "level",
-							expr: &litMatcher{
-								pos:        position{line: 56, col: 56, offset: 2034},
-								val:        "=",
-								ignoreCase: false,
+							expr: &choiceExpr{
+								pos: position{line: 62, col: 73, offset: 2378},
+								alternatives: []interface{}{
+									&litMatcher{
+										pos:        position{line: 62, col: 73, offset: 2378},
+										val:        "=",
+										ignoreCase: false,
+									},
+									&litMatcher{
+										pos:        position{line: 62, col: 79, offset: 2384},
+										val:        "#",
+										ignoreCase: false,
+									},
+								},
 							},
 						},
 						&oneOrMoreExpr{
-							pos: position{line: 56, col: 61, offset: 2039},
+							pos: position{line: 62, col: 84, offset: 2389},
 							expr: &ruleRefExpr{
-								pos:  position{line: 56, col: 61, offset: 2039},
+								pos:  position{line: 62, col: 84, offset: 2389},
 								name: "WS",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 56, col: 65, offset: 2043},
+							pos:   position{line: 62, col: 88, offset: 2393},
 							label: "content",
 							expr: &ruleRefExpr{
-								pos:  position{line: 56, col: 74, offset: 2052},
+								pos:  position{line: 62, col: 97, offset: 2402},
 								name: "InlineContent",
 							},
 						},
 						&zeroOrMoreExpr{
-							pos: position{line: 56, col: 89, offset: 2067},
+							pos: position{line: 62, col: 112, offset: 2417},
 							expr: &ruleRefExpr{
-								pos:  position{line: 56, col: 89, offset: 2067},
+								pos:  position{line: 62, col: 112, offset: 2417},
 								name: "WS",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 56, col: 93, offset: 2071},
+							pos:   position{line: 62, col: 116, offset: 2421},
 							label: "id",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 56, col: 96, offset: 2074},
+								pos: position{line: 62, col: 119, offset: 2424},
 								expr: &ruleRefExpr{
-									pos:  position{line: 56, col: 97, offset: 2075},
+									pos:  position{line: 62, col: 120, offset: 2425},
 									name: "InlineElementID",
 								},
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 56, col: 115, offset: 2093},
+							pos:  position{line: 62, col: 138, offset: 2443},
 							name: "EOL",
 						},
 					},
@@ -402,16 +451,16 @@ var g = &grammar{
 		},
 		{
 			name: "DocumentAuthors",
-			pos:  position{line: 60, col: 1, offset: 2208},
+			pos:  position{line: 66, col: 1, offset: 2558},
 			expr: &choiceExpr{
-				pos: position{line: 60, col: 20, offset: 2227},
+				pos: position{line: 66, col: 20, offset: 2577},
 				alternatives: []interface{}{
 					&ruleRefExpr{
-						pos:  position{line: 60, col: 20, offset: 2227},
+						pos:  position{line: 66, col: 20, offset: 2577},
 						name: "DocumentAuthorsInlineForm",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 60, col: 48, offset: 2255},
+						pos:  position{line: 66, col: 48, offset: 2605},
 						name: "DocumentAuthorsAttributeForm",
 					},
 				},
@@ -419,41 +468,41 @@ var g = &grammar{
 		},
 		{
 			name: "DocumentAuthorsInlineForm",
-			pos:  position{line: 62, col: 1, offset: 2285},
+			pos:  position{line: 68, col: 1, offset: 2635},
 			expr: &actionExpr{
-				pos: position{line: 62, col: 30, offset: 2314},
+				pos: position{line: 68, col: 30, offset: 2664},
 				run: (*parser).callonDocumentAuthorsInlineForm1,
 				expr: &seqExpr{
-					pos: position{line: 62, col: 30, offset: 2314},
+					pos: position{line: 68, col: 30, offset: 2664},
 					exprs: []interface{}{
 						&zeroOrMoreExpr{
-							pos: position{line: 62, col: 30, offset: 2314},
+							pos: position{line: 68, col: 30, offset: 2664},
 							expr: &ruleRefExpr{
-								pos:  position{line: 62, col: 30, offset: 2314},
+								pos:  position{line: 68, col: 30, offset: 2664},
 								name: "WS",
 							},
 						},
 						&notExpr{
-							pos: position{line: 62, col: 34, offset: 2318},
+							pos: position{line: 68, col: 34, offset: 2668},
 							expr: &litMatcher{
-								pos:        position{line: 62, col: 35, offset: 2319},
+								pos:        position{line: 68, col: 35, offset: 2669},
 								val:        ":",
 								ignoreCase: false,
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 62, col: 39, offset: 2323},
+							pos:   position{line: 68, col: 39, offset: 2673},
 							label: "authors",
 							expr: &oneOrMoreExpr{
-								pos: position{line: 62, col: 48, offset: 2332},
+								pos: position{line: 68, col: 48, offset: 2682},
 								expr: &ruleRefExpr{
-									pos:  position{line: 62, col: 48, offset: 2332},
+									pos:  position{line: 68, col: 48, offset: 2682},
 									name: "DocumentAuthor",
 								},
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 62, col: 65, offset: 2349},
+							pos:  position{line: 68, col: 65, offset: 2699},
 							name: "EOL",
 						},
 					},
@@ -462,30 +511,30 @@ var g = &grammar{
 		},
 		{
 			name: "DocumentAuthorsAttributeForm",
-			pos:  position{line: 66, col: 1, offset: 2419},
+			pos:  position{line: 72, col: 1, offset: 2769},
 			expr: &actionExpr{
-				pos: position{line: 66, col: 33, offset: 2451},
+				pos: position{line: 72, col: 33, offset: 2801},
 				run: (*parser).callonDocumentAuthorsAttributeForm1,
 				expr: &seqExpr{
-					pos: position{line: 66, col: 33, offset: 2451},
+					pos: position{line: 72, col: 33, offset: 2801},
 					exprs: []interface{}{
 						&zeroOrMoreExpr{
-							pos: position{line: 66, col: 33, offset: 2451},
+							pos: position{line: 72, col: 33, offset: 2801},
 							expr: &ruleRefExpr{
-								pos:  position{line: 66, col: 33, offset: 2451},
+								pos:  position{line: 72, col: 33, offset: 2801},
 								name: "WS",
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 66, col: 37, offset: 2455},
+							pos:        position{line: 72, col: 37, offset: 2805},
 							val:        ":author:",
 							ignoreCase: false,
 						},
 						&labeledExpr{
-							pos:   position{line: 66, col: 48, offset: 2466},
+							pos:   position{line: 72, col: 48, offset: 2816},
 							label: "author",
 							expr: &ruleRefExpr{
-								pos:  position{line: 66, col: 56, offset: 2474},
+								pos:  position{line: 72, col: 56, offset: 2824},
 								name: "DocumentAuthor",
 							},
 						},
@@ -495,80 +544,80 @@ var g = &grammar{
 		},
 		{
 			name: "DocumentAuthor",
-			pos:  position{line: 70, col: 1, offset: 2565},
+			pos:  position{line: 76, col: 1, offset: 2915},
 			expr: &actionExpr{
-				pos: position{line: 70, col: 19, offset: 2583},
+				pos: position{line: 76, col: 19, offset: 2933},
 				run: (*parser).callonDocumentAuthor1,
 				expr: &seqExpr{
-					pos: position{line: 70, col: 19, offset: 2583},
+					pos: position{line: 76, col: 19, offset: 2933},
 					exprs: []interface{}{
 						&zeroOrMoreExpr{
-							pos: position{line: 70, col: 19, offset: 2583},
+							pos: position{line: 76, col: 19, offset: 2933},
 							expr: &ruleRefExpr{
-								pos:  position{line: 70, col: 19, offset: 2583},
+								pos:  position{line: 76, col: 19, offset: 2933},
 								name: "WS",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 70, col: 23, offset: 2587},
+							pos:   position{line: 76, col: 23, offset: 2937},
 							label: "namePart1",
 							expr: &ruleRefExpr{
-								pos:  position{line: 70, col: 34, offset: 2598},
+								pos:  position{line: 76, col: 34, offset: 2948},
 								name: "DocumentAuthorNamePart",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 70, col: 58, offset: 2622},
+							pos:   position{line: 76, col: 58, offset: 2972},
 							label: "namePart2",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 70, col: 68, offset: 2632},
+								pos: position{line: 76, col: 68, offset: 2982},
 								expr: &ruleRefExpr{
-									pos:  position{line: 70, col: 69, offset: 2633},
+									pos:  position{line: 76, col: 69, offset: 2983},
 									name: "DocumentAuthorNamePart",
 								},
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 70, col: 94, offset: 2658},
+							pos:   position{line: 76, col: 94, offset: 3008},
 							label: "namePart3",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 70, col: 104, offset: 2668},
+								pos: position{line: 76, col: 104, offset: 3018},
 								expr: &ruleRefExpr{
-									pos:  position{line: 70, col: 105, offset: 2669},
+									pos:  position{line: 76, col: 105, offset: 3019},
 									name: "DocumentAuthorNamePart",
 								},
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 70, col: 130, offset: 2694},
+							pos:   position{line: 76, col: 130, offset: 3044},
 							label: "email",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 70, col: 136, offset: 2700},
+								pos: position{line: 76, col: 136, offset: 3050},
 								expr: &ruleRefExpr{
-									pos:  position{line: 70, col: 137, offset: 2701},
+									pos:  position{line: 76, col: 137, offset: 3051},
 									name: "DocumentAuthorEmail",
 								},
 							},
 						},
 						&zeroOrMoreExpr{
-							pos: position{line: 70, col: 159, offset: 2723},
+							pos: position{line: 76, col: 159, offset: 3073},
 							expr: &ruleRefExpr{
-								pos:  position{line: 70, col: 159, offset: 2723},
+								pos:  position{line: 76, col: 159, offset: 3073},
 								name: "WS",
 							},
 						},
 						&zeroOrOneExpr{
-							pos: position{line: 70, col: 163, offset: 2727},
+							pos: position{line: 76, col: 163, offset: 3077},
 							expr: &litMatcher{
-								pos:        position{line: 70, col: 163, offset: 2727},
+								pos:        position{line: 76, col: 163, offset: 3077},
 								val:        ";",
 								ignoreCase: false,
 							},
 						},
 						&zeroOrMoreExpr{
-							pos: position{line: 70, col: 168, offset: 2732},
+							pos: position{line: 76, col: 168, offset: 3082},
 							expr: &ruleRefExpr{
-								pos:  position{line: 70, col: 168, offset: 2732},
+								pos:  position{line: 76, col: 168, offset: 3082},
 								name: "WS",
 							},
 						},
@@ -578,34 +627,34 @@ var g = &grammar{
 		},
 		{
 			name: "DocumentAuthorNamePart",
-			pos:  position{line: 75, col: 1, offset: 2897},
+			pos:  position{line: 81, col: 1, offset: 3247},
 			expr: &seqExpr{
-				pos: position{line: 75, col: 27, offset: 2923},
+				pos: position{line: 81, col: 27, offset: 3273},
 				exprs: []interface{}{
 					&notExpr{
-						pos: position{line: 75, col: 27, offset: 2923},
+						pos: position{line: 81, col: 27, offset: 3273},
 						expr: &litMatcher{
-							pos:        position{line: 75, col: 28, offset: 2924},
+							pos:        position{line: 81, col: 28, offset: 3274},
 							val:        "<",
 							ignoreCase: false,
 						},
 					},
 					&notExpr{
-						pos: position{line: 75, col: 32, offset: 2928},
+						pos: position{line: 81, col: 32, offset: 3278},
 						expr: &litMatcher{
-							pos:        position{line: 75, col: 33, offset: 2929},
+							pos:        position{line: 81, col: 33, offset: 3279},
 							val:        ";",
 							ignoreCase: false,
 						},
 					},
 					&ruleRefExpr{
-						pos:  position{line: 75, col: 37, offset: 2933},
+						pos:  position{line: 81, col: 37, offset: 3283},
 						name: "Characters",
 					},
 					&zeroOrMoreExpr{
-						pos: position{line: 75, col: 48, offset: 2944},
+						pos: position{line: 81, col: 48, offset: 3294},
 						expr: &ruleRefExpr{
-							pos:  position{line: 75, col: 48, offset: 2944},
+							pos:  position{line: 81, col: 48, offset: 3294},
 							name: "WS",
 						},
 					},
@@ -614,47 +663,47 @@ var g = &grammar{
 		},
 		{
 			name: "DocumentAuthorEmail",
-			pos:  position{line: 77, col: 1, offset: 2949},
+			pos:  position{line: 83, col: 1, offset: 3299},
 			expr: &seqExpr{
-				pos: position{line: 77, col: 24, offset: 2972},
+				pos: position{line: 83, col: 24, offset: 3322},
 				exprs: []interface{}{
 					&litMatcher{
-						pos:        position{line: 77, col: 24, offset: 2972},
+						pos:        position{line: 83, col: 24, offset: 3322},
 						val:        "<",
 						ignoreCase: false,
 					},
 					&labeledExpr{
-						pos:   position{line: 77, col: 28, offset: 2976},
+						pos:   position{line: 83, col: 28, offset: 3326},
 						label: "email",
 						expr: &oneOrMoreExpr{
-							pos: position{line: 77, col: 34, offset: 2982},
+							pos: position{line: 83, col: 34, offset: 3332},
 							expr: &seqExpr{
-								pos: position{line: 77, col: 35, offset: 2983},
+								pos: position{line: 83, col: 35, offset: 3333},
 								exprs: []interface{}{
 									&notExpr{
-										pos: position{line: 77, col: 35, offset: 2983},
+										pos: position{line: 83, col: 35, offset: 3333},
 										expr: &litMatcher{
-											pos:        position{line: 77, col: 36, offset: 2984},
+											pos:        position{line: 83, col: 36, offset: 3334},
 											val:        ">",
 											ignoreCase: false,
 										},
 									},
 									&notExpr{
-										pos: position{line: 77, col: 40, offset: 2988},
+										pos: position{line: 83, col: 40, offset: 3338},
 										expr: &ruleRefExpr{
-											pos:  position{line: 77, col: 41, offset: 2989},
+											pos:  position{line: 83, col: 41, offset: 3339},
 											name: "EOL",
 										},
 									},
 									&anyMatcher{
-										line: 77, col: 45, offset: 2993,
+										line: 83, col: 45, offset: 3343,
 									},
 								},
 							},
 						},
 					},
 					&litMatcher{
-						pos:        position{line: 77, col: 49, offset: 2997},
+						pos:        position{line: 83, col: 49, offset: 3347},
 						val:        ">",
 						ignoreCase: false,
 					},
@@ -663,79 +712,79 @@ var g = &grammar{
 		},
 		{
 			name: "DocumentRevision",
-			pos:  position{line: 81, col: 1, offset: 3133},
+			pos:  position{line: 87, col: 1, offset: 3483},
 			expr: &actionExpr{
-				pos: position{line: 81, col: 21, offset: 3153},
+				pos: position{line: 87, col: 21, offset: 3503},
 				run: (*parser).callonDocumentRevision1,
 				expr: &seqExpr{
-					pos: position{line: 81, col: 21, offset: 3153},
+					pos: position{line: 87, col: 21, offset: 3503},
 					exprs: []interface{}{
 						&zeroOrMoreExpr{
-							pos: position{line: 81, col: 21, offset: 3153},
+							pos: position{line: 87, col: 21, offset: 3503},
 							expr: &ruleRefExpr{
-								pos:  position{line: 81, col: 21, offset: 3153},
+								pos:  position{line: 87, col: 21, offset: 3503},
 								name: "WS",
 							},
 						},
 						&notExpr{
-							pos: position{line: 81, col: 25, offset: 3157},
+							pos: position{line: 87, col: 25, offset: 3507},
 							expr: &litMatcher{
-								pos:        position{line: 81, col: 26, offset: 3158},
+								pos:        position{line: 87, col: 26, offset: 3508},
 								val:        ":",
 								ignoreCase: false,
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 81, col: 30, offset: 3162},
+							pos:   position{line: 87, col: 30, offset: 3512},
 							label: "revnumber",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 81, col: 40, offset: 3172},
+								pos: position{line: 87, col: 40, offset: 3522},
 								expr: &ruleRefExpr{
-									pos:  position{line: 81, col: 41, offset: 3173},
+									pos:  position{line: 87, col: 41, offset: 3523},
 									name: "DocumentRevisionNumber",
 								},
 							},
 						},
 						&zeroOrOneExpr{
-							pos: position{line: 81, col: 66, offset: 3198},
+							pos: position{line: 87, col: 66, offset: 3548},
 							expr: &litMatcher{
-								pos:        position{line: 81, col: 66, offset: 3198},
+								pos:        position{line: 87, col: 66, offset: 3548},
 								val:        ",",
 								ignoreCase: false,
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 81, col: 71, offset: 3203},
+							pos:   position{line: 87, col: 71, offset: 3553},
 							label: "revdate",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 81, col: 79, offset: 3211},
+								pos: position{line: 87, col: 79, offset: 3561},
 								expr: &ruleRefExpr{
-									pos:  position{line: 81, col: 80, offset: 3212},
+									pos:  position{line: 87, col: 80, offset: 3562},
 									name: "DocumentRevisionDate",
 								},
 							},
 						},
 						&zeroOrOneExpr{
-							pos: position{line: 81, col: 103, offset: 3235},
+							pos: position{line: 87, col: 103, offset: 3585},
 							expr: &litMatcher{
-								pos:        position{line: 81, col: 103, offset: 3235},
+								pos:        position{line: 87, col: 103, offset: 3585},
 								val:        ":",
 								ignoreCase: false,
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 81, col: 108, offset: 3240},
+							pos:   position{line: 87, col: 108, offset: 3590},
 							label: "revremark",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 81, col: 118, offset: 3250},
+								pos: position{line: 87, col: 118, offset: 3600},
 								expr: &ruleRefExpr{
-									pos:  position{line: 81, col: 119, offset: 3251},
+									pos:  position{line: 87, col: 119, offset: 3601},
 									name: "DocumentRevisionRemark",
 								},
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 81, col: 144, offset: 3276},
+							pos:  position{line: 87, col: 144, offset: 3626},
 							name: "EOL",
 						},
 					},
@@ -744,52 +793,52 @@ var g = &grammar{
 		},
 		{
 			name: "DocumentRevisionNumber",
-			pos:  position{line: 86, col: 1, offset: 3449},
+			pos:  position{line: 92, col: 1, offset: 3799},
 			expr: &choiceExpr{
-				pos: position{line: 86, col: 27, offset: 3475},
+				pos: position{line: 92, col: 27, offset: 3825},
 				alternatives: []interface{}{
 					&seqExpr{
-						pos: position{line: 86, col: 27, offset: 3475},
+						pos: position{line: 92, col: 27, offset: 3825},
 						exprs: []interface{}{
 							&litMatcher{
-								pos:        position{line: 86, col: 27, offset: 3475},
+								pos:        position{line: 92, col: 27, offset: 3825},
 								val:        "v",
 								ignoreCase: true,
 							},
 							&ruleRefExpr{
-								pos:  position{line: 86, col: 32, offset: 3480},
+								pos:  position{line: 92, col: 32, offset: 3830},
 								name: "DIGIT",
 							},
 							&zeroOrMoreExpr{
-								pos: position{line: 86, col: 39, offset: 3487},
+								pos: position{line: 92, col: 39, offset: 3837},
 								expr: &seqExpr{
-									pos: position{line: 86, col: 40, offset: 3488},
+									pos: position{line: 92, col: 40, offset: 3838},
 									exprs: []interface{}{
 										&notExpr{
-											pos: position{line: 86, col: 40, offset: 3488},
+											pos: position{line: 92, col: 40, offset: 3838},
 											expr: &ruleRefExpr{
-												pos:  position{line: 86, col: 41, offset: 3489},
+												pos:  position{line: 92, col: 41, offset: 3839},
 												name: "EOL",
 											},
 										},
 										&notExpr{
-											pos: position{line: 86, col: 45, offset: 3493},
+											pos: position{line: 92, col: 45, offset: 3843},
 											expr: &litMatcher{
-												pos:        position{line: 86, col: 46, offset: 3494},
+												pos:        position{line: 92, col: 46, offset: 3844},
 												val:        ",",
 												ignoreCase: false,
 											},
 										},
 										&notExpr{
-											pos: position{line: 86, col: 50, offset: 3498},
+											pos: position{line: 92, col: 50, offset: 3848},
 											expr: &litMatcher{
-												pos:        position{line: 86, col: 51, offset: 3499},
+												pos:        position{line: 92, col: 51, offset: 3849},
 												val:        ":",
 												ignoreCase: false,
 											},
 										},
 										&anyMatcher{
-											line: 86, col: 55, offset: 3503,
+											line: 92, col: 55, offset: 3853,
 										},
 									},
 								},
@@ -797,65 +846,65 @@ var g = &grammar{
 						},
 					},
 					&seqExpr{
-						pos: position{line: 86, col: 61, offset: 3509},
+						pos: position{line: 92, col: 61, offset: 3859},
 						exprs: []interface{}{
 							&zeroOrOneExpr{
-								pos: position{line: 86, col: 61, offset: 3509},
+								pos: position{line: 92, col: 61, offset: 3859},
 								expr: &litMatcher{
-									pos:        position{line: 86, col: 61, offset: 3509},
+									pos:        position{line: 92, col: 61, offset: 3859},
 									val:        "v",
 									ignoreCase: true,
 								},
 							},
 							&ruleRefExpr{
-								pos:  position{line: 86, col: 67, offset: 3515},
+								pos:  position{line: 92, col: 67, offset: 3865},
 								name: "DIGIT",
 							},
 							&zeroOrMoreExpr{
-								pos: position{line: 86, col: 74, offset: 3522},
+								pos: position{line: 92, col: 74, offset: 3872},
 								expr: &seqExpr{
-									pos: position{line: 86, col: 75, offset: 3523},
+									pos: position{line: 92, col: 75, offset: 3873},
 									exprs: []interface{}{
 										&notExpr{
-											pos: position{line: 86, col: 75, offset: 3523},
+											pos: position{line: 92, col: 75, offset: 3873},
 											expr: &ruleRefExpr{
-												pos:  position{line: 86, col: 76, offset: 3524},
+												pos:  position{line: 92, col: 76, offset: 3874},
 												name: "EOL",
 											},
 										},
 										&notExpr{
-											pos: position{line: 86, col: 80, offset: 3528},
+											pos: position{line: 92, col: 80, offset: 3878},
 											expr: &litMatcher{
-												pos:        position{line: 86, col: 81, offset: 3529},
+												pos:        position{line: 92, col: 81, offset: 3879},
 												val:        ",",
 												ignoreCase: false,
 											},
 										},
 										&notExpr{
-											pos: position{line: 86, col: 85, offset: 3533},
+											pos: position{line: 92, col: 85, offset: 3883},
 											expr: &litMatcher{
-												pos:        position{line: 86, col: 86, offset: 3534},
+												pos:        position{line: 92, col: 86, offset: 3884},
 												val:        ":",
 												ignoreCase: false,
 											},
 										},
 										&anyMatcher{
-											line: 86, col: 90, offset: 3538,
+											line: 92, col: 90, offset: 3888,
 										},
 									},
 								},
 							},
 							&zeroOrMoreExpr{
-								pos: position{line: 86, col: 94, offset: 3542},
+								pos: position{line: 92, col: 94, offset: 3892},
 								expr: &ruleRefExpr{
-									pos:  position{line: 86, col: 94, offset: 3542},
+									pos:  position{line: 92, col: 94, offset: 3892},
 									name: "WS",
 								},
 							},
 							&andExpr{
-								pos: position{line: 86, col: 98, offset: 3546},
+								pos: position{line: 92, col: 98, offset: 3896},
 								expr: &litMatcher{
-									pos:        position{line: 86, col: 99, offset: 3547},
+									pos:        position{line: 92, col: 99, offset: 3897},
 									val:        ",",
 									ignoreCase: false,
 								},
@@ -867,29 +916,29 @@ var g = &grammar{
 		},
 		{
 			name: "DocumentRevisionDate",
-			pos:  position{line: 87, col: 1, offset: 3551},
+			pos:  position{line: 93, col: 1, offset: 3901},
 			expr: &zeroOrMoreExpr{
-				pos: position{line: 87, col: 25, offset: 3575},
+				pos: position{line: 93, col: 25, offset: 3925},
 				expr: &seqExpr{
-					pos: position{line: 87, col: 26, offset: 3576},
+					pos: position{line: 93, col: 26, offset: 3926},
 					exprs: []interface{}{
 						&notExpr{
-							pos: position{line: 87, col: 26, offset: 3576},
+							pos: position{line: 93, col: 26, offset: 3926},
 							expr: &ruleRefExpr{
-								pos:  position{line: 87, col: 27, offset: 3577},
+								pos:  position{line: 93, col: 27, offset: 3927},
 								name: "EOL",
 							},
 						},
 						&notExpr{
-							pos: position{line: 87, col: 31, offset: 3581},
+							pos: position{line: 93, col: 31, offset: 3931},
 							expr: &litMatcher{
-								pos:        position{line: 87, col: 32, offset: 3582},
+								pos:        position{line: 93, col: 32, offset: 3932},
 								val:        ":",
 								ignoreCase: false,
 							},
 						},
 						&anyMatcher{
-							line: 87, col: 36, offset: 3586,
+							line: 93, col: 36, offset: 3936,
 						},
 					},
 				},
@@ -897,21 +946,21 @@ var g = &grammar{
 		},
 		{
 			name: "DocumentRevisionRemark",
-			pos:  position{line: 88, col: 1, offset: 3591},
+			pos:  position{line: 94, col: 1, offset: 3941},
 			expr: &zeroOrMoreExpr{
-				pos: position{line: 88, col: 27, offset: 3617},
+				pos: position{line: 94, col: 27, offset: 3967},
 				expr: &seqExpr{
-					pos: position{line: 88, col: 28, offset: 3618},
+					pos: position{line: 94, col: 28, offset: 3968},
 					exprs: []interface{}{
 						&notExpr{
-							pos: position{line: 88, col: 28, offset: 3618},
+							pos: position{line: 94, col: 28, offset: 3968},
 							expr: &ruleRefExpr{
-								pos:  position{line: 88, col: 29, offset: 3619},
+								pos:  position{line: 94, col: 29, offset: 3969},
 								name: "EOL",
 							},
 						},
 						&anyMatcher{
-							line: 88, col: 33, offset: 3623,
+							line: 94, col: 33, offset: 3973,
 						},
 					},
 				},
@@ -919,16 +968,16 @@ var g = &grammar{
 		},
 		{
 			name: "DocumentAttributeDeclaration",
-			pos:  position{line: 93, col: 1, offset: 3743},
+			pos:  position{line: 99, col: 1, offset: 4093},
 			expr: &choiceExpr{
-				pos: position{line: 93, col: 33, offset: 3775},
+				pos: position{line: 99, col: 33, offset: 4125},
 				alternatives: []interface{}{
 					&ruleRefExpr{
-						pos:  position{line: 93, col: 33, offset: 3775},
+						pos:  position{line: 99, col: 33, offset: 4125},
 						name: "DocumentAttributeDeclarationWithNameOnly",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 93, col: 76, offset: 3818},
+						pos:  position{line: 99, col: 76, offset: 4168},
 						name: "DocumentAttributeDeclarationWithNameAndValue",
 					},
 				},
@@ -936,40 +985,40 @@ var g = &grammar{
 		},
 		{
 			name: "DocumentAttributeDeclarationWithNameOnly",
-			pos:  position{line: 95, col: 1, offset: 3865},
+			pos:  position{line: 102, col: 1, offset: 4329},
 			expr: &actionExpr{
-				pos: position{line: 95, col: 45, offset: 3909},
+				pos: position{line: 102, col: 45, offset: 4373},
 				run: (*parser).callonDocumentAttributeDeclarationWithNameOnly1,
 				expr: &seqExpr{
-					pos: position{line: 95, col: 45, offset: 3909},
+					pos: position{line: 102, col: 45, offset: 4373},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 95, col: 45, offset: 3909},
+							pos:        position{line: 102, col: 45, offset: 4373},
 							val:        ":",
 							ignoreCase: false,
 						},
 						&labeledExpr{
-							pos:   position{line: 95, col: 49, offset: 3913},
+							pos:   position{line: 102, col: 49, offset: 4377},
 							label: "name",
 							expr: &ruleRefExpr{
-								pos:  position{line: 95, col: 55, offset: 3919},
+								pos:  position{line: 102, col: 55, offset: 4383},
 								name: "AttributeName",
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 95, col: 70, offset: 3934},
+							pos:        position{line: 102, col: 70, offset: 4398},
 							val:        ":",
 							ignoreCase: false,
 						},
 						&zeroOrMoreExpr{
-							pos: position{line: 95, col: 74, offset: 3938},
+							pos: position{line: 102, col: 74, offset: 4402},
 							expr: &ruleRefExpr{
-								pos:  position{line: 95, col: 74, offset: 3938},
+								pos:  position{line: 102, col: 74, offset: 4402},
 								name: "WS",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 95, col: 78, offset: 3942},
+							pos:  position{line: 102, col: 78, offset: 4406},
 							name: "EOL",
 						},
 					},
@@ -978,62 +1027,62 @@ var g = &grammar{
 		},
 		{
 			name: "DocumentAttributeDeclarationWithNameAndValue",
-			pos:  position{line: 99, col: 1, offset: 4027},
+			pos:  position{line: 111, col: 1, offset: 4615},
 			expr: &actionExpr{
-				pos: position{line: 99, col: 49, offset: 4075},
+				pos: position{line: 111, col: 49, offset: 4663},
 				run: (*parser).callonDocumentAttributeDeclarationWithNameAndValue1,
 				expr: &seqExpr{
-					pos: position{line: 99, col: 49, offset: 4075},
+					pos: position{line: 111, col: 49, offset: 4663},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 99, col: 49, offset: 4075},
+							pos:        position{line: 111, col: 49, offset: 4663},
 							val:        ":",
 							ignoreCase: false,
 						},
 						&labeledExpr{
-							pos:   position{line: 99, col: 53, offset: 4079},
+							pos:   position{line: 111, col: 53, offset: 4667},
 							label: "name",
 							expr: &ruleRefExpr{
-								pos:  position{line: 99, col: 59, offset: 4085},
+								pos:  position{line: 111, col: 59, offset: 4673},
 								name: "AttributeName",
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 99, col: 74, offset: 4100},
+							pos:        position{line: 111, col: 74, offset: 4688},
 							val:        ":",
 							ignoreCase: false,
 						},
 						&oneOrMoreExpr{
-							pos: position{line: 99, col: 78, offset: 4104},
+							pos: position{line: 111, col: 78, offset: 4692},
 							expr: &ruleRefExpr{
-								pos:  position{line: 99, col: 78, offset: 4104},
+								pos:  position{line: 111, col: 78, offset: 4692},
 								name: "WS",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 99, col: 82, offset: 4108},
+							pos:   position{line: 111, col: 82, offset: 4696},
 							label: "value",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 99, col: 88, offset: 4114},
+								pos: position{line: 111, col: 88, offset: 4702},
 								expr: &seqExpr{
-									pos: position{line: 99, col: 89, offset: 4115},
+									pos: position{line: 111, col: 89, offset: 4703},
 									exprs: []interface{}{
 										&notExpr{
-											pos: position{line: 99, col: 89, offset: 4115},
+											pos: position{line: 111, col: 89, offset: 4703},
 											expr: &ruleRefExpr{
-												pos:  position{line: 99, col: 90, offset: 4116},
+												pos:  position{line: 111, col: 90, offset: 4704},
 												name: "NEWLINE",
 											},
 										},
 										&anyMatcher{
-											line: 99, col: 98, offset: 4124,
+											line: 111, col: 98, offset: 4712,
 										},
 									},
 								},
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 99, col: 102, offset: 4128},
+							pos:  position{line: 111, col: 102, offset: 4716},
 							name: "EOL",
 						},
 					},
@@ -1042,16 +1091,16 @@ var g = &grammar{
 		},
 		{
 			name: "DocumentAttributeReset",
-			pos:  position{line: 103, col: 1, offset: 4231},
+			pos:  position{line: 120, col: 1, offset: 4943},
 			expr: &choiceExpr{
-				pos: position{line: 103, col: 27, offset: 4257},
+				pos: position{line: 120, col: 27, offset: 4969},
 				alternatives: []interface{}{
 					&ruleRefExpr{
-						pos:  position{line: 103, col: 27, offset: 4257},
+						pos:  position{line: 120, col: 27, offset: 4969},
 						name: "DocumentAttributeResetWithSectionTitleBangSymbol",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 103, col: 78, offset: 4308},
+						pos:  position{line: 120, col: 78, offset: 5020},
 						name: "DocumentAttributeResetWithTrailingBangSymbol",
 					},
 				},
@@ -1059,40 +1108,40 @@ var g = &grammar{
 		},
 		{
 			name: "DocumentAttributeResetWithSectionTitleBangSymbol",
-			pos:  position{line: 105, col: 1, offset: 4354},
+			pos:  position{line: 122, col: 1, offset: 5066},
 			expr: &actionExpr{
-				pos: position{line: 105, col: 53, offset: 4406},
+				pos: position{line: 122, col: 53, offset: 5118},
 				run: (*parser).callonDocumentAttributeResetWithSectionTitleBangSymbol1,
 				expr: &seqExpr{
-					pos: position{line: 105, col: 53, offset: 4406},
+					pos: position{line: 122, col: 53, offset: 5118},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 105, col: 53, offset: 4406},
+							pos:        position{line: 122, col: 53, offset: 5118},
 							val:        ":!",
 							ignoreCase: false,
 						},
 						&labeledExpr{
-							pos:   position{line: 105, col: 58, offset: 4411},
+							pos:   position{line: 122, col: 58, offset: 5123},
 							label: "name",
 							expr: &ruleRefExpr{
-								pos:  position{line: 105, col: 64, offset: 4417},
+								pos:  position{line: 122, col: 64, offset: 5129},
 								name: "AttributeName",
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 105, col: 79, offset: 4432},
+							pos:        position{line: 122, col: 79, offset: 5144},
 							val:        ":",
 							ignoreCase: false,
 						},
 						&zeroOrMoreExpr{
-							pos: position{line: 105, col: 83, offset: 4436},
+							pos: position{line: 122, col: 83, offset: 5148},
 							expr: &ruleRefExpr{
-								pos:  position{line: 105, col: 83, offset: 4436},
+								pos:  position{line: 122, col: 83, offset: 5148},
 								name: "WS",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 105, col: 87, offset: 4440},
+							pos:  position{line: 122, col: 87, offset: 5152},
 							name: "EOL",
 						},
 					},
@@ -1101,40 +1150,40 @@ var g = &grammar{
 		},
 		{
 			name: "DocumentAttributeResetWithTrailingBangSymbol",
-			pos:  position{line: 109, col: 1, offset: 4514},
+			pos:  position{line: 131, col: 1, offset: 5344},
 			expr: &actionExpr{
-				pos: position{line: 109, col: 49, offset: 4562},
+				pos: position{line: 131, col: 49, offset: 5392},
 				run: (*parser).callonDocumentAttributeResetWithTrailingBangSymbol1,
 				expr: &seqExpr{
-					pos: position{line: 109, col: 49, offset: 4562},
+					pos: position{line: 131, col: 49, offset: 5392},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 109, col: 49, offset: 4562},
+							pos:        position{line: 131, col: 49, offset: 5392},
 							val:        ":",
 							ignoreCase: false,
 						},
 						&labeledExpr{
-							pos:   position{line: 109, col: 53, offset: 4566},
+							pos:   position{line: 131, col: 53, offset: 5396},
 							label: "name",
 							expr: &ruleRefExpr{
-								pos:  position{line: 109, col: 59, offset: 4572},
+								pos:  position{line: 131, col: 59, offset: 5402},
 								name: "AttributeName",
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 109, col: 74, offset: 4587},
+							pos:        position{line: 131, col: 74, offset: 5417},
 							val:        "!:",
 							ignoreCase: false,
 						},
 						&zeroOrMoreExpr{
-							pos: position{line: 109, col: 79, offset: 4592},
+							pos: position{line: 131, col: 79, offset: 5422},
 							expr: &ruleRefExpr{
-								pos:  position{line: 109, col: 79, offset: 4592},
+								pos:  position{line: 131, col: 79, offset: 5422},
 								name: "WS",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 109, col: 83, offset: 4596},
+							pos:  position{line: 131, col: 83, offset: 5426},
 							name: "EOL",
 						},
 					},
@@ -1143,28 +1192,32 @@ var g = &grammar{
 		},
 		{
 			name: "DocumentAttributeSubstitution",
-			pos:  position{line: 113, col: 1, offset: 4670},
+			pos:  position{line: 140, col: 1, offset: 5618},
 			expr: &actionExpr{
-				pos: position{line: 113, col: 34, offset: 4703},
+				pos: position{line: 140, col: 34, offset: 5651},
 				run: (*parser).callonDocumentAttributeSubstitution1,
 				expr: &seqExpr{
-					pos: position{line: 113, col: 34, offset: 4703},
+					pos: position{line: 140, col: 34, offset: 5651},
 					exprs: []interface{}{
+						&andCodeExpr{
+							pos: position{line: 140, col: 34, offset: 5651},
+							run: (*parser).callonDocumentAttributeSubstitution3,
+						},
 						&litMatcher{
-							pos:        position{line: 113, col: 34, offset: 4703},
+							pos:        position{line: 140, col: 106, offset: 5723},
 							val:        "{",
 							ignoreCase: false,
 						},
 						&labeledExpr{
-							pos:   position{line: 113, col: 38, offset: 4707},
+							pos:   position{line: 140, col: 110, offset: 5727},
 							label: "name",
 							expr: &ruleRefExpr{
-								pos:  position{line: 113, col: 44, offset: 4713},
+								pos:  position{line: 140, col: 116, offset: 5733},
 								name: "AttributeName",
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 113, col: 59, offset: 4728},
+							pos:        position{line: 140, col: 131, offset: 5748},
 							val:        "}",
 							ignoreCase: false,
 						},
@@ -1174,69 +1227,69 @@ var g = &grammar{
 		},
 		{
 			name: "AttributeName",
-			pos:  position{line: 120, col: 1, offset: 4982},
+			pos:  position{line: 147, col: 1, offset: 6002},
 			expr: &seqExpr{
-				pos: position{line: 120, col: 18, offset: 4999},
+				pos: position{line: 147, col: 18, offset: 6019},
 				exprs: []interface{}{
 					&choiceExpr{
-						pos: position{line: 120, col: 19, offset: 5000},
+						pos: position{line: 147, col: 19, offset: 6020},
 						alternatives: []interface{}{
 							&charClassMatcher{
-								pos:        position{line: 120, col: 19, offset: 5000},
+								pos:        position{line: 147, col: 19, offset: 6020},
 								val:        "[A-Z]",
 								ranges:     []rune{'A', 'Z'},
 								ignoreCase: false,
 								inverted:   false,
 							},
 							&charClassMatcher{
-								pos:        position{line: 120, col: 27, offset: 5008},
+								pos:        position{line: 147, col: 27, offset: 6028},
 								val:        "[a-z]",
 								ranges:     []rune{'a', 'z'},
 								ignoreCase: false,
 								inverted:   false,
 							},
 							&charClassMatcher{
-								pos:        position{line: 120, col: 35, offset: 5016},
+								pos:        position{line: 147, col: 35, offset: 6036},
 								val:        "[0-9]",
 								ranges:     []rune{'0', '9'},
 								ignoreCase: false,
 								inverted:   false,
 							},
 							&litMatcher{
-								pos:        position{line: 120, col: 43, offset: 5024},
+								pos:        position{line: 147, col: 43, offset: 6044},
 								val:        "_",
 								ignoreCase: false,
 							},
 						},
 					},
 					&zeroOrMoreExpr{
-						pos: position{line: 120, col: 48, offset: 5029},
+						pos: position{line: 147, col: 48, offset: 6049},
 						expr: &choiceExpr{
-							pos: position{line: 120, col: 49, offset: 5030},
+							pos: position{line: 147, col: 49, offset: 6050},
 							alternatives: []interface{}{
 								&charClassMatcher{
-									pos:        position{line: 120, col: 49, offset: 5030},
+									pos:        position{line: 147, col: 49, offset: 6050},
 									val:        "[A-Z]",
 									ranges:     []rune{'A', 'Z'},
 									ignoreCase: false,
 									inverted:   false,
 								},
 								&charClassMatcher{
-									pos:        position{line: 120, col: 57, offset: 5038},
+									pos:        position{line: 147, col: 57, offset: 6058},
 									val:        "[a-z]",
 									ranges:     []rune{'a', 'z'},
 									ignoreCase: false,
 									inverted:   false,
 								},
 								&charClassMatcher{
-									pos:        position{line: 120, col: 65, offset: 5046},
+									pos:        position{line: 147, col: 65, offset: 6066},
 									val:        "[0-9]",
 									ranges:     []rune{'0', '9'},
 									ignoreCase: false,
 									inverted:   false,
 								},
 								&litMatcher{
-									pos:        position{line: 120, col: 73, offset: 5054},
+									pos:        position{line: 147, col: 73, offset: 6074},
 									val:        "-",
 									ignoreCase: false,
 								},
@@ -1248,75 +1301,260 @@ var g = &grammar{
 		},
 		{
 			name: "TableOfContentsMacro",
-			pos:  position{line: 125, col: 1, offset: 5174},
+			pos:  position{line: 152, col: 1, offset: 6194},
 			expr: &seqExpr{
-				pos: position{line: 125, col: 25, offset: 5198},
+				pos: position{line: 152, col: 25, offset: 6218},
 				exprs: []interface{}{
 					&litMatcher{
-						pos:        position{line: 125, col: 25, offset: 5198},
+						pos:        position{line: 152, col: 25, offset: 6218},
 						val:        "toc::[]",
 						ignoreCase: false,
 					},
 					&ruleRefExpr{
-						pos:  position{line: 125, col: 35, offset: 5208},
+						pos:  position{line: 152, col: 35, offset: 6228},
 						name: "NEWLINE",
 					},
 				},
 			},
 		},
+		{
+			name: "ThematicBreak",
+			pos:  position{line: 157, col: 1, offset: 6357},
+			expr: &actionExpr{
+				pos: position{line: 157, col: 18, offset: 6374},
+				run: (*parser).callonThematicBreak1,
+				expr: &seqExpr{
+					pos: position{line: 157, col: 18, offset: 6374},
+					exprs: []interface{}{
+						&choiceExpr{
+							pos: position{line: 157, col: 19, offset: 6375},
+							alternatives: []interface{}{
+								&litMatcher{
+									pos:        position{line: 157, col: 19, offset: 6375},
+									val:        "'''",
+									ignoreCase: false,
+								},
+								&litMatcher{
+									pos:        position{line: 157, col: 27, offset: 6383},
+									val:        "---",
+									ignoreCase: false,
+								},
+								&litMatcher{
+									pos:        position{line: 157, col: 35, offset: 6391},
+									val:        "- - -",
+									ignoreCase: false,
+								},
+								&litMatcher{
+									pos:        position{line: 157, col: 45, offset: 6401},
+									val:        "***",
+									ignoreCase: false,
+								},
+								&litMatcher{
+									pos:        position{line: 157, col: 53, offset: 6409},
+									val:        "* * *",
+									ignoreCase: false,
+								},
+							},
+						},
+						&zeroOrMoreExpr{
+							pos: position{line: 157, col: 62, offset: 6418},
+							expr: &ruleRefExpr{
+								pos:  position{line: 157, col: 62, offset: 6418},
+								name: "WS",
+							},
+						},
+						&ruleRefExpr{
+							pos:  position{line: 157, col: 66, offset: 6422},
+							name: "EOL",
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "PageBreak",
+			pos:  position{line: 161, col: 1, offset: 6467},
+			expr: &actionExpr{
+				pos: position{line: 161, col: 14, offset: 6480},
+				run: (*parser).callonPageBreak1,
+				expr: &seqExpr{
+					pos: position{line: 161, col: 14, offset: 6480},
+					exprs: []interface{}{
+						&litMatcher{
+							pos:        position{line: 161, col: 14, offset: 6480},
+							val:        "<<<",
+							ignoreCase: false,
+						},
+						&zeroOrMoreExpr{
+							pos: position{line: 161, col: 20, offset: 6486},
+							expr: &ruleRefExpr{
+								pos:  position{line: 161, col: 20, offset: 6486},
+								name: "WS",
+							},
+						},
+						&ruleRefExpr{
+							pos:  position{line: 161, col: 24, offset: 6490},
+							name: "EOL",
+						},
+					},
+				},
+			},
+		},
 		{
 			name: "Section",
-			pos:  position{line: 130, col: 1, offset: 5321},
+			pos:  position{line: 168, col: 1, offset: 6635},
 			expr: &choiceExpr{
-				pos: position{line: 130, col: 12, offset: 5332},
+				pos: position{line: 168, col: 12, offset: 6646},
 				alternatives: []interface{}{
 					&ruleRefExpr{
-						pos:  position{line: 130, col: 12, offset: 5332},
+						pos:  position{line: 168, col: 12, offset: 6646},
+						name: "Section0",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 168, col: 23, offset: 6657},
 						name: "Section1",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 130, col: 23, offset: 5343},
+						pos:  position{line: 168, col: 34, offset: 6668},
 						name: "Section2",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 130, col: 34, offset: 5354},
+						pos:  position{line: 168, col: 45, offset: 6679},
 						name: "Section3",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 130, col: 45, offset: 5365},
+						pos:  position{line: 168, col: 56, offset: 6690},
 						name: "Section4",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 130, col: 56, offset: 5376},
+						pos:  position{line: 168, col: 67, offset: 6701},
 						name: "Section5",
 					},
 				},
 			},
 		},
+		{
+			name: "Section0",
+			pos:  position{line: 171, col: 1, offset: 6784},
+			expr: &actionExpr{
+				pos: position{line: 171, col: 13, offset: 6796},
+				run: (*parser).callonSection01,
+				expr: &seqExpr{
+					pos: position{line: 171, col: 13, offset: 6796},
+					exprs: []interface{}{
+						&andCodeExpr{
+							pos: position{line: 171, col: 13, offset: 6796},
+							run: (*parser).callonSection03,
+						},
+						&labeledExpr{
+							pos:   position{line: 171, col: 47, offset: 6830},
+							label: "header",
+							expr: &ruleRefExpr{
+								pos:  position{line: 171, col: 55, offset: 6838},
+								name: "Section0Title",
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 171, col: 70, offset: 6853},
+							label: "elements",
+							expr: &zeroOrMoreExpr{
+								pos: position{line: 171, col: 80, offset: 6863},
+								expr: &ruleRefExpr{
+									pos:  position{line: 171, col: 80, offset: 6863},
+									name: "Section0Block",
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "Section0Block",
+			pos:  position{line: 175, col: 1, offset: 6970},
+			expr: &actionExpr{
+				pos: position{line: 175, col: 18, offset: 6987},
+				run: (*parser).callonSection0Block1,
+				expr: &seqExpr{
+					pos: position{line: 175, col: 18, offset: 6987},
+					exprs: []interface{}{
+						&notExpr{
+							pos: position{line: 175, col: 18, offset: 6987},
+							expr: &ruleRefExpr{
+								pos:  position{line: 175, col: 19, offset: 6988},
+								name: "Section0",
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 175, col: 28, offset: 6997},
+							label: "content",
+							expr: &choiceExpr{
+								pos: position{line: 175, col: 37, offset: 7006},
+								alternatives: []interface{}{
+									&ruleRefExpr{
+										pos:  position{line: 175, col: 37, offset: 7006},
+										name: "Section1",
+									},
+									&ruleRefExpr{
+										pos:  position{line: 175, col: 48, offset: 7017},
+										name: "Section2",
+									},
+									&ruleRefExpr{
+										pos:  position{line: 175, col: 59, offset: 7028},
+										name: "Section3",
+									},
+									&ruleRefExpr{
+										pos:  position{line: 175, col: 70, offset: 7039},
+										name: "Section4",
+									},
+									&ruleRefExpr{
+										pos:  position{line: 175, col: 81, offset: 7050},
+										name: "Section5",
+									},
+									&ruleRefExpr{
+										pos:  position{line: 175, col: 92, offset: 7061},
+										name: "BlockElement",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
 		{
 			name: "Section1",
-			pos:  position{line: 133, col: 1, offset: 5387},
+			pos:  position{line: 179, col: 1, offset: 7123},
 			expr: &actionExpr{
-				pos: position{line: 133, col: 13, offset: 5399},
+				pos: position{line: 179, col: 13, offset: 7135},
 				run: (*parser).callonSection11,
 				expr: &seqExpr{
-					pos: position{line: 133, col: 13, offset: 5399},
+					pos: position{line: 179, col: 13, offset: 7135},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 133, col: 13, offset: 5399},
+							pos:   position{line: 179, col: 13, offset: 7135},
 							label: "header",
-							expr: &ruleRefExpr{
-								pos:  position{line: 133, col: 21, offset: 5407},
-								name: "Section1Title",
+							expr: &choiceExpr{
+								pos: position{line: 179, col: 21, offset: 7143},
+								alternatives: []interface{}{
+									&ruleRefExpr{
+										pos:  position{line: 179, col: 21, offset: 7143},
+										name: "Section1Title",
+									},
+									&ruleRefExpr{
+										pos:  position{line: 179, col: 37, offset: 7159},
+										name: "InvalidSection0Title",
+									},
+								},
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 133, col: 36, offset: 5422},
+							pos:   position{line: 179, col: 59, offset: 7181},
 							label: "elements",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 133, col: 46, offset: 5432},
+								pos: position{line: 179, col: 69, offset: 7191},
 								expr: &ruleRefExpr{
-									pos:  position{line: 133, col: 46, offset: 5432},
+									pos:  position{line: 179, col: 69, offset: 7191},
 									name: "Section1Block",
 								},
 							},
@@ -1327,44 +1565,51 @@ var g = &grammar{
 		},
 		{
 			name: "Section1Block",
-			pos:  position{line: 137, col: 1, offset: 5539},
+			pos:  position{line: 183, col: 1, offset: 7298},
 			expr: &actionExpr{
-				pos: position{line: 137, col: 18, offset: 5556},
+				pos: position{line: 183, col: 18, offset: 7315},
 				run: (*parser).callonSection1Block1,
 				expr: &seqExpr{
-					pos: position{line: 137, col: 18, offset: 5556},
+					pos: position{line: 183, col: 18, offset: 7315},
 					exprs: []interface{}{
 						&notExpr{
-							pos: position{line: 137, col: 18, offset: 5556},
+							pos: position{line: 183, col: 18, offset: 7315},
+							expr: &ruleRefExpr{
+								pos:  position{line: 183, col: 19, offset: 7316},
+								name: "Section0",
+							},
+						},
+						&notExpr{
+							pos: position{line: 183, col: 28, offset: 7325},
 							expr: &ruleRefExpr{
-								pos:  position{line: 137, col: 19, offset: 5557},
+								pos:  position{line: 183, col: 29, offset: 7326},
 								name: "Section1",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 137, col: 28, offset: 5566},
+							pos:   position{line: 183, col: 38, offset: 7335},
 							label: "content",
 							expr: &choiceExpr{
-								pos: position{line: 137, col: 37, offset: 5575},
+								pos: position{line: 183, col: 47, offset: 7344},
 								alternatives: []interface{}{
 									&ruleRefExpr{
-										pos:  position{line: 137, col: 37, offset: 5575},
+										pos:  position{line: 183, col: 47, offset: 7344},
 										name: "Section2",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 137, col: 48, offset: 5586},
+										pos:  position{line: 183, col: 58, offset: 7355},
 										name: "Section3",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 137, col: 59, offset: 5597},
+										pos:  position{line: 183, col: 69, offset: 7366},
 										name: "Section4",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 137, col: 70, offset: 5608},
+										pos:  position{line: 183, col: 80, offset: 7377},
 										name: "Section5",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 137, col: 81, offset: 5619},
+										pos:  position{line: 183, col: 91, offset: 7388},
 										name: "BlockElement",
 									},
 								},
@@ -1376,38 +1621,38 @@ var g = &grammar{
 		},
 		{
 			name: "Section2",
-			pos:  position{line: 141, col: 1, offset: 5681},
+			pos:  position{line: 187, col: 1, offset: 7450},
 			expr: &actionExpr{
-				pos: position{line: 141, col: 13, offset: 5693},
+				pos: position{line: 187, col: 13, offset: 7462},
 				run: (*parser).callonSection21,
 				expr: &seqExpr{
-					pos: position{line: 141, col: 13, offset: 5693},
+					pos: position{line: 187, col: 13, offset: 7462},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 141, col: 13, offset: 5693},
+							pos:   position{line: 187, col: 13, offset: 7462},
 							label: "header",
 							expr: &ruleRefExpr{
-								pos:  position{line: 141, col: 21, offset: 5701},
+								pos:  position{line: 187, col: 21, offset: 7470},
 								name: "Section2Title",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 141, col: 36, offset: 5716},
+							pos:   position{line: 187, col: 36, offset: 7485},
 							label: "elements",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 141, col: 46, offset: 5726},
+								pos: position{line: 187, col: 46, offset: 7495},
 								expr: &ruleRefExpr{
-									pos:  position{line: 141, col: 46, offset: 5726},
+									pos:  position{line: 187, col: 46, offset: 7495},
 									name: "Section2Block",
 								},
 							},
 						},
 						&andExpr{
-							pos: position{line: 141, col: 62, offset: 5742},
+							pos: position{line: 187, col: 62, offset: 7511},
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 141, col: 63, offset: 5743},
+								pos: position{line: 187, col: 63, offset: 7512},
 								expr: &ruleRefExpr{
-									pos:  position{line: 141, col: 64, offset: 5744},
+									pos:  position{line: 187, col: 64, offset: 7513},
 									name: "Section2",
 								},
 							},
@@ -1418,47 +1663,54 @@ var g = &grammar{
 		},
 		{
 			name: "Section2Block",
-			pos:  position{line: 145, col: 1, offset: 5846},
+			pos:  position{line: 191, col: 1, offset: 7615},
 			expr: &actionExpr{
-				pos: position{line: 145, col: 18, offset: 5863},
+				pos: position{line: 191, col: 18, offset: 7632},
 				run: (*parser).callonSection2Block1,
 				expr: &seqExpr{
-					pos: position{line: 145, col: 18, offset: 5863},
+					pos: position{line: 191, col: 18, offset: 7632},
 					exprs: []interface{}{
 						&notExpr{
-							pos: position{line: 145, col: 18, offset: 5863},
+							pos: position{line: 191, col: 18, offset: 7632},
+							expr: &ruleRefExpr{
+								pos:  position{line: 191, col: 19, offset: 7633},
+								name: "Section0",
+							},
+						},
+						&notExpr{
+							pos: position{line: 191, col: 28, offset: 7642},
 							expr: &ruleRefExpr{
-								pos:  position{line: 145, col: 19, offset: 5864},
+								pos:  position{line: 191, col: 29, offset: 7643},
 								name: "Section1",
 							},
 						},
 						&notExpr{
-							pos: position{line: 145, col: 28, offset: 5873},
+							pos: position{line: 191, col: 38, offset: 7652},
 							expr: &ruleRefExpr{
-								pos:  position{line: 145, col: 29, offset: 5874},
+								pos:  position{line: 191, col: 39, offset: 7653},
 								name: "Section2",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 145, col: 38, offset: 5883},
+							pos:   position{line: 191, col: 48, offset: 7662},
 							label: "content",
 							expr: &choiceExpr{
-								pos: position{line: 145, col: 47, offset: 5892},
+								pos: position{line: 191, col: 57, offset: 7671},
 								alternatives: []interface{}{
 									&ruleRefExpr{
-										pos:  position{line: 145, col: 47, offset: 5892},
+										pos:  position{line: 191, col: 57, offset: 7671},
 										name: "Section3",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 145, col: 58, offset: 5903},
+										pos:  position{line: 191, col: 68, offset: 7682},
 										name: "Section4",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 145, col: 69, offset: 5914},
+										pos:  position{line: 191, col: 79, offset: 7693},
 										name: "Section5",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 145, col: 80, offset: 5925},
+										pos:  position{line: 191, col: 90, offset: 7704},
 										name: "BlockElement",
 									},
 								},
@@ -1470,28 +1722,28 @@ var g = &grammar{
 		},
 		{
 			name: "Section3",
-			pos:  position{line: 149, col: 1, offset: 5987},
+			pos:  position{line: 195, col: 1, offset: 7766},
 			expr: &actionExpr{
-				pos: position{line: 149, col: 13, offset: 5999},
+				pos: position{line: 195, col: 13, offset: 7778},
 				run: (*parser).callonSection31,
 				expr: &seqExpr{
-					pos: position{line: 149, col: 13, offset: 5999},
+					pos: position{line: 195, col: 13, offset: 7778},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 149, col: 13, offset: 5999},
+							pos:   position{line: 195, col: 13, offset: 7778},
 							label: "header",
 							expr: &ruleRefExpr{
-								pos:  position{line: 149, col: 21, offset: 6007},
+								pos:  position{line: 195, col: 21, offset: 7786},
 								name: "Section3Title",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 149, col: 36, offset: 6022},
+							pos:   position{line: 195, col: 36, offset: 7801},
 							label: "elements",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 149, col: 46, offset: 6032},
+								pos: position{line: 195, col: 46, offset: 7811},
 								expr: &ruleRefExpr{
-									pos:  position{line: 149, col: 46, offset: 6032},
+									pos:  position{line: 195, col: 46, offset: 7811},
 									name: "Section3Block",
 								},
 							},
@@ -1502,50 +1754,57 @@ var g = &grammar{
 		},
 		{
 			name: "Section3Block",
-			pos:  position{line: 153, col: 1, offset: 6139},
+			pos:  position{line: 199, col: 1, offset: 7918},
 			expr: &actionExpr{
-				pos: position{line: 153, col: 18, offset: 6156},
+				pos: position{line: 199, col: 18, offset: 7935},
 				run: (*parser).callonSection3Block1,
 				expr: &seqExpr{
-					pos: position{line: 153, col: 18, offset: 6156},
+					pos: position{line: 199, col: 18, offset: 7935},
 					exprs: []interface{}{
 						&notExpr{
-							pos: position{line: 153, col: 18, offset: 6156},
+							pos: position{line: 199, col: 18, offset: 7935},
+							expr: &ruleRefExpr{
+								pos:  position{line: 199, col: 19, offset: 7936},
+								name: "Section0",
+							},
+						},
+						&notExpr{
+							pos: position{line: 199, col: 28, offset: 7945},
 							expr: &ruleRefExpr{
-								pos:  position{line: 153, col: 19, offset: 6157},
+								pos:  position{line: 199, col: 29, offset: 7946},
 								name: "Section1",
 							},
 						},
 						&notExpr{
-							pos: position{line: 153, col: 28, offset: 6166},
+							pos: position{line: 199, col: 38, offset: 7955},
 							expr: &ruleRefExpr{
-								pos:  position{line: 153, col: 29, offset: 6167},
+								pos:  position{line: 199, col: 39, offset: 7956},
 								name: "Section2",
 							},
 						},
 						&notExpr{
-							pos: position{line: 153, col: 38, offset: 6176},
+							pos: position{line: 199, col: 48, offset: 7965},
 							expr: &ruleRefExpr{
-								pos:  position{line: 153, col: 39, offset: 6177},
+								pos:  position{line: 199, col: 49, offset: 7966},
 								name: "Section3",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 153, col: 48, offset: 6186},
+							pos:   position{line: 199, col: 58, offset: 7975},
 							label: "content",
 							expr: &choiceExpr{
-								pos: position{line: 153, col: 57, offset: 6195},
+								pos: position{line: 199, col: 67, offset: 7984},
 								alternatives: []interface{}{
 									&ruleRefExpr{
-										pos:  position{line: 153, col: 57, offset: 6195},
+										pos:  position{line: 199, col: 67, offset: 7984},
 										name: "Section4",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 153, col: 68, offset: 6206},
+										pos:  position{line: 199, col: 78, offset: 7995},
 										name: "Section5",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 153, col: 79, offset: 6217},
+										pos:  position{line: 199, col: 89, offset: 8006},
 										name: "BlockElement",
 									},
 								},
@@ -1557,28 +1816,28 @@ var g = &grammar{
 		},
 		{
 			name: "Section4",
-			pos:  position{line: 157, col: 1, offset: 6279},
+			pos:  position{line: 203, col: 1, offset: 8068},
 			expr: &actionExpr{
-				pos: position{line: 157, col: 13, offset: 6291},
+				pos: position{line: 203, col: 13, offset: 8080},
 				run: (*parser).callonSection41,
 				expr: &seqExpr{
-					pos: position{line: 157, col: 13, offset: 6291},
+					pos: position{line: 203, col: 13, offset: 8080},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 157, col: 13, offset: 6291},
+							pos:   position{line: 203, col: 13, offset: 8080},
 							label: "header",
 							expr: &ruleRefExpr{
-								pos:  position{line: 157, col: 21, offset: 6299},
+								pos:  position{line: 203, col: 21, offset: 8088},
 								name: "Section4Title",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 157, col: 36, offset: 6314},
+							pos:   position{line: 203, col: 36, offset: 8103},
 							label: "elements",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 157, col: 46, offset: 6324},
+								pos: position{line: 203, col: 46, offset: 8113},
 								expr: &ruleRefExpr{
-									pos:  position{line: 157, col: 46, offset: 6324},
+									pos:  position{line: 203, col: 46, offset: 8113},
 									name: "Section4Block",
 								},
 							},
@@ -1589,53 +1848,60 @@ var g = &grammar{
 		},
 		{
 			name: "Section4Block",
-			pos:  position{line: 161, col: 1, offset: 6431},
+			pos:  position{line: 207, col: 1, offset: 8220},
 			expr: &actionExpr{
-				pos: position{line: 161, col: 18, offset: 6448},
+				pos: position{line: 207, col: 18, offset: 8237},
 				run: (*parser).callonSection4Block1,
 				expr: &seqExpr{
-					pos: position{line: 161, col: 18, offset: 6448},
+					pos: position{line: 207, col: 18, offset: 8237},
 					exprs: []interface{}{
 						&notExpr{
-							pos: position{line: 161, col: 18, offset: 6448},
+							pos: position{line: 207, col: 18, offset: 8237},
+							expr: &ruleRefExpr{
+								pos:  position{line: 207, col: 19, offset: 8238},
+								name: "Section0",
+							},
+						},
+						&notExpr{
+							pos: position{line: 207, col: 28, offset: 8247},
 							expr: &ruleRefExpr{
-								pos:  position{line: 161, col: 19, offset: 6449},
+								pos:  position{line: 207, col: 29, offset: 8248},
 								name: "Section1",
 							},
 						},
 						&notExpr{
-							pos: position{line: 161, col: 28, offset: 6458},
+							pos: position{line: 207, col: 38, offset: 8257},
 							expr: &ruleRefExpr{
-								pos:  position{line: 161, col: 29, offset: 6459},
+								pos:  position{line: 207, col: 39, offset: 8258},
 								name: "Section2",
 							},
 						},
 						&notExpr{
-							pos: position{line: 161, col: 38, offset: 6468},
+							pos: position{line: 207, col: 48, offset: 8267},
 							expr: &ruleRefExpr{
-								pos:  position{line: 161, col: 39, offset: 6469},
+								pos:  position{line: 207, col: 49, offset: 8268},
 								name: "Section3",
 							},
 						},
 						&notExpr{
-							pos: position{line: 161, col: 48, offset: 6478},
+							pos: position{line: 207, col: 58, offset: 8277},
 							expr: &ruleRefExpr{
-								pos:  position{line: 161, col: 49, offset: 6479},
+								pos:  position{line: 207, col: 59, offset: 8278},
 								name: "Section4",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 161, col: 58, offset: 6488},
+							pos:   position{line: 207, col: 68, offset: 8287},
 							label: "content",
 							expr: &choiceExpr{
-								pos: position{line: 161, col: 67, offset: 6497},
+								pos: position{line: 207, col: 77, offset: 8296},
 								alternatives: []interface{}{
 									&ruleRefExpr{
-										pos:  position{line: 161, col: 67, offset: 6497},
+										pos:  position{line: 207, col: 77, offset: 8296},
 										name: "Section5",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 161, col: 78, offset: 6508},
+										pos:  position{line: 207, col: 88, offset: 8307},
 										name: "BlockElement",
 									},
 								},
@@ -1647,28 +1913,28 @@ var g = &grammar{
 		},
 		{
 			name: "Section5",
-			pos:  position{line: 165, col: 1, offset: 6570},
+			pos:  position{line: 211, col: 1, offset: 8369},
 			expr: &actionExpr{
-				pos: position{line: 165, col: 13, offset: 6582},
+				pos: position{line: 211, col: 13, offset: 8381},
 				run: (*parser).callonSection51,
 				expr: &seqExpr{
-					pos: position{line: 165, col: 13, offset: 6582},
+					pos: position{line: 211, col: 13, offset: 8381},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 165, col: 13, offset: 6582},
+							pos:   position{line: 211, col: 13, offset: 8381},
 							label: "header",
 							expr: &ruleRefExpr{
-								pos:  position{line: 165, col: 21, offset: 6590},
+								pos:  position{line: 211, col: 21, offset: 8389},
 								name: "Section5Title",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 165, col: 36, offset: 6605},
+							pos:   position{line: 211, col: 36, offset: 8404},
 							label: "elements",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 165, col: 46, offset: 6615},
+								pos: position{line: 211, col: 46, offset: 8414},
 								expr: &ruleRefExpr{
-									pos:  position{line: 165, col: 46, offset: 6615},
+									pos:  position{line: 211, col: 46, offset: 8414},
 									name: "Section5Block",
 								},
 							},
@@ -1679,53 +1945,60 @@ var g = &grammar{
 		},
 		{
 			name: "Section5Block",
-			pos:  position{line: 169, col: 1, offset: 6722},
+			pos:  position{line: 215, col: 1, offset: 8521},
 			expr: &actionExpr{
-				pos: position{line: 169, col: 18, offset: 6739},
+				pos: position{line: 215, col: 18, offset: 8538},
 				run: (*parser).callonSection5Block1,
 				expr: &seqExpr{
-					pos: position{line: 169, col: 18, offset: 6739},
+					pos: position{line: 215, col: 18, offset: 8538},
 					exprs: []interface{}{
 						&notExpr{
-							pos: position{line: 169, col: 18, offset: 6739},
+							pos: position{line: 215, col: 18, offset: 8538},
+							expr: &ruleRefExpr{
+								pos:  position{line: 215, col: 19, offset: 8539},
+								name: "Section0",
+							},
+						},
+						&notExpr{
+							pos: position{line: 215, col: 28, offset: 8548},
 							expr: &ruleRefExpr{
-								pos:  position{line: 169, col: 19, offset: 6740},
+								pos:  position{line: 215, col: 29, offset: 8549},
 								name: "Section1",
 							},
 						},
 						&notExpr{
-							pos: position{line: 169, col: 28, offset: 6749},
+							pos: position{line: 215, col: 38, offset: 8558},
 							expr: &ruleRefExpr{
-								pos:  position{line: 169, col: 29, offset: 6750},
+								pos:  position{line: 215, col: 39, offset: 8559},
 								name: "Section2",
 							},
 						},
 						&notExpr{
-							pos: position{line: 169, col: 38, offset: 6759},
+							pos: position{line: 215, col: 48, offset: 8568},
 							expr: &ruleRefExpr{
-								pos:  position{line: 169, col: 39, offset: 6760},
+								pos:  position{line: 215, col: 49, offset: 8569},
 								name: "Section3",
 							},
 						},
 						&notExpr{
-							pos: position{line: 169, col: 48, offset: 6769},
+							pos: position{line: 215, col: 58, offset: 8578},
 							expr: &ruleRefExpr{
-								pos:  position{line: 169, col: 49, offset: 6770},
+								pos:  position{line: 215, col: 59, offset: 8579},
 								name: "Section4",
 							},
 						},
 						&notExpr{
-							pos: position{line: 169, col: 58, offset: 6779},
+							pos: position{line: 215, col: 68, offset: 8588},
 							expr: &ruleRefExpr{
-								pos:  position{line: 169, col: 59, offset: 6780},
+								pos:  position{line: 215, col: 69, offset: 8589},
 								name: "Section5",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 169, col: 68, offset: 6789},
+							pos:   position{line: 215, col: 78, offset: 8598},
 							label: "content",
 							expr: &ruleRefExpr{
-								pos:  position{line: 169, col: 77, offset: 6798},
+								pos:  position{line: 215, col: 87, offset: 8607},
 								name: "BlockElement",
 							},
 						},
@@ -1735,118 +2008,139 @@ var g = &grammar{
 		},
 		{
 			name: "SectionTitle",
-			pos:  position{line: 177, col: 1, offset: 6971},
+			pos:  position{line: 223, col: 1, offset: 8780},
 			expr: &choiceExpr{
-				pos: position{line: 177, col: 17, offset: 6987},
+				pos: position{line: 223, col: 17, offset: 8796},
 				alternatives: []interface{}{
 					&ruleRefExpr{
-						pos:  position{line: 177, col: 17, offset: 6987},
+						pos:  position{line: 223, col: 17, offset: 8796},
+						name: "Section0Title",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 223, col: 33, offset: 8812},
 						name: "Section1Title",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 177, col: 33, offset: 7003},
+						pos:  position{line: 223, col: 49, offset: 8828},
 						name: "Section2Title",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 177, col: 49, offset: 7019},
+						pos:  position{line: 223, col: 65, offset: 8844},
 						name: "Section3Title",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 177, col: 65, offset: 7035},
+						pos:  position{line: 223, col: 81, offset: 8860},
 						name: "Section4Title",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 177, col: 81, offset: 7051},
+						pos:  position{line: 223, col: 97, offset: 8876},
 						name: "Section5Title",
 					},
 				},
 			},
 		},
 		{
-			name: "Section1Title",
-			pos:  position{line: 179, col: 1, offset: 7066},
+			name: "Section0Title",
+			pos:  position{line: 225, col: 1, offset: 8891},
 			expr: &actionExpr{
-				pos: position{line: 179, col: 18, offset: 7083},
-				run: (*parser).callonSection1Title1,
+				pos: position{line: 225, col: 18, offset: 8908},
+				run: (*parser).callonSection0Title1,
 				expr: &seqExpr{
-					pos: position{line: 179, col: 18, offset: 7083},
+					pos: position{line: 225, col: 18, offset: 8908},
 					exprs: []interface{}{
+						&notExpr{
+							pos: position{line: 225, col: 18, offset: 8908},
+							expr: &ruleRefExpr{
+								pos:  position{line: 225, col: 19, offset: 8909},
+								name: "DiscreteHeading",
+							},
+						},
 						&labeledExpr{
-							pos:   position{line: 179, col: 18, offset: 7083},
+							pos:   position{line: 225, col: 35, offset: 8925},
 							label: "attributes",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 179, col: 29, offset: 7094},
+								pos: position{line: 225, col: 46, offset: 8936},
 								expr: &ruleRefExpr{
-									pos:  position{line: 179, col: 30, offset: 7095},
+									pos:  position{line: 225, col: 47, offset: 8937},
 									name: "ElementAttribute",
 								},
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 179, col: 49, offset: 7114},
+							pos:   position{line: 225, col: 66, offset: 8956},
 							label: "level",
-							expr: &litMatcher{
-								pos:        position{line: 179, col: 56, offset: 7121},
-								val:        "==",
-								ignoreCase: false,
+							expr: &choiceExpr{
+								pos: position{line: 225, col: 73, offset: 8963},
+								alternatives: []interface{}{
+									&litMatcher{
+										pos:        position{line: 225, col: 73, offset: 8963},
+										val:        "=",
+										ignoreCase: false,
+									},
+									&litMatcher{
+										pos:        position{line: 225, col: 79, offset: 8969},
+										val:        "#",
+										ignoreCase: false,
+									},
+								},
 							},
 						},
 						&oneOrMoreExpr{
-							pos: position{line: 179, col: 62, offset: 7127},
+							pos: position{line: 225, col: 84, offset: 8974},
 							expr: &ruleRefExpr{
-								pos:  position{line: 179, col: 62, offset: 7127},
+								pos:  position{line: 225, col: 84, offset: 8974},
 								name: "WS",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 179, col: 66, offset: 7131},
+							pos:   position{line: 225, col: 88, offset: 8978},
 							label: "content",
 							expr: &ruleRefExpr{
-								pos:  position{line: 179, col: 75, offset: 7140},
+								pos:  position{line: 225, col: 97, offset: 8987},
 								name: "InlineContent",
 							},
 						},
 						&zeroOrMoreExpr{
-							pos: position{line: 179, col: 90, offset: 7155},
+							pos: position{line: 225, col: 112, offset: 9002},
 							expr: &ruleRefExpr{
-								pos:  position{line: 179, col: 90, offset: 7155},
+								pos:  position{line: 225, col: 112, offset: 9002},
 								name: "WS",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 179, col: 94, offset: 7159},
+							pos:   position{line: 225, col: 116, offset: 9006},
 							label: "id",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 179, col: 97, offset: 7162},
+								pos: position{line: 225, col: 119, offset: 9009},
 								expr: &ruleRefExpr{
-									pos:  position{line: 179, col: 98, offset: 7163},
+									pos:  position{line: 225, col: 120, offset: 9010},
 									name: "InlineElementID",
 								},
 							},
 						},
 						&zeroOrMoreExpr{
-							pos: position{line: 179, col: 116, offset: 7181},
+							pos: position{line: 225, col: 138, offset: 9028},
 							expr: &ruleRefExpr{
-								pos:  position{line: 179, col: 116, offset: 7181},
+								pos:  position{line: 225, col: 138, offset: 9028},
 								name: "WS",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 179, col: 120, offset: 7185},
+							pos:  position{line: 225, col: 142, offset: 9032},
 							name: "EOL",
 						},
 						&choiceExpr{
-							pos: position{line: 179, col: 125, offset: 7190},
+							pos: position{line: 225, col: 147, offset: 9037},
 							alternatives: []interface{}{
 								&zeroOrOneExpr{
-									pos: position{line: 179, col: 125, offset: 7190},
+									pos: position{line: 225, col: 147, offset: 9037},
 									expr: &ruleRefExpr{
-										pos:  position{line: 179, col: 125, offset: 7190},
+										pos:  position{line: 225, col: 147, offset: 9037},
 										name: "BlankLine",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 179, col: 138, offset: 7203},
+									pos:  position{line: 225, col: 160, offset: 9050},
 									name: "EOF",
 								},
 							},
@@ -1856,90 +2150,107 @@ var g = &grammar{
 			},
 		},
 		{
-			name: "Section2Title",
-			pos:  position{line: 183, col: 1, offset: 7318},
+			name: "Section1Title",
+			pos:  position{line: 229, col: 1, offset: 9165},
 			expr: &actionExpr{
-				pos: position{line: 183, col: 18, offset: 7335},
-				run: (*parser).callonSection2Title1,
+				pos: position{line: 229, col: 18, offset: 9182},
+				run: (*parser).callonSection1Title1,
 				expr: &seqExpr{
-					pos: position{line: 183, col: 18, offset: 7335},
+					pos: position{line: 229, col: 18, offset: 9182},
 					exprs: []interface{}{
+						&notExpr{
+							pos: position{line: 229, col: 18, offset: 9182},
+							expr: &ruleRefExpr{
+								pos:  position{line: 229, col: 19, offset: 9183},
+								name: "DiscreteHeading",
+							},
+						},
 						&labeledExpr{
-							pos:   position{line: 183, col: 18, offset: 7335},
+							pos:   position{line: 229, col: 35, offset: 9199},
 							label: "attributes",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 183, col: 29, offset: 7346},
+								pos: position{line: 229, col: 46, offset: 9210},
 								expr: &ruleRefExpr{
-									pos:  position{line: 183, col: 30, offset: 7347},
+									pos:  position{line: 229, col: 47, offset: 9211},
 									name: "ElementAttribute",
 								},
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 183, col: 49, offset: 7366},
+							pos:   position{line: 229, col: 66, offset: 9230},
 							label: "level",
-							expr: &litMatcher{
-								pos:        position{line: 183, col: 56, offset: 7373},
-								val:        "===",
-								ignoreCase: false,
+							expr: &choiceExpr{
+								pos: position{line: 229, col: 73, offset: 9237},
+								alternatives: []interface{}{
+									&litMatcher{
+										pos:        position{line: 229, col: 73, offset: 9237},
+										val:        "==",
+										ignoreCase: false,
+									},
+									&litMatcher{
+										pos:        position{line: 229, col: 80, offset: 9244},
+										val:        "##",
+										ignoreCase: false,
+									},
+								},
 							},
 						},
 						&oneOrMoreExpr{
-							pos: position{line: 183, col: 63, offset: 7380},
+							pos: position{line: 229, col: 86, offset: 9250},
 							expr: &ruleRefExpr{
-								pos:  position{line: 183, col: 63, offset: 7380},
+								pos:  position{line: 229, col: 86, offset: 9250},
 								name: "WS",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 183, col: 67, offset: 7384},
+							pos:   position{line: 229, col: 90, offset: 9254},
 							label: "content",
 							expr: &ruleRefExpr{
-								pos:  position{line: 183, col: 76, offset: 7393},
+								pos:  position{line: 229, col: 99, offset: 9263},
 								name: "InlineContent",
 							},
 						},
 						&zeroOrMoreExpr{
-							pos: position{line: 183, col: 91, offset: 7408},
+							pos: position{line: 229, col: 114, offset: 9278},
 							expr: &ruleRefExpr{
-								pos:  position{line: 183, col: 91, offset: 7408},
+								pos:  position{line: 229, col: 114, offset: 9278},
 								name: "WS",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 183, col: 95, offset: 7412},
+							pos:   position{line: 229, col: 118, offset: 9282},
 							label: "id",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 183, col: 98, offset: 7415},
+								pos: position{line: 229, col: 121, offset: 9285},
 								expr: &ruleRefExpr{
-									pos:  position{line: 183, col: 99, offset: 7416},
+									pos:  position{line: 229, col: 122, offset: 9286},
 									name: "InlineElementID",
 								},
 							},
 						},
 						&zeroOrMoreExpr{
-							pos: position{line: 183, col: 117, offset: 7434},
+							pos: position{line: 229, col: 140, offset: 9304},
 							expr: &ruleRefExpr{
-								pos:  position{line: 183, col: 117, offset: 7434},
+								pos:  position{line: 229, col: 140, offset: 9304},
 								name: "WS",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 183, col: 121, offset: 7438},
+							pos:  position{line: 229, col: 144, offset: 9308},
 							name: "EOL",
 						},
 						&choiceExpr{
-							pos: position{line: 183, col: 126, offset: 7443},
+							pos: position{line: 229, col: 149, offset: 9313},
 							alternatives: []interface{}{
 								&zeroOrOneExpr{
-									pos: position{line: 183, col: 126, offset: 7443},
+									pos: position{line: 229, col: 149, offset: 9313},
 									expr: &ruleRefExpr{
-										pos:  position{line: 183, col: 126, offset: 7443},
+										pos:  position{line: 229, col: 149, offset: 9313},
 										name: "BlankLine",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 183, col: 139, offset: 7456},
+									pos:  position{line: 229, col: 162, offset: 9326},
 									name: "EOF",
 								},
 							},
@@ -1949,83 +2260,132 @@ var g = &grammar{
 			},
 		},
 		{
-			name: "Section3Title",
-			pos:  position{line: 187, col: 1, offset: 7570},
+			name: "InvalidSection0Title",
+			pos:  position{line: 234, col: 1, offset: 9549},
 			expr: &actionExpr{
-				pos: position{line: 187, col: 18, offset: 7587},
-				run: (*parser).callonSection3Title1,
+				pos: position{line: 234, col: 25, offset: 9573},
+				run: (*parser).callonInvalidSection0Title1,
+				expr: &seqExpr{
+					pos: position{line: 234, col: 25, offset: 9573},
+					exprs: []interface{}{
+						&notCodeExpr{
+							pos: position{line: 234, col: 25, offset: 9573},
+							run: (*parser).callonInvalidSection0Title3,
+						},
+						&labeledExpr{
+							pos:   position{line: 234, col: 59, offset: 9607},
+							label: "title",
+							expr: &ruleRefExpr{
+								pos:  position{line: 234, col: 66, offset: 9614},
+								name: "Section0Title",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "Section2Title",
+			pos:  position{line: 239, col: 1, offset: 9683},
+			expr: &actionExpr{
+				pos: position{line: 239, col: 18, offset: 9700},
+				run: (*parser).callonSection2Title1,
 				expr: &seqExpr{
-					pos: position{line: 187, col: 18, offset: 7587},
+					pos: position{line: 239, col: 18, offset: 9700},
 					exprs: []interface{}{
+						&notExpr{
+							pos: position{line: 239, col: 18, offset: 9700},
+							expr: &ruleRefExpr{
+								pos:  position{line: 239, col: 19, offset: 9701},
+								name: "DiscreteHeading",
+							},
+						},
 						&labeledExpr{
-							pos:   position{line: 187, col: 18, offset: 7587},
+							pos:   position{line: 239, col: 35, offset: 9717},
 							label: "attributes",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 187, col: 29, offset: 7598},
+								pos: position{line: 239, col: 46, offset: 9728},
 								expr: &ruleRefExpr{
-									pos:  position{line: 187, col: 30, offset: 7599},
+									pos:  position{line: 239, col: 47, offset: 9729},
 									name: "ElementAttribute",
 								},
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 187, col: 49, offset: 7618},
+							pos:   position{line: 239, col: 66, offset: 9748},
 							label: "level",
-							expr: &litMatcher{
-								pos:        position{line: 187, col: 56, offset: 7625},
-								val:        "====",
-								ignoreCase: false,
+							expr: &choiceExpr{
+								pos: position{line: 239, col: 73, offset: 9755},
+								alternatives: []interface{}{
+									&litMatcher{
+										pos:        position{line: 239, col: 73, offset: 9755},
+										val:        "===",
+										ignoreCase: false,
+									},
+									&litMatcher{
+										pos:        position{line: 239, col: 81, offset: 9763},
+										val:        "###",
+										ignoreCase: false,
+									},
+								},
 							},
 						},
 						&oneOrMoreExpr{
-							pos: position{line: 187, col: 64, offset: 7633},
+							pos: position{line: 239, col: 88, offset: 9770},
 							expr: &ruleRefExpr{
-								pos:  position{line: 187, col: 64, offset: 7633},
+								pos:  position{line: 239, col: 88, offset: 9770},
 								name: "WS",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 187, col: 68, offset: 7637},
+							pos:   position{line: 239, col: 92, offset: 9774},
 							label: "content",
 							expr: &ruleRefExpr{
-								pos:  position{line: 187, col: 77, offset: 7646},
+								pos:  position{line: 239, col: 101, offset: 9783},
 								name: "InlineContent",
 							},
 						},
 						&zeroOrMoreExpr{
-							pos: position{line: 187, col: 92, offset: 7661},
+							pos: position{line: 239, col: 116, offset: 9798},
 							expr: &ruleRefExpr{
-								pos:  position{line: 187, col: 92, offset: 7661},
+								pos:  position{line: 239, col: 116, offset: 9798},
 								name: "WS",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 187, col: 96, offset: 7665},
+							pos:   position{line: 239, col: 120, offset: 9802},
 							label: "id",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 187, col: 99, offset: 7668},
+								pos: position{line: 239, col: 123, offset: 9805},
 								expr: &ruleRefExpr{
-									pos:  position{line: 187, col: 100, offset: 7669},
+									pos:  position{line: 239, col: 124, offset: 9806},
 									name: "InlineElementID",
 								},
 							},
 						},
+						&zeroOrMoreExpr{
+							pos: position{line: 239, col: 142, offset: 9824},
+							expr: &ruleRefExpr{
+								pos:  position{line: 239, col: 142, offset: 9824},
+								name: "WS",
+							},
+						},
 						&ruleRefExpr{
-							pos:  position{line: 187, col: 118, offset: 7687},
+							pos:  position{line: 239, col: 146, offset: 9828},
 							name: "EOL",
 						},
 						&choiceExpr{
-							pos: position{line: 187, col: 123, offset: 7692},
+							pos: position{line: 239, col: 151, offset: 9833},
 							alternatives: []interface{}{
 								&zeroOrOneExpr{
-									pos: position{line: 187, col: 123, offset: 7692},
+									pos: position{line: 239, col: 151, offset: 9833},
 									expr: &ruleRefExpr{
-										pos:  position{line: 187, col: 123, offset: 7692},
+										pos:  position{line: 239, col: 151, offset: 9833},
 										name: "BlankLine",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 187, col: 136, offset: 7705},
+									pos:  position{line: 239, col: 164, offset: 9846},
 									name: "EOF",
 								},
 							},
@@ -2035,83 +2395,203 @@ var g = &grammar{
 			},
 		},
 		{
-			name: "Section4Title",
-			pos:  position{line: 191, col: 1, offset: 7819},
+			name: "Section3Title",
+			pos:  position{line: 243, col: 1, offset: 9960},
 			expr: &actionExpr{
-				pos: position{line: 191, col: 18, offset: 7836},
-				run: (*parser).callonSection4Title1,
+				pos: position{line: 243, col: 18, offset: 9977},
+				run: (*parser).callonSection3Title1,
 				expr: &seqExpr{
-					pos: position{line: 191, col: 18, offset: 7836},
+					pos: position{line: 243, col: 18, offset: 9977},
 					exprs: []interface{}{
-						&labeledExpr{
-							pos:   position{line: 191, col: 18, offset: 7836},
-							label: "attributes",
-							expr: &zeroOrMoreExpr{
-								pos: position{line: 191, col: 29, offset: 7847},
+						&notExpr{
+							pos: position{line: 243, col: 18, offset: 9977},
+							expr: &ruleRefExpr{
+								pos:  position{line: 243, col: 19, offset: 9978},
+								name: "DiscreteHeading",
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 243, col: 35, offset: 9994},
+							label: "attributes",
+							expr: &zeroOrMoreExpr{
+								pos: position{line: 243, col: 46, offset: 10005},
 								expr: &ruleRefExpr{
-									pos:  position{line: 191, col: 30, offset: 7848},
+									pos:  position{line: 243, col: 47, offset: 10006},
 									name: "ElementAttribute",
 								},
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 191, col: 49, offset: 7867},
+							pos:   position{line: 243, col: 66, offset: 10025},
 							label: "level",
-							expr: &litMatcher{
-								pos:        position{line: 191, col: 56, offset: 7874},
-								val:        "=====",
-								ignoreCase: false,
+							expr: &choiceExpr{
+								pos: position{line: 243, col: 73, offset: 10032},
+								alternatives: []interface{}{
+									&litMatcher{
+										pos:        position{line: 243, col: 73, offset: 10032},
+										val:        "====",
+										ignoreCase: false,
+									},
+									&litMatcher{
+										pos:        position{line: 243, col: 82, offset: 10041},
+										val:        "####",
+										ignoreCase: false,
+									},
+								},
+							},
+						},
+						&oneOrMoreExpr{
+							pos: position{line: 243, col: 90, offset: 10049},
+							expr: &ruleRefExpr{
+								pos:  position{line: 243, col: 90, offset: 10049},
+								name: "WS",
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 243, col: 94, offset: 10053},
+							label: "content",
+							expr: &ruleRefExpr{
+								pos:  position{line: 243, col: 103, offset: 10062},
+								name: "InlineContent",
+							},
+						},
+						&zeroOrMoreExpr{
+							pos: position{line: 243, col: 118, offset: 10077},
+							expr: &ruleRefExpr{
+								pos:  position{line: 243, col: 118, offset: 10077},
+								name: "WS",
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 243, col: 122, offset: 10081},
+							label: "id",
+							expr: &zeroOrOneExpr{
+								pos: position{line: 243, col: 125, offset: 10084},
+								expr: &ruleRefExpr{
+									pos:  position{line: 243, col: 126, offset: 10085},
+									name: "InlineElementID",
+								},
+							},
+						},
+						&ruleRefExpr{
+							pos:  position{line: 243, col: 144, offset: 10103},
+							name: "EOL",
+						},
+						&choiceExpr{
+							pos: position{line: 243, col: 149, offset: 10108},
+							alternatives: []interface{}{
+								&zeroOrOneExpr{
+									pos: position{line: 243, col: 149, offset: 10108},
+									expr: &ruleRefExpr{
+										pos:  position{line: 243, col: 149, offset: 10108},
+										name: "BlankLine",
+									},
+								},
+								&ruleRefExpr{
+									pos:  position{line: 243, col: 162, offset: 10121},
+									name: "EOF",
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "Section4Title",
+			pos:  position{line: 247, col: 1, offset: 10235},
+			expr: &actionExpr{
+				pos: position{line: 247, col: 18, offset: 10252},
+				run: (*parser).callonSection4Title1,
+				expr: &seqExpr{
+					pos: position{line: 247, col: 18, offset: 10252},
+					exprs: []interface{}{
+						&notExpr{
+							pos: position{line: 247, col: 18, offset: 10252},
+							expr: &ruleRefExpr{
+								pos:  position{line: 247, col: 19, offset: 10253},
+								name: "DiscreteHeading",
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 247, col: 35, offset: 10269},
+							label: "attributes",
+							expr: &zeroOrMoreExpr{
+								pos: position{line: 247, col: 46, offset: 10280},
+								expr: &ruleRefExpr{
+									pos:  position{line: 247, col: 47, offset: 10281},
+									name: "ElementAttribute",
+								},
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 247, col: 66, offset: 10300},
+							label: "level",
+							expr: &choiceExpr{
+								pos: position{line: 247, col: 73, offset: 10307},
+								alternatives: []interface{}{
+									&litMatcher{
+										pos:        position{line: 247, col: 73, offset: 10307},
+										val:        "=====",
+										ignoreCase: false,
+									},
+									&litMatcher{
+										pos:        position{line: 247, col: 83, offset: 10317},
+										val:        "#####",
+										ignoreCase: false,
+									},
+								},
 							},
 						},
 						&oneOrMoreExpr{
-							pos: position{line: 191, col: 65, offset: 7883},
+							pos: position{line: 247, col: 92, offset: 10326},
 							expr: &ruleRefExpr{
-								pos:  position{line: 191, col: 65, offset: 7883},
+								pos:  position{line: 247, col: 92, offset: 10326},
 								name: "WS",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 191, col: 69, offset: 7887},
+							pos:   position{line: 247, col: 96, offset: 10330},
 							label: "content",
 							expr: &ruleRefExpr{
-								pos:  position{line: 191, col: 78, offset: 7896},
+								pos:  position{line: 247, col: 105, offset: 10339},
 								name: "InlineContent",
 							},
 						},
 						&zeroOrMoreExpr{
-							pos: position{line: 191, col: 93, offset: 7911},
+							pos: position{line: 247, col: 120, offset: 10354},
 							expr: &ruleRefExpr{
-								pos:  position{line: 191, col: 93, offset: 7911},
+								pos:  position{line: 247, col: 120, offset: 10354},
 								name: "WS",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 191, col: 97, offset: 7915},
+							pos:   position{line: 247, col: 124, offset: 10358},
 							label: "id",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 191, col: 100, offset: 7918},
+								pos: position{line: 247, col: 127, offset: 10361},
 								expr: &ruleRefExpr{
-									pos:  position{line: 191, col: 101, offset: 7919},
+									pos:  position{line: 247, col: 128, offset: 10362},
 									name: "InlineElementID",
 								},
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 191, col: 119, offset: 7937},
+							pos:  position{line: 247, col: 146, offset: 10380},
 							name: "EOL",
 						},
 						&choiceExpr{
-							pos: position{line: 191, col: 124, offset: 7942},
+							pos: position{line: 247, col: 151, offset: 10385},
 							alternatives: []interface{}{
 								&zeroOrOneExpr{
-									pos: position{line: 191, col: 124, offset: 7942},
+									pos: position{line: 247, col: 151, offset: 10385},
 									expr: &ruleRefExpr{
-										pos:  position{line: 191, col: 124, offset: 7942},
+										pos:  position{line: 247, col: 151, offset: 10385},
 										name: "BlankLine",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 191, col: 137, offset: 7955},
+									pos:  position{line: 247, col: 164, offset: 10398},
 									name: "EOF",
 								},
 							},
@@ -2122,82 +2602,99 @@ var g = &grammar{
 		},
 		{
 			name: "Section5Title",
-			pos:  position{line: 195, col: 1, offset: 8069},
+			pos:  position{line: 251, col: 1, offset: 10512},
 			expr: &actionExpr{
-				pos: position{line: 195, col: 18, offset: 8086},
+				pos: position{line: 251, col: 18, offset: 10529},
 				run: (*parser).callonSection5Title1,
 				expr: &seqExpr{
-					pos: position{line: 195, col: 18, offset: 8086},
+					pos: position{line: 251, col: 18, offset: 10529},
 					exprs: []interface{}{
+						&notExpr{
+							pos: position{line: 251, col: 18, offset: 10529},
+							expr: &ruleRefExpr{
+								pos:  position{line: 251, col: 19, offset: 10530},
+								name: "DiscreteHeading",
+							},
+						},
 						&labeledExpr{
-							pos:   position{line: 195, col: 18, offset: 8086},
+							pos:   position{line: 251, col: 35, offset: 10546},
 							label: "attributes",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 195, col: 29, offset: 8097},
+								pos: position{line: 251, col: 46, offset: 10557},
 								expr: &ruleRefExpr{
-									pos:  position{line: 195, col: 30, offset: 8098},
+									pos:  position{line: 251, col: 47, offset: 10558},
 									name: "ElementAttribute",
 								},
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 195, col: 49, offset: 8117},
+							pos:   position{line: 251, col: 66, offset: 10577},
 							label: "level",
-							expr: &litMatcher{
-								pos:        position{line: 195, col: 56, offset: 8124},
-								val:        "======",
-								ignoreCase: false,
+							expr: &choiceExpr{
+								pos: position{line: 251, col: 73, offset: 10584},
+								alternatives: []interface{}{
+									&litMatcher{
+										pos:        position{line: 251, col: 73, offset: 10584},
+										val:        "======",
+										ignoreCase: false,
+									},
+									&litMatcher{
+										pos:        position{line: 251, col: 84, offset: 10595},
+										val:        "######",
+										ignoreCase: false,
+									},
+								},
 							},
 						},
 						&oneOrMoreExpr{
-							pos: position{line: 195, col: 66, offset: 8134},
+							pos: position{line: 251, col: 94, offset: 10605},
 							expr: &ruleRefExpr{
-								pos:  position{line: 195, col: 66, offset: 8134},
+								pos:  position{line: 251, col: 94, offset: 10605},
 								name: "WS",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 195, col: 70, offset: 8138},
+							pos:   position{line: 251, col: 98, offset: 10609},
 							label: "content",
 							expr: &ruleRefExpr{
-								pos:  position{line: 195, col: 79, offset: 8147},
+								pos:  position{line: 251, col: 107, offset: 10618},
 								name: "InlineContent",
 							},
 						},
 						&zeroOrMoreExpr{
-							pos: position{line: 195, col: 94, offset: 8162},
+							pos: position{line: 251, col: 122, offset: 10633},
 							expr: &ruleRefExpr{
-								pos:  position{line: 195, col: 94, offset: 8162},
+								pos:  position{line: 251, col: 122, offset: 10633},
 								name: "WS",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 195, col: 98, offset: 8166},
+							pos:   position{line: 251, col: 126, offset: 10637},
 							label: "id",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 195, col: 101, offset: 8169},
+								pos: position{line: 251, col: 129, offset: 10640},
 								expr: &ruleRefExpr{
-									pos:  position{line: 195, col: 102, offset: 8170},
+									pos:  position{line: 251, col: 130, offset: 10641},
 									name: "InlineElementID",
 								},
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 195, col: 120, offset: 8188},
+							pos:  position{line: 251, col: 148, offset: 10659},
 							name: "EOL",
 						},
 						&choiceExpr{
-							pos: position{line: 195, col: 125, offset: 8193},
+							pos: position{line: 251, col: 153, offset: 10664},
 							alternatives: []interface{}{
 								&zeroOrOneExpr{
-									pos: position{line: 195, col: 125, offset: 8193},
+									pos: position{line: 251, col: 153, offset: 10664},
 									expr: &ruleRefExpr{
-										pos:  position{line: 195, col: 125, offset: 8193},
+										pos:  position{line: 251, col: 153, offset: 10664},
 										name: "BlankLine",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 195, col: 138, offset: 8206},
+									pos:  position{line: 251, col: 166, offset: 10677},
 									name: "EOF",
 								},
 							},
@@ -2206,31 +2703,260 @@ var g = &grammar{
 				},
 			},
 		},
+		{
+			name: "DiscreteHeading",
+			pos:  position{line: 260, col: 1, offset: 11032},
+			expr: &actionExpr{
+				pos: position{line: 260, col: 20, offset: 11051},
+				run: (*parser).callonDiscreteHeading1,
+				expr: &seqExpr{
+					pos: position{line: 260, col: 20, offset: 11051},
+					exprs: []interface{}{
+						&labeledExpr{
+							pos:   position{line: 260, col: 20, offset: 11051},
+							label: "before",
+							expr: &zeroOrMoreExpr{
+								pos: position{line: 260, col: 27, offset: 11058},
+								expr: &actionExpr{
+									pos: position{line: 260, col: 28, offset: 11059},
+									run: (*parser).callonDiscreteHeading5,
+									expr: &seqExpr{
+										pos: position{line: 260, col: 28, offset: 11059},
+										exprs: []interface{}{
+											&notExpr{
+												pos: position{line: 260, col: 28, offset: 11059},
+												expr: &ruleRefExpr{
+													pos:  position{line: 260, col: 29, offset: 11060},
+													name: "DiscreteHeadingAttribute",
+												},
+											},
+											&labeledExpr{
+												pos:   position{line: 260, col: 54, offset: 11085},
+												label: "attr",
+												expr: &ruleRefExpr{
+													pos:  position{line: 260, col: 60, offset: 11091},
+													name: "ElementAttribute",
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						&ruleRefExpr{
+							pos:  position{line: 260, col: 101, offset: 11132},
+							name: "DiscreteHeadingAttribute",
+						},
+						&labeledExpr{
+							pos:   position{line: 260, col: 126, offset: 11157},
+							label: "after",
+							expr: &zeroOrMoreExpr{
+								pos: position{line: 260, col: 132, offset: 11163},
+								expr: &ruleRefExpr{
+									pos:  position{line: 260, col: 133, offset: 11164},
+									name: "ElementAttribute",
+								},
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 260, col: 152, offset: 11183},
+							label: "level",
+							expr: &ruleRefExpr{
+								pos:  position{line: 260, col: 159, offset: 11190},
+								name: "DiscreteHeadingLevel",
+							},
+						},
+						&oneOrMoreExpr{
+							pos: position{line: 260, col: 181, offset: 11212},
+							expr: &ruleRefExpr{
+								pos:  position{line: 260, col: 181, offset: 11212},
+								name: "WS",
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 260, col: 185, offset: 11216},
+							label: "content",
+							expr: &ruleRefExpr{
+								pos:  position{line: 260, col: 194, offset: 11225},
+								name: "InlineContent",
+							},
+						},
+						&zeroOrMoreExpr{
+							pos: position{line: 260, col: 209, offset: 11240},
+							expr: &ruleRefExpr{
+								pos:  position{line: 260, col: 209, offset: 11240},
+								name: "WS",
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 260, col: 213, offset: 11244},
+							label: "id",
+							expr: &zeroOrOneExpr{
+								pos: position{line: 260, col: 216, offset: 11247},
+								expr: &ruleRefExpr{
+									pos:  position{line: 260, col: 217, offset: 11248},
+									name: "InlineElementID",
+								},
+							},
+						},
+						&zeroOrMoreExpr{
+							pos: position{line: 260, col: 235, offset: 11266},
+							expr: &ruleRefExpr{
+								pos:  position{line: 260, col: 235, offset: 11266},
+								name: "WS",
+							},
+						},
+						&ruleRefExpr{
+							pos:  position{line: 260, col: 239, offset: 11270},
+							name: "EOL",
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "DiscreteHeadingAttribute",
+			pos:  position{line: 264, col: 1, offset: 11429},
+			expr: &seqExpr{
+				pos: position{line: 264, col: 29, offset: 11457},
+				exprs: []interface{}{
+					&litMatcher{
+						pos:        position{line: 264, col: 29, offset: 11457},
+						val:        "[",
+						ignoreCase: false,
+					},
+					&choiceExpr{
+						pos: position{line: 264, col: 34, offset: 11462},
+						alternatives: []interface{}{
+							&litMatcher{
+								pos:        position{line: 264, col: 34, offset: 11462},
+								val:        "discrete",
+								ignoreCase: false,
+							},
+							&litMatcher{
+								pos:        position{line: 264, col: 47, offset: 11475},
+								val:        "float",
+								ignoreCase: false,
+							},
+						},
+					},
+					&litMatcher{
+						pos:        position{line: 264, col: 56, offset: 11484},
+						val:        "]",
+						ignoreCase: false,
+					},
+					&zeroOrMoreExpr{
+						pos: position{line: 264, col: 60, offset: 11488},
+						expr: &ruleRefExpr{
+							pos:  position{line: 264, col: 60, offset: 11488},
+							name: "WS",
+						},
+					},
+					&ruleRefExpr{
+						pos:  position{line: 264, col: 64, offset: 11492},
+						name: "EOL",
+					},
+				},
+			},
+		},
+		{
+			name: "DiscreteHeadingLevel",
+			pos:  position{line: 267, col: 1, offset: 11568},
+			expr: &actionExpr{
+				pos: position{line: 267, col: 25, offset: 11592},
+				run: (*parser).callonDiscreteHeadingLevel1,
+				expr: &choiceExpr{
+					pos: position{line: 267, col: 26, offset: 11593},
+					alternatives: []interface{}{
+						&litMatcher{
+							pos:        position{line: 267, col: 26, offset: 11593},
+							val:        "======",
+							ignoreCase: false,
+						},
+						&litMatcher{
+							pos:        position{line: 267, col: 37, offset: 11604},
+							val:        "=====",
+							ignoreCase: false,
+						},
+						&litMatcher{
+							pos:        position{line: 267, col: 47, offset: 11614},
+							val:        "====",
+							ignoreCase: false,
+						},
+						&litMatcher{
+							pos:        position{line: 267, col: 56, offset: 11623},
+							val:        "===",
+							ignoreCase: false,
+						},
+						&litMatcher{
+							pos:        position{line: 267, col: 64, offset: 11631},
+							val:        "==",
+							ignoreCase: false,
+						},
+						&litMatcher{
+							pos:        position{line: 267, col: 71, offset: 11638},
+							val:        "=",
+							ignoreCase: false,
+						},
+						&litMatcher{
+							pos:        position{line: 267, col: 77, offset: 11644},
+							val:        "######",
+							ignoreCase: false,
+						},
+						&litMatcher{
+							pos:        position{line: 267, col: 88, offset: 11655},
+							val:        "#####",
+							ignoreCase: false,
+						},
+						&litMatcher{
+							pos:        position{line: 267, col: 98, offset: 11665},
+							val:        "####",
+							ignoreCase: false,
+						},
+						&litMatcher{
+							pos:        position{line: 267, col: 107, offset: 11674},
+							val:        "###",
+							ignoreCase: false,
+						},
+						&litMatcher{
+							pos:        position{line: 267, col: 115, offset: 11682},
+							val:        "##",
+							ignoreCase: false,
+						},
+						&litMatcher{
+							pos:        position{line: 267, col: 122, offset: 11689},
+							val:        "#",
+							ignoreCase: false,
+						},
+					},
+				},
+			},
+		},
 		{
 			name: "List",
-			pos:  position{line: 202, col: 1, offset: 8421},
+			pos:  position{line: 274, col: 1, offset: 11832},
 			expr: &actionExpr{
-				pos: position{line: 202, col: 9, offset: 8429},
+				pos: position{line: 274, col: 9, offset: 11840},
 				run: (*parser).callonList1,
 				expr: &seqExpr{
-					pos: position{line: 202, col: 9, offset: 8429},
+					pos: position{line: 274, col: 9, offset: 11840},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 202, col: 9, offset: 8429},
+							pos:   position{line: 274, col: 9, offset: 11840},
 							label: "attributes",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 202, col: 20, offset: 8440},
+								pos: position{line: 274, col: 20, offset: 11851},
 								expr: &ruleRefExpr{
-									pos:  position{line: 202, col: 21, offset: 8441},
+									pos:  position{line: 274, col: 21, offset: 11852},
 									name: "ListAttribute",
 								},
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 204, col: 5, offset: 8530},
+							pos:   position{line: 276, col: 5, offset: 11941},
 							label: "elements",
 							expr: &ruleRefExpr{
-								pos:  position{line: 204, col: 14, offset: 8539},
+								pos:  position{line: 276, col: 14, offset: 11950},
 								name: "ListItems",
 							},
 						},
@@ -2240,22 +2966,22 @@ var g = &grammar{
 		},
 		{
 			name: "ListItems",
-			pos:  position{line: 208, col: 1, offset: 8633},
+			pos:  position{line: 280, col: 1, offset: 12044},
 			expr: &oneOrMoreExpr{
-				pos: position{line: 208, col: 14, offset: 8646},
+				pos: position{line: 280, col: 14, offset: 12057},
 				expr: &choiceExpr{
-					pos: position{line: 208, col: 15, offset: 8647},
+					pos: position{line: 280, col: 15, offset: 12058},
 					alternatives: []interface{}{
 						&ruleRefExpr{
-							pos:  position{line: 208, col: 15, offset: 8647},
+							pos:  position{line: 280, col: 15, offset: 12058},
 							name: "OrderedListItem",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 208, col: 33, offset: 8665},
+							pos:  position{line: 280, col: 33, offset: 12076},
 							name: "UnorderedListItem",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 208, col: 53, offset: 8685},
+							pos:  position{line: 280, col: 53, offset: 12096},
 							name: "LabeledListItem",
 						},
 					},
@@ -2264,32 +2990,32 @@ var g = &grammar{
 		},
 		{
 			name: "ListAttribute",
-			pos:  position{line: 210, col: 1, offset: 8704},
+			pos:  position{line: 282, col: 1, offset: 12115},
 			expr: &actionExpr{
-				pos: position{line: 210, col: 18, offset: 8721},
+				pos: position{line: 282, col: 18, offset: 12132},
 				run: (*parser).callonListAttribute1,
 				expr: &seqExpr{
-					pos: position{line: 210, col: 18, offset: 8721},
+					pos: position{line: 282, col: 18, offset: 12132},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 210, col: 18, offset: 8721},
+							pos:   position{line: 282, col: 18, offset: 12132},
 							label: "attribute",
 							expr: &choiceExpr{
-								pos: position{line: 210, col: 29, offset: 8732},
+								pos: position{line: 282, col: 29, offset: 12143},
 								alternatives: []interface{}{
 									&ruleRefExpr{
-										pos:  position{line: 210, col: 29, offset: 8732},
+										pos:  position{line: 282, col: 29, offset: 12143},
 										name: "HorizontalLayout",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 210, col: 48, offset: 8751},
+										pos:  position{line: 282, col: 48, offset: 12162},
 										name: "ListID",
 									},
 								},
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 210, col: 56, offset: 8759},
+							pos:  position{line: 282, col: 56, offset: 12170},
 							name: "NEWLINE",
 						},
 					},
@@ -2298,28 +3024,28 @@ var g = &grammar{
 		},
 		{
 			name: "ListID",
-			pos:  position{line: 214, col: 1, offset: 8798},
+			pos:  position{line: 286, col: 1, offset: 12209},
 			expr: &actionExpr{
-				pos: position{line: 214, col: 11, offset: 8808},
+				pos: position{line: 286, col: 11, offset: 12219},
 				run: (*parser).callonListID1,
 				expr: &seqExpr{
-					pos: position{line: 214, col: 11, offset: 8808},
+					pos: position{line: 286, col: 11, offset: 12219},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 214, col: 11, offset: 8808},
+							pos:        position{line: 286, col: 11, offset: 12219},
 							val:        "[#",
 							ignoreCase: false,
 						},
 						&labeledExpr{
-							pos:   position{line: 214, col: 16, offset: 8813},
+							pos:   position{line: 286, col: 16, offset: 12224},
 							label: "id",
 							expr: &ruleRefExpr{
-								pos:  position{line: 214, col: 20, offset: 8817},
+								pos:  position{line: 286, col: 20, offset: 12228},
 								name: "ID",
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 214, col: 24, offset: 8821},
+							pos:        position{line: 286, col: 24, offset: 12232},
 							val:        "]",
 							ignoreCase: false,
 						},
@@ -2329,12 +3055,12 @@ var g = &grammar{
 		},
 		{
 			name: "HorizontalLayout",
-			pos:  position{line: 218, col: 1, offset: 8887},
+			pos:  position{line: 290, col: 1, offset: 12298},
 			expr: &actionExpr{
-				pos: position{line: 218, col: 21, offset: 8907},
+				pos: position{line: 290, col: 21, offset: 12318},
 				run: (*parser).callonHorizontalLayout1,
 				expr: &litMatcher{
-					pos:        position{line: 218, col: 21, offset: 8907},
+					pos:        position{line: 290, col: 21, offset: 12318},
 					val:        "[horizontal]",
 					ignoreCase: false,
 				},
@@ -2342,70 +3068,98 @@ var g = &grammar{
 		},
 		{
 			name: "ListParagraph",
-			pos:  position{line: 222, col: 1, offset: 8990},
+			pos:  position{line: 295, col: 1, offset: 12463},
 			expr: &actionExpr{
-				pos: position{line: 222, col: 19, offset: 9008},
+				pos: position{line: 295, col: 19, offset: 12481},
 				run: (*parser).callonListParagraph1,
-				expr: &labeledExpr{
-					pos:   position{line: 222, col: 19, offset: 9008},
-					label: "lines",
-					expr: &oneOrMoreExpr{
-						pos: position{line: 222, col: 25, offset: 9014},
-						expr: &seqExpr{
-							pos: position{line: 223, col: 5, offset: 9020},
-							exprs: []interface{}{
-								&notExpr{
-									pos: position{line: 223, col: 5, offset: 9020},
-									expr: &ruleRefExpr{
-										pos:  position{line: 223, col: 7, offset: 9022},
-										name: "OrderedListItemPrefix",
-									},
-								},
-								&notExpr{
-									pos: position{line: 224, col: 5, offset: 9050},
-									expr: &ruleRefExpr{
-										pos:  position{line: 224, col: 7, offset: 9052},
-										name: "UnorderedListItemPrefix",
-									},
-								},
-								&notExpr{
-									pos: position{line: 225, col: 5, offset: 9082},
-									expr: &seqExpr{
-										pos: position{line: 225, col: 7, offset: 9084},
-										exprs: []interface{}{
-											&ruleRefExpr{
-												pos:  position{line: 225, col: 7, offset: 9084},
-												name: "LabeledListItemTerm",
-											},
-											&ruleRefExpr{
-												pos:  position{line: 225, col: 27, offset: 9104},
-												name: "LabeledListItemSeparator",
+				expr: &seqExpr{
+					pos: position{line: 295, col: 19, offset: 12481},
+					exprs: []interface{}{
+						&notExpr{
+							pos: position{line: 295, col: 19, offset: 12481},
+							expr: &ruleRefExpr{
+								pos:  position{line: 295, col: 20, offset: 12482},
+								name: "SingleLineComment",
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 295, col: 38, offset: 12500},
+							label: "lines",
+							expr: &oneOrMoreExpr{
+								pos: position{line: 295, col: 44, offset: 12506},
+								expr: &choiceExpr{
+									pos: position{line: 295, col: 45, offset: 12507},
+									alternatives: []interface{}{
+										&ruleRefExpr{
+											pos:  position{line: 295, col: 45, offset: 12507},
+											name: "SingleLineComment",
+										},
+										&seqExpr{
+											pos: position{line: 296, col: 5, offset: 12533},
+											exprs: []interface{}{
+												&notExpr{
+													pos: position{line: 296, col: 5, offset: 12533},
+													expr: &ruleRefExpr{
+														pos:  position{line: 296, col: 7, offset: 12535},
+														name: "OrderedListItemPrefix",
+													},
+												},
+												&notExpr{
+													pos: position{line: 297, col: 5, offset: 12563},
+													expr: &ruleRefExpr{
+														pos:  position{line: 297, col: 7, offset: 12565},
+														name: "UnorderedListItemPrefix",
+													},
+												},
+												&notExpr{
+													pos: position{line: 298, col: 5, offset: 12595},
+													expr: &seqExpr{
+														pos: position{line: 298, col: 7, offset: 12597},
+														exprs: []interface{}{
+															&ruleRefExpr{
+																pos:  position{line: 298, col: 7, offset: 12597},
+																name: "LabeledListItemTerm",
+															},
+															&ruleRefExpr{
+																pos:  position{line: 298, col: 27, offset: 12617},
+																name: "LabeledListItemSeparator",
+															},
+														},
+													},
+												},
+												&notExpr{
+													pos: position{line: 299, col: 5, offset: 12648},
+													expr: &ruleRefExpr{
+														pos:  position{line: 299, col: 7, offset: 12650},
+														name: "CalloutListItemPrefix",
+													},
+												},
+												&notExpr{
+													pos: position{line: 300, col: 5, offset: 12678},
+													expr: &ruleRefExpr{
+														pos:  position{line: 300, col: 7, offset: 12680},
+														name: "ListItemContinuation",
+													},
+												},
+												&notExpr{
+													pos: position{line: 301, col: 5, offset: 12707},
+													expr: &ruleRefExpr{
+														pos:  position{line: 301, col: 7, offset: 12709},
+														name: "ElementAttribute",
+													},
+												},
+												&ruleRefExpr{
+													pos:  position{line: 302, col: 5, offset: 12731},
+													name: "InlineContentWithTrailingSpaces",
+												},
+												&ruleRefExpr{
+													pos:  position{line: 302, col: 37, offset: 12763},
+													name: "EOL",
+												},
 											},
 										},
 									},
 								},
-								&notExpr{
-									pos: position{line: 226, col: 5, offset: 9135},
-									expr: &ruleRefExpr{
-										pos:  position{line: 226, col: 7, offset: 9137},
-										name: "ListItemContinuation",
-									},
-								},
-								&notExpr{
-									pos: position{line: 227, col: 5, offset: 9164},
-									expr: &ruleRefExpr{
-										pos:  position{line: 227, col: 7, offset: 9166},
-										name: "ElementAttribute",
-									},
-								},
-								&ruleRefExpr{
-									pos:  position{line: 228, col: 5, offset: 9188},
-									name: "InlineContentWithTrailingSpaces",
-								},
-								&ruleRefExpr{
-									pos:  position{line: 228, col: 37, offset: 9220},
-									name: "EOL",
-								},
 							},
 						},
 					},
@@ -2414,27 +3168,27 @@ var g = &grammar{
 		},
 		{
 			name: "ListItemContinuation",
-			pos:  position{line: 232, col: 1, offset: 9289},
+			pos:  position{line: 306, col: 1, offset: 12833},
 			expr: &actionExpr{
-				pos: position{line: 232, col: 25, offset: 9313},
+				pos: position{line: 306, col: 25, offset: 12857},
 				run: (*parser).callonListItemContinuation1,
 				expr: &seqExpr{
-					pos: position{line: 232, col: 25, offset: 9313},
+					pos: position{line: 306, col: 25, offset: 12857},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 232, col: 25, offset: 9313},
+							pos:        position{line: 306, col: 25, offset: 12857},
 							val:        "+",
 							ignoreCase: false,
 						},
 						&zeroOrMoreExpr{
-							pos: position{line: 232, col: 29, offset: 9317},
+							pos: position{line: 306, col: 29, offset: 12861},
 							expr: &ruleRefExpr{
-								pos:  position{line: 232, col: 29, offset: 9317},
+								pos:  position{line: 306, col: 29, offset: 12861},
 								name: "WS",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 232, col: 33, offset: 9321},
+							pos:  position{line: 306, col: 33, offset: 12865},
 							name: "EOL",
 						},
 					},
@@ -2443,22 +3197,22 @@ var g = &grammar{
 		},
 		{
 			name: "ContinuedBlockElement",
-			pos:  position{line: 236, col: 1, offset: 9373},
+			pos:  position{line: 310, col: 1, offset: 12917},
 			expr: &actionExpr{
-				pos: position{line: 236, col: 26, offset: 9398},
+				pos: position{line: 310, col: 26, offset: 12942},
 				run: (*parser).callonContinuedBlockElement1,
 				expr: &seqExpr{
-					pos: position{line: 236, col: 26, offset: 9398},
+					pos: position{line: 310, col: 26, offset: 12942},
 					exprs: []interface{}{
 						&ruleRefExpr{
-							pos:  position{line: 236, col: 26, offset: 9398},
+							pos:  position{line: 310, col: 26, offset: 12942},
 							name: "ListItemContinuation",
 						},
 						&labeledExpr{
-							pos:   position{line: 236, col: 47, offset: 9419},
+							pos:   position{line: 310, col: 47, offset: 12963},
 							label: "element",
 							expr: &ruleRefExpr{
-								pos:  position{line: 236, col: 55, offset: 9427},
+								pos:  position{line: 310, col: 55, offset: 12971},
 								name: "BlockElement",
 							},
 						},
@@ -2468,44 +3222,44 @@ var g = &grammar{
 		},
 		{
 			name: "OrderedListItem",
-			pos:  position{line: 243, col: 1, offset: 9583},
+			pos:  position{line: 317, col: 1, offset: 13127},
 			expr: &actionExpr{
-				pos: position{line: 243, col: 20, offset: 9602},
+				pos: position{line: 317, col: 20, offset: 13146},
 				run: (*parser).callonOrderedListItem1,
 				expr: &seqExpr{
-					pos: position{line: 243, col: 20, offset: 9602},
+					pos: position{line: 317, col: 20, offset: 13146},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 243, col: 20, offset: 9602},
+							pos:   position{line: 317, col: 20, offset: 13146},
 							label: "attributes",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 243, col: 31, offset: 9613},
+								pos: position{line: 317, col: 31, offset: 13157},
 								expr: &ruleRefExpr{
-									pos:  position{line: 243, col: 32, offset: 9614},
+									pos:  position{line: 317, col: 32, offset: 13158},
 									name: "ElementAttribute",
 								},
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 243, col: 51, offset: 9633},
+							pos:   position{line: 317, col: 51, offset: 13177},
 							label: "prefix",
 							expr: &ruleRefExpr{
-								pos:  position{line: 243, col: 59, offset: 9641},
+								pos:  position{line: 317, col: 59, offset: 13185},
 								name: "OrderedListItemPrefix",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 243, col: 82, offset: 9664},
+							pos:   position{line: 317, col: 82, offset: 13208},
 							label: "content",
 							expr: &ruleRefExpr{
-								pos:  position{line: 243, col: 91, offset: 9673},
+								pos:  position{line: 317, col: 91, offset: 13217},
 								name: "OrderedListItemContent",
 							},
 						},
 						&zeroOrOneExpr{
-							pos: position{line: 243, col: 115, offset: 9697},
+							pos: position{line: 317, col: 115, offset: 13241},
 							expr: &ruleRefExpr{
-								pos:  position{line: 243, col: 115, offset: 9697},
+								pos:  position{line: 317, col: 115, offset: 13241},
 								name: "BlankLine",
 							},
 						},
@@ -2515,36 +3269,36 @@ var g = &grammar{
 		},
 		{
 			name: "OrderedListItemPrefix",
-			pos:  position{line: 247, col: 1, offset: 9845},
+			pos:  position{line: 321, col: 1, offset: 13389},
 			expr: &choiceExpr{
-				pos: position{line: 249, col: 1, offset: 9909},
+				pos: position{line: 323, col: 1, offset: 13453},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 249, col: 1, offset: 9909},
+						pos: position{line: 323, col: 1, offset: 13453},
 						run: (*parser).callonOrderedListItemPrefix2,
 						expr: &seqExpr{
-							pos: position{line: 249, col: 1, offset: 9909},
+							pos: position{line: 323, col: 1, offset: 13453},
 							exprs: []interface{}{
 								&zeroOrMoreExpr{
-									pos: position{line: 249, col: 1, offset: 9909},
+									pos: position{line: 323, col: 1, offset: 13453},
 									expr: &ruleRefExpr{
-										pos:  position{line: 249, col: 1, offset: 9909},
+										pos:  position{line: 323, col: 1, offset: 13453},
 										name: "WS",
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 249, col: 5, offset: 9913},
+									pos:   position{line: 323, col: 5, offset: 13457},
 									label: "style",
 									expr: &litMatcher{
-										pos:        position{line: 249, col: 12, offset: 9920},
+										pos:        position{line: 323, col: 12, offset: 13464},
 										val:        ".",
 										ignoreCase: false,
 									},
 								},
 								&oneOrMoreExpr{
-									pos: position{line: 249, col: 17, offset: 9925},
+									pos: position{line: 323, col: 17, offset: 13469},
 									expr: &ruleRefExpr{
-										pos:  position{line: 249, col: 17, offset: 9925},
+										pos:  position{line: 323, col: 17, offset: 13469},
 										name: "WS",
 									},
 								},
@@ -2552,31 +3306,31 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 251, col: 5, offset: 10018},
+						pos: position{line: 325, col: 5, offset: 13562},
 						run: (*parser).callonOrderedListItemPrefix10,
 						expr: &seqExpr{
-							pos: position{line: 251, col: 5, offset: 10018},
+							pos: position{line: 325, col: 5, offset: 13562},
 							exprs: []interface{}{
 								&zeroOrMoreExpr{
-									pos: position{line: 251, col: 5, offset: 10018},
+									pos: position{line: 325, col: 5, offset: 13562},
 									expr: &ruleRefExpr{
-										pos:  position{line: 251, col: 5, offset: 10018},
+										pos:  position{line: 325, col: 5, offset: 13562},
 										name: "WS",
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 251, col: 9, offset: 10022},
+									pos:   position{line: 325, col: 9, offset: 13566},
 									label: "style",
 									expr: &litMatcher{
-										pos:        position{line: 251, col: 16, offset: 10029},
+										pos:        position{line: 325, col: 16, offset: 13573},
 										val:        "..",
 										ignoreCase: false,
 									},
 								},
 								&oneOrMoreExpr{
-									pos: position{line: 251, col: 22, offset: 10035},
+									pos: position{line: 325, col: 22, offset: 13579},
 									expr: &ruleRefExpr{
-										pos:  position{line: 251, col: 22, offset: 10035},
+										pos:  position{line: 325, col: 22, offset: 13579},
 										name: "WS",
 									},
 								},
@@ -2584,31 +3338,31 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 253, col: 5, offset: 10133},
+						pos: position{line: 327, col: 5, offset: 13677},
 						run: (*parser).callonOrderedListItemPrefix18,
 						expr: &seqExpr{
-							pos: position{line: 253, col: 5, offset: 10133},
+							pos: position{line: 327, col: 5, offset: 13677},
 							exprs: []interface{}{
 								&zeroOrMoreExpr{
-									pos: position{line: 253, col: 5, offset: 10133},
+									pos: position{line: 327, col: 5, offset: 13677},
 									expr: &ruleRefExpr{
-										pos:  position{line: 253, col: 5, offset: 10133},
+										pos:  position{line: 327, col: 5, offset: 13677},
 										name: "WS",
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 253, col: 9, offset: 10137},
+									pos:   position{line: 327, col: 9, offset: 13681},
 									label: "style",
 									expr: &litMatcher{
-										pos:        position{line: 253, col: 16, offset: 10144},
+										pos:        position{line: 327, col: 16, offset: 13688},
 										val:        "...",
 										ignoreCase: false,
 									},
 								},
 								&oneOrMoreExpr{
-									pos: position{line: 253, col: 23, offset: 10151},
+									pos: position{line: 327, col: 23, offset: 13695},
 									expr: &ruleRefExpr{
-										pos:  position{line: 253, col: 23, offset: 10151},
+										pos:  position{line: 327, col: 23, offset: 13695},
 										name: "WS",
 									},
 								},
@@ -2616,31 +3370,31 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 255, col: 5, offset: 10250},
+						pos: position{line: 329, col: 5, offset: 13794},
 						run: (*parser).callonOrderedListItemPrefix26,
 						expr: &seqExpr{
-							pos: position{line: 255, col: 5, offset: 10250},
+							pos: position{line: 329, col: 5, offset: 13794},
 							exprs: []interface{}{
 								&zeroOrMoreExpr{
-									pos: position{line: 255, col: 5, offset: 10250},
+									pos: position{line: 329, col: 5, offset: 13794},
 									expr: &ruleRefExpr{
-										pos:  position{line: 255, col: 5, offset: 10250},
+										pos:  position{line: 329, col: 5, offset: 13794},
 										name: "WS",
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 255, col: 9, offset: 10254},
+									pos:   position{line: 329, col: 9, offset: 13798},
 									label: "style",
 									expr: &litMatcher{
-										pos:        position{line: 255, col: 16, offset: 10261},
+										pos:        position{line: 329, col: 16, offset: 13805},
 										val:        "....",
 										ignoreCase: false,
 									},
 								},
 								&oneOrMoreExpr{
-									pos: position{line: 255, col: 24, offset: 10269},
+									pos: position{line: 329, col: 24, offset: 13813},
 									expr: &ruleRefExpr{
-										pos:  position{line: 255, col: 24, offset: 10269},
+										pos:  position{line: 329, col: 24, offset: 13813},
 										name: "WS",
 									},
 								},
@@ -2648,31 +3402,31 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 257, col: 5, offset: 10369},
+						pos: position{line: 331, col: 5, offset: 13913},
 						run: (*parser).callonOrderedListItemPrefix34,
 						expr: &seqExpr{
-							pos: position{line: 257, col: 5, offset: 10369},
+							pos: position{line: 331, col: 5, offset: 13913},
 							exprs: []interface{}{
 								&zeroOrMoreExpr{
-									pos: position{line: 257, col: 5, offset: 10369},
+									pos: position{line: 331, col: 5, offset: 13913},
 									expr: &ruleRefExpr{
-										pos:  position{line: 257, col: 5, offset: 10369},
+										pos:  position{line: 331, col: 5, offset: 13913},
 										name: "WS",
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 257, col: 9, offset: 10373},
+									pos:   position{line: 331, col: 9, offset: 13917},
 									label: "style",
 									expr: &litMatcher{
-										pos:        position{line: 257, col: 16, offset: 10380},
+										pos:        position{line: 331, col: 16, offset: 13924},
 										val:        ".....",
 										ignoreCase: false,
 									},
 								},
 								&oneOrMoreExpr{
-									pos: position{line: 257, col: 25, offset: 10389},
+									pos: position{line: 331, col: 25, offset: 13933},
 									expr: &ruleRefExpr{
-										pos:  position{line: 257, col: 25, offset: 10389},
+										pos:  position{line: 331, col: 25, offset: 13933},
 										name: "WS",
 									},
 								},
@@ -2680,53 +3434,53 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 260, col: 5, offset: 10512},
+						pos: position{line: 334, col: 5, offset: 14056},
 						run: (*parser).callonOrderedListItemPrefix42,
 						expr: &seqExpr{
-							pos: position{line: 260, col: 5, offset: 10512},
+							pos: position{line: 334, col: 5, offset: 14056},
 							exprs: []interface{}{
 								&zeroOrMoreExpr{
-									pos: position{line: 260, col: 5, offset: 10512},
+									pos: position{line: 334, col: 5, offset: 14056},
 									expr: &ruleRefExpr{
-										pos:  position{line: 260, col: 5, offset: 10512},
+										pos:  position{line: 334, col: 5, offset: 14056},
 										name: "WS",
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 260, col: 9, offset: 10516},
+									pos:   position{line: 334, col: 9, offset: 14060},
 									label: "style",
 									expr: &seqExpr{
-										pos: position{line: 260, col: 16, offset: 10523},
+										pos: position{line: 334, col: 16, offset: 14067},
 										exprs: []interface{}{
 											&oneOrMoreExpr{
-												pos: position{line: 260, col: 16, offset: 10523},
+												pos: position{line: 334, col: 16, offset: 14067},
 												expr: &seqExpr{
-													pos: position{line: 260, col: 17, offset: 10524},
+													pos: position{line: 334, col: 17, offset: 14068},
 													exprs: []interface{}{
 														&notExpr{
-															pos: position{line: 260, col: 17, offset: 10524},
+															pos: position{line: 334, col: 17, offset: 14068},
 															expr: &litMatcher{
-																pos:        position{line: 260, col: 18, offset: 10525},
+																pos:        position{line: 334, col: 18, offset: 14069},
 																val:        ".",
 																ignoreCase: false,
 															},
 														},
 														&notExpr{
-															pos: position{line: 260, col: 22, offset: 10529},
+															pos: position{line: 334, col: 22, offset: 14073},
 															expr: &ruleRefExpr{
-																pos:  position{line: 260, col: 23, offset: 10530},
+																pos:  position{line: 334, col: 23, offset: 14074},
 																name: "WS",
 															},
 														},
 														&notExpr{
-															pos: position{line: 260, col: 26, offset: 10533},
+															pos: position{line: 334, col: 26, offset: 14077},
 															expr: &ruleRefExpr{
-																pos:  position{line: 260, col: 27, offset: 10534},
+																pos:  position{line: 334, col: 27, offset: 14078},
 																name: "NEWLINE",
 															},
 														},
 														&charClassMatcher{
-															pos:        position{line: 260, col: 35, offset: 10542},
+															pos:        position{line: 334, col: 35, offset: 14086},
 															val:        "[0-9]",
 															ranges:     []rune{'0', '9'},
 															ignoreCase: false,
@@ -2736,7 +3490,7 @@ var g = &grammar{
 												},
 											},
 											&litMatcher{
-												pos:        position{line: 260, col: 43, offset: 10550},
+												pos:        position{line: 334, col: 43, offset: 14094},
 												val:        ".",
 												ignoreCase: false,
 											},
@@ -2744,9 +3498,9 @@ var g = &grammar{
 									},
 								},
 								&oneOrMoreExpr{
-									pos: position{line: 260, col: 48, offset: 10555},
+									pos: position{line: 334, col: 48, offset: 14099},
 									expr: &ruleRefExpr{
-										pos:  position{line: 260, col: 48, offset: 10555},
+										pos:  position{line: 334, col: 48, offset: 14099},
 										name: "WS",
 									},
 								},
@@ -2754,53 +3508,53 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 262, col: 5, offset: 10650},
+						pos: position{line: 336, col: 5, offset: 14194},
 						run: (*parser).callonOrderedListItemPrefix60,
 						expr: &seqExpr{
-							pos: position{line: 262, col: 5, offset: 10650},
+							pos: position{line: 336, col: 5, offset: 14194},
 							exprs: []interface{}{
 								&zeroOrMoreExpr{
-									pos: position{line: 262, col: 5, offset: 10650},
+									pos: position{line: 336, col: 5, offset: 14194},
 									expr: &ruleRefExpr{
-										pos:  position{line: 262, col: 5, offset: 10650},
+										pos:  position{line: 336, col: 5, offset: 14194},
 										name: "WS",
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 262, col: 9, offset: 10654},
+									pos:   position{line: 336, col: 9, offset: 14198},
 									label: "style",
 									expr: &seqExpr{
-										pos: position{line: 262, col: 16, offset: 10661},
+										pos: position{line: 336, col: 16, offset: 14205},
 										exprs: []interface{}{
 											&oneOrMoreExpr{
-												pos: position{line: 262, col: 16, offset: 10661},
+												pos: position{line: 336, col: 16, offset: 14205},
 												expr: &seqExpr{
-													pos: position{line: 262, col: 17, offset: 10662},
+													pos: position{line: 336, col: 17, offset: 14206},
 													exprs: []interface{}{
 														&notExpr{
-															pos: position{line: 262, col: 17, offset: 10662},
+															pos: position{line: 336, col: 17, offset: 14206},
 															expr: &litMatcher{
-																pos:        position{line: 262, col: 18, offset: 10663},
+																pos:        position{line: 336, col: 18, offset: 14207},
 																val:        ".",
 																ignoreCase: false,
 															},
 														},
 														&notExpr{
-															pos: position{line: 262, col: 22, offset: 10667},
+															pos: position{line: 336, col: 22, offset: 14211},
 															expr: &ruleRefExpr{
-																pos:  position{line: 262, col: 23, offset: 10668},
+																pos:  position{line: 336, col: 23, offset: 14212},
 																name: "WS",
 															},
 														},
 														&notExpr{
-															pos: position{line: 262, col: 26, offset: 10671},
+															pos: position{line: 336, col: 26, offset: 14215},
 															expr: &ruleRefExpr{
-																pos:  position{line: 262, col: 27, offset: 10672},
+																pos:  position{line: 336, col: 27, offset: 14216},
 																name: "NEWLINE",
 															},
 														},
 														&charClassMatcher{
-															pos:        position{line: 262, col: 35, offset: 10680},
+															pos:        position{line: 336, col: 35, offset: 14224},
 															val:        "[a-z]",
 															ranges:     []rune{'a', 'z'},
 															ignoreCase: false,
@@ -2810,7 +3564,7 @@ var g = &grammar{
 												},
 											},
 											&litMatcher{
-												pos:        position{line: 262, col: 43, offset: 10688},
+												pos:        position{line: 336, col: 43, offset: 14232},
 												val:        ".",
 												ignoreCase: false,
 											},
@@ -2818,9 +3572,9 @@ var g = &grammar{
 									},
 								},
 								&oneOrMoreExpr{
-									pos: position{line: 262, col: 48, offset: 10693},
+									pos: position{line: 336, col: 48, offset: 14237},
 									expr: &ruleRefExpr{
-										pos:  position{line: 262, col: 48, offset: 10693},
+										pos:  position{line: 336, col: 48, offset: 14237},
 										name: "WS",
 									},
 								},
@@ -2828,53 +3582,53 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 264, col: 5, offset: 10791},
+						pos: position{line: 338, col: 5, offset: 14335},
 						run: (*parser).callonOrderedListItemPrefix78,
 						expr: &seqExpr{
-							pos: position{line: 264, col: 5, offset: 10791},
+							pos: position{line: 338, col: 5, offset: 14335},
 							exprs: []interface{}{
 								&zeroOrMoreExpr{
-									pos: position{line: 264, col: 5, offset: 10791},
+									pos: position{line: 338, col: 5, offset: 14335},
 									expr: &ruleRefExpr{
-										pos:  position{line: 264, col: 5, offset: 10791},
+										pos:  position{line: 338, col: 5, offset: 14335},
 										name: "WS",
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 264, col: 9, offset: 10795},
+									pos:   position{line: 338, col: 9, offset: 14339},
 									label: "style",
 									expr: &seqExpr{
-										pos: position{line: 264, col: 16, offset: 10802},
+										pos: position{line: 338, col: 16, offset: 14346},
 										exprs: []interface{}{
 											&oneOrMoreExpr{
-												pos: position{line: 264, col: 16, offset: 10802},
+												pos: position{line: 338, col: 16, offset: 14346},
 												expr: &seqExpr{
-													pos: position{line: 264, col: 17, offset: 10803},
+													pos: position{line: 338, col: 17, offset: 14347},
 													exprs: []interface{}{
 														&notExpr{
-															pos: position{line: 264, col: 17, offset: 10803},
+															pos: position{line: 338, col: 17, offset: 14347},
 															expr: &litMatcher{
-																pos:        position{line: 264, col: 18, offset: 10804},
+																pos:        position{line: 338, col: 18, offset: 14348},
 																val:        ".",
 																ignoreCase: false,
 															},
 														},
 														&notExpr{
-															pos: position{line: 264, col: 22, offset: 10808},
+															pos: position{line: 338, col: 22, offset: 14352},
 															expr: &ruleRefExpr{
-																pos:  position{line: 264, col: 23, offset: 10809},
+																pos:  position{line: 338, col: 23, offset: 14353},
 																name: "WS",
 															},
 														},
 														&notExpr{
-															pos: position{line: 264, col: 26, offset: 10812},
+															pos: position{line: 338, col: 26, offset: 14356},
 															expr: &ruleRefExpr{
-																pos:  position{line: 264, col: 27, offset: 10813},
+																pos:  position{line: 338, col: 27, offset: 14357},
 																name: "NEWLINE",
 															},
 														},
 														&charClassMatcher{
-															pos:        position{line: 264, col: 35, offset: 10821},
+															pos:        position{line: 338, col: 35, offset: 14365},
 															val:        "[A-Z]",
 															ranges:     []rune{'A', 'Z'},
 															ignoreCase: false,
@@ -2884,7 +3638,7 @@ var g = &grammar{
 												},
 											},
 											&litMatcher{
-												pos:        position{line: 264, col: 43, offset: 10829},
+												pos:        position{line: 338, col: 43, offset: 14373},
 												val:        ".",
 												ignoreCase: false,
 											},
@@ -2892,9 +3646,9 @@ var g = &grammar{
 									},
 								},
 								&oneOrMoreExpr{
-									pos: position{line: 264, col: 48, offset: 10834},
+									pos: position{line: 338, col: 48, offset: 14378},
 									expr: &ruleRefExpr{
-										pos:  position{line: 264, col: 48, offset: 10834},
+										pos:  position{line: 338, col: 48, offset: 14378},
 										name: "WS",
 									},
 								},
@@ -2902,53 +3656,53 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 266, col: 5, offset: 10932},
+						pos: position{line: 340, col: 5, offset: 14476},
 						run: (*parser).callonOrderedListItemPrefix96,
 						expr: &seqExpr{
-							pos: position{line: 266, col: 5, offset: 10932},
+							pos: position{line: 340, col: 5, offset: 14476},
 							exprs: []interface{}{
 								&zeroOrMoreExpr{
-									pos: position{line: 266, col: 5, offset: 10932},
+									pos: position{line: 340, col: 5, offset: 14476},
 									expr: &ruleRefExpr{
-										pos:  position{line: 266, col: 5, offset: 10932},
+										pos:  position{line: 340, col: 5, offset: 14476},
 										name: "WS",
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 266, col: 9, offset: 10936},
+									pos:   position{line: 340, col: 9, offset: 14480},
 									label: "style",
 									expr: &seqExpr{
-										pos: position{line: 266, col: 16, offset: 10943},
+										pos: position{line: 340, col: 16, offset: 14487},
 										exprs: []interface{}{
 											&oneOrMoreExpr{
-												pos: position{line: 266, col: 16, offset: 10943},
+												pos: position{line: 340, col: 16, offset: 14487},
 												expr: &seqExpr{
-													pos: position{line: 266, col: 17, offset: 10944},
+													pos: position{line: 340, col: 17, offset: 14488},
 													exprs: []interface{}{
 														&notExpr{
-															pos: position{line: 266, col: 17, offset: 10944},
+															pos: position{line: 340, col: 17, offset: 14488},
 															expr: &litMatcher{
-																pos:        position{line: 266, col: 18, offset: 10945},
+																pos:        position{line: 340, col: 18, offset: 14489},
 																val:        ")",
 																ignoreCase: false,
 															},
 														},
 														&notExpr{
-															pos: position{line: 266, col: 22, offset: 10949},
+															pos: position{line: 340, col: 22, offset: 14493},
 															expr: &ruleRefExpr{
-																pos:  position{line: 266, col: 23, offset: 10950},
+																pos:  position{line: 340, col: 23, offset: 14494},
 																name: "WS",
 															},
 														},
 														&notExpr{
-															pos: position{line: 266, col: 26, offset: 10953},
+															pos: position{line: 340, col: 26, offset: 14497},
 															expr: &ruleRefExpr{
-																pos:  position{line: 266, col: 27, offset: 10954},
+																pos:  position{line: 340, col: 27, offset: 14498},
 																name: "NEWLINE",
 															},
 														},
 														&charClassMatcher{
-															pos:        position{line: 266, col: 35, offset: 10962},
+															pos:        position{line: 340, col: 35, offset: 14506},
 															val:        "[a-z]",
 															ranges:     []rune{'a', 'z'},
 															ignoreCase: false,
@@ -2958,7 +3712,7 @@ var g = &grammar{
 												},
 											},
 											&litMatcher{
-												pos:        position{line: 266, col: 43, offset: 10970},
+												pos:        position{line: 340, col: 43, offset: 14514},
 												val:        ")",
 												ignoreCase: false,
 											},
@@ -2966,9 +3720,9 @@ var g = &grammar{
 									},
 								},
 								&oneOrMoreExpr{
-									pos: position{line: 266, col: 48, offset: 10975},
+									pos: position{line: 340, col: 48, offset: 14519},
 									expr: &ruleRefExpr{
-										pos:  position{line: 266, col: 48, offset: 10975},
+										pos:  position{line: 340, col: 48, offset: 14519},
 										name: "WS",
 									},
 								},
@@ -2976,53 +3730,53 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 268, col: 5, offset: 11073},
+						pos: position{line: 342, col: 5, offset: 14617},
 						run: (*parser).callonOrderedListItemPrefix114,
 						expr: &seqExpr{
-							pos: position{line: 268, col: 5, offset: 11073},
+							pos: position{line: 342, col: 5, offset: 14617},
 							exprs: []interface{}{
 								&zeroOrMoreExpr{
-									pos: position{line: 268, col: 5, offset: 11073},
+									pos: position{line: 342, col: 5, offset: 14617},
 									expr: &ruleRefExpr{
-										pos:  position{line: 268, col: 5, offset: 11073},
+										pos:  position{line: 342, col: 5, offset: 14617},
 										name: "WS",
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 268, col: 9, offset: 11077},
+									pos:   position{line: 342, col: 9, offset: 14621},
 									label: "style",
 									expr: &seqExpr{
-										pos: position{line: 268, col: 16, offset: 11084},
+										pos: position{line: 342, col: 16, offset: 14628},
 										exprs: []interface{}{
 											&oneOrMoreExpr{
-												pos: position{line: 268, col: 16, offset: 11084},
+												pos: position{line: 342, col: 16, offset: 14628},
 												expr: &seqExpr{
-													pos: position{line: 268, col: 17, offset: 11085},
+													pos: position{line: 342, col: 17, offset: 14629},
 													exprs: []interface{}{
 														&notExpr{
-															pos: position{line: 268, col: 17, offset: 11085},
+															pos: position{line: 342, col: 17, offset: 14629},
 															expr: &litMatcher{
-																pos:        position{line: 268, col: 18, offset: 11086},
+																pos:        position{line: 342, col: 18, offset: 14630},
 																val:        ")",
 																ignoreCase: false,
 															},
 														},
 														&notExpr{
-															pos: position{line: 268, col: 22, offset: 11090},
+															pos: position{line: 342, col: 22, offset: 14634},
 															expr: &ruleRefExpr{
-																pos:  position{line: 268, col: 23, offset: 11091},
+																pos:  position{line: 342, col: 23, offset: 14635},
 																name: "WS",
 															},
 														},
 														&notExpr{
-															pos: position{line: 268, col: 26, offset: 11094},
+															pos: position{line: 342, col: 26, offset: 14638},
 															expr: &ruleRefExpr{
-																pos:  position{line: 268, col: 27, offset: 11095},
+																pos:  position{line: 342, col: 27, offset: 14639},
 																name: "NEWLINE",
 															},
 														},
 														&charClassMatcher{
-															pos:        position{line: 268, col: 35, offset: 11103},
+															pos:        position{line: 342, col: 35, offset: 14647},
 															val:        "[A-Z]",
 															ranges:     []rune{'A', 'Z'},
 															ignoreCase: false,
@@ -3032,7 +3786,7 @@ var g = &grammar{
 												},
 											},
 											&litMatcher{
-												pos:        position{line: 268, col: 43, offset: 11111},
+												pos:        position{line: 342, col: 43, offset: 14655},
 												val:        ")",
 												ignoreCase: false,
 											},
@@ -3040,9 +3794,9 @@ var g = &grammar{
 									},
 								},
 								&oneOrMoreExpr{
-									pos: position{line: 268, col: 48, offset: 11116},
+									pos: position{line: 342, col: 48, offset: 14660},
 									expr: &ruleRefExpr{
-										pos:  position{line: 268, col: 48, offset: 11116},
+										pos:  position{line: 342, col: 48, offset: 14660},
 										name: "WS",
 									},
 								},
@@ -3054,27 +3808,27 @@ var g = &grammar{
 		},
 		{
 			name: "OrderedListItemContent",
-			pos:  position{line: 291, col: 1, offset: 11900},
+			pos:  position{line: 365, col: 1, offset: 15444},
 			expr: &actionExpr{
-				pos: position{line: 291, col: 27, offset: 11926},
+				pos: position{line: 365, col: 27, offset: 15470},
 				run: (*parser).callonOrderedListItemContent1,
 				expr: &labeledExpr{
-					pos:   position{line: 291, col: 27, offset: 11926},
+					pos:   position{line: 365, col: 27, offset: 15470},
 					label: "elements",
 					expr: &seqExpr{
-						pos: position{line: 291, col: 37, offset: 11936},
+						pos: position{line: 365, col: 37, offset: 15480},
 						exprs: []interface{}{
 							&oneOrMoreExpr{
-								pos: position{line: 291, col: 37, offset: 11936},
+								pos: position{line: 365, col: 37, offset: 15480},
 								expr: &ruleRefExpr{
-									pos:  position{line: 291, col: 37, offset: 11936},
+									pos:  position{line: 365, col: 37, offset: 15480},
 									name: "ListParagraph",
 								},
 							},
 							&zeroOrMoreExpr{
-								pos: position{line: 291, col: 52, offset: 11951},
+								pos: position{line: 365, col: 52, offset: 15495},
 								expr: &ruleRefExpr{
-									pos:  position{line: 291, col: 52, offset: 11951},
+									pos:  position{line: 365, col: 52, offset: 15495},
 									name: "ContinuedBlockElement",
 								},
 							},
@@ -3085,33 +3839,33 @@ var g = &grammar{
 		},
 		{
 			name: "UnorderedListItem",
-			pos:  position{line: 298, col: 1, offset: 12277},
+			pos:  position{line: 372, col: 1, offset: 15821},
 			expr: &actionExpr{
-				pos: position{line: 298, col: 22, offset: 12298},
+				pos: position{line: 372, col: 22, offset: 15842},
 				run: (*parser).callonUnorderedListItem1,
 				expr: &seqExpr{
-					pos: position{line: 298, col: 22, offset: 12298},
+					pos: position{line: 372, col: 22, offset: 15842},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 298, col: 22, offset: 12298},
+							pos:   position{line: 372, col: 22, offset: 15842},
 							label: "prefix",
 							expr: &ruleRefExpr{
-								pos:  position{line: 298, col: 30, offset: 12306},
+								pos:  position{line: 372, col: 30, offset: 15850},
 								name: "UnorderedListItemPrefix",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 298, col: 55, offset: 12331},
+							pos:   position{line: 372, col: 55, offset: 15875},
 							label: "content",
 							expr: &ruleRefExpr{
-								pos:  position{line: 298, col: 64, offset: 12340},
+								pos:  position{line: 372, col: 64, offset: 15884},
 								name: "UnorderedListItemContent",
 							},
 						},
 						&zeroOrOneExpr{
-							pos: position{line: 298, col: 90, offset: 12366},
+							pos: position{line: 372, col: 90, offset: 15910},
 							expr: &ruleRefExpr{
-								pos:  position{line: 298, col: 90, offset: 12366},
+								pos:  position{line: 372, col: 90, offset: 15910},
 								name: "BlankLine",
 							},
 						},
@@ -3121,36 +3875,36 @@ var g = &grammar{
 		},
 		{
 			name: "UnorderedListItemPrefix",
-			pos:  position{line: 302, col: 1, offset: 12490},
+			pos:  position{line: 376, col: 1, offset: 16034},
 			expr: &choiceExpr{
-				pos: position{line: 302, col: 28, offset: 12517},
+				pos: position{line: 376, col: 28, offset: 16061},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 302, col: 28, offset: 12517},
+						pos: position{line: 376, col: 28, offset: 16061},
 						run: (*parser).callonUnorderedListItemPrefix2,
 						expr: &seqExpr{
-							pos: position{line: 302, col: 28, offset: 12517},
+							pos: position{line: 376, col: 28, offset: 16061},
 							exprs: []interface{}{
 								&zeroOrMoreExpr{
-									pos: position{line: 302, col: 28, offset: 12517},
+									pos: position{line: 376, col: 28, offset: 16061},
 									expr: &ruleRefExpr{
-										pos:  position{line: 302, col: 28, offset: 12517},
+										pos:  position{line: 376, col: 28, offset: 16061},
 										name: "WS",
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 302, col: 32, offset: 12521},
+									pos:   position{line: 376, col: 32, offset: 16065},
 									label: "level",
 									expr: &litMatcher{
-										pos:        position{line: 302, col: 39, offset: 12528},
+										pos:        position{line: 376, col: 39, offset: 16072},
 										val:        "*****",
 										ignoreCase: false,
 									},
 								},
 								&oneOrMoreExpr{
-									pos: position{line: 302, col: 48, offset: 12537},
+									pos: position{line: 376, col: 48, offset: 16081},
 									expr: &ruleRefExpr{
-										pos:  position{line: 302, col: 48, offset: 12537},
+										pos:  position{line: 376, col: 48, offset: 16081},
 										name: "WS",
 									},
 								},
@@ -3158,31 +3912,31 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 304, col: 5, offset: 12682},
+						pos: position{line: 378, col: 5, offset: 16226},
 						run: (*parser).callonUnorderedListItemPrefix10,
 						expr: &seqExpr{
-							pos: position{line: 304, col: 5, offset: 12682},
+							pos: position{line: 378, col: 5, offset: 16226},
 							exprs: []interface{}{
 								&zeroOrMoreExpr{
-									pos: position{line: 304, col: 5, offset: 12682},
+									pos: position{line: 378, col: 5, offset: 16226},
 									expr: &ruleRefExpr{
-										pos:  position{line: 304, col: 5, offset: 12682},
+										pos:  position{line: 378, col: 5, offset: 16226},
 										name: "WS",
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 304, col: 9, offset: 12686},
+									pos:   position{line: 378, col: 9, offset: 16230},
 									label: "level",
 									expr: &litMatcher{
-										pos:        position{line: 304, col: 16, offset: 12693},
+										pos:        position{line: 378, col: 16, offset: 16237},
 										val:        "****",
 										ignoreCase: false,
 									},
 								},
 								&oneOrMoreExpr{
-									pos: position{line: 304, col: 24, offset: 12701},
+									pos: position{line: 378, col: 24, offset: 16245},
 									expr: &ruleRefExpr{
-										pos:  position{line: 304, col: 24, offset: 12701},
+										pos:  position{line: 378, col: 24, offset: 16245},
 										name: "WS",
 									},
 								},
@@ -3190,31 +3944,31 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 306, col: 5, offset: 12846},
+						pos: position{line: 380, col: 5, offset: 16390},
 						run: (*parser).callonUnorderedListItemPrefix18,
 						expr: &seqExpr{
-							pos: position{line: 306, col: 5, offset: 12846},
+							pos: position{line: 380, col: 5, offset: 16390},
 							exprs: []interface{}{
 								&zeroOrMoreExpr{
-									pos: position{line: 306, col: 5, offset: 12846},
+									pos: position{line: 380, col: 5, offset: 16390},
 									expr: &ruleRefExpr{
-										pos:  position{line: 306, col: 5, offset: 12846},
+										pos:  position{line: 380, col: 5, offset: 16390},
 										name: "WS",
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 306, col: 9, offset: 12850},
+									pos:   position{line: 380, col: 9, offset: 16394},
 									label: "level",
 									expr: &litMatcher{
-										pos:        position{line: 306, col: 16, offset: 12857},
+										pos:        position{line: 380, col: 16, offset: 16401},
 										val:        "***",
 										ignoreCase: false,
 									},
 								},
 								&oneOrMoreExpr{
-									pos: position{line: 306, col: 23, offset: 12864},
+									pos: position{line: 380, col: 23, offset: 16408},
 									expr: &ruleRefExpr{
-										pos:  position{line: 306, col: 23, offset: 12864},
+										pos:  position{line: 380, col: 23, offset: 16408},
 										name: "WS",
 									},
 								},
@@ -3222,31 +3976,31 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 308, col: 5, offset: 13010},
+						pos: position{line: 382, col: 5, offset: 16554},
 						run: (*parser).callonUnorderedListItemPrefix26,
 						expr: &seqExpr{
-							pos: position{line: 308, col: 5, offset: 13010},
+							pos: position{line: 382, col: 5, offset: 16554},
 							exprs: []interface{}{
 								&zeroOrMoreExpr{
-									pos: position{line: 308, col: 5, offset: 13010},
+									pos: position{line: 382, col: 5, offset: 16554},
 									expr: &ruleRefExpr{
-										pos:  position{line: 308, col: 5, offset: 13010},
+										pos:  position{line: 382, col: 5, offset: 16554},
 										name: "WS",
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 308, col: 9, offset: 13014},
+									pos:   position{line: 382, col: 9, offset: 16558},
 									label: "level",
 									expr: &litMatcher{
-										pos:        position{line: 308, col: 16, offset: 13021},
+										pos:        position{line: 382, col: 16, offset: 16565},
 										val:        "**",
 										ignoreCase: false,
 									},
 								},
 								&oneOrMoreExpr{
-									pos: position{line: 308, col: 22, offset: 13027},
+									pos: position{line: 382, col: 22, offset: 16571},
 									expr: &ruleRefExpr{
-										pos:  position{line: 308, col: 22, offset: 13027},
+										pos:  position{line: 382, col: 22, offset: 16571},
 										name: "WS",
 									},
 								},
@@ -3254,31 +4008,31 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 310, col: 5, offset: 13171},
+						pos: position{line: 384, col: 5, offset: 16715},
 						run: (*parser).callonUnorderedListItemPrefix34,
 						expr: &seqExpr{
-							pos: position{line: 310, col: 5, offset: 13171},
+							pos: position{line: 384, col: 5, offset: 16715},
 							exprs: []interface{}{
 								&zeroOrMoreExpr{
-									pos: position{line: 310, col: 5, offset: 13171},
+									pos: position{line: 384, col: 5, offset: 16715},
 									expr: &ruleRefExpr{
-										pos:  position{line: 310, col: 5, offset: 13171},
+										pos:  position{line: 384, col: 5, offset: 16715},
 										name: "WS",
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 310, col: 9, offset: 13175},
+									pos:   position{line: 384, col: 9, offset: 16719},
 									label: "level",
 									expr: &litMatcher{
-										pos:        position{line: 310, col: 16, offset: 13182},
+										pos:        position{line: 384, col: 16, offset: 16726},
 										val:        "*",
 										ignoreCase: false,
 									},
 								},
 								&oneOrMoreExpr{
-									pos: position{line: 310, col: 21, offset: 13187},
+									pos: position{line: 384, col: 21, offset: 16731},
 									expr: &ruleRefExpr{
-										pos:  position{line: 310, col: 21, offset: 13187},
+										pos:  position{line: 384, col: 21, offset: 16731},
 										name: "WS",
 									},
 								},
@@ -3286,31 +4040,31 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 312, col: 5, offset: 13330},
+						pos: position{line: 386, col: 5, offset: 16874},
 						run: (*parser).callonUnorderedListItemPrefix42,
 						expr: &seqExpr{
-							pos: position{line: 312, col: 5, offset: 13330},
+							pos: position{line: 386, col: 5, offset: 16874},
 							exprs: []interface{}{
 								&zeroOrMoreExpr{
-									pos: position{line: 312, col: 5, offset: 13330},
+									pos: position{line: 386, col: 5, offset: 16874},
 									expr: &ruleRefExpr{
-										pos:  position{line: 312, col: 5, offset: 13330},
+										pos:  position{line: 386, col: 5, offset: 16874},
 										name: "WS",
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 312, col: 9, offset: 13334},
+									pos:   position{line: 386, col: 9, offset: 16878},
 									label: "level",
 									expr: &litMatcher{
-										pos:        position{line: 312, col: 16, offset: 13341},
+										pos:        position{line: 386, col: 16, offset: 16885},
 										val:        "-",
 										ignoreCase: false,
 									},
 								},
 								&oneOrMoreExpr{
-									pos: position{line: 312, col: 21, offset: 13346},
+									pos: position{line: 386, col: 21, offset: 16890},
 									expr: &ruleRefExpr{
-										pos:  position{line: 312, col: 21, offset: 13346},
+										pos:  position{line: 386, col: 21, offset: 16890},
 										name: "WS",
 									},
 								},
@@ -3322,27 +4076,27 @@ var g = &grammar{
 		},
 		{
 			name: "UnorderedListItemContent",
-			pos:  position{line: 316, col: 1, offset: 13482},
+			pos:  position{line: 390, col: 1, offset: 17026},
 			expr: &actionExpr{
-				pos: position{line: 316, col: 29, offset: 13510},
+				pos: position{line: 390, col: 29, offset: 17054},
 				run: (*parser).callonUnorderedListItemContent1,
 				expr: &labeledExpr{
-					pos:   position{line: 316, col: 29, offset: 13510},
+					pos:   position{line: 390, col: 29, offset: 17054},
 					label: "elements",
 					expr: &seqExpr{
-						pos: position{line: 316, col: 39, offset: 13520},
+						pos: position{line: 390, col: 39, offset: 17064},
 						exprs: []interface{}{
 							&oneOrMoreExpr{
-								pos: position{line: 316, col: 39, offset: 13520},
+								pos: position{line: 390, col: 39, offset: 17064},
 								expr: &ruleRefExpr{
-									pos:  position{line: 316, col: 39, offset: 13520},
+									pos:  position{line: 390, col: 39, offset: 17064},
 									name: "ListParagraph",
 								},
 							},
 							&zeroOrMoreExpr{
-								pos: position{line: 316, col: 54, offset: 13535},
+								pos: position{line: 390, col: 54, offset: 17079},
 								expr: &ruleRefExpr{
-									pos:  position{line: 316, col: 54, offset: 13535},
+									pos:  position{line: 390, col: 54, offset: 17079},
 									name: "ContinuedBlockElement",
 								},
 							},
@@ -3353,33 +4107,33 @@ var g = &grammar{
 		},
 		{
 			name: "LabeledListItem",
-			pos:  position{line: 323, col: 1, offset: 13859},
+			pos:  position{line: 397, col: 1, offset: 17403},
 			expr: &choiceExpr{
-				pos: position{line: 323, col: 20, offset: 13878},
+				pos: position{line: 397, col: 20, offset: 17422},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 323, col: 20, offset: 13878},
+						pos: position{line: 397, col: 20, offset: 17422},
 						run: (*parser).callonLabeledListItem2,
 						expr: &seqExpr{
-							pos: position{line: 323, col: 20, offset: 13878},
+							pos: position{line: 397, col: 20, offset: 17422},
 							exprs: []interface{}{
 								&labeledExpr{
-									pos:   position{line: 323, col: 20, offset: 13878},
+									pos:   position{line: 397, col: 20, offset: 17422},
 									label: "term",
 									expr: &ruleRefExpr{
-										pos:  position{line: 323, col: 26, offset: 13884},
+										pos:  position{line: 397, col: 26, offset: 17428},
 										name: "LabeledListItemTerm",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 323, col: 47, offset: 13905},
+									pos:  position{line: 397, col: 47, offset: 17449},
 									name: "LabeledListItemSeparator",
 								},
 								&labeledExpr{
-									pos:   position{line: 323, col: 72, offset: 13930},
+									pos:   position{line: 397, col: 72, offset: 17474},
 									label: "description",
 									expr: &ruleRefExpr{
-										pos:  position{line: 323, col: 85, offset: 13943},
+										pos:  position{line: 397, col: 85, offset: 17487},
 										name: "LabeledListItemDescription",
 									},
 								},
@@ -3387,33 +4141,33 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 325, col: 6, offset: 14070},
+						pos: position{line: 399, col: 6, offset: 17614},
 						run: (*parser).callonLabeledListItem9,
 						expr: &seqExpr{
-							pos: position{line: 325, col: 6, offset: 14070},
+							pos: position{line: 399, col: 6, offset: 17614},
 							exprs: []interface{}{
 								&labeledExpr{
-									pos:   position{line: 325, col: 6, offset: 14070},
+									pos:   position{line: 399, col: 6, offset: 17614},
 									label: "term",
 									expr: &ruleRefExpr{
-										pos:  position{line: 325, col: 12, offset: 14076},
+										pos:  position{line: 399, col: 12, offset: 17620},
 										name: "LabeledListItemTerm",
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 325, col: 33, offset: 14097},
+									pos:        position{line: 399, col: 33, offset: 17641},
 									val:        "::",
 									ignoreCase: false,
 								},
 								&zeroOrMoreExpr{
-									pos: position{line: 325, col: 38, offset: 14102},
+									pos: position{line: 399, col: 38, offset: 17646},
 									expr: &ruleRefExpr{
-										pos:  position{line: 325, col: 38, offset: 14102},
+										pos:  position{line: 399, col: 38, offset: 17646},
 										name: "WS",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 325, col: 42, offset: 14106},
+									pos:  position{line: 399, col: 42, offset: 17650},
 									name: "EOL",
 								},
 							},
@@ -3424,35 +4178,35 @@ var g = &grammar{
 		},
 		{
 			name: "LabeledListItemTerm",
-			pos:  position{line: 329, col: 1, offset: 14243},
+			pos:  position{line: 403, col: 1, offset: 17787},
 			expr: &actionExpr{
-				pos: position{line: 329, col: 24, offset: 14266},
+				pos: position{line: 403, col: 24, offset: 17810},
 				run: (*parser).callonLabeledListItemTerm1,
 				expr: &labeledExpr{
-					pos:   position{line: 329, col: 24, offset: 14266},
+					pos:   position{line: 403, col: 24, offset: 17810},
 					label: "term",
 					expr: &zeroOrMoreExpr{
-						pos: position{line: 329, col: 29, offset: 14271},
+						pos: position{line: 403, col: 29, offset: 17815},
 						expr: &seqExpr{
-							pos: position{line: 329, col: 30, offset: 14272},
+							pos: position{line: 403, col: 30, offset: 17816},
 							exprs: []interface{}{
 								&notExpr{
-									pos: position{line: 329, col: 30, offset: 14272},
+									pos: position{line: 403, col: 30, offset: 17816},
 									expr: &ruleRefExpr{
-										pos:  position{line: 329, col: 31, offset: 14273},
+										pos:  position{line: 403, col: 31, offset: 17817},
 										name: "NEWLINE",
 									},
 								},
 								&notExpr{
-									pos: position{line: 329, col: 39, offset: 14281},
+									pos: position{line: 403, col: 39, offset: 17825},
 									expr: &litMatcher{
-										pos:        position{line: 329, col: 40, offset: 14282},
+										pos:        position{line: 403, col: 40, offset: 17826},
 										val:        "::",
 										ignoreCase: false,
 									},
 								},
 								&anyMatcher{
-									line: 329, col: 45, offset: 14287,
+									line: 403, col: 45, offset: 17831,
 								},
 							},
 						},
@@ -3462,26 +4216,26 @@ var g = &grammar{
 		},
 		{
 			name: "LabeledListItemSeparator",
-			pos:  position{line: 334, col: 1, offset: 14378},
+			pos:  position{line: 408, col: 1, offset: 17922},
 			expr: &seqExpr{
-				pos: position{line: 334, col: 30, offset: 14407},
+				pos: position{line: 408, col: 30, offset: 17951},
 				exprs: []interface{}{
 					&litMatcher{
-						pos:        position{line: 334, col: 30, offset: 14407},
+						pos:        position{line: 408, col: 30, offset: 17951},
 						val:        "::",
 						ignoreCase: false,
 					},
 					&oneOrMoreExpr{
-						pos: position{line: 334, col: 35, offset: 14412},
+						pos: position{line: 408, col: 35, offset: 17956},
 						expr: &choiceExpr{
-							pos: position{line: 334, col: 36, offset: 14413},
+							pos: position{line: 408, col: 36, offset: 17957},
 							alternatives: []interface{}{
 								&ruleRefExpr{
-									pos:  position{line: 334, col: 36, offset: 14413},
+									pos:  position{line: 408, col: 36, offset: 17957},
 									name: "WS",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 334, col: 41, offset: 14418},
+									pos:  position{line: 408, col: 41, offset: 17962},
 									name: "NEWLINE",
 								},
 							},
@@ -3492,24 +4246,24 @@ var g = &grammar{
 		},
 		{
 			name: "LabeledListItemDescription",
-			pos:  position{line: 336, col: 1, offset: 14429},
+			pos:  position{line: 410, col: 1, offset: 17973},
 			expr: &actionExpr{
-				pos: position{line: 336, col: 31, offset: 14459},
+				pos: position{line: 410, col: 31, offset: 18003},
 				run: (*parser).callonLabeledListItemDescription1,
 				expr: &labeledExpr{
-					pos:   position{line: 336, col: 31, offset: 14459},
+					pos:   position{line: 410, col: 31, offset: 18003},
 					label: "elements",
 					expr: &zeroOrMoreExpr{
-						pos: position{line: 336, col: 40, offset: 14468},
+						pos: position{line: 410, col: 40, offset: 18012},
 						expr: &choiceExpr{
-							pos: position{line: 336, col: 41, offset: 14469},
+							pos: position{line: 410, col: 41, offset: 18013},
 							alternatives: []interface{}{
 								&ruleRefExpr{
-									pos:  position{line: 336, col: 41, offset: 14469},
+									pos:  position{line: 410, col: 41, offset: 18013},
 									name: "ListParagraph",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 336, col: 57, offset: 14485},
+									pos:  position{line: 410, col: 57, offset: 18029},
 									name: "ContinuedBlockElement",
 								},
 							},
@@ -3519,65 +4273,33 @@ var g = &grammar{
 			},
 		},
 		{
-			name: "Paragraph",
-			pos:  position{line: 345, col: 1, offset: 14942},
+			name: "CalloutList",
+			pos:  position{line: 417, col: 1, offset: 18337},
 			expr: &actionExpr{
-				pos: position{line: 345, col: 14, offset: 14955},
-				run: (*parser).callonParagraph1,
+				pos: position{line: 417, col: 16, offset: 18352},
+				run: (*parser).callonCalloutList1,
 				expr: &seqExpr{
-					pos: position{line: 345, col: 14, offset: 14955},
+					pos: position{line: 417, col: 16, offset: 18352},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 345, col: 14, offset: 14955},
+							pos:   position{line: 417, col: 16, offset: 18352},
 							label: "attributes",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 345, col: 25, offset: 14966},
+								pos: position{line: 417, col: 27, offset: 18363},
 								expr: &ruleRefExpr{
-									pos:  position{line: 345, col: 26, offset: 14967},
+									pos:  position{line: 417, col: 28, offset: 18364},
 									name: "ElementAttribute",
 								},
 							},
 						},
-						&notExpr{
-							pos: position{line: 345, col: 45, offset: 14986},
-							expr: &seqExpr{
-								pos: position{line: 345, col: 47, offset: 14988},
-								exprs: []interface{}{
-									&oneOrMoreExpr{
-										pos: position{line: 345, col: 47, offset: 14988},
-										expr: &litMatcher{
-											pos:        position{line: 345, col: 47, offset: 14988},
-											val:        "=",
-											ignoreCase: false,
-										},
-									},
-									&oneOrMoreExpr{
-										pos: position{line: 345, col: 52, offset: 14993},
-										expr: &ruleRefExpr{
-											pos:  position{line: 345, col: 52, offset: 14993},
-											name: "WS",
-										},
-									},
-								},
-							},
-						},
 						&labeledExpr{
-							pos:   position{line: 345, col: 57, offset: 14998},
-							label: "lines",
+							pos:   position{line: 417, col: 47, offset: 18383},
+							label: "items",
 							expr: &oneOrMoreExpr{
-								pos: position{line: 345, col: 63, offset: 15004},
-								expr: &seqExpr{
-									pos: position{line: 345, col: 64, offset: 15005},
-									exprs: []interface{}{
-										&ruleRefExpr{
-											pos:  position{line: 345, col: 64, offset: 15005},
-											name: "InlineContentWithTrailingSpaces",
-										},
-										&ruleRefExpr{
-											pos:  position{line: 345, col: 96, offset: 15037},
-											name: "EOL",
-										},
-									},
+								pos: position{line: 417, col: 53, offset: 18389},
+								expr: &ruleRefExpr{
+									pos:  position{line: 417, col: 54, offset: 18390},
+									name: "CalloutListItem",
 								},
 							},
 						},
@@ -3586,56 +4308,35 @@ var g = &grammar{
 			},
 		},
 		{
-			name: "InlineContentWithTrailingSpaces",
-			pos:  position{line: 351, col: 1, offset: 15327},
+			name: "CalloutListItem",
+			pos:  position{line: 421, col: 1, offset: 18496},
 			expr: &actionExpr{
-				pos: position{line: 351, col: 36, offset: 15362},
-				run: (*parser).callonInlineContentWithTrailingSpaces1,
+				pos: position{line: 421, col: 20, offset: 18515},
+				run: (*parser).callonCalloutListItem1,
 				expr: &seqExpr{
-					pos: position{line: 351, col: 36, offset: 15362},
+					pos: position{line: 421, col: 20, offset: 18515},
 					exprs: []interface{}{
-						&notExpr{
-							pos: position{line: 351, col: 36, offset: 15362},
+						&labeledExpr{
+							pos:   position{line: 421, col: 20, offset: 18515},
+							label: "ref",
 							expr: &ruleRefExpr{
-								pos:  position{line: 351, col: 37, offset: 15363},
-								name: "BlockDelimiter",
+								pos:  position{line: 421, col: 25, offset: 18520},
+								name: "CalloutListItemPrefix",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 351, col: 52, offset: 15378},
-							label: "elements",
-							expr: &oneOrMoreExpr{
-								pos: position{line: 351, col: 61, offset: 15387},
-								expr: &seqExpr{
-									pos: position{line: 351, col: 62, offset: 15388},
-									exprs: []interface{}{
-										&zeroOrMoreExpr{
-											pos: position{line: 351, col: 62, offset: 15388},
-											expr: &ruleRefExpr{
-												pos:  position{line: 351, col: 62, offset: 15388},
-												name: "WS",
-											},
-										},
-										&notExpr{
-											pos: position{line: 351, col: 66, offset: 15392},
-											expr: &ruleRefExpr{
-												pos:  position{line: 351, col: 67, offset: 15393},
-												name: "InlineElementID",
-											},
-										},
-										&ruleRefExpr{
-											pos:  position{line: 351, col: 83, offset: 15409},
-											name: "InlineElement",
-										},
-										&zeroOrMoreExpr{
-											pos: position{line: 351, col: 97, offset: 15423},
-											expr: &ruleRefExpr{
-												pos:  position{line: 351, col: 97, offset: 15423},
-												name: "WS",
-											},
-										},
-									},
-								},
+							pos:   position{line: 421, col: 48, offset: 18543},
+							label: "content",
+							expr: &ruleRefExpr{
+								pos:  position{line: 421, col: 57, offset: 18552},
+								name: "CalloutListItemContent",
+							},
+						},
+						&zeroOrOneExpr{
+							pos: position{line: 421, col: 81, offset: 18576},
+							expr: &ruleRefExpr{
+								pos:  position{line: 421, col: 81, offset: 18576},
+								name: "BlankLine",
 							},
 						},
 					},
@@ -3643,212 +4344,289 @@ var g = &grammar{
 			},
 		},
 		{
-			name: "InlineContent",
-			pos:  position{line: 355, col: 1, offset: 15556},
+			name: "CalloutListItemPrefix",
+			pos:  position{line: 425, col: 1, offset: 18679},
 			expr: &actionExpr{
-				pos: position{line: 355, col: 18, offset: 15573},
-				run: (*parser).callonInlineContent1,
+				pos: position{line: 425, col: 26, offset: 18704},
+				run: (*parser).callonCalloutListItemPrefix1,
 				expr: &seqExpr{
-					pos: position{line: 355, col: 18, offset: 15573},
+					pos: position{line: 425, col: 26, offset: 18704},
 					exprs: []interface{}{
-						&notExpr{
-							pos: position{line: 355, col: 18, offset: 15573},
-							expr: &ruleRefExpr{
-								pos:  position{line: 355, col: 19, offset: 15574},
-								name: "BlockDelimiter",
-							},
+						&litMatcher{
+							pos:        position{line: 425, col: 26, offset: 18704},
+							val:        "<",
+							ignoreCase: false,
 						},
 						&labeledExpr{
-							pos:   position{line: 355, col: 34, offset: 15589},
-							label: "elements",
+							pos:   position{line: 425, col: 30, offset: 18708},
+							label: "ref",
 							expr: &oneOrMoreExpr{
-								pos: position{line: 355, col: 43, offset: 15598},
-								expr: &seqExpr{
-									pos: position{line: 355, col: 44, offset: 15599},
-									exprs: []interface{}{
-										&zeroOrMoreExpr{
-											pos: position{line: 355, col: 44, offset: 15599},
-											expr: &ruleRefExpr{
-												pos:  position{line: 355, col: 44, offset: 15599},
-												name: "WS",
-											},
-										},
-										&notExpr{
-											pos: position{line: 355, col: 48, offset: 15603},
-											expr: &ruleRefExpr{
-												pos:  position{line: 355, col: 49, offset: 15604},
-												name: "InlineElementID",
-											},
-										},
-										&ruleRefExpr{
-											pos:  position{line: 355, col: 65, offset: 15620},
-											name: "InlineElement",
-										},
-									},
+								pos: position{line: 425, col: 35, offset: 18713},
+								expr: &charClassMatcher{
+									pos:        position{line: 425, col: 35, offset: 18713},
+									val:        "[0-9]",
+									ranges:     []rune{'0', '9'},
+									ignoreCase: false,
+									inverted:   false,
 								},
 							},
 						},
+						&litMatcher{
+							pos:        position{line: 425, col: 43, offset: 18721},
+							val:        ">",
+							ignoreCase: false,
+						},
+						&oneOrMoreExpr{
+							pos: position{line: 425, col: 47, offset: 18725},
+							expr: &ruleRefExpr{
+								pos:  position{line: 425, col: 47, offset: 18725},
+								name: "WS",
+							},
+						},
 					},
 				},
 			},
 		},
 		{
-			name: "InlineElement",
-			pos:  position{line: 359, col: 1, offset: 15742},
-			expr: &choiceExpr{
-				pos: position{line: 359, col: 18, offset: 15759},
-				alternatives: []interface{}{
-					&ruleRefExpr{
-						pos:  position{line: 359, col: 18, offset: 15759},
-						name: "CrossReference",
-					},
-					&ruleRefExpr{
-						pos:  position{line: 359, col: 35, offset: 15776},
-						name: "Passthrough",
-					},
-					&ruleRefExpr{
-						pos:  position{line: 359, col: 49, offset: 15790},
-						name: "InlineImage",
-					},
-					&ruleRefExpr{
-						pos:  position{line: 359, col: 63, offset: 15804},
-						name: "QuotedText",
-					},
-					&ruleRefExpr{
-						pos:  position{line: 359, col: 76, offset: 15817},
-						name: "Link",
-					},
-					&ruleRefExpr{
-						pos:  position{line: 359, col: 83, offset: 15824},
-						name: "DocumentAttributeSubstitution",
-					},
-					&ruleRefExpr{
-						pos:  position{line: 359, col: 115, offset: 15856},
-						name: "Characters",
+			name: "CalloutListItemContent",
+			pos:  position{line: 429, col: 1, offset: 18754},
+			expr: &actionExpr{
+				pos: position{line: 429, col: 27, offset: 18780},
+				run: (*parser).callonCalloutListItemContent1,
+				expr: &labeledExpr{
+					pos:   position{line: 429, col: 27, offset: 18780},
+					label: "elements",
+					expr: &seqExpr{
+						pos: position{line: 429, col: 37, offset: 18790},
+						exprs: []interface{}{
+							&oneOrMoreExpr{
+								pos: position{line: 429, col: 37, offset: 18790},
+								expr: &ruleRefExpr{
+									pos:  position{line: 429, col: 37, offset: 18790},
+									name: "ListParagraph",
+								},
+							},
+							&zeroOrMoreExpr{
+								pos: position{line: 429, col: 52, offset: 18805},
+								expr: &ruleRefExpr{
+									pos:  position{line: 429, col: 52, offset: 18805},
+									name: "ContinuedBlockElement",
+								},
+							},
+						},
 					},
 				},
 			},
 		},
 		{
-			name: "Admonition",
-			pos:  position{line: 365, col: 1, offset: 15976},
-			expr: &ruleRefExpr{
-				pos:  position{line: 365, col: 15, offset: 15990},
-				name: "AdmonitionParagraph",
-			},
-		},
-		{
-			name: "AdmonitionParagraph",
-			pos:  position{line: 369, col: 1, offset: 16161},
+			name: "Paragraph",
+			pos:  position{line: 439, col: 1, offset: 19211},
 			expr: &choiceExpr{
-				pos: position{line: 369, col: 24, offset: 16184},
+				pos: position{line: 439, col: 14, offset: 19224},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 369, col: 24, offset: 16184},
-						run: (*parser).callonAdmonitionParagraph2,
+						pos: position{line: 439, col: 14, offset: 19224},
+						run: (*parser).callonParagraph2,
 						expr: &seqExpr{
-							pos: position{line: 369, col: 24, offset: 16184},
+							pos: position{line: 439, col: 14, offset: 19224},
 							exprs: []interface{}{
 								&labeledExpr{
-									pos:   position{line: 369, col: 24, offset: 16184},
+									pos:   position{line: 439, col: 14, offset: 19224},
 									label: "attributes",
 									expr: &zeroOrMoreExpr{
-										pos: position{line: 369, col: 35, offset: 16195},
+										pos: position{line: 439, col: 25, offset: 19235},
 										expr: &ruleRefExpr{
-											pos:  position{line: 369, col: 36, offset: 16196},
+											pos:  position{line: 439, col: 26, offset: 19236},
 											name: "ElementAttribute",
 										},
 									},
 								},
+								&andCodeExpr{
+									pos: position{line: 439, col: 45, offset: 19255},
+									run: (*parser).callonParagraph7,
+								},
 								&notExpr{
-									pos: position{line: 369, col: 55, offset: 16215},
+									pos: position{line: 439, col: 91, offset: 19301},
 									expr: &seqExpr{
-										pos: position{line: 369, col: 57, offset: 16217},
+										pos: position{line: 439, col: 93, offset: 19303},
 										exprs: []interface{}{
 											&oneOrMoreExpr{
-												pos: position{line: 369, col: 57, offset: 16217},
+												pos: position{line: 439, col: 93, offset: 19303},
 												expr: &litMatcher{
-													pos:        position{line: 369, col: 57, offset: 16217},
+													pos:        position{line: 439, col: 93, offset: 19303},
 													val:        "=",
 													ignoreCase: false,
 												},
 											},
 											&oneOrMoreExpr{
-												pos: position{line: 369, col: 62, offset: 16222},
+												pos: position{line: 439, col: 98, offset: 19308},
 												expr: &ruleRefExpr{
-													pos:  position{line: 369, col: 62, offset: 16222},
+													pos:  position{line: 439, col: 98, offset: 19308},
 													name: "WS",
 												},
 											},
 										},
 									},
 								},
-								&labeledExpr{
-									pos:   position{line: 369, col: 67, offset: 16227},
-									label: "t",
-									expr: &ruleRefExpr{
-										pos:  position{line: 369, col: 70, offset: 16230},
-										name: "AdmonitionKind",
+								&notExpr{
+									pos: position{line: 439, col: 103, offset: 19313},
+									expr: &seqExpr{
+										pos: position{line: 439, col: 105, offset: 19315},
+										exprs: []interface{}{
+											&oneOrMoreExpr{
+												pos: position{line: 439, col: 105, offset: 19315},
+												expr: &litMatcher{
+													pos:        position{line: 439, col: 105, offset: 19315},
+													val:        "#",
+													ignoreCase: false,
+												},
+											},
+											&oneOrMoreExpr{
+												pos: position{line: 439, col: 110, offset: 19320},
+												expr: &ruleRefExpr{
+													pos:  position{line: 439, col: 110, offset: 19320},
+													name: "WS",
+												},
+											},
+										},
 									},
 								},
-								&litMatcher{
-									pos:        position{line: 369, col: 86, offset: 16246},
-									val:        ": ",
-									ignoreCase: false,
+								&notExpr{
+									pos: position{line: 439, col: 115, offset: 19325},
+									expr: &ruleRefExpr{
+										pos:  position{line: 439, col: 116, offset: 19326},
+										name: "SingleLineComment",
+									},
 								},
 								&labeledExpr{
-									pos:   position{line: 369, col: 91, offset: 16251},
-									label: "content",
-									expr: &ruleRefExpr{
-										pos:  position{line: 369, col: 100, offset: 16260},
-										name: "AdmonitionParagraphContent",
+									pos:   position{line: 439, col: 134, offset: 19344},
+									label: "lines",
+									expr: &oneOrMoreExpr{
+										pos: position{line: 439, col: 140, offset: 19350},
+										expr: &choiceExpr{
+											pos: position{line: 439, col: 141, offset: 19351},
+											alternatives: []interface{}{
+												&ruleRefExpr{
+													pos:  position{line: 439, col: 141, offset: 19351},
+													name: "SingleLineComment",
+												},
+												&seqExpr{
+													pos: position{line: 439, col: 162, offset: 19372},
+													exprs: []interface{}{
+														&ruleRefExpr{
+															pos:  position{line: 439, col: 162, offset: 19372},
+															name: "RawParagraphLine",
+														},
+														&ruleRefExpr{
+															pos:  position{line: 439, col: 179, offset: 19389},
+															name: "EOL",
+														},
+													},
+												},
+											},
+										},
 									},
 								},
 							},
 						},
 					},
 					&actionExpr{
-						pos: position{line: 371, col: 5, offset: 16416},
-						run: (*parser).callonAdmonitionParagraph18,
+						pos: position{line: 445, col: 5, offset: 19637},
+						run: (*parser).callonParagraph29,
 						expr: &seqExpr{
-							pos: position{line: 371, col: 5, offset: 16416},
+							pos: position{line: 445, col: 5, offset: 19637},
 							exprs: []interface{}{
 								&labeledExpr{
-									pos:   position{line: 371, col: 5, offset: 16416},
+									pos:   position{line: 445, col: 5, offset: 19637},
 									label: "attributes",
 									expr: &zeroOrMoreExpr{
-										pos: position{line: 371, col: 16, offset: 16427},
+										pos: position{line: 445, col: 16, offset: 19648},
 										expr: &ruleRefExpr{
-											pos:  position{line: 371, col: 17, offset: 16428},
+											pos:  position{line: 445, col: 17, offset: 19649},
 											name: "ElementAttribute",
 										},
 									},
 								},
-								&labeledExpr{
-									pos:   position{line: 371, col: 36, offset: 16447},
-									label: "k",
-									expr: &ruleRefExpr{
-										pos:  position{line: 371, col: 39, offset: 16450},
-										name: "AdmonitionMarker",
-									},
-								},
-								&labeledExpr{
-									pos:   position{line: 371, col: 57, offset: 16468},
-									label: "otherAttributes",
-									expr: &zeroOrMoreExpr{
-										pos: position{line: 371, col: 73, offset: 16484},
-										expr: &ruleRefExpr{
-											pos:  position{line: 371, col: 74, offset: 16485},
-											name: "ElementAttribute",
-										},
-									},
+								&notExpr{
+									pos: position{line: 445, col: 36, offset: 19668},
+									expr: &seqExpr{
+										pos: position{line: 445, col: 38, offset: 19670},
+										exprs: []interface{}{
+											&oneOrMoreExpr{
+												pos: position{line: 445, col: 38, offset: 19670},
+												expr: &litMatcher{
+													pos:        position{line: 445, col: 38, offset: 19670},
+													val:        "=",
+													ignoreCase: false,
+												},
+											},
+											&oneOrMoreExpr{
+												pos: position{line: 445, col: 43, offset: 19675},
+												expr: &ruleRefExpr{
+													pos:  position{line: 445, col: 43, offset: 19675},
+													name: "WS",
+												},
+											},
+										},
+									},
 								},
-								&labeledExpr{
-									pos:   position{line: 371, col: 93, offset: 16504},
-									label: "content",
+								&notExpr{
+									pos: position{line: 445, col: 48, offset: 19680},
+									expr: &seqExpr{
+										pos: position{line: 445, col: 50, offset: 19682},
+										exprs: []interface{}{
+											&oneOrMoreExpr{
+												pos: position{line: 445, col: 50, offset: 19682},
+												expr: &litMatcher{
+													pos:        position{line: 445, col: 50, offset: 19682},
+													val:        "#",
+													ignoreCase: false,
+												},
+											},
+											&oneOrMoreExpr{
+												pos: position{line: 445, col: 55, offset: 19687},
+												expr: &ruleRefExpr{
+													pos:  position{line: 445, col: 55, offset: 19687},
+													name: "WS",
+												},
+											},
+										},
+									},
+								},
+								&notExpr{
+									pos: position{line: 445, col: 60, offset: 19692},
 									expr: &ruleRefExpr{
-										pos:  position{line: 371, col: 102, offset: 16513},
-										name: "AdmonitionParagraphContent",
+										pos:  position{line: 445, col: 61, offset: 19693},
+										name: "SingleLineComment",
+									},
+								},
+								&labeledExpr{
+									pos:   position{line: 445, col: 79, offset: 19711},
+									label: "lines",
+									expr: &oneOrMoreExpr{
+										pos: position{line: 445, col: 85, offset: 19717},
+										expr: &choiceExpr{
+											pos: position{line: 445, col: 86, offset: 19718},
+											alternatives: []interface{}{
+												&ruleRefExpr{
+													pos:  position{line: 445, col: 86, offset: 19718},
+													name: "SingleLineComment",
+												},
+												&seqExpr{
+													pos: position{line: 445, col: 107, offset: 19739},
+													exprs: []interface{}{
+														&ruleRefExpr{
+															pos:  position{line: 445, col: 107, offset: 19739},
+															name: "InlineContentWithTrailingSpaces",
+														},
+														&ruleRefExpr{
+															pos:  position{line: 445, col: 139, offset: 19771},
+															name: "EOL",
+														},
+													},
+												},
+											},
+										},
 									},
 								},
 							},
@@ -3858,26 +4636,59 @@ var g = &grammar{
 			},
 		},
 		{
-			name: "AdmonitionParagraphContent",
-			pos:  position{line: 375, col: 1, offset: 16708},
+			name: "RawParagraphLine",
+			pos:  position{line: 450, col: 1, offset: 19909},
 			expr: &actionExpr{
-				pos: position{line: 375, col: 31, offset: 16738},
-				run: (*parser).callonAdmonitionParagraphContent1,
-				expr: &labeledExpr{
-					pos:   position{line: 375, col: 31, offset: 16738},
-					label: "lines",
-					expr: &oneOrMoreExpr{
-						pos: position{line: 375, col: 37, offset: 16744},
-						expr: &seqExpr{
-							pos: position{line: 375, col: 38, offset: 16745},
-							exprs: []interface{}{
-								&ruleRefExpr{
-									pos:  position{line: 375, col: 38, offset: 16745},
-									name: "InlineContentWithTrailingSpaces",
+				pos: position{line: 450, col: 21, offset: 19929},
+				run: (*parser).callonRawParagraphLine1,
+				expr: &seqExpr{
+					pos: position{line: 450, col: 21, offset: 19929},
+					exprs: []interface{}{
+						&notExpr{
+							pos: position{line: 450, col: 21, offset: 19929},
+							expr: &ruleRefExpr{
+								pos:  position{line: 450, col: 22, offset: 19930},
+								name: "BlockDelimiter",
+							},
+						},
+						&notExpr{
+							pos: position{line: 450, col: 37, offset: 19945},
+							expr: &seqExpr{
+								pos: position{line: 450, col: 39, offset: 19947},
+								exprs: []interface{}{
+									&zeroOrMoreExpr{
+										pos: position{line: 450, col: 39, offset: 19947},
+										expr: &ruleRefExpr{
+											pos:  position{line: 450, col: 39, offset: 19947},
+											name: "WS",
+										},
+									},
+									&ruleRefExpr{
+										pos:  position{line: 450, col: 43, offset: 19951},
+										name: "EOL",
+									},
 								},
-								&ruleRefExpr{
-									pos:  position{line: 375, col: 70, offset: 16777},
-									name: "EOL",
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 450, col: 48, offset: 19956},
+							label: "content",
+							expr: &oneOrMoreExpr{
+								pos: position{line: 450, col: 56, offset: 19964},
+								expr: &seqExpr{
+									pos: position{line: 450, col: 57, offset: 19965},
+									exprs: []interface{}{
+										&notExpr{
+											pos: position{line: 450, col: 57, offset: 19965},
+											expr: &ruleRefExpr{
+												pos:  position{line: 450, col: 58, offset: 19966},
+												name: "NEWLINE",
+											},
+										},
+										&anyMatcher{
+											line: 450, col: 66, offset: 19974,
+										},
+									},
 								},
 							},
 						},
@@ -3886,241 +4697,366 @@ var g = &grammar{
 			},
 		},
 		{
-			name: "AdmonitionMarker",
-			pos:  position{line: 380, col: 1, offset: 16938},
+			name: "InlineContentWithTrailingSpaces",
+			pos:  position{line: 456, col: 1, offset: 20239},
 			expr: &actionExpr{
-				pos: position{line: 380, col: 21, offset: 16958},
-				run: (*parser).callonAdmonitionMarker1,
+				pos: position{line: 456, col: 36, offset: 20274},
+				run: (*parser).callonInlineContentWithTrailingSpaces1,
 				expr: &seqExpr{
-					pos: position{line: 380, col: 21, offset: 16958},
+					pos: position{line: 456, col: 36, offset: 20274},
 					exprs: []interface{}{
-						&litMatcher{
-							pos:        position{line: 380, col: 21, offset: 16958},
-							val:        "[",
-							ignoreCase: false,
-						},
-						&labeledExpr{
-							pos:   position{line: 380, col: 25, offset: 16962},
-							label: "k",
+						&notExpr{
+							pos: position{line: 456, col: 36, offset: 20274},
 							expr: &ruleRefExpr{
-								pos:  position{line: 380, col: 28, offset: 16965},
-								name: "AdmonitionKind",
+								pos:  position{line: 456, col: 37, offset: 20275},
+								name: "BlockDelimiter",
 							},
 						},
-						&litMatcher{
-							pos:        position{line: 380, col: 44, offset: 16981},
-							val:        "]",
-							ignoreCase: false,
+						&labeledExpr{
+							pos:   position{line: 456, col: 52, offset: 20290},
+							label: "elements",
+							expr: &oneOrMoreExpr{
+								pos: position{line: 456, col: 61, offset: 20299},
+								expr: &seqExpr{
+									pos: position{line: 456, col: 62, offset: 20300},
+									exprs: []interface{}{
+										&zeroOrMoreExpr{
+											pos: position{line: 456, col: 62, offset: 20300},
+											expr: &ruleRefExpr{
+												pos:  position{line: 456, col: 62, offset: 20300},
+												name: "WS",
+											},
+										},
+										&ruleRefExpr{
+											pos:  position{line: 456, col: 66, offset: 20304},
+											name: "InlineElement",
+										},
+										&zeroOrMoreExpr{
+											pos: position{line: 456, col: 80, offset: 20318},
+											expr: &ruleRefExpr{
+												pos:  position{line: 456, col: 80, offset: 20318},
+												name: "WS",
+											},
+										},
+									},
+								},
+							},
 						},
-						&zeroOrMoreExpr{
-							pos: position{line: 380, col: 48, offset: 16985},
+					},
+				},
+			},
+		},
+		{
+			name: "InlineContent",
+			pos:  position{line: 460, col: 1, offset: 20451},
+			expr: &actionExpr{
+				pos: position{line: 460, col: 18, offset: 20468},
+				run: (*parser).callonInlineContent1,
+				expr: &seqExpr{
+					pos: position{line: 460, col: 18, offset: 20468},
+					exprs: []interface{}{
+						&notExpr{
+							pos: position{line: 460, col: 18, offset: 20468},
 							expr: &ruleRefExpr{
-								pos:  position{line: 380, col: 48, offset: 16985},
-								name: "WS",
+								pos:  position{line: 460, col: 19, offset: 20469},
+								name: "BlockDelimiter",
 							},
 						},
-						&ruleRefExpr{
-							pos:  position{line: 380, col: 52, offset: 16989},
-							name: "NEWLINE",
+						&labeledExpr{
+							pos:   position{line: 460, col: 34, offset: 20484},
+							label: "elements",
+							expr: &oneOrMoreExpr{
+								pos: position{line: 460, col: 43, offset: 20493},
+								expr: &seqExpr{
+									pos: position{line: 460, col: 44, offset: 20494},
+									exprs: []interface{}{
+										&zeroOrMoreExpr{
+											pos: position{line: 460, col: 44, offset: 20494},
+											expr: &ruleRefExpr{
+												pos:  position{line: 460, col: 44, offset: 20494},
+												name: "WS",
+											},
+										},
+										&notExpr{
+											pos: position{line: 460, col: 48, offset: 20498},
+											expr: &seqExpr{
+												pos: position{line: 460, col: 50, offset: 20500},
+												exprs: []interface{}{
+													&ruleRefExpr{
+														pos:  position{line: 460, col: 50, offset: 20500},
+														name: "InlineElementID",
+													},
+													&zeroOrMoreExpr{
+														pos: position{line: 460, col: 66, offset: 20516},
+														expr: &ruleRefExpr{
+															pos:  position{line: 460, col: 66, offset: 20516},
+															name: "WS",
+														},
+													},
+													&ruleRefExpr{
+														pos:  position{line: 460, col: 70, offset: 20520},
+														name: "EOL",
+													},
+												},
+											},
+										},
+										&ruleRefExpr{
+											pos:  position{line: 460, col: 75, offset: 20525},
+											name: "InlineElement",
+										},
+									},
+								},
+							},
 						},
 					},
 				},
 			},
 		},
 		{
-			name: "AdmonitionKind",
-			pos:  position{line: 384, col: 1, offset: 17020},
+			name: "InlineElement",
+			pos:  position{line: 464, col: 1, offset: 20697},
 			expr: &choiceExpr{
-				pos: position{line: 384, col: 19, offset: 17038},
+				pos: position{line: 464, col: 18, offset: 20714},
 				alternatives: []interface{}{
-					&actionExpr{
-						pos: position{line: 384, col: 19, offset: 17038},
-						run: (*parser).callonAdmonitionKind2,
-						expr: &litMatcher{
-							pos:        position{line: 384, col: 19, offset: 17038},
-							val:        "TIP",
-							ignoreCase: false,
-						},
+					&ruleRefExpr{
+						pos:  position{line: 464, col: 18, offset: 20714},
+						name: "CrossReference",
 					},
-					&actionExpr{
-						pos: position{line: 386, col: 5, offset: 17076},
-						run: (*parser).callonAdmonitionKind4,
-						expr: &litMatcher{
-							pos:        position{line: 386, col: 5, offset: 17076},
-							val:        "NOTE",
-							ignoreCase: false,
-						},
+					&ruleRefExpr{
+						pos:  position{line: 464, col: 35, offset: 20731},
+						name: "InlineAnchor",
 					},
-					&actionExpr{
-						pos: position{line: 388, col: 5, offset: 17116},
-						run: (*parser).callonAdmonitionKind6,
-						expr: &litMatcher{
-							pos:        position{line: 388, col: 5, offset: 17116},
-							val:        "IMPORTANT",
-							ignoreCase: false,
-						},
+					&ruleRefExpr{
+						pos:  position{line: 464, col: 50, offset: 20746},
+						name: "LineBreak",
 					},
-					&actionExpr{
-						pos: position{line: 390, col: 5, offset: 17166},
-						run: (*parser).callonAdmonitionKind8,
-						expr: &litMatcher{
-							pos:        position{line: 390, col: 5, offset: 17166},
-							val:        "WARNING",
-							ignoreCase: false,
-						},
+					&ruleRefExpr{
+						pos:  position{line: 464, col: 62, offset: 20758},
+						name: "Passthrough",
 					},
-					&actionExpr{
-						pos: position{line: 392, col: 5, offset: 17212},
-						run: (*parser).callonAdmonitionKind10,
-						expr: &litMatcher{
-							pos:        position{line: 392, col: 5, offset: 17212},
-							val:        "CAUTION",
-							ignoreCase: false,
-						},
+					&ruleRefExpr{
+						pos:  position{line: 464, col: 76, offset: 20772},
+						name: "InlineStem",
 					},
-				},
-			},
-		},
-		{
-			name: "QuotedText",
-			pos:  position{line: 399, col: 1, offset: 17496},
-			expr: &choiceExpr{
-				pos: position{line: 399, col: 15, offset: 17510},
-				alternatives: []interface{}{
 					&ruleRefExpr{
-						pos:  position{line: 399, col: 15, offset: 17510},
-						name: "BoldText",
+						pos:  position{line: 464, col: 89, offset: 20785},
+						name: "InlineImage",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 464, col: 103, offset: 20799},
+						name: "Footnote",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 399, col: 26, offset: 17521},
-						name: "ItalicText",
+						pos:  position{line: 464, col: 114, offset: 20810},
+						name: "InlineUIMacro",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 399, col: 39, offset: 17534},
-						name: "MonospaceText",
+						pos:  position{line: 464, col: 130, offset: 20826},
+						name: "QuotedText",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 400, col: 13, offset: 17562},
-						name: "EscapedBoldText",
+						pos:  position{line: 464, col: 143, offset: 20839},
+						name: "Link",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 400, col: 31, offset: 17580},
-						name: "EscapedItalicText",
+						pos:  position{line: 464, col: 150, offset: 20846},
+						name: "DocumentAttributeSubstitution",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 400, col: 51, offset: 17600},
-						name: "EscapedMonospaceText",
+						pos:  position{line: 464, col: 182, offset: 20878},
+						name: "InlineCharacters",
 					},
 				},
 			},
 		},
 		{
-			name: "BoldText",
-			pos:  position{line: 402, col: 1, offset: 17622},
-			expr: &choiceExpr{
-				pos: position{line: 402, col: 13, offset: 17634},
-				alternatives: []interface{}{
-					&actionExpr{
-						pos: position{line: 402, col: 13, offset: 17634},
-						run: (*parser).callonBoldText2,
-						expr: &seqExpr{
-							pos: position{line: 402, col: 13, offset: 17634},
-							exprs: []interface{}{
-								&notExpr{
-									pos: position{line: 402, col: 13, offset: 17634},
-									expr: &litMatcher{
-										pos:        position{line: 402, col: 14, offset: 17635},
-										val:        "\\\\",
-										ignoreCase: false,
-									},
-								},
-								&litMatcher{
-									pos:        position{line: 402, col: 19, offset: 17640},
-									val:        "**",
-									ignoreCase: false,
-								},
-								&labeledExpr{
-									pos:   position{line: 402, col: 24, offset: 17645},
-									label: "content",
-									expr: &ruleRefExpr{
-										pos:  position{line: 402, col: 33, offset: 17654},
-										name: "QuotedTextContent",
-									},
+			name: "InlineCharacters",
+			pos:  position{line: 468, col: 1, offset: 21123},
+			expr: &actionExpr{
+				pos: position{line: 468, col: 21, offset: 21143},
+				run: (*parser).callonInlineCharacters1,
+				expr: &oneOrMoreExpr{
+					pos: position{line: 468, col: 21, offset: 21143},
+					expr: &seqExpr{
+						pos: position{line: 468, col: 22, offset: 21144},
+						exprs: []interface{}{
+							&notExpr{
+								pos: position{line: 468, col: 22, offset: 21144},
+								expr: &ruleRefExpr{
+									pos:  position{line: 468, col: 23, offset: 21145},
+									name: "NEWLINE",
 								},
-								&litMatcher{
-									pos:        position{line: 402, col: 52, offset: 17673},
-									val:        "**",
-									ignoreCase: false,
+							},
+							&notExpr{
+								pos: position{line: 468, col: 31, offset: 21153},
+								expr: &ruleRefExpr{
+									pos:  position{line: 468, col: 32, offset: 21154},
+									name: "WS",
 								},
 							},
-						},
-					},
-					&actionExpr{
-						pos: position{line: 404, col: 5, offset: 17798},
-						run: (*parser).callonBoldText10,
-						expr: &seqExpr{
-							pos: position{line: 404, col: 5, offset: 17798},
-							exprs: []interface{}{
-								&notExpr{
-									pos: position{line: 404, col: 5, offset: 17798},
-									expr: &litMatcher{
-										pos:        position{line: 404, col: 6, offset: 17799},
-										val:        "\\\\",
-										ignoreCase: false,
-									},
+							&notExpr{
+								pos: position{line: 468, col: 35, offset: 21157},
+								expr: &ruleRefExpr{
+									pos:  position{line: 468, col: 36, offset: 21158},
+									name: "Footnote",
 								},
-								&litMatcher{
-									pos:        position{line: 404, col: 11, offset: 17804},
-									val:        "**",
-									ignoreCase: false,
+							},
+							&notExpr{
+								pos: position{line: 468, col: 45, offset: 21167},
+								expr: &ruleRefExpr{
+									pos:  position{line: 468, col: 46, offset: 21168},
+									name: "InlineStem",
 								},
-								&labeledExpr{
-									pos:   position{line: 404, col: 16, offset: 17809},
-									label: "content",
-									expr: &ruleRefExpr{
-										pos:  position{line: 404, col: 25, offset: 17818},
-										name: "QuotedTextContent",
+							},
+							&notExpr{
+								pos: position{line: 468, col: 57, offset: 21179},
+								expr: &ruleRefExpr{
+									pos:  position{line: 468, col: 58, offset: 21180},
+									name: "DocumentAttributeSubstitution",
+								},
+							},
+							&notExpr{
+								pos: position{line: 468, col: 88, offset: 21210},
+								expr: &seqExpr{
+									pos: position{line: 468, col: 90, offset: 21212},
+									exprs: []interface{}{
+										&zeroOrOneExpr{
+											pos: position{line: 468, col: 90, offset: 21212},
+											expr: &ruleRefExpr{
+												pos:  position{line: 468, col: 90, offset: 21212},
+												name: "QuotedTextAttributes",
+											},
+										},
+										&ruleRefExpr{
+											pos:  position{line: 468, col: 112, offset: 21234},
+											name: "UnconstrainedQuotedText",
+										},
 									},
 								},
-								&litMatcher{
-									pos:        position{line: 404, col: 44, offset: 17837},
-									val:        "*",
-									ignoreCase: false,
+							},
+							&notExpr{
+								pos: position{line: 468, col: 137, offset: 21259},
+								expr: &seqExpr{
+									pos: position{line: 468, col: 139, offset: 21261},
+									exprs: []interface{}{
+										&oneOrMoreExpr{
+											pos: position{line: 468, col: 139, offset: 21261},
+											expr: &litMatcher{
+												pos:        position{line: 468, col: 139, offset: 21261},
+												val:        "\\",
+												ignoreCase: false,
+											},
+										},
+										&ruleRefExpr{
+											pos:  position{line: 468, col: 144, offset: 21266},
+											name: "UnconstrainedQuotedText",
+										},
+									},
 								},
 							},
+							&anyMatcher{
+								line: 468, col: 169, offset: 21291,
+							},
 						},
 					},
-					&actionExpr{
-						pos: position{line: 407, col: 5, offset: 18002},
-						run: (*parser).callonBoldText18,
-						expr: &seqExpr{
-							pos: position{line: 407, col: 5, offset: 18002},
-							exprs: []interface{}{
-								&notExpr{
-									pos: position{line: 407, col: 5, offset: 18002},
-									expr: &litMatcher{
-										pos:        position{line: 407, col: 6, offset: 18003},
-										val:        "\\",
-										ignoreCase: false,
-									},
-								},
-								&litMatcher{
-									pos:        position{line: 407, col: 10, offset: 18007},
-									val:        "*",
-									ignoreCase: false,
+				},
+			},
+		},
+		{
+			name: "LineBreak",
+			pos:  position{line: 473, col: 1, offset: 21399},
+			expr: &actionExpr{
+				pos: position{line: 473, col: 14, offset: 21412},
+				run: (*parser).callonLineBreak1,
+				expr: &seqExpr{
+					pos: position{line: 473, col: 14, offset: 21412},
+					exprs: []interface{}{
+						&andCodeExpr{
+							pos: position{line: 473, col: 14, offset: 21412},
+							run: (*parser).callonLineBreak3,
+						},
+						&litMatcher{
+							pos:        position{line: 473, col: 92, offset: 21490},
+							val:        "+",
+							ignoreCase: false,
+						},
+						&zeroOrMoreExpr{
+							pos: position{line: 473, col: 96, offset: 21494},
+							expr: &ruleRefExpr{
+								pos:  position{line: 473, col: 96, offset: 21494},
+								name: "WS",
+							},
+						},
+						&andExpr{
+							pos: position{line: 473, col: 100, offset: 21498},
+							expr: &ruleRefExpr{
+								pos:  position{line: 473, col: 101, offset: 21499},
+								name: "EOL",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "Admonition",
+			pos:  position{line: 481, col: 1, offset: 21648},
+			expr: &choiceExpr{
+				pos: position{line: 481, col: 15, offset: 21662},
+				alternatives: []interface{}{
+					&ruleRefExpr{
+						pos:  position{line: 481, col: 15, offset: 21662},
+						name: "AdmonitionBlock",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 481, col: 33, offset: 21680},
+						name: "AdmonitionParagraph",
+					},
+				},
+			},
+		},
+		{
+			name: "AdmonitionBlock",
+			pos:  position{line: 488, col: 1, offset: 21840},
+			expr: &actionExpr{
+				pos: position{line: 488, col: 20, offset: 21859},
+				run: (*parser).callonAdmonitionBlock1,
+				expr: &seqExpr{
+					pos: position{line: 488, col: 20, offset: 21859},
+					exprs: []interface{}{
+						&labeledExpr{
+							pos:   position{line: 488, col: 20, offset: 21859},
+							label: "attributes",
+							expr: &zeroOrMoreExpr{
+								pos: position{line: 488, col: 31, offset: 21870},
+								expr: &ruleRefExpr{
+									pos:  position{line: 488, col: 32, offset: 21871},
+									name: "ElementAttribute",
 								},
-								&labeledExpr{
-									pos:   position{line: 407, col: 14, offset: 18011},
-									label: "content",
-									expr: &ruleRefExpr{
-										pos:  position{line: 407, col: 23, offset: 18020},
-										name: "QuotedTextContent",
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 488, col: 51, offset: 21890},
+							label: "k",
+							expr: &ruleRefExpr{
+								pos:  position{line: 488, col: 54, offset: 21893},
+								name: "AdmonitionMarker",
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 488, col: 72, offset: 21911},
+							label: "block",
+							expr: &choiceExpr{
+								pos: position{line: 488, col: 79, offset: 21918},
+								alternatives: []interface{}{
+									&ruleRefExpr{
+										pos:  position{line: 488, col: 79, offset: 21918},
+										name: "ExampleBlock",
+									},
+									&ruleRefExpr{
+										pos:  position{line: 488, col: 94, offset: 21933},
+										name: "OpenBlock",
 									},
-								},
-								&litMatcher{
-									pos:        position{line: 407, col: 42, offset: 18039},
-									val:        "*",
-									ignoreCase: false,
 								},
 							},
 						},
@@ -4129,155 +5065,119 @@ var g = &grammar{
 			},
 		},
 		{
-			name: "EscapedBoldText",
-			pos:  position{line: 411, col: 1, offset: 18139},
+			name: "AdmonitionParagraph",
+			pos:  position{line: 494, col: 1, offset: 22219},
 			expr: &choiceExpr{
-				pos: position{line: 411, col: 20, offset: 18158},
+				pos: position{line: 494, col: 24, offset: 22242},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 411, col: 20, offset: 18158},
-						run: (*parser).callonEscapedBoldText2,
+						pos: position{line: 494, col: 24, offset: 22242},
+						run: (*parser).callonAdmonitionParagraph2,
 						expr: &seqExpr{
-							pos: position{line: 411, col: 20, offset: 18158},
+							pos: position{line: 494, col: 24, offset: 22242},
 							exprs: []interface{}{
 								&labeledExpr{
-									pos:   position{line: 411, col: 20, offset: 18158},
-									label: "backslashes",
+									pos:   position{line: 494, col: 24, offset: 22242},
+									label: "attributes",
+									expr: &zeroOrMoreExpr{
+										pos: position{line: 494, col: 35, offset: 22253},
+										expr: &ruleRefExpr{
+											pos:  position{line: 494, col: 36, offset: 22254},
+											name: "ElementAttribute",
+										},
+									},
+								},
+								&notExpr{
+									pos: position{line: 494, col: 55, offset: 22273},
 									expr: &seqExpr{
-										pos: position{line: 411, col: 33, offset: 18171},
+										pos: position{line: 494, col: 57, offset: 22275},
 										exprs: []interface{}{
-											&litMatcher{
-												pos:        position{line: 411, col: 33, offset: 18171},
-												val:        "\\\\",
-												ignoreCase: false,
-											},
-											&zeroOrMoreExpr{
-												pos: position{line: 411, col: 38, offset: 18176},
+											&oneOrMoreExpr{
+												pos: position{line: 494, col: 57, offset: 22275},
 												expr: &litMatcher{
-													pos:        position{line: 411, col: 38, offset: 18176},
-													val:        "\\",
+													pos:        position{line: 494, col: 57, offset: 22275},
+													val:        "=",
 													ignoreCase: false,
 												},
 											},
+											&oneOrMoreExpr{
+												pos: position{line: 494, col: 62, offset: 22280},
+												expr: &ruleRefExpr{
+													pos:  position{line: 494, col: 62, offset: 22280},
+													name: "WS",
+												},
+											},
 										},
 									},
 								},
+								&labeledExpr{
+									pos:   position{line: 494, col: 67, offset: 22285},
+									label: "t",
+									expr: &ruleRefExpr{
+										pos:  position{line: 494, col: 70, offset: 22288},
+										name: "AdmonitionKind",
+									},
+								},
 								&litMatcher{
-									pos:        position{line: 411, col: 44, offset: 18182},
-									val:        "**",
+									pos:        position{line: 494, col: 86, offset: 22304},
+									val:        ": ",
 									ignoreCase: false,
 								},
 								&labeledExpr{
-									pos:   position{line: 411, col: 49, offset: 18187},
+									pos:   position{line: 494, col: 91, offset: 22309},
 									label: "content",
 									expr: &ruleRefExpr{
-										pos:  position{line: 411, col: 58, offset: 18196},
-										name: "QuotedTextContent",
+										pos:  position{line: 494, col: 100, offset: 22318},
+										name: "AdmonitionParagraphContent",
 									},
 								},
-								&litMatcher{
-									pos:        position{line: 411, col: 77, offset: 18215},
-									val:        "**",
-									ignoreCase: false,
-								},
 							},
 						},
 					},
 					&actionExpr{
-						pos: position{line: 413, col: 5, offset: 18370},
-						run: (*parser).callonEscapedBoldText13,
+						pos: position{line: 496, col: 5, offset: 22474},
+						run: (*parser).callonAdmonitionParagraph18,
 						expr: &seqExpr{
-							pos: position{line: 413, col: 5, offset: 18370},
+							pos: position{line: 496, col: 5, offset: 22474},
 							exprs: []interface{}{
 								&labeledExpr{
-									pos:   position{line: 413, col: 5, offset: 18370},
-									label: "backslashes",
-									expr: &seqExpr{
-										pos: position{line: 413, col: 18, offset: 18383},
-										exprs: []interface{}{
-											&litMatcher{
-												pos:        position{line: 413, col: 18, offset: 18383},
-												val:        "\\",
-												ignoreCase: false,
-											},
-											&zeroOrMoreExpr{
-												pos: position{line: 413, col: 22, offset: 18387},
-												expr: &litMatcher{
-													pos:        position{line: 413, col: 22, offset: 18387},
-													val:        "\\",
-													ignoreCase: false,
-												},
-											},
+									pos:   position{line: 496, col: 5, offset: 22474},
+									label: "attributes",
+									expr: &zeroOrMoreExpr{
+										pos: position{line: 496, col: 16, offset: 22485},
+										expr: &ruleRefExpr{
+											pos:  position{line: 496, col: 17, offset: 22486},
+											name: "ElementAttribute",
 										},
 									},
 								},
-								&litMatcher{
-									pos:        position{line: 413, col: 28, offset: 18393},
-									val:        "**",
-									ignoreCase: false,
-								},
 								&labeledExpr{
-									pos:   position{line: 413, col: 33, offset: 18398},
-									label: "content",
+									pos:   position{line: 496, col: 36, offset: 22505},
+									label: "k",
 									expr: &ruleRefExpr{
-										pos:  position{line: 413, col: 42, offset: 18407},
-										name: "QuotedTextContent",
+										pos:  position{line: 496, col: 39, offset: 22508},
+										name: "AdmonitionMarker",
 									},
 								},
-								&litMatcher{
-									pos:        position{line: 413, col: 61, offset: 18426},
-									val:        "*",
-									ignoreCase: false,
-								},
-							},
-						},
-					},
-					&actionExpr{
-						pos: position{line: 416, col: 5, offset: 18620},
-						run: (*parser).callonEscapedBoldText24,
-						expr: &seqExpr{
-							pos: position{line: 416, col: 5, offset: 18620},
-							exprs: []interface{}{
 								&labeledExpr{
-									pos:   position{line: 416, col: 5, offset: 18620},
-									label: "backslashes",
-									expr: &seqExpr{
-										pos: position{line: 416, col: 18, offset: 18633},
-										exprs: []interface{}{
-											&litMatcher{
-												pos:        position{line: 416, col: 18, offset: 18633},
-												val:        "\\",
-												ignoreCase: false,
-											},
-											&zeroOrMoreExpr{
-												pos: position{line: 416, col: 22, offset: 18637},
-												expr: &litMatcher{
-													pos:        position{line: 416, col: 22, offset: 18637},
-													val:        "\\",
-													ignoreCase: false,
-												},
-											},
+									pos:   position{line: 496, col: 57, offset: 22526},
+									label: "otherAttributes",
+									expr: &zeroOrMoreExpr{
+										pos: position{line: 496, col: 73, offset: 22542},
+										expr: &ruleRefExpr{
+											pos:  position{line: 496, col: 74, offset: 22543},
+											name: "ElementAttribute",
 										},
 									},
 								},
-								&litMatcher{
-									pos:        position{line: 416, col: 28, offset: 18643},
-									val:        "*",
-									ignoreCase: false,
-								},
 								&labeledExpr{
-									pos:   position{line: 416, col: 32, offset: 18647},
+									pos:   position{line: 496, col: 93, offset: 22562},
 									label: "content",
 									expr: &ruleRefExpr{
-										pos:  position{line: 416, col: 41, offset: 18656},
-										name: "QuotedTextContent",
+										pos:  position{line: 496, col: 102, offset: 22571},
+										name: "AdmonitionParagraphContent",
 									},
 								},
-								&litMatcher{
-									pos:        position{line: 416, col: 60, offset: 18675},
-									val:        "*",
-									ignoreCase: false,
-								},
 							},
 						},
 					},
@@ -4285,113 +5185,223 @@ var g = &grammar{
 			},
 		},
 		{
-			name: "ItalicText",
-			pos:  position{line: 420, col: 1, offset: 18827},
-			expr: &choiceExpr{
-				pos: position{line: 420, col: 15, offset: 18841},
-				alternatives: []interface{}{
-					&actionExpr{
-						pos: position{line: 420, col: 15, offset: 18841},
-						run: (*parser).callonItalicText2,
+			name: "AdmonitionParagraphContent",
+			pos:  position{line: 500, col: 1, offset: 22766},
+			expr: &actionExpr{
+				pos: position{line: 500, col: 31, offset: 22796},
+				run: (*parser).callonAdmonitionParagraphContent1,
+				expr: &labeledExpr{
+					pos:   position{line: 500, col: 31, offset: 22796},
+					label: "lines",
+					expr: &oneOrMoreExpr{
+						pos: position{line: 500, col: 37, offset: 22802},
 						expr: &seqExpr{
-							pos: position{line: 420, col: 15, offset: 18841},
+							pos: position{line: 500, col: 38, offset: 22803},
 							exprs: []interface{}{
-								&notExpr{
-									pos: position{line: 420, col: 15, offset: 18841},
-									expr: &litMatcher{
-										pos:        position{line: 420, col: 16, offset: 18842},
-										val:        "\\\\",
-										ignoreCase: false,
-									},
+								&ruleRefExpr{
+									pos:  position{line: 500, col: 38, offset: 22803},
+									name: "InlineContentWithTrailingSpaces",
 								},
-								&litMatcher{
-									pos:        position{line: 420, col: 21, offset: 18847},
-									val:        "__",
-									ignoreCase: false,
-								},
-								&labeledExpr{
-									pos:   position{line: 420, col: 26, offset: 18852},
-									label: "content",
-									expr: &ruleRefExpr{
-										pos:  position{line: 420, col: 35, offset: 18861},
-										name: "QuotedTextContent",
-									},
-								},
-								&litMatcher{
-									pos:        position{line: 420, col: 54, offset: 18880},
-									val:        "__",
-									ignoreCase: false,
+								&ruleRefExpr{
+									pos:  position{line: 500, col: 70, offset: 22835},
+									name: "EOL",
 								},
 							},
 						},
 					},
+				},
+			},
+		},
+		{
+			name: "AdmonitionMarker",
+			pos:  position{line: 505, col: 1, offset: 22996},
+			expr: &actionExpr{
+				pos: position{line: 505, col: 21, offset: 23016},
+				run: (*parser).callonAdmonitionMarker1,
+				expr: &seqExpr{
+					pos: position{line: 505, col: 21, offset: 23016},
+					exprs: []interface{}{
+						&litMatcher{
+							pos:        position{line: 505, col: 21, offset: 23016},
+							val:        "[",
+							ignoreCase: false,
+						},
+						&labeledExpr{
+							pos:   position{line: 505, col: 25, offset: 23020},
+							label: "k",
+							expr: &ruleRefExpr{
+								pos:  position{line: 505, col: 28, offset: 23023},
+								name: "AdmonitionKind",
+							},
+						},
+						&litMatcher{
+							pos:        position{line: 505, col: 44, offset: 23039},
+							val:        "]",
+							ignoreCase: false,
+						},
+						&zeroOrMoreExpr{
+							pos: position{line: 505, col: 48, offset: 23043},
+							expr: &ruleRefExpr{
+								pos:  position{line: 505, col: 48, offset: 23043},
+								name: "WS",
+							},
+						},
+						&ruleRefExpr{
+							pos:  position{line: 505, col: 52, offset: 23047},
+							name: "NEWLINE",
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "AdmonitionKind",
+			pos:  position{line: 509, col: 1, offset: 23078},
+			expr: &choiceExpr{
+				pos: position{line: 509, col: 19, offset: 23096},
+				alternatives: []interface{}{
+					&actionExpr{
+						pos: position{line: 509, col: 19, offset: 23096},
+						run: (*parser).callonAdmonitionKind2,
+						expr: &litMatcher{
+							pos:        position{line: 509, col: 19, offset: 23096},
+							val:        "TIP",
+							ignoreCase: false,
+						},
+					},
+					&actionExpr{
+						pos: position{line: 511, col: 5, offset: 23134},
+						run: (*parser).callonAdmonitionKind4,
+						expr: &litMatcher{
+							pos:        position{line: 511, col: 5, offset: 23134},
+							val:        "NOTE",
+							ignoreCase: false,
+						},
+					},
+					&actionExpr{
+						pos: position{line: 513, col: 5, offset: 23174},
+						run: (*parser).callonAdmonitionKind6,
+						expr: &litMatcher{
+							pos:        position{line: 513, col: 5, offset: 23174},
+							val:        "IMPORTANT",
+							ignoreCase: false,
+						},
+					},
+					&actionExpr{
+						pos: position{line: 515, col: 5, offset: 23224},
+						run: (*parser).callonAdmonitionKind8,
+						expr: &litMatcher{
+							pos:        position{line: 515, col: 5, offset: 23224},
+							val:        "WARNING",
+							ignoreCase: false,
+						},
+					},
+					&actionExpr{
+						pos: position{line: 517, col: 5, offset: 23270},
+						run: (*parser).callonAdmonitionKind10,
+						expr: &litMatcher{
+							pos:        position{line: 517, col: 5, offset: 23270},
+							val:        "CAUTION",
+							ignoreCase: false,
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "QuotedText",
+			pos:  position{line: 524, col: 1, offset: 23586},
+			expr: &choiceExpr{
+				pos: position{line: 524, col: 15, offset: 23600},
+				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 422, col: 5, offset: 18961},
-						run: (*parser).callonItalicText10,
+						pos: position{line: 524, col: 15, offset: 23600},
+						run: (*parser).callonQuotedText2,
 						expr: &seqExpr{
-							pos: position{line: 422, col: 5, offset: 18961},
+							pos: position{line: 524, col: 15, offset: 23600},
 							exprs: []interface{}{
-								&notExpr{
-									pos: position{line: 422, col: 5, offset: 18961},
-									expr: &litMatcher{
-										pos:        position{line: 422, col: 6, offset: 18962},
-										val:        "\\\\",
-										ignoreCase: false,
-									},
-								},
-								&litMatcher{
-									pos:        position{line: 422, col: 11, offset: 18967},
-									val:        "__",
-									ignoreCase: false,
+								&andCodeExpr{
+									pos: position{line: 524, col: 15, offset: 23600},
+									run: (*parser).callonQuotedText4,
 								},
 								&labeledExpr{
-									pos:   position{line: 422, col: 16, offset: 18972},
-									label: "content",
-									expr: &ruleRefExpr{
-										pos:  position{line: 422, col: 25, offset: 18981},
-										name: "QuotedTextContent",
+									pos:   position{line: 524, col: 83, offset: 23668},
+									label: "attributes",
+									expr: &zeroOrOneExpr{
+										pos: position{line: 524, col: 94, offset: 23679},
+										expr: &ruleRefExpr{
+											pos:  position{line: 524, col: 95, offset: 23680},
+											name: "QuotedTextAttributes",
+										},
 									},
 								},
-								&litMatcher{
-									pos:        position{line: 422, col: 44, offset: 19000},
-									val:        "_",
-									ignoreCase: false,
+								&labeledExpr{
+									pos:   position{line: 524, col: 118, offset: 23703},
+									label: "text",
+									expr: &choiceExpr{
+										pos: position{line: 524, col: 124, offset: 23709},
+										alternatives: []interface{}{
+											&ruleRefExpr{
+												pos:  position{line: 524, col: 124, offset: 23709},
+												name: "UnconstrainedQuotedText",
+											},
+											&ruleRefExpr{
+												pos:  position{line: 524, col: 150, offset: 23735},
+												name: "ConstrainedQuotedText",
+											},
+										},
+									},
 								},
 							},
 						},
 					},
 					&actionExpr{
-						pos: position{line: 425, col: 5, offset: 19167},
-						run: (*parser).callonItalicText18,
+						pos: position{line: 526, col: 5, offset: 23846},
+						run: (*parser).callonQuotedText12,
 						expr: &seqExpr{
-							pos: position{line: 425, col: 5, offset: 19167},
+							pos: position{line: 526, col: 5, offset: 23846},
 							exprs: []interface{}{
-								&notExpr{
-									pos: position{line: 425, col: 5, offset: 19167},
-									expr: &litMatcher{
-										pos:        position{line: 425, col: 6, offset: 19168},
-										val:        "\\",
-										ignoreCase: false,
-									},
-								},
-								&litMatcher{
-									pos:        position{line: 425, col: 10, offset: 19172},
-									val:        "_",
-									ignoreCase: false,
+								&andCodeExpr{
+									pos: position{line: 526, col: 5, offset: 23846},
+									run: (*parser).callonQuotedText14,
 								},
 								&labeledExpr{
-									pos:   position{line: 425, col: 14, offset: 19176},
-									label: "content",
-									expr: &ruleRefExpr{
-										pos:  position{line: 425, col: 23, offset: 19185},
-										name: "QuotedTextContent",
+									pos:   position{line: 526, col: 73, offset: 23914},
+									label: "text",
+									expr: &choiceExpr{
+										pos: position{line: 526, col: 79, offset: 23920},
+										alternatives: []interface{}{
+											&ruleRefExpr{
+												pos:  position{line: 526, col: 79, offset: 23920},
+												name: "EscapedBoldText",
+											},
+											&ruleRefExpr{
+												pos:  position{line: 526, col: 97, offset: 23938},
+												name: "EscapedItalicText",
+											},
+											&ruleRefExpr{
+												pos:  position{line: 526, col: 117, offset: 23958},
+												name: "EscapedMonospaceText",
+											},
+											&ruleRefExpr{
+												pos:  position{line: 526, col: 140, offset: 23981},
+												name: "EscapedMarkedText",
+											},
+											&ruleRefExpr{
+												pos:  position{line: 526, col: 160, offset: 24001},
+												name: "EscapedSuperscriptText",
+											},
+											&ruleRefExpr{
+												pos:  position{line: 526, col: 185, offset: 24026},
+												name: "EscapedSubscriptText",
+											},
+											&ruleRefExpr{
+												pos:  position{line: 526, col: 208, offset: 24049},
+												name: "EscapedCurvedQuotedText",
+											},
+										},
 									},
 								},
-								&litMatcher{
-									pos:        position{line: 425, col: 42, offset: 19204},
-									val:        "_",
-									ignoreCase: false,
-								},
 							},
 						},
 					},
@@ -4399,153 +5409,110 @@ var g = &grammar{
 			},
 		},
 		{
-			name: "EscapedItalicText",
-			pos:  position{line: 429, col: 1, offset: 19283},
+			name: "QuotedTextAttributes",
+			pos:  position{line: 531, col: 1, offset: 24194},
 			expr: &choiceExpr{
-				pos: position{line: 429, col: 22, offset: 19304},
+				pos: position{line: 531, col: 25, offset: 24218},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 429, col: 22, offset: 19304},
-						run: (*parser).callonEscapedItalicText2,
+						pos: position{line: 531, col: 25, offset: 24218},
+						run: (*parser).callonQuotedTextAttributes2,
 						expr: &seqExpr{
-							pos: position{line: 429, col: 22, offset: 19304},
+							pos: position{line: 531, col: 25, offset: 24218},
 							exprs: []interface{}{
-								&labeledExpr{
-									pos:   position{line: 429, col: 22, offset: 19304},
-									label: "backslashes",
-									expr: &seqExpr{
-										pos: position{line: 429, col: 35, offset: 19317},
-										exprs: []interface{}{
-											&litMatcher{
-												pos:        position{line: 429, col: 35, offset: 19317},
-												val:        "\\\\",
-												ignoreCase: false,
-											},
-											&zeroOrMoreExpr{
-												pos: position{line: 429, col: 40, offset: 19322},
-												expr: &litMatcher{
-													pos:        position{line: 429, col: 40, offset: 19322},
-													val:        "\\",
-													ignoreCase: false,
-												},
-											},
-										},
-									},
-								},
-								&litMatcher{
-									pos:        position{line: 429, col: 46, offset: 19328},
-									val:        "__",
-									ignoreCase: false,
-								},
-								&labeledExpr{
-									pos:   position{line: 429, col: 51, offset: 19333},
-									label: "content",
-									expr: &ruleRefExpr{
-										pos:  position{line: 429, col: 60, offset: 19342},
-										name: "QuotedTextContent",
-									},
-								},
 								&litMatcher{
-									pos:        position{line: 429, col: 79, offset: 19361},
-									val:        "__",
+									pos:        position{line: 531, col: 25, offset: 24218},
+									val:        "[",
 									ignoreCase: false,
 								},
-							},
-						},
-					},
-					&actionExpr{
-						pos: position{line: 431, col: 5, offset: 19516},
-						run: (*parser).callonEscapedItalicText13,
-						expr: &seqExpr{
-							pos: position{line: 431, col: 5, offset: 19516},
-							exprs: []interface{}{
 								&labeledExpr{
-									pos:   position{line: 431, col: 5, offset: 19516},
-									label: "backslashes",
-									expr: &seqExpr{
-										pos: position{line: 431, col: 18, offset: 19529},
-										exprs: []interface{}{
-											&litMatcher{
-												pos:        position{line: 431, col: 18, offset: 19529},
-												val:        "\\",
-												ignoreCase: false,
-											},
-											&zeroOrMoreExpr{
-												pos: position{line: 431, col: 22, offset: 19533},
-												expr: &litMatcher{
-													pos:        position{line: 431, col: 22, offset: 19533},
-													val:        "\\",
-													ignoreCase: false,
+									pos:   position{line: 531, col: 29, offset: 24222},
+									label: "id",
+									expr: &zeroOrOneExpr{
+										pos: position{line: 531, col: 32, offset: 24225},
+										expr: &actionExpr{
+											pos: position{line: 531, col: 33, offset: 24226},
+											run: (*parser).callonQuotedTextAttributes7,
+											expr: &seqExpr{
+												pos: position{line: 531, col: 33, offset: 24226},
+												exprs: []interface{}{
+													&litMatcher{
+														pos:        position{line: 531, col: 33, offset: 24226},
+														val:        "#",
+														ignoreCase: false,
+													},
+													&labeledExpr{
+														pos:   position{line: 531, col: 37, offset: 24230},
+														label: "id",
+														expr: &ruleRefExpr{
+															pos:  position{line: 531, col: 41, offset: 24234},
+															name: "QuotedTextAttributeValue",
+														},
+													},
 												},
 											},
 										},
 									},
 								},
-								&litMatcher{
-									pos:        position{line: 431, col: 28, offset: 19539},
-									val:        "__",
-									ignoreCase: false,
-								},
 								&labeledExpr{
-									pos:   position{line: 431, col: 33, offset: 19544},
-									label: "content",
-									expr: &ruleRefExpr{
-										pos:  position{line: 431, col: 42, offset: 19553},
-										name: "QuotedTextContent",
+									pos:   position{line: 531, col: 88, offset: 24281},
+									label: "roles",
+									expr: &zeroOrMoreExpr{
+										pos: position{line: 531, col: 94, offset: 24287},
+										expr: &actionExpr{
+											pos: position{line: 531, col: 95, offset: 24288},
+											run: (*parser).callonQuotedTextAttributes14,
+											expr: &seqExpr{
+												pos: position{line: 531, col: 95, offset: 24288},
+												exprs: []interface{}{
+													&litMatcher{
+														pos:        position{line: 531, col: 95, offset: 24288},
+														val:        ".",
+														ignoreCase: false,
+													},
+													&labeledExpr{
+														pos:   position{line: 531, col: 99, offset: 24292},
+														label: "role",
+														expr: &ruleRefExpr{
+															pos:  position{line: 531, col: 105, offset: 24298},
+															name: "QuotedTextAttributeValue",
+														},
+													},
+												},
+											},
+										},
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 431, col: 61, offset: 19572},
-									val:        "_",
+									pos:        position{line: 531, col: 154, offset: 24347},
+									val:        "]",
 									ignoreCase: false,
 								},
 							},
 						},
 					},
 					&actionExpr{
-						pos: position{line: 434, col: 5, offset: 19766},
-						run: (*parser).callonEscapedItalicText24,
+						pos: position{line: 533, col: 5, offset: 24425},
+						run: (*parser).callonQuotedTextAttributes20,
 						expr: &seqExpr{
-							pos: position{line: 434, col: 5, offset: 19766},
+							pos: position{line: 533, col: 5, offset: 24425},
 							exprs: []interface{}{
-								&labeledExpr{
-									pos:   position{line: 434, col: 5, offset: 19766},
-									label: "backslashes",
-									expr: &seqExpr{
-										pos: position{line: 434, col: 18, offset: 19779},
-										exprs: []interface{}{
-											&litMatcher{
-												pos:        position{line: 434, col: 18, offset: 19779},
-												val:        "\\",
-												ignoreCase: false,
-											},
-											&zeroOrMoreExpr{
-												pos: position{line: 434, col: 22, offset: 19783},
-												expr: &litMatcher{
-													pos:        position{line: 434, col: 22, offset: 19783},
-													val:        "\\",
-													ignoreCase: false,
-												},
-											},
-										},
-									},
-								},
 								&litMatcher{
-									pos:        position{line: 434, col: 28, offset: 19789},
-									val:        "_",
+									pos:        position{line: 533, col: 5, offset: 24425},
+									val:        "[",
 									ignoreCase: false,
 								},
 								&labeledExpr{
-									pos:   position{line: 434, col: 32, offset: 19793},
-									label: "content",
+									pos:   position{line: 533, col: 9, offset: 24429},
+									label: "role",
 									expr: &ruleRefExpr{
-										pos:  position{line: 434, col: 41, offset: 19802},
-										name: "QuotedTextContent",
+										pos:  position{line: 533, col: 15, offset: 24435},
+										name: "QuotedTextAttributeValue",
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 434, col: 60, offset: 19821},
-									val:        "_",
+									pos:        position{line: 533, col: 41, offset: 24461},
+									val:        "]",
 									ignoreCase: false,
 								},
 							},
@@ -4555,113 +5522,275 @@ var g = &grammar{
 			},
 		},
 		{
-			name: "MonospaceText",
-			pos:  position{line: 438, col: 1, offset: 19973},
-			expr: &choiceExpr{
-				pos: position{line: 438, col: 18, offset: 19990},
-				alternatives: []interface{}{
-					&actionExpr{
-						pos: position{line: 438, col: 18, offset: 19990},
-						run: (*parser).callonMonospaceText2,
-						expr: &seqExpr{
-							pos: position{line: 438, col: 18, offset: 19990},
-							exprs: []interface{}{
-								&notExpr{
-									pos: position{line: 438, col: 18, offset: 19990},
-									expr: &litMatcher{
-										pos:        position{line: 438, col: 19, offset: 19991},
-										val:        "\\\\",
-										ignoreCase: false,
-									},
-								},
-								&litMatcher{
-									pos:        position{line: 438, col: 24, offset: 19996},
-									val:        "``",
-									ignoreCase: false,
+			name: "QuotedTextAttributeValue",
+			pos:  position{line: 537, col: 1, offset: 24537},
+			expr: &actionExpr{
+				pos: position{line: 537, col: 29, offset: 24565},
+				run: (*parser).callonQuotedTextAttributeValue1,
+				expr: &oneOrMoreExpr{
+					pos: position{line: 537, col: 29, offset: 24565},
+					expr: &seqExpr{
+						pos: position{line: 537, col: 30, offset: 24566},
+						exprs: []interface{}{
+							&notExpr{
+								pos: position{line: 537, col: 30, offset: 24566},
+								expr: &ruleRefExpr{
+									pos:  position{line: 537, col: 31, offset: 24567},
+									name: "NEWLINE",
 								},
-								&labeledExpr{
-									pos:   position{line: 438, col: 29, offset: 20001},
-									label: "content",
-									expr: &ruleRefExpr{
-										pos:  position{line: 438, col: 38, offset: 20010},
-										name: "QuotedTextContent",
-									},
+							},
+							&notExpr{
+								pos: position{line: 537, col: 39, offset: 24575},
+								expr: &ruleRefExpr{
+									pos:  position{line: 537, col: 40, offset: 24576},
+									name: "WS",
 								},
-								&litMatcher{
-									pos:        position{line: 438, col: 57, offset: 20029},
-									val:        "``",
+							},
+							&notExpr{
+								pos: position{line: 537, col: 43, offset: 24579},
+								expr: &litMatcher{
+									pos:        position{line: 537, col: 44, offset: 24580},
+									val:        "[",
+									ignoreCase: false,
+								},
+							},
+							&notExpr{
+								pos: position{line: 537, col: 48, offset: 24584},
+								expr: &litMatcher{
+									pos:        position{line: 537, col: 49, offset: 24585},
+									val:        "]",
+									ignoreCase: false,
+								},
+							},
+							&notExpr{
+								pos: position{line: 537, col: 53, offset: 24589},
+								expr: &litMatcher{
+									pos:        position{line: 537, col: 54, offset: 24590},
+									val:        "#",
+									ignoreCase: false,
+								},
+							},
+							&notExpr{
+								pos: position{line: 537, col: 58, offset: 24594},
+								expr: &litMatcher{
+									pos:        position{line: 537, col: 59, offset: 24595},
+									val:        ".",
 									ignoreCase: false,
 								},
 							},
+							&anyMatcher{
+								line: 537, col: 63, offset: 24599,
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "UnconstrainedQuotedText",
+			pos:  position{line: 542, col: 1, offset: 24745},
+			expr: &actionExpr{
+				pos: position{line: 542, col: 28, offset: 24772},
+				run: (*parser).callonUnconstrainedQuotedText1,
+				expr: &seqExpr{
+					pos: position{line: 542, col: 28, offset: 24772},
+					exprs: []interface{}{
+						&andCodeExpr{
+							pos: position{line: 542, col: 28, offset: 24772},
+							run: (*parser).callonUnconstrainedQuotedText3,
+						},
+						&labeledExpr{
+							pos:   position{line: 542, col: 96, offset: 24840},
+							label: "text",
+							expr: &choiceExpr{
+								pos: position{line: 542, col: 102, offset: 24846},
+								alternatives: []interface{}{
+									&ruleRefExpr{
+										pos:  position{line: 542, col: 102, offset: 24846},
+										name: "DoubleQuoteBoldText",
+									},
+									&ruleRefExpr{
+										pos:  position{line: 542, col: 124, offset: 24868},
+										name: "DoubleQuoteItalicText",
+									},
+									&ruleRefExpr{
+										pos:  position{line: 542, col: 148, offset: 24892},
+										name: "DoubleQuoteMonospaceText",
+									},
+									&ruleRefExpr{
+										pos:  position{line: 542, col: 175, offset: 24919},
+										name: "DoubleQuoteMarkedText",
+									},
+									&ruleRefExpr{
+										pos:  position{line: 542, col: 199, offset: 24943},
+										name: "SuperscriptText",
+									},
+									&ruleRefExpr{
+										pos:  position{line: 542, col: 217, offset: 24961},
+										name: "SubscriptText",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "ConstrainedQuotedText",
+			pos:  position{line: 547, col: 1, offset: 25105},
+			expr: &choiceExpr{
+				pos: position{line: 547, col: 26, offset: 25130},
+				alternatives: []interface{}{
+					&ruleRefExpr{
+						pos:  position{line: 547, col: 26, offset: 25130},
+						name: "CurvedQuotedText",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 547, col: 45, offset: 25149},
+						name: "SingleQuoteBoldText",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 547, col: 67, offset: 25171},
+						name: "SingleQuoteItalicText",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 547, col: 91, offset: 25195},
+						name: "SingleQuoteMonospaceText",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 547, col: 118, offset: 25222},
+						name: "SingleQuoteMarkedText",
+					},
+				},
+			},
+		},
+		{
+			name: "DoubleQuoteBoldText",
+			pos:  position{line: 549, col: 1, offset: 25245},
+			expr: &actionExpr{
+				pos: position{line: 549, col: 24, offset: 25268},
+				run: (*parser).callonDoubleQuoteBoldText1,
+				expr: &seqExpr{
+					pos: position{line: 549, col: 24, offset: 25268},
+					exprs: []interface{}{
+						&notExpr{
+							pos: position{line: 549, col: 24, offset: 25268},
+							expr: &litMatcher{
+								pos:        position{line: 549, col: 25, offset: 25269},
+								val:        "\\\\",
+								ignoreCase: false,
+							},
+						},
+						&litMatcher{
+							pos:        position{line: 549, col: 30, offset: 25274},
+							val:        "**",
+							ignoreCase: false,
+						},
+						&labeledExpr{
+							pos:   position{line: 549, col: 35, offset: 25279},
+							label: "content",
+							expr: &ruleRefExpr{
+								pos:  position{line: 549, col: 44, offset: 25288},
+								name: "QuotedTextContent",
+							},
+						},
+						&litMatcher{
+							pos:        position{line: 549, col: 63, offset: 25307},
+							val:        "**",
+							ignoreCase: false,
 						},
 					},
+				},
+			},
+		},
+		{
+			name: "SingleQuoteBoldText",
+			pos:  position{line: 553, col: 1, offset: 25431},
+			expr: &choiceExpr{
+				pos: position{line: 553, col: 24, offset: 25454},
+				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 440, col: 5, offset: 20159},
-						run: (*parser).callonMonospaceText10,
+						pos: position{line: 553, col: 24, offset: 25454},
+						run: (*parser).callonSingleQuoteBoldText2,
 						expr: &seqExpr{
-							pos: position{line: 440, col: 5, offset: 20159},
+							pos: position{line: 553, col: 24, offset: 25454},
 							exprs: []interface{}{
 								&notExpr{
-									pos: position{line: 440, col: 5, offset: 20159},
+									pos: position{line: 553, col: 24, offset: 25454},
 									expr: &litMatcher{
-										pos:        position{line: 440, col: 6, offset: 20160},
+										pos:        position{line: 553, col: 25, offset: 25455},
 										val:        "\\\\",
 										ignoreCase: false,
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 440, col: 11, offset: 20165},
-									val:        "``",
+									pos:        position{line: 553, col: 30, offset: 25460},
+									val:        "**",
 									ignoreCase: false,
 								},
 								&labeledExpr{
-									pos:   position{line: 440, col: 16, offset: 20170},
+									pos:   position{line: 553, col: 35, offset: 25465},
 									label: "content",
 									expr: &ruleRefExpr{
-										pos:  position{line: 440, col: 25, offset: 20179},
+										pos:  position{line: 553, col: 44, offset: 25474},
 										name: "QuotedTextContent",
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 440, col: 44, offset: 20198},
-									val:        "`",
+									pos:        position{line: 553, col: 63, offset: 25493},
+									val:        "*",
 									ignoreCase: false,
 								},
+								&notExpr{
+									pos: position{line: 553, col: 67, offset: 25497},
+									expr: &ruleRefExpr{
+										pos:  position{line: 553, col: 68, offset: 25498},
+										name: "QuotedTextWordCharacter",
+									},
+								},
 							},
 						},
 					},
 					&actionExpr{
-						pos: position{line: 443, col: 5, offset: 20368},
-						run: (*parser).callonMonospaceText18,
+						pos: position{line: 556, col: 5, offset: 25683},
+						run: (*parser).callonSingleQuoteBoldText12,
 						expr: &seqExpr{
-							pos: position{line: 443, col: 5, offset: 20368},
+							pos: position{line: 556, col: 5, offset: 25683},
 							exprs: []interface{}{
 								&notExpr{
-									pos: position{line: 443, col: 5, offset: 20368},
+									pos: position{line: 556, col: 5, offset: 25683},
 									expr: &litMatcher{
-										pos:        position{line: 443, col: 6, offset: 20369},
+										pos:        position{line: 556, col: 6, offset: 25684},
 										val:        "\\",
 										ignoreCase: false,
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 443, col: 10, offset: 20373},
-									val:        "`",
+									pos:        position{line: 556, col: 10, offset: 25688},
+									val:        "*",
 									ignoreCase: false,
 								},
 								&labeledExpr{
-									pos:   position{line: 443, col: 14, offset: 20377},
+									pos:   position{line: 556, col: 14, offset: 25692},
 									label: "content",
 									expr: &ruleRefExpr{
-										pos:  position{line: 443, col: 23, offset: 20386},
+										pos:  position{line: 556, col: 23, offset: 25701},
 										name: "QuotedTextContent",
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 443, col: 42, offset: 20405},
-									val:        "`",
+									pos:        position{line: 556, col: 42, offset: 25720},
+									val:        "*",
 									ignoreCase: false,
 								},
+								&notExpr{
+									pos: position{line: 556, col: 46, offset: 25724},
+									expr: &ruleRefExpr{
+										pos:  position{line: 556, col: 47, offset: 25725},
+										name: "QuotedTextWordCharacter",
+									},
+								},
 							},
 						},
 					},
@@ -4669,32 +5798,32 @@ var g = &grammar{
 			},
 		},
 		{
-			name: "EscapedMonospaceText",
-			pos:  position{line: 447, col: 1, offset: 20532},
+			name: "EscapedBoldText",
+			pos:  position{line: 560, col: 1, offset: 25845},
 			expr: &choiceExpr{
-				pos: position{line: 447, col: 25, offset: 20556},
+				pos: position{line: 560, col: 20, offset: 25864},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 447, col: 25, offset: 20556},
-						run: (*parser).callonEscapedMonospaceText2,
+						pos: position{line: 560, col: 20, offset: 25864},
+						run: (*parser).callonEscapedBoldText2,
 						expr: &seqExpr{
-							pos: position{line: 447, col: 25, offset: 20556},
+							pos: position{line: 560, col: 20, offset: 25864},
 							exprs: []interface{}{
 								&labeledExpr{
-									pos:   position{line: 447, col: 25, offset: 20556},
+									pos:   position{line: 560, col: 20, offset: 25864},
 									label: "backslashes",
 									expr: &seqExpr{
-										pos: position{line: 447, col: 38, offset: 20569},
+										pos: position{line: 560, col: 33, offset: 25877},
 										exprs: []interface{}{
 											&litMatcher{
-												pos:        position{line: 447, col: 38, offset: 20569},
+												pos:        position{line: 560, col: 33, offset: 25877},
 												val:        "\\\\",
 												ignoreCase: false,
 											},
 											&zeroOrMoreExpr{
-												pos: position{line: 447, col: 43, offset: 20574},
+												pos: position{line: 560, col: 38, offset: 25882},
 												expr: &litMatcher{
-													pos:        position{line: 447, col: 43, offset: 20574},
+													pos:        position{line: 560, col: 38, offset: 25882},
 													val:        "\\",
 													ignoreCase: false,
 												},
@@ -4703,47 +5832,47 @@ var g = &grammar{
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 447, col: 49, offset: 20580},
-									val:        "``",
+									pos:        position{line: 560, col: 44, offset: 25888},
+									val:        "**",
 									ignoreCase: false,
 								},
 								&labeledExpr{
-									pos:   position{line: 447, col: 54, offset: 20585},
+									pos:   position{line: 560, col: 49, offset: 25893},
 									label: "content",
 									expr: &ruleRefExpr{
-										pos:  position{line: 447, col: 63, offset: 20594},
+										pos:  position{line: 560, col: 58, offset: 25902},
 										name: "QuotedTextContent",
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 447, col: 82, offset: 20613},
-									val:        "``",
+									pos:        position{line: 560, col: 77, offset: 25921},
+									val:        "**",
 									ignoreCase: false,
 								},
 							},
 						},
 					},
 					&actionExpr{
-						pos: position{line: 449, col: 5, offset: 20768},
-						run: (*parser).callonEscapedMonospaceText13,
+						pos: position{line: 562, col: 5, offset: 26076},
+						run: (*parser).callonEscapedBoldText13,
 						expr: &seqExpr{
-							pos: position{line: 449, col: 5, offset: 20768},
+							pos: position{line: 562, col: 5, offset: 26076},
 							exprs: []interface{}{
 								&labeledExpr{
-									pos:   position{line: 449, col: 5, offset: 20768},
+									pos:   position{line: 562, col: 5, offset: 26076},
 									label: "backslashes",
 									expr: &seqExpr{
-										pos: position{line: 449, col: 18, offset: 20781},
+										pos: position{line: 562, col: 18, offset: 26089},
 										exprs: []interface{}{
 											&litMatcher{
-												pos:        position{line: 449, col: 18, offset: 20781},
+												pos:        position{line: 562, col: 18, offset: 26089},
 												val:        "\\",
 												ignoreCase: false,
 											},
 											&zeroOrMoreExpr{
-												pos: position{line: 449, col: 22, offset: 20785},
+												pos: position{line: 562, col: 22, offset: 26093},
 												expr: &litMatcher{
-													pos:        position{line: 449, col: 22, offset: 20785},
+													pos:        position{line: 562, col: 22, offset: 26093},
 													val:        "\\",
 													ignoreCase: false,
 												},
@@ -4752,47 +5881,47 @@ var g = &grammar{
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 449, col: 28, offset: 20791},
-									val:        "``",
+									pos:        position{line: 562, col: 28, offset: 26099},
+									val:        "**",
 									ignoreCase: false,
 								},
 								&labeledExpr{
-									pos:   position{line: 449, col: 33, offset: 20796},
+									pos:   position{line: 562, col: 33, offset: 26104},
 									label: "content",
 									expr: &ruleRefExpr{
-										pos:  position{line: 449, col: 42, offset: 20805},
+										pos:  position{line: 562, col: 42, offset: 26113},
 										name: "QuotedTextContent",
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 449, col: 61, offset: 20824},
-									val:        "`",
+									pos:        position{line: 562, col: 61, offset: 26132},
+									val:        "*",
 									ignoreCase: false,
 								},
 							},
 						},
 					},
 					&actionExpr{
-						pos: position{line: 452, col: 5, offset: 21018},
-						run: (*parser).callonEscapedMonospaceText24,
+						pos: position{line: 565, col: 5, offset: 26326},
+						run: (*parser).callonEscapedBoldText24,
 						expr: &seqExpr{
-							pos: position{line: 452, col: 5, offset: 21018},
+							pos: position{line: 565, col: 5, offset: 26326},
 							exprs: []interface{}{
 								&labeledExpr{
-									pos:   position{line: 452, col: 5, offset: 21018},
+									pos:   position{line: 565, col: 5, offset: 26326},
 									label: "backslashes",
 									expr: &seqExpr{
-										pos: position{line: 452, col: 18, offset: 21031},
+										pos: position{line: 565, col: 18, offset: 26339},
 										exprs: []interface{}{
 											&litMatcher{
-												pos:        position{line: 452, col: 18, offset: 21031},
+												pos:        position{line: 565, col: 18, offset: 26339},
 												val:        "\\",
 												ignoreCase: false,
 											},
 											&zeroOrMoreExpr{
-												pos: position{line: 452, col: 22, offset: 21035},
+												pos: position{line: 565, col: 22, offset: 26343},
 												expr: &litMatcher{
-													pos:        position{line: 452, col: 22, offset: 21035},
+													pos:        position{line: 565, col: 22, offset: 26343},
 													val:        "\\",
 													ignoreCase: false,
 												},
@@ -4801,21 +5930,21 @@ var g = &grammar{
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 452, col: 28, offset: 21041},
-									val:        "`",
+									pos:        position{line: 565, col: 28, offset: 26349},
+									val:        "*",
 									ignoreCase: false,
 								},
 								&labeledExpr{
-									pos:   position{line: 452, col: 32, offset: 21045},
+									pos:   position{line: 565, col: 32, offset: 26353},
 									label: "content",
 									expr: &ruleRefExpr{
-										pos:  position{line: 452, col: 41, offset: 21054},
+										pos:  position{line: 565, col: 41, offset: 26362},
 										name: "QuotedTextContent",
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 452, col: 60, offset: 21073},
-									val:        "`",
+									pos:        position{line: 565, col: 60, offset: 26381},
+									val:        "*",
 									ignoreCase: false,
 								},
 							},
@@ -4825,358 +5954,285 @@ var g = &grammar{
 			},
 		},
 		{
-			name: "QuotedTextContent",
-			pos:  position{line: 456, col: 1, offset: 21225},
-			expr: &seqExpr{
-				pos: position{line: 456, col: 22, offset: 21246},
-				exprs: []interface{}{
-					&ruleRefExpr{
-						pos:  position{line: 456, col: 22, offset: 21246},
-						name: "QuotedTextContentElement",
-					},
-					&zeroOrMoreExpr{
-						pos: position{line: 456, col: 47, offset: 21271},
-						expr: &seqExpr{
-							pos: position{line: 456, col: 48, offset: 21272},
-							exprs: []interface{}{
-								&oneOrMoreExpr{
-									pos: position{line: 456, col: 48, offset: 21272},
-									expr: &ruleRefExpr{
-										pos:  position{line: 456, col: 48, offset: 21272},
-										name: "WS",
-									},
-								},
-								&ruleRefExpr{
-									pos:  position{line: 456, col: 52, offset: 21276},
-									name: "QuotedTextContentElement",
-								},
-							},
-						},
-					},
-				},
-			},
-		},
-		{
-			name: "QuotedTextContentElement",
-			pos:  position{line: 458, col: 1, offset: 21304},
-			expr: &choiceExpr{
-				pos: position{line: 458, col: 29, offset: 21332},
-				alternatives: []interface{}{
-					&ruleRefExpr{
-						pos:  position{line: 458, col: 29, offset: 21332},
-						name: "QuotedText",
-					},
-					&ruleRefExpr{
-						pos:  position{line: 458, col: 42, offset: 21345},
-						name: "QuotedTextCharacters",
-					},
-					&ruleRefExpr{
-						pos:  position{line: 458, col: 65, offset: 21368},
-						name: "CharactersWithQuotePunctuation",
-					},
-				},
-			},
-		},
-		{
-			name: "QuotedTextCharacters",
-			pos:  position{line: 460, col: 1, offset: 21503},
-			expr: &oneOrMoreExpr{
-				pos: position{line: 460, col: 25, offset: 21527},
+			name: "DoubleQuoteItalicText",
+			pos:  position{line: 569, col: 1, offset: 26533},
+			expr: &actionExpr{
+				pos: position{line: 569, col: 26, offset: 26558},
+				run: (*parser).callonDoubleQuoteItalicText1,
 				expr: &seqExpr{
-					pos: position{line: 460, col: 26, offset: 21528},
+					pos: position{line: 569, col: 26, offset: 26558},
 					exprs: []interface{}{
 						&notExpr{
-							pos: position{line: 460, col: 26, offset: 21528},
-							expr: &ruleRefExpr{
-								pos:  position{line: 460, col: 27, offset: 21529},
-								name: "NEWLINE",
-							},
-						},
-						&notExpr{
-							pos: position{line: 460, col: 35, offset: 21537},
-							expr: &ruleRefExpr{
-								pos:  position{line: 460, col: 36, offset: 21538},
-								name: "WS",
-							},
-						},
-						&notExpr{
-							pos: position{line: 460, col: 39, offset: 21541},
+							pos: position{line: 569, col: 26, offset: 26558},
 							expr: &litMatcher{
-								pos:        position{line: 460, col: 40, offset: 21542},
-								val:        "*",
+								pos:        position{line: 569, col: 27, offset: 26559},
+								val:        "\\\\",
 								ignoreCase: false,
 							},
 						},
-						&notExpr{
-							pos: position{line: 460, col: 44, offset: 21546},
-							expr: &litMatcher{
-								pos:        position{line: 460, col: 45, offset: 21547},
-								val:        "_",
-								ignoreCase: false,
-							},
+						&litMatcher{
+							pos:        position{line: 569, col: 32, offset: 26564},
+							val:        "__",
+							ignoreCase: false,
 						},
-						&notExpr{
-							pos: position{line: 460, col: 49, offset: 21551},
-							expr: &litMatcher{
-								pos:        position{line: 460, col: 50, offset: 21552},
-								val:        "`",
-								ignoreCase: false,
+						&labeledExpr{
+							pos:   position{line: 569, col: 37, offset: 26569},
+							label: "content",
+							expr: &ruleRefExpr{
+								pos:  position{line: 569, col: 46, offset: 26578},
+								name: "QuotedTextContent",
 							},
 						},
-						&anyMatcher{
-							line: 460, col: 54, offset: 21556,
-						},
-					},
-				},
-			},
-		},
-		{
-			name: "CharactersWithQuotePunctuation",
-			pos:  position{line: 462, col: 1, offset: 21599},
-			expr: &actionExpr{
-				pos: position{line: 462, col: 35, offset: 21633},
-				run: (*parser).callonCharactersWithQuotePunctuation1,
-				expr: &oneOrMoreExpr{
-					pos: position{line: 462, col: 35, offset: 21633},
-					expr: &seqExpr{
-						pos: position{line: 462, col: 36, offset: 21634},
-						exprs: []interface{}{
-							&notExpr{
-								pos: position{line: 462, col: 36, offset: 21634},
-								expr: &ruleRefExpr{
-									pos:  position{line: 462, col: 37, offset: 21635},
-									name: "NEWLINE",
-								},
-							},
-							&notExpr{
-								pos: position{line: 462, col: 45, offset: 21643},
-								expr: &ruleRefExpr{
-									pos:  position{line: 462, col: 46, offset: 21644},
-									name: "WS",
-								},
-							},
-							&anyMatcher{
-								line: 462, col: 50, offset: 21648,
-							},
+						&litMatcher{
+							pos:        position{line: 569, col: 65, offset: 26597},
+							val:        "__",
+							ignoreCase: false,
 						},
 					},
 				},
 			},
 		},
 		{
-			name: "UnbalancedQuotePunctuation",
-			pos:  position{line: 467, col: 1, offset: 21893},
+			name: "SingleQuoteItalicText",
+			pos:  position{line: 573, col: 1, offset: 26677},
 			expr: &choiceExpr{
-				pos: position{line: 467, col: 31, offset: 21923},
+				pos: position{line: 573, col: 26, offset: 26702},
 				alternatives: []interface{}{
-					&litMatcher{
-						pos:        position{line: 467, col: 31, offset: 21923},
-						val:        "*",
-						ignoreCase: false,
-					},
-					&litMatcher{
-						pos:        position{line: 467, col: 37, offset: 21929},
-						val:        "_",
-						ignoreCase: false,
-					},
-					&litMatcher{
-						pos:        position{line: 467, col: 43, offset: 21935},
-						val:        "`",
-						ignoreCase: false,
-					},
-				},
-			},
-		},
-		{
-			name: "Passthrough",
-			pos:  position{line: 472, col: 1, offset: 22047},
-			expr: &choiceExpr{
-				pos: position{line: 472, col: 16, offset: 22062},
-				alternatives: []interface{}{
-					&ruleRefExpr{
-						pos:  position{line: 472, col: 16, offset: 22062},
-						name: "TriplePlusPassthrough",
-					},
-					&ruleRefExpr{
-						pos:  position{line: 472, col: 40, offset: 22086},
-						name: "SinglePlusPassthrough",
-					},
-					&ruleRefExpr{
-						pos:  position{line: 472, col: 64, offset: 22110},
-						name: "PassthroughMacro",
-					},
-				},
-			},
-		},
-		{
-			name: "SinglePlusPassthrough",
-			pos:  position{line: 474, col: 1, offset: 22128},
-			expr: &actionExpr{
-				pos: position{line: 474, col: 26, offset: 22153},
-				run: (*parser).callonSinglePlusPassthrough1,
-				expr: &seqExpr{
-					pos: position{line: 474, col: 26, offset: 22153},
-					exprs: []interface{}{
-						&litMatcher{
-							pos:        position{line: 474, col: 26, offset: 22153},
-							val:        "+",
-							ignoreCase: false,
-						},
-						&labeledExpr{
-							pos:   position{line: 474, col: 30, offset: 22157},
-							label: "content",
-							expr: &zeroOrMoreExpr{
-								pos: position{line: 474, col: 38, offset: 22165},
-								expr: &seqExpr{
-									pos: position{line: 474, col: 39, offset: 22166},
-									exprs: []interface{}{
-										&notExpr{
-											pos: position{line: 474, col: 39, offset: 22166},
-											expr: &ruleRefExpr{
-												pos:  position{line: 474, col: 40, offset: 22167},
-												name: "NEWLINE",
-											},
-										},
-										&notExpr{
-											pos: position{line: 474, col: 48, offset: 22175},
-											expr: &litMatcher{
-												pos:        position{line: 474, col: 49, offset: 22176},
-												val:        "+",
-												ignoreCase: false,
-											},
-										},
-										&anyMatcher{
-											line: 474, col: 53, offset: 22180,
-										},
+					&actionExpr{
+						pos: position{line: 573, col: 26, offset: 26702},
+						run: (*parser).callonSingleQuoteItalicText2,
+						expr: &seqExpr{
+							pos: position{line: 573, col: 26, offset: 26702},
+							exprs: []interface{}{
+								&notExpr{
+									pos: position{line: 573, col: 26, offset: 26702},
+									expr: &litMatcher{
+										pos:        position{line: 573, col: 27, offset: 26703},
+										val:        "\\\\",
+										ignoreCase: false,
+									},
+								},
+								&litMatcher{
+									pos:        position{line: 573, col: 32, offset: 26708},
+									val:        "__",
+									ignoreCase: false,
+								},
+								&labeledExpr{
+									pos:   position{line: 573, col: 37, offset: 26713},
+									label: "content",
+									expr: &ruleRefExpr{
+										pos:  position{line: 573, col: 46, offset: 26722},
+										name: "QuotedTextContent",
+									},
+								},
+								&litMatcher{
+									pos:        position{line: 573, col: 65, offset: 26741},
+									val:        "_",
+									ignoreCase: false,
+								},
+								&notExpr{
+									pos: position{line: 573, col: 69, offset: 26745},
+									expr: &ruleRefExpr{
+										pos:  position{line: 573, col: 70, offset: 26746},
+										name: "QuotedTextWordCharacter",
 									},
 								},
 							},
 						},
-						&litMatcher{
-							pos:        position{line: 474, col: 57, offset: 22184},
-							val:        "+",
-							ignoreCase: false,
-						},
 					},
-				},
-			},
-		},
-		{
-			name: "TriplePlusPassthrough",
-			pos:  position{line: 478, col: 1, offset: 22279},
-			expr: &actionExpr{
-				pos: position{line: 478, col: 26, offset: 22304},
-				run: (*parser).callonTriplePlusPassthrough1,
-				expr: &seqExpr{
-					pos: position{line: 478, col: 26, offset: 22304},
-					exprs: []interface{}{
-						&litMatcher{
-							pos:        position{line: 478, col: 26, offset: 22304},
-							val:        "+++",
-							ignoreCase: false,
-						},
-						&labeledExpr{
-							pos:   position{line: 478, col: 32, offset: 22310},
-							label: "content",
-							expr: &zeroOrMoreExpr{
-								pos: position{line: 478, col: 40, offset: 22318},
-								expr: &seqExpr{
-									pos: position{line: 478, col: 41, offset: 22319},
-									exprs: []interface{}{
-										&notExpr{
-											pos: position{line: 478, col: 41, offset: 22319},
-											expr: &litMatcher{
-												pos:        position{line: 478, col: 42, offset: 22320},
-												val:        "+++",
-												ignoreCase: false,
-											},
-										},
-										&anyMatcher{
-											line: 478, col: 48, offset: 22326,
-										},
+					&actionExpr{
+						pos: position{line: 576, col: 5, offset: 26933},
+						run: (*parser).callonSingleQuoteItalicText12,
+						expr: &seqExpr{
+							pos: position{line: 576, col: 5, offset: 26933},
+							exprs: []interface{}{
+								&notExpr{
+									pos: position{line: 576, col: 5, offset: 26933},
+									expr: &litMatcher{
+										pos:        position{line: 576, col: 6, offset: 26934},
+										val:        "\\",
+										ignoreCase: false,
+									},
+								},
+								&litMatcher{
+									pos:        position{line: 576, col: 10, offset: 26938},
+									val:        "_",
+									ignoreCase: false,
+								},
+								&labeledExpr{
+									pos:   position{line: 576, col: 14, offset: 26942},
+									label: "content",
+									expr: &ruleRefExpr{
+										pos:  position{line: 576, col: 23, offset: 26951},
+										name: "QuotedTextContent",
+									},
+								},
+								&litMatcher{
+									pos:        position{line: 576, col: 42, offset: 26970},
+									val:        "_",
+									ignoreCase: false,
+								},
+								&notExpr{
+									pos: position{line: 576, col: 46, offset: 26974},
+									expr: &ruleRefExpr{
+										pos:  position{line: 576, col: 47, offset: 26975},
+										name: "QuotedTextWordCharacter",
 									},
 								},
 							},
 						},
-						&litMatcher{
-							pos:        position{line: 478, col: 52, offset: 22330},
-							val:        "+++",
-							ignoreCase: false,
-						},
 					},
 				},
 			},
 		},
 		{
-			name: "PassthroughMacro",
-			pos:  position{line: 482, col: 1, offset: 22427},
+			name: "EscapedItalicText",
+			pos:  position{line: 580, col: 1, offset: 27074},
 			expr: &choiceExpr{
-				pos: position{line: 482, col: 21, offset: 22447},
+				pos: position{line: 580, col: 22, offset: 27095},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 482, col: 21, offset: 22447},
-						run: (*parser).callonPassthroughMacro2,
+						pos: position{line: 580, col: 22, offset: 27095},
+						run: (*parser).callonEscapedItalicText2,
 						expr: &seqExpr{
-							pos: position{line: 482, col: 21, offset: 22447},
+							pos: position{line: 580, col: 22, offset: 27095},
 							exprs: []interface{}{
+								&labeledExpr{
+									pos:   position{line: 580, col: 22, offset: 27095},
+									label: "backslashes",
+									expr: &seqExpr{
+										pos: position{line: 580, col: 35, offset: 27108},
+										exprs: []interface{}{
+											&litMatcher{
+												pos:        position{line: 580, col: 35, offset: 27108},
+												val:        "\\\\",
+												ignoreCase: false,
+											},
+											&zeroOrMoreExpr{
+												pos: position{line: 580, col: 40, offset: 27113},
+												expr: &litMatcher{
+													pos:        position{line: 580, col: 40, offset: 27113},
+													val:        "\\",
+													ignoreCase: false,
+												},
+											},
+										},
+									},
+								},
 								&litMatcher{
-									pos:        position{line: 482, col: 21, offset: 22447},
-									val:        "pass:[",
+									pos:        position{line: 580, col: 46, offset: 27119},
+									val:        "__",
 									ignoreCase: false,
 								},
 								&labeledExpr{
-									pos:   position{line: 482, col: 30, offset: 22456},
+									pos:   position{line: 580, col: 51, offset: 27124},
 									label: "content",
-									expr: &zeroOrMoreExpr{
-										pos: position{line: 482, col: 38, offset: 22464},
-										expr: &ruleRefExpr{
-											pos:  position{line: 482, col: 39, offset: 22465},
-											name: "PassthroughMacroCharacter",
-										},
+									expr: &ruleRefExpr{
+										pos:  position{line: 580, col: 60, offset: 27133},
+										name: "QuotedTextContent",
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 482, col: 67, offset: 22493},
-									val:        "]",
+									pos:        position{line: 580, col: 79, offset: 27152},
+									val:        "__",
 									ignoreCase: false,
 								},
 							},
 						},
 					},
 					&actionExpr{
-						pos: position{line: 484, col: 5, offset: 22584},
-						run: (*parser).callonPassthroughMacro9,
+						pos: position{line: 582, col: 5, offset: 27307},
+						run: (*parser).callonEscapedItalicText13,
 						expr: &seqExpr{
-							pos: position{line: 484, col: 5, offset: 22584},
+							pos: position{line: 582, col: 5, offset: 27307},
 							exprs: []interface{}{
+								&labeledExpr{
+									pos:   position{line: 582, col: 5, offset: 27307},
+									label: "backslashes",
+									expr: &seqExpr{
+										pos: position{line: 582, col: 18, offset: 27320},
+										exprs: []interface{}{
+											&litMatcher{
+												pos:        position{line: 582, col: 18, offset: 27320},
+												val:        "\\",
+												ignoreCase: false,
+											},
+											&zeroOrMoreExpr{
+												pos: position{line: 582, col: 22, offset: 27324},
+												expr: &litMatcher{
+													pos:        position{line: 582, col: 22, offset: 27324},
+													val:        "\\",
+													ignoreCase: false,
+												},
+											},
+										},
+									},
+								},
 								&litMatcher{
-									pos:        position{line: 484, col: 5, offset: 22584},
-									val:        "pass:q[",
+									pos:        position{line: 582, col: 28, offset: 27330},
+									val:        "__",
 									ignoreCase: false,
 								},
 								&labeledExpr{
-									pos:   position{line: 484, col: 15, offset: 22594},
+									pos:   position{line: 582, col: 33, offset: 27335},
 									label: "content",
-									expr: &zeroOrMoreExpr{
-										pos: position{line: 484, col: 23, offset: 22602},
-										expr: &choiceExpr{
-											pos: position{line: 484, col: 24, offset: 22603},
-											alternatives: []interface{}{
-												&ruleRefExpr{
-													pos:  position{line: 484, col: 24, offset: 22603},
-													name: "QuotedText",
-												},
-												&ruleRefExpr{
-													pos:  position{line: 484, col: 37, offset: 22616},
-													name: "PassthroughMacroCharacter",
+									expr: &ruleRefExpr{
+										pos:  position{line: 582, col: 42, offset: 27344},
+										name: "QuotedTextContent",
+									},
+								},
+								&litMatcher{
+									pos:        position{line: 582, col: 61, offset: 27363},
+									val:        "_",
+									ignoreCase: false,
+								},
+							},
+						},
+					},
+					&actionExpr{
+						pos: position{line: 585, col: 5, offset: 27557},
+						run: (*parser).callonEscapedItalicText24,
+						expr: &seqExpr{
+							pos: position{line: 585, col: 5, offset: 27557},
+							exprs: []interface{}{
+								&labeledExpr{
+									pos:   position{line: 585, col: 5, offset: 27557},
+									label: "backslashes",
+									expr: &seqExpr{
+										pos: position{line: 585, col: 18, offset: 27570},
+										exprs: []interface{}{
+											&litMatcher{
+												pos:        position{line: 585, col: 18, offset: 27570},
+												val:        "\\",
+												ignoreCase: false,
+											},
+											&zeroOrMoreExpr{
+												pos: position{line: 585, col: 22, offset: 27574},
+												expr: &litMatcher{
+													pos:        position{line: 585, col: 22, offset: 27574},
+													val:        "\\",
+													ignoreCase: false,
 												},
 											},
 										},
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 484, col: 65, offset: 22644},
-									val:        "]",
+									pos:        position{line: 585, col: 28, offset: 27580},
+									val:        "_",
+									ignoreCase: false,
+								},
+								&labeledExpr{
+									pos:   position{line: 585, col: 32, offset: 27584},
+									label: "content",
+									expr: &ruleRefExpr{
+										pos:  position{line: 585, col: 41, offset: 27593},
+										name: "QuotedTextContent",
+									},
+								},
+								&litMatcher{
+									pos:        position{line: 585, col: 60, offset: 27612},
+									val:        "_",
 									ignoreCase: false,
 								},
 							},
@@ -5186,50 +6242,38 @@ var g = &grammar{
 			},
 		},
 		{
-			name: "PassthroughMacroCharacter",
-			pos:  position{line: 488, col: 1, offset: 22734},
-			expr: &seqExpr{
-				pos: position{line: 488, col: 31, offset: 22764},
-				exprs: []interface{}{
-					&notExpr{
-						pos: position{line: 488, col: 31, offset: 22764},
-						expr: &litMatcher{
-							pos:        position{line: 488, col: 32, offset: 22765},
-							val:        "]",
-							ignoreCase: false,
-						},
-					},
-					&anyMatcher{
-						line: 488, col: 36, offset: 22769,
-					},
-				},
-			},
-		},
-		{
-			name: "CrossReference",
-			pos:  position{line: 493, col: 1, offset: 22885},
+			name: "DoubleQuoteMonospaceText",
+			pos:  position{line: 589, col: 1, offset: 27764},
 			expr: &actionExpr{
-				pos: position{line: 493, col: 19, offset: 22903},
-				run: (*parser).callonCrossReference1,
+				pos: position{line: 589, col: 29, offset: 27792},
+				run: (*parser).callonDoubleQuoteMonospaceText1,
 				expr: &seqExpr{
-					pos: position{line: 493, col: 19, offset: 22903},
+					pos: position{line: 589, col: 29, offset: 27792},
 					exprs: []interface{}{
+						&notExpr{
+							pos: position{line: 589, col: 29, offset: 27792},
+							expr: &litMatcher{
+								pos:        position{line: 589, col: 30, offset: 27793},
+								val:        "\\\\",
+								ignoreCase: false,
+							},
+						},
 						&litMatcher{
-							pos:        position{line: 493, col: 19, offset: 22903},
-							val:        "<<",
+							pos:        position{line: 589, col: 35, offset: 27798},
+							val:        "``",
 							ignoreCase: false,
 						},
 						&labeledExpr{
-							pos:   position{line: 493, col: 24, offset: 22908},
-							label: "id",
+							pos:   position{line: 589, col: 40, offset: 27803},
+							label: "content",
 							expr: &ruleRefExpr{
-								pos:  position{line: 493, col: 28, offset: 22912},
-								name: "ID",
+								pos:  position{line: 589, col: 49, offset: 27812},
+								name: "QuotedTextContent",
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 493, col: 32, offset: 22916},
-							val:        ">>",
+							pos:        position{line: 589, col: 68, offset: 27831},
+							val:        "``",
 							ignoreCase: false,
 						},
 					},
@@ -5237,73 +6281,90 @@ var g = &grammar{
 			},
 		},
 		{
-			name: "Link",
-			pos:  position{line: 500, col: 1, offset: 23075},
+			name: "SingleQuoteMonospaceText",
+			pos:  position{line: 593, col: 1, offset: 27960},
 			expr: &choiceExpr{
-				pos: position{line: 500, col: 9, offset: 23083},
+				pos: position{line: 593, col: 29, offset: 27988},
 				alternatives: []interface{}{
-					&ruleRefExpr{
-						pos:  position{line: 500, col: 9, offset: 23083},
-						name: "RelativeLink",
-					},
-					&ruleRefExpr{
-						pos:  position{line: 500, col: 24, offset: 23098},
-						name: "ExternalLink",
-					},
-				},
-			},
-		},
-		{
-			name: "ExternalLink",
-			pos:  position{line: 502, col: 1, offset: 23113},
-			expr: &actionExpr{
-				pos: position{line: 502, col: 17, offset: 23129},
-				run: (*parser).callonExternalLink1,
-				expr: &seqExpr{
-					pos: position{line: 502, col: 17, offset: 23129},
-					exprs: []interface{}{
-						&labeledExpr{
-							pos:   position{line: 502, col: 17, offset: 23129},
-							label: "url",
-							expr: &seqExpr{
-								pos: position{line: 502, col: 22, offset: 23134},
-								exprs: []interface{}{
-									&ruleRefExpr{
-										pos:  position{line: 502, col: 22, offset: 23134},
-										name: "URL_SCHEME",
+					&actionExpr{
+						pos: position{line: 593, col: 29, offset: 27988},
+						run: (*parser).callonSingleQuoteMonospaceText2,
+						expr: &seqExpr{
+							pos: position{line: 593, col: 29, offset: 27988},
+							exprs: []interface{}{
+								&notExpr{
+									pos: position{line: 593, col: 29, offset: 27988},
+									expr: &litMatcher{
+										pos:        position{line: 593, col: 30, offset: 27989},
+										val:        "\\\\",
+										ignoreCase: false,
 									},
-									&ruleRefExpr{
-										pos:  position{line: 502, col: 33, offset: 23145},
-										name: "URL",
+								},
+								&litMatcher{
+									pos:        position{line: 593, col: 35, offset: 27994},
+									val:        "``",
+									ignoreCase: false,
+								},
+								&labeledExpr{
+									pos:   position{line: 593, col: 40, offset: 27999},
+									label: "content",
+									expr: &ruleRefExpr{
+										pos:  position{line: 593, col: 49, offset: 28008},
+										name: "QuotedTextContent",
+									},
+								},
+								&litMatcher{
+									pos:        position{line: 593, col: 68, offset: 28027},
+									val:        "`",
+									ignoreCase: false,
+								},
+								&notExpr{
+									pos: position{line: 593, col: 72, offset: 28031},
+									expr: &ruleRefExpr{
+										pos:  position{line: 593, col: 73, offset: 28032},
+										name: "QuotedTextWordCharacter",
 									},
 								},
 							},
 						},
-						&labeledExpr{
-							pos:   position{line: 502, col: 38, offset: 23150},
-							label: "text",
-							expr: &zeroOrOneExpr{
-								pos: position{line: 502, col: 43, offset: 23155},
-								expr: &seqExpr{
-									pos: position{line: 502, col: 44, offset: 23156},
-									exprs: []interface{}{
-										&litMatcher{
-											pos:        position{line: 502, col: 44, offset: 23156},
-											val:        "[",
-											ignoreCase: false,
-										},
-										&zeroOrMoreExpr{
-											pos: position{line: 502, col: 48, offset: 23160},
-											expr: &ruleRefExpr{
-												pos:  position{line: 502, col: 49, offset: 23161},
-												name: "URL_TEXT",
-											},
-										},
-										&litMatcher{
-											pos:        position{line: 502, col: 60, offset: 23172},
-											val:        "]",
-											ignoreCase: false,
-										},
+					},
+					&actionExpr{
+						pos: position{line: 596, col: 5, offset: 28222},
+						run: (*parser).callonSingleQuoteMonospaceText12,
+						expr: &seqExpr{
+							pos: position{line: 596, col: 5, offset: 28222},
+							exprs: []interface{}{
+								&notExpr{
+									pos: position{line: 596, col: 5, offset: 28222},
+									expr: &litMatcher{
+										pos:        position{line: 596, col: 6, offset: 28223},
+										val:        "\\",
+										ignoreCase: false,
+									},
+								},
+								&litMatcher{
+									pos:        position{line: 596, col: 10, offset: 28227},
+									val:        "`",
+									ignoreCase: false,
+								},
+								&labeledExpr{
+									pos:   position{line: 596, col: 14, offset: 28231},
+									label: "content",
+									expr: &ruleRefExpr{
+										pos:  position{line: 596, col: 23, offset: 28240},
+										name: "QuotedTextContent",
+									},
+								},
+								&litMatcher{
+									pos:        position{line: 596, col: 42, offset: 28259},
+									val:        "`",
+									ignoreCase: false,
+								},
+								&notExpr{
+									pos: position{line: 596, col: 46, offset: 28263},
+									expr: &ruleRefExpr{
+										pos:  position{line: 596, col: 47, offset: 28264},
+										name: "QuotedTextWordCharacter",
 									},
 								},
 							},
@@ -5313,63 +6374,155 @@ var g = &grammar{
 			},
 		},
 		{
-			name: "RelativeLink",
-			pos:  position{line: 509, col: 1, offset: 23333},
-			expr: &actionExpr{
-				pos: position{line: 509, col: 17, offset: 23349},
-				run: (*parser).callonRelativeLink1,
-				expr: &seqExpr{
-					pos: position{line: 509, col: 17, offset: 23349},
-					exprs: []interface{}{
-						&litMatcher{
-							pos:        position{line: 509, col: 17, offset: 23349},
-							val:        "link:",
-							ignoreCase: false,
-						},
-						&labeledExpr{
-							pos:   position{line: 509, col: 25, offset: 23357},
-							label: "url",
-							expr: &seqExpr{
-								pos: position{line: 509, col: 30, offset: 23362},
-								exprs: []interface{}{
-									&zeroOrOneExpr{
-										pos: position{line: 509, col: 30, offset: 23362},
-										expr: &ruleRefExpr{
-											pos:  position{line: 509, col: 30, offset: 23362},
-											name: "URL_SCHEME",
+			name: "EscapedMonospaceText",
+			pos:  position{line: 600, col: 1, offset: 28411},
+			expr: &choiceExpr{
+				pos: position{line: 600, col: 25, offset: 28435},
+				alternatives: []interface{}{
+					&actionExpr{
+						pos: position{line: 600, col: 25, offset: 28435},
+						run: (*parser).callonEscapedMonospaceText2,
+						expr: &seqExpr{
+							pos: position{line: 600, col: 25, offset: 28435},
+							exprs: []interface{}{
+								&labeledExpr{
+									pos:   position{line: 600, col: 25, offset: 28435},
+									label: "backslashes",
+									expr: &seqExpr{
+										pos: position{line: 600, col: 38, offset: 28448},
+										exprs: []interface{}{
+											&litMatcher{
+												pos:        position{line: 600, col: 38, offset: 28448},
+												val:        "\\\\",
+												ignoreCase: false,
+											},
+											&zeroOrMoreExpr{
+												pos: position{line: 600, col: 43, offset: 28453},
+												expr: &litMatcher{
+													pos:        position{line: 600, col: 43, offset: 28453},
+													val:        "\\",
+													ignoreCase: false,
+												},
+											},
 										},
 									},
-									&ruleRefExpr{
-										pos:  position{line: 509, col: 42, offset: 23374},
-										name: "URL",
+								},
+								&litMatcher{
+									pos:        position{line: 600, col: 49, offset: 28459},
+									val:        "``",
+									ignoreCase: false,
+								},
+								&labeledExpr{
+									pos:   position{line: 600, col: 54, offset: 28464},
+									label: "content",
+									expr: &ruleRefExpr{
+										pos:  position{line: 600, col: 63, offset: 28473},
+										name: "QuotedTextContent",
 									},
 								},
+								&litMatcher{
+									pos:        position{line: 600, col: 82, offset: 28492},
+									val:        "``",
+									ignoreCase: false,
+								},
 							},
 						},
-						&labeledExpr{
-							pos:   position{line: 509, col: 47, offset: 23379},
-							label: "text",
-							expr: &seqExpr{
-								pos: position{line: 509, col: 53, offset: 23385},
-								exprs: []interface{}{
-									&litMatcher{
-										pos:        position{line: 509, col: 53, offset: 23385},
-										val:        "[",
-										ignoreCase: false,
+					},
+					&actionExpr{
+						pos: position{line: 602, col: 5, offset: 28647},
+						run: (*parser).callonEscapedMonospaceText13,
+						expr: &seqExpr{
+							pos: position{line: 602, col: 5, offset: 28647},
+							exprs: []interface{}{
+								&labeledExpr{
+									pos:   position{line: 602, col: 5, offset: 28647},
+									label: "backslashes",
+									expr: &seqExpr{
+										pos: position{line: 602, col: 18, offset: 28660},
+										exprs: []interface{}{
+											&litMatcher{
+												pos:        position{line: 602, col: 18, offset: 28660},
+												val:        "\\",
+												ignoreCase: false,
+											},
+											&zeroOrMoreExpr{
+												pos: position{line: 602, col: 22, offset: 28664},
+												expr: &litMatcher{
+													pos:        position{line: 602, col: 22, offset: 28664},
+													val:        "\\",
+													ignoreCase: false,
+												},
+											},
+										},
 									},
-									&zeroOrMoreExpr{
-										pos: position{line: 509, col: 57, offset: 23389},
-										expr: &ruleRefExpr{
-											pos:  position{line: 509, col: 58, offset: 23390},
-											name: "URL_TEXT",
+								},
+								&litMatcher{
+									pos:        position{line: 602, col: 28, offset: 28670},
+									val:        "``",
+									ignoreCase: false,
+								},
+								&labeledExpr{
+									pos:   position{line: 602, col: 33, offset: 28675},
+									label: "content",
+									expr: &ruleRefExpr{
+										pos:  position{line: 602, col: 42, offset: 28684},
+										name: "QuotedTextContent",
+									},
+								},
+								&litMatcher{
+									pos:        position{line: 602, col: 61, offset: 28703},
+									val:        "`",
+									ignoreCase: false,
+								},
+							},
+						},
+					},
+					&actionExpr{
+						pos: position{line: 605, col: 5, offset: 28897},
+						run: (*parser).callonEscapedMonospaceText24,
+						expr: &seqExpr{
+							pos: position{line: 605, col: 5, offset: 28897},
+							exprs: []interface{}{
+								&labeledExpr{
+									pos:   position{line: 605, col: 5, offset: 28897},
+									label: "backslashes",
+									expr: &seqExpr{
+										pos: position{line: 605, col: 18, offset: 28910},
+										exprs: []interface{}{
+											&litMatcher{
+												pos:        position{line: 605, col: 18, offset: 28910},
+												val:        "\\",
+												ignoreCase: false,
+											},
+											&zeroOrMoreExpr{
+												pos: position{line: 605, col: 22, offset: 28914},
+												expr: &litMatcher{
+													pos:        position{line: 605, col: 22, offset: 28914},
+													val:        "\\",
+													ignoreCase: false,
+												},
+											},
 										},
 									},
-									&litMatcher{
-										pos:        position{line: 509, col: 69, offset: 23401},
-										val:        "]",
-										ignoreCase: false,
+								},
+								&litMatcher{
+									pos:        position{line: 605, col: 28, offset: 28920},
+									val:        "`",
+									ignoreCase: false,
+								},
+								&labeledExpr{
+									pos:   position{line: 605, col: 32, offset: 28924},
+									label: "content",
+									expr: &ruleRefExpr{
+										pos:  position{line: 605, col: 41, offset: 28933},
+										name: "QuotedTextContent",
 									},
 								},
+								&litMatcher{
+									pos:        position{line: 605, col: 60, offset: 28952},
+									val:        "`",
+									ignoreCase: false,
+								},
 							},
 						},
 					},
@@ -5377,543 +6530,784 @@ var g = &grammar{
 			},
 		},
 		{
-			name: "BlockImage",
-			pos:  position{line: 519, col: 1, offset: 23663},
+			name: "DoubleQuoteMarkedText",
+			pos:  position{line: 609, col: 1, offset: 29104},
 			expr: &actionExpr{
-				pos: position{line: 519, col: 15, offset: 23677},
-				run: (*parser).callonBlockImage1,
+				pos: position{line: 609, col: 26, offset: 29129},
+				run: (*parser).callonDoubleQuoteMarkedText1,
 				expr: &seqExpr{
-					pos: position{line: 519, col: 15, offset: 23677},
+					pos: position{line: 609, col: 26, offset: 29129},
 					exprs: []interface{}{
-						&labeledExpr{
-							pos:   position{line: 519, col: 15, offset: 23677},
-							label: "attributes",
-							expr: &zeroOrMoreExpr{
-								pos: position{line: 519, col: 26, offset: 23688},
-								expr: &ruleRefExpr{
-									pos:  position{line: 519, col: 27, offset: 23689},
-									name: "ElementAttribute",
-								},
+						&notExpr{
+							pos: position{line: 609, col: 26, offset: 29129},
+							expr: &litMatcher{
+								pos:        position{line: 609, col: 27, offset: 29130},
+								val:        "\\\\",
+								ignoreCase: false,
 							},
 						},
-						&labeledExpr{
-							pos:   position{line: 519, col: 46, offset: 23708},
-							label: "image",
-							expr: &ruleRefExpr{
-								pos:  position{line: 519, col: 52, offset: 23714},
-								name: "BlockImageMacro",
-							},
+						&litMatcher{
+							pos:        position{line: 609, col: 32, offset: 29135},
+							val:        "##",
+							ignoreCase: false,
 						},
-						&zeroOrMoreExpr{
-							pos: position{line: 519, col: 69, offset: 23731},
+						&labeledExpr{
+							pos:   position{line: 609, col: 37, offset: 29140},
+							label: "content",
 							expr: &ruleRefExpr{
-								pos:  position{line: 519, col: 69, offset: 23731},
-								name: "WS",
+								pos:  position{line: 609, col: 46, offset: 29149},
+								name: "QuotedTextContent",
 							},
 						},
-						&ruleRefExpr{
-							pos:  position{line: 519, col: 73, offset: 23735},
-							name: "EOL",
+						&litMatcher{
+							pos:        position{line: 609, col: 65, offset: 29168},
+							val:        "##",
+							ignoreCase: false,
 						},
 					},
 				},
 			},
 		},
 		{
-			name: "BlockImageMacro",
-			pos:  position{line: 524, col: 1, offset: 23894},
-			expr: &actionExpr{
-				pos: position{line: 524, col: 20, offset: 23913},
-				run: (*parser).callonBlockImageMacro1,
-				expr: &seqExpr{
-					pos: position{line: 524, col: 20, offset: 23913},
-					exprs: []interface{}{
-						&litMatcher{
-							pos:        position{line: 524, col: 20, offset: 23913},
-							val:        "image::",
-							ignoreCase: false,
-						},
-						&labeledExpr{
-							pos:   position{line: 524, col: 30, offset: 23923},
-							label: "path",
-							expr: &ruleRefExpr{
-								pos:  position{line: 524, col: 36, offset: 23929},
-								name: "URL",
+			name: "SingleQuoteMarkedText",
+			pos:  position{line: 613, col: 1, offset: 29294},
+			expr: &choiceExpr{
+				pos: position{line: 613, col: 26, offset: 29319},
+				alternatives: []interface{}{
+					&actionExpr{
+						pos: position{line: 613, col: 26, offset: 29319},
+						run: (*parser).callonSingleQuoteMarkedText2,
+						expr: &seqExpr{
+							pos: position{line: 613, col: 26, offset: 29319},
+							exprs: []interface{}{
+								&notExpr{
+									pos: position{line: 613, col: 26, offset: 29319},
+									expr: &litMatcher{
+										pos:        position{line: 613, col: 27, offset: 29320},
+										val:        "\\\\",
+										ignoreCase: false,
+									},
+								},
+								&litMatcher{
+									pos:        position{line: 613, col: 32, offset: 29325},
+									val:        "##",
+									ignoreCase: false,
+								},
+								&labeledExpr{
+									pos:   position{line: 613, col: 37, offset: 29330},
+									label: "content",
+									expr: &ruleRefExpr{
+										pos:  position{line: 613, col: 46, offset: 29339},
+										name: "QuotedTextContent",
+									},
+								},
+								&litMatcher{
+									pos:        position{line: 613, col: 65, offset: 29358},
+									val:        "#",
+									ignoreCase: false,
+								},
+								&notExpr{
+									pos: position{line: 613, col: 69, offset: 29362},
+									expr: &ruleRefExpr{
+										pos:  position{line: 613, col: 70, offset: 29363},
+										name: "QuotedTextWordCharacter",
+									},
+								},
 							},
 						},
-						&litMatcher{
-							pos:        position{line: 524, col: 41, offset: 23934},
-							val:        "[",
-							ignoreCase: false,
-						},
-						&labeledExpr{
-							pos:   position{line: 524, col: 45, offset: 23938},
-							label: "attributes",
-							expr: &zeroOrOneExpr{
-								pos: position{line: 524, col: 57, offset: 23950},
-								expr: &ruleRefExpr{
-									pos:  position{line: 524, col: 57, offset: 23950},
-									name: "URL_TEXT",
+					},
+					&actionExpr{
+						pos: position{line: 616, col: 5, offset: 29550},
+						run: (*parser).callonSingleQuoteMarkedText12,
+						expr: &seqExpr{
+							pos: position{line: 616, col: 5, offset: 29550},
+							exprs: []interface{}{
+								&notExpr{
+									pos: position{line: 616, col: 5, offset: 29550},
+									expr: &litMatcher{
+										pos:        position{line: 616, col: 6, offset: 29551},
+										val:        "\\",
+										ignoreCase: false,
+									},
+								},
+								&litMatcher{
+									pos:        position{line: 616, col: 10, offset: 29555},
+									val:        "#",
+									ignoreCase: false,
+								},
+								&labeledExpr{
+									pos:   position{line: 616, col: 14, offset: 29559},
+									label: "content",
+									expr: &ruleRefExpr{
+										pos:  position{line: 616, col: 23, offset: 29568},
+										name: "QuotedTextContent",
+									},
+								},
+								&litMatcher{
+									pos:        position{line: 616, col: 42, offset: 29587},
+									val:        "#",
+									ignoreCase: false,
+								},
+								&notExpr{
+									pos: position{line: 616, col: 46, offset: 29591},
+									expr: &ruleRefExpr{
+										pos:  position{line: 616, col: 47, offset: 29592},
+										name: "QuotedTextWordCharacter",
+									},
 								},
 							},
 						},
-						&litMatcher{
-							pos:        position{line: 524, col: 68, offset: 23961},
-							val:        "]",
-							ignoreCase: false,
-						},
-					},
-				},
-			},
-		},
-		{
-			name: "InlineImage",
-			pos:  position{line: 528, col: 1, offset: 24028},
-			expr: &actionExpr{
-				pos: position{line: 528, col: 16, offset: 24043},
-				run: (*parser).callonInlineImage1,
-				expr: &labeledExpr{
-					pos:   position{line: 528, col: 16, offset: 24043},
-					label: "image",
-					expr: &ruleRefExpr{
-						pos:  position{line: 528, col: 22, offset: 24049},
-						name: "InlineImageMacro",
 					},
 				},
 			},
 		},
 		{
-			name: "InlineImageMacro",
-			pos:  position{line: 533, col: 1, offset: 24194},
-			expr: &actionExpr{
-				pos: position{line: 533, col: 21, offset: 24214},
-				run: (*parser).callonInlineImageMacro1,
-				expr: &seqExpr{
-					pos: position{line: 533, col: 21, offset: 24214},
-					exprs: []interface{}{
-						&litMatcher{
-							pos:        position{line: 533, col: 21, offset: 24214},
-							val:        "image:",
-							ignoreCase: false,
-						},
-						&notExpr{
-							pos: position{line: 533, col: 30, offset: 24223},
-							expr: &litMatcher{
-								pos:        position{line: 533, col: 31, offset: 24224},
-								val:        ":",
-								ignoreCase: false,
+			name: "EscapedMarkedText",
+			pos:  position{line: 620, col: 1, offset: 29736},
+			expr: &choiceExpr{
+				pos: position{line: 620, col: 22, offset: 29757},
+				alternatives: []interface{}{
+					&actionExpr{
+						pos: position{line: 620, col: 22, offset: 29757},
+						run: (*parser).callonEscapedMarkedText2,
+						expr: &seqExpr{
+							pos: position{line: 620, col: 22, offset: 29757},
+							exprs: []interface{}{
+								&labeledExpr{
+									pos:   position{line: 620, col: 22, offset: 29757},
+									label: "backslashes",
+									expr: &seqExpr{
+										pos: position{line: 620, col: 35, offset: 29770},
+										exprs: []interface{}{
+											&litMatcher{
+												pos:        position{line: 620, col: 35, offset: 29770},
+												val:        "\\\\",
+												ignoreCase: false,
+											},
+											&zeroOrMoreExpr{
+												pos: position{line: 620, col: 40, offset: 29775},
+												expr: &litMatcher{
+													pos:        position{line: 620, col: 40, offset: 29775},
+													val:        "\\",
+													ignoreCase: false,
+												},
+											},
+										},
+									},
+								},
+								&litMatcher{
+									pos:        position{line: 620, col: 46, offset: 29781},
+									val:        "##",
+									ignoreCase: false,
+								},
+								&labeledExpr{
+									pos:   position{line: 620, col: 51, offset: 29786},
+									label: "content",
+									expr: &ruleRefExpr{
+										pos:  position{line: 620, col: 60, offset: 29795},
+										name: "QuotedTextContent",
+									},
+								},
+								&litMatcher{
+									pos:        position{line: 620, col: 79, offset: 29814},
+									val:        "##",
+									ignoreCase: false,
+								},
 							},
 						},
-						&labeledExpr{
-							pos:   position{line: 533, col: 35, offset: 24228},
-							label: "path",
-							expr: &ruleRefExpr{
-								pos:  position{line: 533, col: 41, offset: 24234},
-								name: "URL",
+					},
+					&actionExpr{
+						pos: position{line: 622, col: 5, offset: 29969},
+						run: (*parser).callonEscapedMarkedText13,
+						expr: &seqExpr{
+							pos: position{line: 622, col: 5, offset: 29969},
+							exprs: []interface{}{
+								&labeledExpr{
+									pos:   position{line: 622, col: 5, offset: 29969},
+									label: "backslashes",
+									expr: &seqExpr{
+										pos: position{line: 622, col: 18, offset: 29982},
+										exprs: []interface{}{
+											&litMatcher{
+												pos:        position{line: 622, col: 18, offset: 29982},
+												val:        "\\",
+												ignoreCase: false,
+											},
+											&zeroOrMoreExpr{
+												pos: position{line: 622, col: 22, offset: 29986},
+												expr: &litMatcher{
+													pos:        position{line: 622, col: 22, offset: 29986},
+													val:        "\\",
+													ignoreCase: false,
+												},
+											},
+										},
+									},
+								},
+								&litMatcher{
+									pos:        position{line: 622, col: 28, offset: 29992},
+									val:        "##",
+									ignoreCase: false,
+								},
+								&labeledExpr{
+									pos:   position{line: 622, col: 33, offset: 29997},
+									label: "content",
+									expr: &ruleRefExpr{
+										pos:  position{line: 622, col: 42, offset: 30006},
+										name: "QuotedTextContent",
+									},
+								},
+								&litMatcher{
+									pos:        position{line: 622, col: 61, offset: 30025},
+									val:        "#",
+									ignoreCase: false,
+								},
 							},
 						},
-						&litMatcher{
-							pos:        position{line: 533, col: 46, offset: 24239},
-							val:        "[",
-							ignoreCase: false,
-						},
-						&labeledExpr{
-							pos:   position{line: 533, col: 50, offset: 24243},
-							label: "attributes",
-							expr: &zeroOrOneExpr{
-								pos: position{line: 533, col: 62, offset: 24255},
-								expr: &ruleRefExpr{
-									pos:  position{line: 533, col: 62, offset: 24255},
-									name: "URL_TEXT",
+					},
+					&actionExpr{
+						pos: position{line: 625, col: 5, offset: 30219},
+						run: (*parser).callonEscapedMarkedText24,
+						expr: &seqExpr{
+							pos: position{line: 625, col: 5, offset: 30219},
+							exprs: []interface{}{
+								&labeledExpr{
+									pos:   position{line: 625, col: 5, offset: 30219},
+									label: "backslashes",
+									expr: &seqExpr{
+										pos: position{line: 625, col: 18, offset: 30232},
+										exprs: []interface{}{
+											&litMatcher{
+												pos:        position{line: 625, col: 18, offset: 30232},
+												val:        "\\",
+												ignoreCase: false,
+											},
+											&zeroOrMoreExpr{
+												pos: position{line: 625, col: 22, offset: 30236},
+												expr: &litMatcher{
+													pos:        position{line: 625, col: 22, offset: 30236},
+													val:        "\\",
+													ignoreCase: false,
+												},
+											},
+										},
+									},
+								},
+								&litMatcher{
+									pos:        position{line: 625, col: 28, offset: 30242},
+									val:        "#",
+									ignoreCase: false,
+								},
+								&labeledExpr{
+									pos:   position{line: 625, col: 32, offset: 30246},
+									label: "content",
+									expr: &ruleRefExpr{
+										pos:  position{line: 625, col: 41, offset: 30255},
+										name: "QuotedTextContent",
+									},
+								},
+								&litMatcher{
+									pos:        position{line: 625, col: 60, offset: 30274},
+									val:        "#",
+									ignoreCase: false,
 								},
 							},
 						},
-						&litMatcher{
-							pos:        position{line: 533, col: 73, offset: 24266},
-							val:        "]",
-							ignoreCase: false,
-						},
-					},
-				},
-			},
-		},
-		{
-			name: "DelimitedBlock",
-			pos:  position{line: 540, col: 1, offset: 24596},
-			expr: &choiceExpr{
-				pos: position{line: 540, col: 19, offset: 24614},
-				alternatives: []interface{}{
-					&ruleRefExpr{
-						pos:  position{line: 540, col: 19, offset: 24614},
-						name: "FencedBlock",
-					},
-					&ruleRefExpr{
-						pos:  position{line: 540, col: 33, offset: 24628},
-						name: "ListingBlock",
-					},
-					&ruleRefExpr{
-						pos:  position{line: 540, col: 48, offset: 24643},
-						name: "ExampleBlock",
 					},
 				},
 			},
 		},
 		{
-			name: "BlockDelimiter",
-			pos:  position{line: 542, col: 1, offset: 24657},
+			name: "CurvedQuotedText",
+			pos:  position{line: 630, col: 1, offset: 30515},
 			expr: &choiceExpr{
-				pos: position{line: 542, col: 19, offset: 24675},
+				pos: position{line: 630, col: 21, offset: 30535},
 				alternatives: []interface{}{
-					&ruleRefExpr{
-						pos:  position{line: 542, col: 19, offset: 24675},
-						name: "LiteralBlockDelimiter",
-					},
-					&ruleRefExpr{
-						pos:  position{line: 542, col: 43, offset: 24699},
-						name: "FencedBlockDelimiter",
+					&actionExpr{
+						pos: position{line: 630, col: 21, offset: 30535},
+						run: (*parser).callonCurvedQuotedText2,
+						expr: &seqExpr{
+							pos: position{line: 630, col: 21, offset: 30535},
+							exprs: []interface{}{
+								&notExpr{
+									pos: position{line: 630, col: 21, offset: 30535},
+									expr: &litMatcher{
+										pos:        position{line: 630, col: 22, offset: 30536},
+										val:        "\\",
+										ignoreCase: false,
+									},
+								},
+								&litMatcher{
+									pos:        position{line: 630, col: 26, offset: 30540},
+									val:        "\"`",
+									ignoreCase: false,
+								},
+								&labeledExpr{
+									pos:   position{line: 630, col: 32, offset: 30546},
+									label: "content",
+									expr: &ruleRefExpr{
+										pos:  position{line: 630, col: 41, offset: 30555},
+										name: "QuotedTextContent",
+									},
+								},
+								&litMatcher{
+									pos:        position{line: 630, col: 60, offset: 30574},
+									val:        "`\"",
+									ignoreCase: false,
+								},
+								&notExpr{
+									pos: position{line: 630, col: 66, offset: 30580},
+									expr: &ruleRefExpr{
+										pos:  position{line: 630, col: 67, offset: 30581},
+										name: "QuotedTextWordCharacter",
+									},
+								},
+							},
+						},
 					},
-					&ruleRefExpr{
-						pos:  position{line: 542, col: 66, offset: 24722},
-						name: "ListingBlockDelimiter",
-					},
-					&ruleRefExpr{
-						pos:  position{line: 542, col: 90, offset: 24746},
-						name: "ExampleBlockDelimiter",
+					&actionExpr{
+						pos: position{line: 632, col: 5, offset: 30687},
+						run: (*parser).callonCurvedQuotedText12,
+						expr: &seqExpr{
+							pos: position{line: 632, col: 5, offset: 30687},
+							exprs: []interface{}{
+								&notExpr{
+									pos: position{line: 632, col: 5, offset: 30687},
+									expr: &litMatcher{
+										pos:        position{line: 632, col: 6, offset: 30688},
+										val:        "\\",
+										ignoreCase: false,
+									},
+								},
+								&litMatcher{
+									pos:        position{line: 632, col: 10, offset: 30692},
+									val:        "'`",
+									ignoreCase: false,
+								},
+								&labeledExpr{
+									pos:   position{line: 632, col: 15, offset: 30697},
+									label: "content",
+									expr: &ruleRefExpr{
+										pos:  position{line: 632, col: 24, offset: 30706},
+										name: "QuotedTextContent",
+									},
+								},
+								&litMatcher{
+									pos:        position{line: 632, col: 43, offset: 30725},
+									val:        "`'",
+									ignoreCase: false,
+								},
+								&notExpr{
+									pos: position{line: 632, col: 48, offset: 30730},
+									expr: &ruleRefExpr{
+										pos:  position{line: 632, col: 49, offset: 30731},
+										name: "QuotedTextWordCharacter",
+									},
+								},
+							},
+						},
 					},
 				},
 			},
 		},
 		{
-			name: "FencedBlockDelimiter",
-			pos:  position{line: 544, col: 1, offset: 24769},
-			expr: &litMatcher{
-				pos:        position{line: 544, col: 25, offset: 24793},
-				val:        "```",
-				ignoreCase: false,
+			name: "EscapedCurvedQuotedText",
+			pos:  position{line: 636, col: 1, offset: 30836},
+			expr: &choiceExpr{
+				pos: position{line: 636, col: 28, offset: 30863},
+				alternatives: []interface{}{
+					&actionExpr{
+						pos: position{line: 636, col: 28, offset: 30863},
+						run: (*parser).callonEscapedCurvedQuotedText2,
+						expr: &seqExpr{
+							pos: position{line: 636, col: 28, offset: 30863},
+							exprs: []interface{}{
+								&labeledExpr{
+									pos:   position{line: 636, col: 28, offset: 30863},
+									label: "backslashes",
+									expr: &seqExpr{
+										pos: position{line: 636, col: 41, offset: 30876},
+										exprs: []interface{}{
+											&litMatcher{
+												pos:        position{line: 636, col: 41, offset: 30876},
+												val:        "\\",
+												ignoreCase: false,
+											},
+											&zeroOrMoreExpr{
+												pos: position{line: 636, col: 45, offset: 30880},
+												expr: &litMatcher{
+													pos:        position{line: 636, col: 45, offset: 30880},
+													val:        "\\",
+													ignoreCase: false,
+												},
+											},
+										},
+									},
+								},
+								&litMatcher{
+									pos:        position{line: 636, col: 51, offset: 30886},
+									val:        "\"`",
+									ignoreCase: false,
+								},
+								&labeledExpr{
+									pos:   position{line: 636, col: 57, offset: 30892},
+									label: "content",
+									expr: &ruleRefExpr{
+										pos:  position{line: 636, col: 66, offset: 30901},
+										name: "QuotedTextContent",
+									},
+								},
+								&litMatcher{
+									pos:        position{line: 636, col: 85, offset: 30920},
+									val:        "`\"",
+									ignoreCase: false,
+								},
+							},
+						},
+					},
+					&actionExpr{
+						pos: position{line: 638, col: 5, offset: 31044},
+						run: (*parser).callonEscapedCurvedQuotedText13,
+						expr: &seqExpr{
+							pos: position{line: 638, col: 5, offset: 31044},
+							exprs: []interface{}{
+								&labeledExpr{
+									pos:   position{line: 638, col: 5, offset: 31044},
+									label: "backslashes",
+									expr: &seqExpr{
+										pos: position{line: 638, col: 18, offset: 31057},
+										exprs: []interface{}{
+											&litMatcher{
+												pos:        position{line: 638, col: 18, offset: 31057},
+												val:        "\\",
+												ignoreCase: false,
+											},
+											&zeroOrMoreExpr{
+												pos: position{line: 638, col: 22, offset: 31061},
+												expr: &litMatcher{
+													pos:        position{line: 638, col: 22, offset: 31061},
+													val:        "\\",
+													ignoreCase: false,
+												},
+											},
+										},
+									},
+								},
+								&litMatcher{
+									pos:        position{line: 638, col: 28, offset: 31067},
+									val:        "'`",
+									ignoreCase: false,
+								},
+								&labeledExpr{
+									pos:   position{line: 638, col: 33, offset: 31072},
+									label: "content",
+									expr: &ruleRefExpr{
+										pos:  position{line: 638, col: 42, offset: 31081},
+										name: "QuotedTextContent",
+									},
+								},
+								&litMatcher{
+									pos:        position{line: 638, col: 61, offset: 31100},
+									val:        "`'",
+									ignoreCase: false,
+								},
+							},
+						},
+					},
+				},
 			},
 		},
 		{
-			name: "FencedBlock",
-			pos:  position{line: 546, col: 1, offset: 24800},
+			name: "SuperscriptText",
+			pos:  position{line: 643, col: 1, offset: 31304},
 			expr: &actionExpr{
-				pos: position{line: 546, col: 16, offset: 24815},
-				run: (*parser).callonFencedBlock1,
+				pos: position{line: 643, col: 20, offset: 31323},
+				run: (*parser).callonSuperscriptText1,
 				expr: &seqExpr{
-					pos: position{line: 546, col: 16, offset: 24815},
+					pos: position{line: 643, col: 20, offset: 31323},
 					exprs: []interface{}{
-						&ruleRefExpr{
-							pos:  position{line: 546, col: 16, offset: 24815},
-							name: "FencedBlockDelimiter",
-						},
-						&zeroOrMoreExpr{
-							pos: position{line: 546, col: 37, offset: 24836},
-							expr: &ruleRefExpr{
-								pos:  position{line: 546, col: 37, offset: 24836},
-								name: "WS",
+						&notExpr{
+							pos: position{line: 643, col: 20, offset: 31323},
+							expr: &litMatcher{
+								pos:        position{line: 643, col: 21, offset: 31324},
+								val:        "\\",
+								ignoreCase: false,
 							},
 						},
-						&ruleRefExpr{
-							pos:  position{line: 546, col: 41, offset: 24840},
-							name: "NEWLINE",
+						&litMatcher{
+							pos:        position{line: 643, col: 25, offset: 31328},
+							val:        "^",
+							ignoreCase: false,
 						},
 						&labeledExpr{
-							pos:   position{line: 546, col: 49, offset: 24848},
+							pos:   position{line: 643, col: 29, offset: 31332},
 							label: "content",
-							expr: &zeroOrMoreExpr{
-								pos: position{line: 546, col: 57, offset: 24856},
-								expr: &seqExpr{
-									pos: position{line: 546, col: 58, offset: 24857},
-									exprs: []interface{}{
-										&notExpr{
-											pos: position{line: 546, col: 58, offset: 24857},
-											expr: &ruleRefExpr{
-												pos:  position{line: 546, col: 59, offset: 24858},
-												name: "FencedBlockDelimiter",
-											},
-										},
-										&anyMatcher{
-											line: 546, col: 80, offset: 24879,
-										},
-									},
-								},
-							},
-						},
-						&ruleRefExpr{
-							pos:  position{line: 546, col: 84, offset: 24883},
-							name: "FencedBlockDelimiter",
-						},
-						&zeroOrMoreExpr{
-							pos: position{line: 546, col: 105, offset: 24904},
 							expr: &ruleRefExpr{
-								pos:  position{line: 546, col: 105, offset: 24904},
-								name: "WS",
+								pos:  position{line: 643, col: 38, offset: 31341},
+								name: "SuperscriptTextCharacters",
 							},
 						},
-						&ruleRefExpr{
-							pos:  position{line: 546, col: 109, offset: 24908},
-							name: "EOL",
+						&litMatcher{
+							pos:        position{line: 643, col: 65, offset: 31368},
+							val:        "^",
+							ignoreCase: false,
 						},
 					},
 				},
 			},
 		},
 		{
-			name: "ListingBlockDelimiter",
-			pos:  position{line: 550, col: 1, offset: 25001},
-			expr: &litMatcher{
-				pos:        position{line: 550, col: 26, offset: 25026},
-				val:        "----",
-				ignoreCase: false,
+			name: "SuperscriptTextCharacters",
+			pos:  position{line: 647, col: 1, offset: 31452},
+			expr: &actionExpr{
+				pos: position{line: 647, col: 30, offset: 31481},
+				run: (*parser).callonSuperscriptTextCharacters1,
+				expr: &oneOrMoreExpr{
+					pos: position{line: 647, col: 30, offset: 31481},
+					expr: &seqExpr{
+						pos: position{line: 647, col: 31, offset: 31482},
+						exprs: []interface{}{
+							&notExpr{
+								pos: position{line: 647, col: 31, offset: 31482},
+								expr: &ruleRefExpr{
+									pos:  position{line: 647, col: 32, offset: 31483},
+									name: "NEWLINE",
+								},
+							},
+							&notExpr{
+								pos: position{line: 647, col: 40, offset: 31491},
+								expr: &ruleRefExpr{
+									pos:  position{line: 647, col: 41, offset: 31492},
+									name: "WS",
+								},
+							},
+							&notExpr{
+								pos: position{line: 647, col: 44, offset: 31495},
+								expr: &litMatcher{
+									pos:        position{line: 647, col: 45, offset: 31496},
+									val:        "^",
+									ignoreCase: false,
+								},
+							},
+							&anyMatcher{
+								line: 647, col: 49, offset: 31500,
+							},
+						},
+					},
+				},
 			},
 		},
 		{
-			name: "ListingBlock",
-			pos:  position{line: 552, col: 1, offset: 25034},
+			name: "EscapedSuperscriptText",
+			pos:  position{line: 651, col: 1, offset: 31540},
 			expr: &actionExpr{
-				pos: position{line: 552, col: 17, offset: 25050},
-				run: (*parser).callonListingBlock1,
+				pos: position{line: 651, col: 27, offset: 31566},
+				run: (*parser).callonEscapedSuperscriptText1,
 				expr: &seqExpr{
-					pos: position{line: 552, col: 17, offset: 25050},
+					pos: position{line: 651, col: 27, offset: 31566},
 					exprs: []interface{}{
-						&ruleRefExpr{
-							pos:  position{line: 552, col: 17, offset: 25050},
-							name: "ListingBlockDelimiter",
-						},
-						&zeroOrMoreExpr{
-							pos: position{line: 552, col: 39, offset: 25072},
-							expr: &ruleRefExpr{
-								pos:  position{line: 552, col: 39, offset: 25072},
-								name: "WS",
-							},
-						},
-						&ruleRefExpr{
-							pos:  position{line: 552, col: 43, offset: 25076},
-							name: "NEWLINE",
-						},
 						&labeledExpr{
-							pos:   position{line: 552, col: 51, offset: 25084},
-							label: "content",
-							expr: &zeroOrMoreExpr{
-								pos: position{line: 552, col: 59, offset: 25092},
-								expr: &seqExpr{
-									pos: position{line: 552, col: 60, offset: 25093},
-									exprs: []interface{}{
-										&notExpr{
-											pos: position{line: 552, col: 60, offset: 25093},
-											expr: &ruleRefExpr{
-												pos:  position{line: 552, col: 61, offset: 25094},
-												name: "ListingBlockDelimiter",
-											},
-										},
-										&anyMatcher{
-											line: 552, col: 83, offset: 25116,
+							pos:   position{line: 651, col: 27, offset: 31566},
+							label: "backslashes",
+							expr: &seqExpr{
+								pos: position{line: 651, col: 40, offset: 31579},
+								exprs: []interface{}{
+									&litMatcher{
+										pos:        position{line: 651, col: 40, offset: 31579},
+										val:        "\\",
+										ignoreCase: false,
+									},
+									&zeroOrMoreExpr{
+										pos: position{line: 651, col: 44, offset: 31583},
+										expr: &litMatcher{
+											pos:        position{line: 651, col: 44, offset: 31583},
+											val:        "\\",
+											ignoreCase: false,
 										},
 									},
 								},
 							},
 						},
-						&ruleRefExpr{
-							pos:  position{line: 552, col: 87, offset: 25120},
-							name: "ListingBlockDelimiter",
+						&litMatcher{
+							pos:        position{line: 651, col: 50, offset: 31589},
+							val:        "^",
+							ignoreCase: false,
 						},
-						&zeroOrMoreExpr{
-							pos: position{line: 552, col: 109, offset: 25142},
+						&labeledExpr{
+							pos:   position{line: 651, col: 54, offset: 31593},
+							label: "content",
 							expr: &ruleRefExpr{
-								pos:  position{line: 552, col: 109, offset: 25142},
-								name: "WS",
+								pos:  position{line: 651, col: 63, offset: 31602},
+								name: "SuperscriptTextCharacters",
 							},
 						},
-						&ruleRefExpr{
-							pos:  position{line: 552, col: 113, offset: 25146},
-							name: "EOL",
+						&litMatcher{
+							pos:        position{line: 651, col: 90, offset: 31629},
+							val:        "^",
+							ignoreCase: false,
 						},
 					},
 				},
 			},
 		},
 		{
-			name: "ExampleBlockDelimiter",
-			pos:  position{line: 556, col: 1, offset: 25240},
-			expr: &litMatcher{
-				pos:        position{line: 556, col: 26, offset: 25265},
-				val:        "====",
-				ignoreCase: false,
-			},
-		},
-		{
-			name: "ExampleBlock",
-			pos:  position{line: 558, col: 1, offset: 25273},
+			name: "SubscriptText",
+			pos:  position{line: 655, col: 1, offset: 31735},
 			expr: &actionExpr{
-				pos: position{line: 558, col: 17, offset: 25289},
-				run: (*parser).callonExampleBlock1,
+				pos: position{line: 655, col: 18, offset: 31752},
+				run: (*parser).callonSubscriptText1,
 				expr: &seqExpr{
-					pos: position{line: 558, col: 17, offset: 25289},
+					pos: position{line: 655, col: 18, offset: 31752},
 					exprs: []interface{}{
-						&labeledExpr{
-							pos:   position{line: 558, col: 17, offset: 25289},
-							label: "attributes",
-							expr: &zeroOrMoreExpr{
-								pos: position{line: 558, col: 28, offset: 25300},
-								expr: &ruleRefExpr{
-									pos:  position{line: 558, col: 29, offset: 25301},
-									name: "ElementAttribute",
-								},
+						&notExpr{
+							pos: position{line: 655, col: 18, offset: 31752},
+							expr: &litMatcher{
+								pos:        position{line: 655, col: 19, offset: 31753},
+								val:        "\\",
+								ignoreCase: false,
 							},
 						},
-						&ruleRefExpr{
-							pos:  position{line: 558, col: 48, offset: 25320},
-							name: "ExampleBlockDelimiter",
+						&litMatcher{
+							pos:        position{line: 655, col: 23, offset: 31757},
+							val:        "~",
+							ignoreCase: false,
 						},
-						&zeroOrMoreExpr{
-							pos: position{line: 558, col: 70, offset: 25342},
+						&labeledExpr{
+							pos:   position{line: 655, col: 27, offset: 31761},
+							label: "content",
 							expr: &ruleRefExpr{
-								pos:  position{line: 558, col: 70, offset: 25342},
-								name: "WS",
+								pos:  position{line: 655, col: 36, offset: 31770},
+								name: "SubscriptTextCharacters",
 							},
 						},
-						&ruleRefExpr{
-							pos:  position{line: 558, col: 74, offset: 25346},
-							name: "NEWLINE",
-						},
-						&labeledExpr{
-							pos:   position{line: 558, col: 82, offset: 25354},
-							label: "content",
-							expr: &zeroOrMoreExpr{
-								pos: position{line: 558, col: 90, offset: 25362},
-								expr: &choiceExpr{
-									pos: position{line: 558, col: 91, offset: 25363},
-									alternatives: []interface{}{
-										&ruleRefExpr{
-											pos:  position{line: 558, col: 91, offset: 25363},
-											name: "List",
-										},
-										&ruleRefExpr{
-											pos:  position{line: 558, col: 98, offset: 25370},
-											name: "Paragraph",
-										},
-										&ruleRefExpr{
-											pos:  position{line: 558, col: 110, offset: 25382},
-											name: "BlankLine",
-										},
-									},
-								},
-							},
-						},
-						&ruleRefExpr{
-							pos:  position{line: 558, col: 123, offset: 25395},
-							name: "ExampleBlockDelimiter",
-						},
-						&zeroOrMoreExpr{
-							pos: position{line: 558, col: 145, offset: 25417},
-							expr: &ruleRefExpr{
-								pos:  position{line: 558, col: 145, offset: 25417},
-								name: "WS",
-							},
-						},
-						&ruleRefExpr{
-							pos:  position{line: 558, col: 149, offset: 25421},
-							name: "EOL",
+						&litMatcher{
+							pos:        position{line: 655, col: 61, offset: 31795},
+							val:        "~",
+							ignoreCase: false,
 						},
 					},
 				},
 			},
 		},
 		{
-			name: "LiteralBlock",
-			pos:  position{line: 565, col: 1, offset: 25805},
-			expr: &choiceExpr{
-				pos: position{line: 565, col: 17, offset: 25821},
-				alternatives: []interface{}{
-					&ruleRefExpr{
-						pos:  position{line: 565, col: 17, offset: 25821},
-						name: "ParagraphWithSpaces",
-					},
-					&ruleRefExpr{
-						pos:  position{line: 565, col: 39, offset: 25843},
-						name: "ParagraphWithLiteralBlockDelimiter",
-					},
-					&ruleRefExpr{
-						pos:  position{line: 565, col: 76, offset: 25880},
-						name: "ParagraphWithLiteralAttribute",
+			name: "SubscriptTextCharacters",
+			pos:  position{line: 659, col: 1, offset: 31877},
+			expr: &actionExpr{
+				pos: position{line: 659, col: 28, offset: 31904},
+				run: (*parser).callonSubscriptTextCharacters1,
+				expr: &oneOrMoreExpr{
+					pos: position{line: 659, col: 28, offset: 31904},
+					expr: &seqExpr{
+						pos: position{line: 659, col: 29, offset: 31905},
+						exprs: []interface{}{
+							&notExpr{
+								pos: position{line: 659, col: 29, offset: 31905},
+								expr: &ruleRefExpr{
+									pos:  position{line: 659, col: 30, offset: 31906},
+									name: "NEWLINE",
+								},
+							},
+							&notExpr{
+								pos: position{line: 659, col: 38, offset: 31914},
+								expr: &ruleRefExpr{
+									pos:  position{line: 659, col: 39, offset: 31915},
+									name: "WS",
+								},
+							},
+							&notExpr{
+								pos: position{line: 659, col: 42, offset: 31918},
+								expr: &litMatcher{
+									pos:        position{line: 659, col: 43, offset: 31919},
+									val:        "~",
+									ignoreCase: false,
+								},
+							},
+							&anyMatcher{
+								line: 659, col: 47, offset: 31923,
+							},
+						},
 					},
 				},
 			},
 		},
 		{
-			name: "ParagraphWithSpaces",
-			pos:  position{line: 568, col: 1, offset: 25975},
+			name: "EscapedSubscriptText",
+			pos:  position{line: 663, col: 1, offset: 31963},
 			expr: &actionExpr{
-				pos: position{line: 568, col: 24, offset: 25998},
-				run: (*parser).callonParagraphWithSpaces1,
+				pos: position{line: 663, col: 25, offset: 31987},
+				run: (*parser).callonEscapedSubscriptText1,
 				expr: &seqExpr{
-					pos: position{line: 568, col: 24, offset: 25998},
+					pos: position{line: 663, col: 25, offset: 31987},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 568, col: 24, offset: 25998},
-							label: "spaces",
-							expr: &oneOrMoreExpr{
-								pos: position{line: 568, col: 32, offset: 26006},
-								expr: &ruleRefExpr{
-									pos:  position{line: 568, col: 32, offset: 26006},
-									name: "WS",
+							pos:   position{line: 663, col: 25, offset: 31987},
+							label: "backslashes",
+							expr: &seqExpr{
+								pos: position{line: 663, col: 38, offset: 32000},
+								exprs: []interface{}{
+									&litMatcher{
+										pos:        position{line: 663, col: 38, offset: 32000},
+										val:        "\\",
+										ignoreCase: false,
+									},
+									&zeroOrMoreExpr{
+										pos: position{line: 663, col: 42, offset: 32004},
+										expr: &litMatcher{
+											pos:        position{line: 663, col: 42, offset: 32004},
+											val:        "\\",
+											ignoreCase: false,
+										},
+									},
 								},
 							},
 						},
-						&notExpr{
-							pos: position{line: 568, col: 37, offset: 26011},
-							expr: &ruleRefExpr{
-								pos:  position{line: 568, col: 38, offset: 26012},
-								name: "NEWLINE",
-							},
+						&litMatcher{
+							pos:        position{line: 663, col: 48, offset: 32010},
+							val:        "~",
+							ignoreCase: false,
 						},
 						&labeledExpr{
-							pos:   position{line: 568, col: 46, offset: 26020},
+							pos:   position{line: 663, col: 52, offset: 32014},
 							label: "content",
 							expr: &ruleRefExpr{
-								pos:  position{line: 568, col: 55, offset: 26029},
-								name: "LiteralBlockContent",
+								pos:  position{line: 663, col: 61, offset: 32023},
+								name: "SubscriptTextCharacters",
 							},
 						},
-						&ruleRefExpr{
-							pos:  position{line: 568, col: 76, offset: 26050},
-							name: "EndOfLiteralBlock",
+						&litMatcher{
+							pos:        position{line: 663, col: 86, offset: 32048},
+							val:        "~",
+							ignoreCase: false,
 						},
 					},
 				},
 			},
 		},
 		{
-			name: "LiteralBlockContent",
-			pos:  position{line: 573, col: 1, offset: 26231},
-			expr: &actionExpr{
-				pos: position{line: 573, col: 24, offset: 26254},
-				run: (*parser).callonLiteralBlockContent1,
-				expr: &labeledExpr{
-					pos:   position{line: 573, col: 24, offset: 26254},
-					label: "content",
-					expr: &oneOrMoreExpr{
-						pos: position{line: 573, col: 32, offset: 26262},
+			name: "QuotedTextContent",
+			pos:  position{line: 667, col: 1, offset: 32154},
+			expr: &seqExpr{
+				pos: position{line: 667, col: 22, offset: 32175},
+				exprs: []interface{}{
+					&ruleRefExpr{
+						pos:  position{line: 667, col: 22, offset: 32175},
+						name: "QuotedTextContentElement",
+					},
+					&zeroOrMoreExpr{
+						pos: position{line: 667, col: 47, offset: 32200},
 						expr: &seqExpr{
-							pos: position{line: 573, col: 33, offset: 26263},
+							pos: position{line: 667, col: 48, offset: 32201},
 							exprs: []interface{}{
-								&notExpr{
-									pos: position{line: 573, col: 33, offset: 26263},
-									expr: &seqExpr{
-										pos: position{line: 573, col: 35, offset: 26265},
-										exprs: []interface{}{
-											&ruleRefExpr{
-												pos:  position{line: 573, col: 35, offset: 26265},
-												name: "NEWLINE",
-											},
-											&ruleRefExpr{
-												pos:  position{line: 573, col: 43, offset: 26273},
-												name: "BlankLine",
-											},
-										},
+								&oneOrMoreExpr{
+									pos: position{line: 667, col: 48, offset: 32201},
+									expr: &ruleRefExpr{
+										pos:  position{line: 667, col: 48, offset: 32201},
+										name: "WS",
 									},
 								},
-								&anyMatcher{
-									line: 573, col: 54, offset: 26284,
+								&ruleRefExpr{
+									pos:  position{line: 667, col: 52, offset: 32205},
+									name: "QuotedTextContentElement",
 								},
 							},
 						},
@@ -5922,286 +7316,234 @@ var g = &grammar{
 			},
 		},
 		{
-			name: "EndOfLiteralBlock",
-			pos:  position{line: 578, col: 1, offset: 26369},
+			name: "QuotedTextContentElement",
+			pos:  position{line: 669, col: 1, offset: 32233},
 			expr: &choiceExpr{
-				pos: position{line: 578, col: 22, offset: 26390},
+				pos: position{line: 669, col: 29, offset: 32261},
 				alternatives: []interface{}{
-					&seqExpr{
-						pos: position{line: 578, col: 22, offset: 26390},
-						exprs: []interface{}{
-							&ruleRefExpr{
-								pos:  position{line: 578, col: 22, offset: 26390},
-								name: "NEWLINE",
-							},
-							&ruleRefExpr{
-								pos:  position{line: 578, col: 30, offset: 26398},
-								name: "BlankLine",
-							},
-						},
+					&ruleRefExpr{
+						pos:  position{line: 669, col: 29, offset: 32261},
+						name: "QuotedText",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 578, col: 42, offset: 26410},
-						name: "NEWLINE",
+						pos:  position{line: 669, col: 42, offset: 32274},
+						name: "QuotedTextWord",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 578, col: 52, offset: 26420},
-						name: "EOF",
+						pos:  position{line: 669, col: 59, offset: 32291},
+						name: "CharactersWithQuotePunctuation",
 					},
 				},
 			},
 		},
 		{
-			name: "ParagraphWithLiteralBlockDelimiter",
-			pos:  position{line: 581, col: 1, offset: 26480},
-			expr: &actionExpr{
-				pos: position{line: 581, col: 39, offset: 26518},
-				run: (*parser).callonParagraphWithLiteralBlockDelimiter1,
-				expr: &seqExpr{
-					pos: position{line: 581, col: 39, offset: 26518},
-					exprs: []interface{}{
+			name: "QuotedTextWord",
+			pos:  position{line: 672, col: 1, offset: 32528},
+			expr: &oneOrMoreExpr{
+				pos: position{line: 672, col: 19, offset: 32546},
+				expr: &choiceExpr{
+					pos: position{line: 672, col: 20, offset: 32547},
+					alternatives: []interface{}{
 						&ruleRefExpr{
-							pos:  position{line: 581, col: 39, offset: 26518},
-							name: "LiteralBlockDelimiter",
-						},
-						&zeroOrMoreExpr{
-							pos: position{line: 581, col: 61, offset: 26540},
-							expr: &ruleRefExpr{
-								pos:  position{line: 581, col: 61, offset: 26540},
-								name: "WS",
-							},
+							pos:  position{line: 672, col: 20, offset: 32547},
+							name: "QuotedTextCharacters",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 581, col: 65, offset: 26544},
-							name: "NEWLINE",
-						},
-						&labeledExpr{
-							pos:   position{line: 581, col: 73, offset: 26552},
-							label: "content",
-							expr: &zeroOrMoreExpr{
-								pos: position{line: 581, col: 81, offset: 26560},
-								expr: &seqExpr{
-									pos: position{line: 581, col: 82, offset: 26561},
-									exprs: []interface{}{
-										&notExpr{
-											pos: position{line: 581, col: 82, offset: 26561},
-											expr: &ruleRefExpr{
-												pos:  position{line: 581, col: 83, offset: 26562},
-												name: "LiteralBlockDelimiter",
-											},
-										},
-										&anyMatcher{
-											line: 581, col: 105, offset: 26584,
-										},
-									},
-								},
-							},
+							pos:  position{line: 672, col: 43, offset: 32570},
+							name: "SuperscriptText",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 581, col: 109, offset: 26588},
-							name: "LiteralBlockDelimiter",
-						},
-						&zeroOrMoreExpr{
-							pos: position{line: 581, col: 131, offset: 26610},
-							expr: &ruleRefExpr{
-								pos:  position{line: 581, col: 131, offset: 26610},
-								name: "WS",
-							},
+							pos:  position{line: 672, col: 61, offset: 32588},
+							name: "SubscriptText",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 581, col: 135, offset: 26614},
-							name: "EOL",
+							pos:  position{line: 672, col: 77, offset: 32604},
+							name: "DoubleQuoteMarkedText",
 						},
 					},
 				},
 			},
 		},
 		{
-			name: "LiteralBlockDelimiter",
-			pos:  position{line: 585, col: 1, offset: 26698},
-			expr: &litMatcher{
-				pos:        position{line: 585, col: 26, offset: 26723},
-				val:        "....",
-				ignoreCase: false,
-			},
-		},
-		{
-			name: "ParagraphWithLiteralAttribute",
-			pos:  position{line: 588, col: 1, offset: 26785},
-			expr: &actionExpr{
-				pos: position{line: 588, col: 34, offset: 26818},
-				run: (*parser).callonParagraphWithLiteralAttribute1,
+			name: "QuotedTextCharacters",
+			pos:  position{line: 674, col: 1, offset: 32629},
+			expr: &oneOrMoreExpr{
+				pos: position{line: 674, col: 25, offset: 32653},
 				expr: &seqExpr{
-					pos: position{line: 588, col: 34, offset: 26818},
+					pos: position{line: 674, col: 26, offset: 32654},
 					exprs: []interface{}{
-						&litMatcher{
-							pos:        position{line: 588, col: 34, offset: 26818},
-							val:        "[literal]",
-							ignoreCase: false,
+						&notExpr{
+							pos: position{line: 674, col: 26, offset: 32654},
+							expr: &ruleRefExpr{
+								pos:  position{line: 674, col: 27, offset: 32655},
+								name: "NEWLINE",
+							},
 						},
-						&zeroOrMoreExpr{
-							pos: position{line: 588, col: 46, offset: 26830},
+						&notExpr{
+							pos: position{line: 674, col: 35, offset: 32663},
 							expr: &ruleRefExpr{
-								pos:  position{line: 588, col: 46, offset: 26830},
+								pos:  position{line: 674, col: 36, offset: 32664},
 								name: "WS",
 							},
 						},
-						&ruleRefExpr{
-							pos:  position{line: 588, col: 50, offset: 26834},
-							name: "NEWLINE",
+						&notExpr{
+							pos: position{line: 674, col: 39, offset: 32667},
+							expr: &litMatcher{
+								pos:        position{line: 674, col: 40, offset: 32668},
+								val:        "*",
+								ignoreCase: false,
+							},
 						},
-						&labeledExpr{
-							pos:   position{line: 588, col: 58, offset: 26842},
-							label: "content",
-							expr: &ruleRefExpr{
-								pos:  position{line: 588, col: 67, offset: 26851},
-								name: "LiteralBlockContent",
+						&notExpr{
+							pos: position{line: 674, col: 44, offset: 32672},
+							expr: &litMatcher{
+								pos:        position{line: 674, col: 45, offset: 32673},
+								val:        "_",
+								ignoreCase: false,
 							},
 						},
-						&ruleRefExpr{
-							pos:  position{line: 588, col: 88, offset: 26872},
-							name: "EndOfLiteralBlock",
+						&notExpr{
+							pos: position{line: 674, col: 49, offset: 32677},
+							expr: &litMatcher{
+								pos:        position{line: 674, col: 50, offset: 32678},
+								val:        "`",
+								ignoreCase: false,
+							},
+						},
+						&notExpr{
+							pos: position{line: 674, col: 54, offset: 32682},
+							expr: &litMatcher{
+								pos:        position{line: 674, col: 55, offset: 32683},
+								val:        "#",
+								ignoreCase: false,
+							},
 						},
-					},
-				},
-			},
-		},
-		{
-			name: "ElementAttribute",
-			pos:  position{line: 595, col: 1, offset: 27084},
-			expr: &actionExpr{
-				pos: position{line: 595, col: 21, offset: 27104},
-				run: (*parser).callonElementAttribute1,
-				expr: &seqExpr{
-					pos: position{line: 595, col: 21, offset: 27104},
-					exprs: []interface{}{
 						&notExpr{
-							pos: position{line: 595, col: 21, offset: 27104},
+							pos: position{line: 674, col: 59, offset: 32687},
 							expr: &ruleRefExpr{
-								pos:  position{line: 595, col: 22, offset: 27105},
-								name: "AdmonitionMarker",
+								pos:  position{line: 674, col: 60, offset: 32688},
+								name: "SuperscriptText",
 							},
 						},
-						&labeledExpr{
-							pos:   position{line: 595, col: 39, offset: 27122},
-							label: "attr",
-							expr: &choiceExpr{
-								pos: position{line: 595, col: 45, offset: 27128},
-								alternatives: []interface{}{
-									&ruleRefExpr{
-										pos:  position{line: 595, col: 45, offset: 27128},
-										name: "ElementID",
-									},
-									&ruleRefExpr{
-										pos:  position{line: 595, col: 57, offset: 27140},
-										name: "ElementTitle",
-									},
-									&ruleRefExpr{
-										pos:  position{line: 595, col: 72, offset: 27155},
-										name: "AttributeGroup",
-									},
-									&ruleRefExpr{
-										pos:  position{line: 595, col: 89, offset: 27172},
-										name: "InvalidElementAttribute",
-									},
-								},
+						&notExpr{
+							pos: position{line: 674, col: 76, offset: 32704},
+							expr: &ruleRefExpr{
+								pos:  position{line: 674, col: 77, offset: 32705},
+								name: "SubscriptText",
 							},
 						},
-						&ruleRefExpr{
-							pos:  position{line: 595, col: 114, offset: 27197},
-							name: "EOL",
+						&anyMatcher{
+							line: 674, col: 91, offset: 32719,
 						},
 					},
 				},
 			},
 		},
 		{
-			name: "ElementID",
-			pos:  position{line: 599, col: 1, offset: 27288},
-			expr: &choiceExpr{
-				pos: position{line: 599, col: 14, offset: 27301},
-				alternatives: []interface{}{
-					&actionExpr{
-						pos: position{line: 599, col: 14, offset: 27301},
-						run: (*parser).callonElementID2,
-						expr: &labeledExpr{
-							pos:   position{line: 599, col: 14, offset: 27301},
-							label: "id",
-							expr: &ruleRefExpr{
-								pos:  position{line: 599, col: 18, offset: 27305},
-								name: "InlineElementID",
-							},
-						},
-					},
-					&actionExpr{
-						pos: position{line: 601, col: 5, offset: 27347},
-						run: (*parser).callonElementID5,
-						expr: &seqExpr{
-							pos: position{line: 601, col: 5, offset: 27347},
-							exprs: []interface{}{
-								&litMatcher{
-									pos:        position{line: 601, col: 5, offset: 27347},
-									val:        "[#",
-									ignoreCase: false,
-								},
-								&labeledExpr{
-									pos:   position{line: 601, col: 10, offset: 27352},
-									label: "id",
-									expr: &ruleRefExpr{
-										pos:  position{line: 601, col: 14, offset: 27356},
-										name: "ID",
-									},
-								},
-								&litMatcher{
-									pos:        position{line: 601, col: 18, offset: 27360},
-									val:        "]",
-									ignoreCase: false,
+			name: "CharactersWithQuotePunctuation",
+			pos:  position{line: 676, col: 1, offset: 32767},
+			expr: &actionExpr{
+				pos: position{line: 676, col: 35, offset: 32801},
+				run: (*parser).callonCharactersWithQuotePunctuation1,
+				expr: &oneOrMoreExpr{
+					pos: position{line: 676, col: 35, offset: 32801},
+					expr: &seqExpr{
+						pos: position{line: 676, col: 36, offset: 32802},
+						exprs: []interface{}{
+							&notExpr{
+								pos: position{line: 676, col: 36, offset: 32802},
+								expr: &ruleRefExpr{
+									pos:  position{line: 676, col: 37, offset: 32803},
+									name: "NEWLINE",
 								},
-								&zeroOrMoreExpr{
-									pos: position{line: 601, col: 22, offset: 27364},
-									expr: &ruleRefExpr{
-										pos:  position{line: 601, col: 22, offset: 27364},
-										name: "WS",
-									},
+							},
+							&notExpr{
+								pos: position{line: 676, col: 45, offset: 32811},
+								expr: &ruleRefExpr{
+									pos:  position{line: 676, col: 46, offset: 32812},
+									name: "WS",
 								},
 							},
+							&anyMatcher{
+								line: 676, col: 50, offset: 32816,
+							},
 						},
 					},
 				},
 			},
 		},
 		{
-			name: "InlineElementID",
-			pos:  position{line: 605, col: 1, offset: 27416},
+			name: "QuotedTextWordCharacter",
+			pos:  position{line: 681, col: 1, offset: 33091},
+			expr: &charClassMatcher{
+				pos:        position{line: 681, col: 28, offset: 33118},
+				val:        "[a-zA-Z0-9]",
+				ranges:     []rune{'a', 'z', 'A', 'Z', '0', '9'},
+				ignoreCase: false,
+				inverted:   false,
+			},
+		},
+		{
+			name: "UnbalancedQuotePunctuation",
+			pos:  position{line: 684, col: 1, offset: 33206},
+			expr: &choiceExpr{
+				pos: position{line: 684, col: 31, offset: 33236},
+				alternatives: []interface{}{
+					&litMatcher{
+						pos:        position{line: 684, col: 31, offset: 33236},
+						val:        "*",
+						ignoreCase: false,
+					},
+					&litMatcher{
+						pos:        position{line: 684, col: 37, offset: 33242},
+						val:        "_",
+						ignoreCase: false,
+					},
+					&litMatcher{
+						pos:        position{line: 684, col: 43, offset: 33248},
+						val:        "`",
+						ignoreCase: false,
+					},
+					&litMatcher{
+						pos:        position{line: 684, col: 49, offset: 33254},
+						val:        "#",
+						ignoreCase: false,
+					},
+				},
+			},
+		},
+		{
+			name: "Passthrough",
+			pos:  position{line: 689, col: 1, offset: 33366},
 			expr: &actionExpr{
-				pos: position{line: 605, col: 20, offset: 27435},
-				run: (*parser).callonInlineElementID1,
+				pos: position{line: 689, col: 16, offset: 33381},
+				run: (*parser).callonPassthrough1,
 				expr: &seqExpr{
-					pos: position{line: 605, col: 20, offset: 27435},
+					pos: position{line: 689, col: 16, offset: 33381},
 					exprs: []interface{}{
-						&litMatcher{
-							pos:        position{line: 605, col: 20, offset: 27435},
-							val:        "[[",
-							ignoreCase: false,
+						&andCodeExpr{
+							pos: position{line: 689, col: 16, offset: 33381},
+							run: (*parser).callonPassthrough3,
 						},
 						&labeledExpr{
-							pos:   position{line: 605, col: 25, offset: 27440},
-							label: "id",
-							expr: &ruleRefExpr{
-								pos:  position{line: 605, col: 29, offset: 27444},
-								name: "ID",
-							},
-						},
-						&litMatcher{
-							pos:        position{line: 605, col: 33, offset: 27448},
-							val:        "]]",
-							ignoreCase: false,
-						},
-						&zeroOrMoreExpr{
-							pos: position{line: 605, col: 38, offset: 27453},
-							expr: &ruleRefExpr{
-								pos:  position{line: 605, col: 38, offset: 27453},
-								name: "WS",
+							pos:   position{line: 689, col: 84, offset: 33449},
+							label: "passthrough",
+							expr: &choiceExpr{
+								pos: position{line: 689, col: 97, offset: 33462},
+								alternatives: []interface{}{
+									&ruleRefExpr{
+										pos:  position{line: 689, col: 97, offset: 33462},
+										name: "TriplePlusPassthrough",
+									},
+									&ruleRefExpr{
+										pos:  position{line: 689, col: 121, offset: 33486},
+										name: "SinglePlusPassthrough",
+									},
+									&ruleRefExpr{
+										pos:  position{line: 689, col: 145, offset: 33510},
+										name: "PassthroughMacro",
+									},
+								},
 							},
 						},
 					},
@@ -6209,198 +7551,176 @@ var g = &grammar{
 			},
 		},
 		{
-			name: "ElementTitle",
-			pos:  position{line: 611, col: 1, offset: 27647},
+			name: "SinglePlusPassthrough",
+			pos:  position{line: 693, col: 1, offset: 33561},
 			expr: &actionExpr{
-				pos: position{line: 611, col: 17, offset: 27663},
-				run: (*parser).callonElementTitle1,
+				pos: position{line: 693, col: 26, offset: 33586},
+				run: (*parser).callonSinglePlusPassthrough1,
 				expr: &seqExpr{
-					pos: position{line: 611, col: 17, offset: 27663},
+					pos: position{line: 693, col: 26, offset: 33586},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 611, col: 17, offset: 27663},
-							val:        ".",
+							pos:        position{line: 693, col: 26, offset: 33586},
+							val:        "+",
 							ignoreCase: false,
 						},
-						&notExpr{
-							pos: position{line: 611, col: 21, offset: 27667},
-							expr: &litMatcher{
-								pos:        position{line: 611, col: 22, offset: 27668},
-								val:        ".",
-								ignoreCase: false,
-							},
-						},
-						&notExpr{
-							pos: position{line: 611, col: 26, offset: 27672},
-							expr: &ruleRefExpr{
-								pos:  position{line: 611, col: 27, offset: 27673},
-								name: "WS",
-							},
-						},
 						&labeledExpr{
-							pos:   position{line: 611, col: 30, offset: 27676},
-							label: "title",
-							expr: &oneOrMoreExpr{
-								pos: position{line: 611, col: 36, offset: 27682},
+							pos:   position{line: 693, col: 30, offset: 33590},
+							label: "content",
+							expr: &zeroOrMoreExpr{
+								pos: position{line: 693, col: 38, offset: 33598},
 								expr: &seqExpr{
-									pos: position{line: 611, col: 37, offset: 27683},
+									pos: position{line: 693, col: 39, offset: 33599},
 									exprs: []interface{}{
 										&notExpr{
-											pos: position{line: 611, col: 37, offset: 27683},
+											pos: position{line: 693, col: 39, offset: 33599},
 											expr: &ruleRefExpr{
-												pos:  position{line: 611, col: 38, offset: 27684},
+												pos:  position{line: 693, col: 40, offset: 33600},
 												name: "NEWLINE",
 											},
 										},
+										&notExpr{
+											pos: position{line: 693, col: 48, offset: 33608},
+											expr: &litMatcher{
+												pos:        position{line: 693, col: 49, offset: 33609},
+												val:        "+",
+												ignoreCase: false,
+											},
+										},
 										&anyMatcher{
-											line: 611, col: 46, offset: 27692,
+											line: 693, col: 53, offset: 33613,
 										},
 									},
 								},
 							},
 						},
-						&zeroOrMoreExpr{
-							pos: position{line: 611, col: 50, offset: 27696},
-							expr: &ruleRefExpr{
-								pos:  position{line: 611, col: 50, offset: 27696},
-								name: "WS",
-							},
+						&litMatcher{
+							pos:        position{line: 693, col: 57, offset: 33617},
+							val:        "+",
+							ignoreCase: false,
 						},
 					},
 				},
 			},
 		},
 		{
-			name: "AttributeGroup",
-			pos:  position{line: 616, col: 1, offset: 27824},
+			name: "TriplePlusPassthrough",
+			pos:  position{line: 697, col: 1, offset: 33712},
 			expr: &actionExpr{
-				pos: position{line: 616, col: 19, offset: 27842},
-				run: (*parser).callonAttributeGroup1,
+				pos: position{line: 697, col: 26, offset: 33737},
+				run: (*parser).callonTriplePlusPassthrough1,
 				expr: &seqExpr{
-					pos: position{line: 616, col: 19, offset: 27842},
+					pos: position{line: 697, col: 26, offset: 33737},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 616, col: 19, offset: 27842},
-							val:        "[",
+							pos:        position{line: 697, col: 26, offset: 33737},
+							val:        "+++",
 							ignoreCase: false,
 						},
 						&labeledExpr{
-							pos:   position{line: 616, col: 23, offset: 27846},
-							label: "attributes",
+							pos:   position{line: 697, col: 32, offset: 33743},
+							label: "content",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 616, col: 34, offset: 27857},
-								expr: &ruleRefExpr{
-									pos:  position{line: 616, col: 35, offset: 27858},
-									name: "GenericAttribute",
+								pos: position{line: 697, col: 40, offset: 33751},
+								expr: &seqExpr{
+									pos: position{line: 697, col: 41, offset: 33752},
+									exprs: []interface{}{
+										&notExpr{
+											pos: position{line: 697, col: 41, offset: 33752},
+											expr: &litMatcher{
+												pos:        position{line: 697, col: 42, offset: 33753},
+												val:        "+++",
+												ignoreCase: false,
+											},
+										},
+										&anyMatcher{
+											line: 697, col: 48, offset: 33759,
+										},
+									},
 								},
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 616, col: 54, offset: 27877},
-							val:        "]",
+							pos:        position{line: 697, col: 52, offset: 33763},
+							val:        "+++",
 							ignoreCase: false,
 						},
-						&zeroOrMoreExpr{
-							pos: position{line: 616, col: 58, offset: 27881},
-							expr: &ruleRefExpr{
-								pos:  position{line: 616, col: 58, offset: 27881},
-								name: "WS",
-							},
-						},
 					},
 				},
 			},
 		},
 		{
-			name: "GenericAttribute",
-			pos:  position{line: 620, col: 1, offset: 27953},
+			name: "PassthroughMacro",
+			pos:  position{line: 701, col: 1, offset: 33860},
 			expr: &choiceExpr{
-				pos: position{line: 620, col: 21, offset: 27973},
+				pos: position{line: 701, col: 21, offset: 33880},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 620, col: 21, offset: 27973},
-						run: (*parser).callonGenericAttribute2,
+						pos: position{line: 701, col: 21, offset: 33880},
+						run: (*parser).callonPassthroughMacro2,
 						expr: &seqExpr{
-							pos: position{line: 620, col: 21, offset: 27973},
+							pos: position{line: 701, col: 21, offset: 33880},
 							exprs: []interface{}{
-								&labeledExpr{
-									pos:   position{line: 620, col: 21, offset: 27973},
-									label: "key",
-									expr: &ruleRefExpr{
-										pos:  position{line: 620, col: 26, offset: 27978},
-										name: "AttributeKey",
-									},
-								},
 								&litMatcher{
-									pos:        position{line: 620, col: 40, offset: 27992},
-									val:        "=",
+									pos:        position{line: 701, col: 21, offset: 33880},
+									val:        "pass:[",
 									ignoreCase: false,
 								},
 								&labeledExpr{
-									pos:   position{line: 620, col: 44, offset: 27996},
-									label: "value",
-									expr: &ruleRefExpr{
-										pos:  position{line: 620, col: 51, offset: 28003},
-										name: "AttributeValue",
-									},
-								},
-								&zeroOrOneExpr{
-									pos: position{line: 620, col: 67, offset: 28019},
-									expr: &seqExpr{
-										pos: position{line: 620, col: 68, offset: 28020},
-										exprs: []interface{}{
-											&litMatcher{
-												pos:        position{line: 620, col: 68, offset: 28020},
-												val:        ",",
-												ignoreCase: false,
-											},
-											&zeroOrMoreExpr{
-												pos: position{line: 620, col: 72, offset: 28024},
-												expr: &ruleRefExpr{
-													pos:  position{line: 620, col: 72, offset: 28024},
-													name: "WS",
-												},
-											},
+									pos:   position{line: 701, col: 30, offset: 33889},
+									label: "content",
+									expr: &zeroOrMoreExpr{
+										pos: position{line: 701, col: 38, offset: 33897},
+										expr: &ruleRefExpr{
+											pos:  position{line: 701, col: 39, offset: 33898},
+											name: "PassthroughMacroCharacter",
 										},
 									},
 								},
+								&litMatcher{
+									pos:        position{line: 701, col: 67, offset: 33926},
+									val:        "]",
+									ignoreCase: false,
+								},
 							},
 						},
 					},
 					&actionExpr{
-						pos: position{line: 622, col: 5, offset: 28133},
-						run: (*parser).callonGenericAttribute14,
+						pos: position{line: 703, col: 5, offset: 34017},
+						run: (*parser).callonPassthroughMacro9,
 						expr: &seqExpr{
-							pos: position{line: 622, col: 5, offset: 28133},
+							pos: position{line: 703, col: 5, offset: 34017},
 							exprs: []interface{}{
-								&labeledExpr{
-									pos:   position{line: 622, col: 5, offset: 28133},
-									label: "key",
-									expr: &ruleRefExpr{
-										pos:  position{line: 622, col: 10, offset: 28138},
-										name: "AttributeKey",
-									},
+								&litMatcher{
+									pos:        position{line: 703, col: 5, offset: 34017},
+									val:        "pass:q[",
+									ignoreCase: false,
 								},
-								&zeroOrOneExpr{
-									pos: position{line: 622, col: 24, offset: 28152},
-									expr: &seqExpr{
-										pos: position{line: 622, col: 25, offset: 28153},
-										exprs: []interface{}{
-											&litMatcher{
-												pos:        position{line: 622, col: 25, offset: 28153},
-												val:        ",",
-												ignoreCase: false,
-											},
-											&zeroOrMoreExpr{
-												pos: position{line: 622, col: 29, offset: 28157},
-												expr: &ruleRefExpr{
-													pos:  position{line: 622, col: 29, offset: 28157},
-													name: "WS",
+								&labeledExpr{
+									pos:   position{line: 703, col: 15, offset: 34027},
+									label: "content",
+									expr: &zeroOrMoreExpr{
+										pos: position{line: 703, col: 23, offset: 34035},
+										expr: &choiceExpr{
+											pos: position{line: 703, col: 24, offset: 34036},
+											alternatives: []interface{}{
+												&ruleRefExpr{
+													pos:  position{line: 703, col: 24, offset: 34036},
+													name: "QuotedText",
+												},
+												&ruleRefExpr{
+													pos:  position{line: 703, col: 37, offset: 34049},
+													name: "PassthroughMacroCharacter",
 												},
 											},
 										},
 									},
 								},
+								&litMatcher{
+									pos:        position{line: 703, col: 65, offset: 34077},
+									val:        "]",
+									ignoreCase: false,
+								},
 							},
 						},
 					},
@@ -6408,254 +7728,118 @@ var g = &grammar{
 			},
 		},
 		{
-			name: "AttributeKey",
-			pos:  position{line: 626, col: 1, offset: 28251},
+			name: "PassthroughMacroCharacter",
+			pos:  position{line: 707, col: 1, offset: 34167},
+			expr: &seqExpr{
+				pos: position{line: 707, col: 31, offset: 34197},
+				exprs: []interface{}{
+					&notExpr{
+						pos: position{line: 707, col: 31, offset: 34197},
+						expr: &litMatcher{
+							pos:        position{line: 707, col: 32, offset: 34198},
+							val:        "]",
+							ignoreCase: false,
+						},
+					},
+					&anyMatcher{
+						line: 707, col: 36, offset: 34202,
+					},
+				},
+			},
+		},
+		{
+			name: "InlineStem",
+			pos:  position{line: 713, col: 1, offset: 34421},
 			expr: &actionExpr{
-				pos: position{line: 626, col: 17, offset: 28267},
-				run: (*parser).callonAttributeKey1,
+				pos: position{line: 713, col: 15, offset: 34435},
+				run: (*parser).callonInlineStem1,
 				expr: &seqExpr{
-					pos: position{line: 626, col: 17, offset: 28267},
+					pos: position{line: 713, col: 15, offset: 34435},
 					exprs: []interface{}{
+						&andCodeExpr{
+							pos: position{line: 713, col: 15, offset: 34435},
+							run: (*parser).callonInlineStem3,
+						},
 						&labeledExpr{
-							pos:   position{line: 626, col: 17, offset: 28267},
-							label: "key",
-							expr: &oneOrMoreExpr{
-								pos: position{line: 626, col: 22, offset: 28272},
-								expr: &seqExpr{
-									pos: position{line: 626, col: 23, offset: 28273},
-									exprs: []interface{}{
-										&notExpr{
-											pos: position{line: 626, col: 23, offset: 28273},
-											expr: &ruleRefExpr{
-												pos:  position{line: 626, col: 24, offset: 28274},
-												name: "WS",
-											},
-										},
-										&notExpr{
-											pos: position{line: 626, col: 27, offset: 28277},
-											expr: &litMatcher{
-												pos:        position{line: 626, col: 28, offset: 28278},
-												val:        "=",
-												ignoreCase: false,
-											},
-										},
-										&notExpr{
-											pos: position{line: 626, col: 32, offset: 28282},
-											expr: &litMatcher{
-												pos:        position{line: 626, col: 33, offset: 28283},
-												val:        ",",
-												ignoreCase: false,
-											},
-										},
-										&notExpr{
-											pos: position{line: 626, col: 37, offset: 28287},
-											expr: &litMatcher{
-												pos:        position{line: 626, col: 38, offset: 28288},
-												val:        "]",
-												ignoreCase: false,
-											},
-										},
-										&anyMatcher{
-											line: 626, col: 42, offset: 28292,
-										},
+							pos:   position{line: 713, col: 83, offset: 34503},
+							label: "kind",
+							expr: &choiceExpr{
+								pos: position{line: 713, col: 89, offset: 34509},
+								alternatives: []interface{}{
+									&litMatcher{
+										pos:        position{line: 713, col: 89, offset: 34509},
+										val:        "stem",
+										ignoreCase: false,
 									},
-								},
-							},
-						},
-						&zeroOrMoreExpr{
-							pos: position{line: 626, col: 46, offset: 28296},
-							expr: &ruleRefExpr{
-								pos:  position{line: 626, col: 46, offset: 28296},
-								name: "WS",
-							},
-						},
-					},
-				},
-			},
-		},
-		{
-			name: "AttributeValue",
-			pos:  position{line: 631, col: 1, offset: 28378},
-			expr: &actionExpr{
-				pos: position{line: 631, col: 19, offset: 28396},
-				run: (*parser).callonAttributeValue1,
-				expr: &seqExpr{
-					pos: position{line: 631, col: 19, offset: 28396},
-					exprs: []interface{}{
-						&zeroOrMoreExpr{
-							pos: position{line: 631, col: 19, offset: 28396},
-							expr: &ruleRefExpr{
-								pos:  position{line: 631, col: 19, offset: 28396},
-								name: "WS",
-							},
-						},
-						&labeledExpr{
-							pos:   position{line: 631, col: 23, offset: 28400},
-							label: "value",
-							expr: &zeroOrMoreExpr{
-								pos: position{line: 631, col: 29, offset: 28406},
-								expr: &seqExpr{
-									pos: position{line: 631, col: 30, offset: 28407},
-									exprs: []interface{}{
-										&notExpr{
-											pos: position{line: 631, col: 30, offset: 28407},
-											expr: &ruleRefExpr{
-												pos:  position{line: 631, col: 31, offset: 28408},
-												name: "WS",
-											},
-										},
-										&notExpr{
-											pos: position{line: 631, col: 34, offset: 28411},
-											expr: &litMatcher{
-												pos:        position{line: 631, col: 35, offset: 28412},
-												val:        "=",
-												ignoreCase: false,
-											},
-										},
-										&notExpr{
-											pos: position{line: 631, col: 39, offset: 28416},
-											expr: &litMatcher{
-												pos:        position{line: 631, col: 40, offset: 28417},
-												val:        "]",
-												ignoreCase: false,
-											},
-										},
-										&anyMatcher{
-											line: 631, col: 44, offset: 28421,
-										},
+									&litMatcher{
+										pos:        position{line: 713, col: 98, offset: 34518},
+										val:        "latexmath",
+										ignoreCase: false,
+									},
+									&litMatcher{
+										pos:        position{line: 713, col: 112, offset: 34532},
+										val:        "asciimath",
+										ignoreCase: false,
 									},
 								},
 							},
 						},
-						&zeroOrMoreExpr{
-							pos: position{line: 631, col: 48, offset: 28425},
-							expr: &ruleRefExpr{
-								pos:  position{line: 631, col: 48, offset: 28425},
-								name: "WS",
-							},
-						},
-					},
-				},
-			},
-		},
-		{
-			name: "InvalidElementAttribute",
-			pos:  position{line: 636, col: 1, offset: 28512},
-			expr: &actionExpr{
-				pos: position{line: 636, col: 28, offset: 28539},
-				run: (*parser).callonInvalidElementAttribute1,
-				expr: &seqExpr{
-					pos: position{line: 636, col: 28, offset: 28539},
-					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 636, col: 28, offset: 28539},
-							val:        "[",
+							pos:        position{line: 713, col: 125, offset: 34545},
+							val:        ":[",
 							ignoreCase: false,
 						},
-						&oneOrMoreExpr{
-							pos: position{line: 636, col: 32, offset: 28543},
-							expr: &ruleRefExpr{
-								pos:  position{line: 636, col: 32, offset: 28543},
-								name: "WS",
-							},
-						},
 						&labeledExpr{
-							pos:   position{line: 636, col: 36, offset: 28547},
+							pos:   position{line: 713, col: 130, offset: 34550},
 							label: "content",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 636, col: 44, offset: 28555},
-								expr: &seqExpr{
-									pos: position{line: 636, col: 45, offset: 28556},
-									exprs: []interface{}{
-										&notExpr{
-											pos: position{line: 636, col: 45, offset: 28556},
-											expr: &litMatcher{
-												pos:        position{line: 636, col: 46, offset: 28557},
-												val:        "]",
-												ignoreCase: false,
-											},
-										},
-										&anyMatcher{
-											line: 636, col: 50, offset: 28561,
-										},
-									},
+								pos: position{line: 713, col: 138, offset: 34558},
+								expr: &ruleRefExpr{
+									pos:  position{line: 713, col: 139, offset: 34559},
+									name: "InlineStemCharacter",
 								},
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 636, col: 54, offset: 28565},
+							pos:        position{line: 713, col: 161, offset: 34581},
 							val:        "]",
 							ignoreCase: false,
 						},
-						&zeroOrMoreExpr{
-							pos: position{line: 636, col: 58, offset: 28569},
-							expr: &ruleRefExpr{
-								pos:  position{line: 636, col: 58, offset: 28569},
-								name: "WS",
-							},
-						},
 					},
 				},
 			},
 		},
 		{
-			name: "BlankLine",
-			pos:  position{line: 643, col: 1, offset: 28735},
-			expr: &actionExpr{
-				pos: position{line: 643, col: 14, offset: 28748},
-				run: (*parser).callonBlankLine1,
-				expr: &seqExpr{
-					pos: position{line: 643, col: 14, offset: 28748},
-					exprs: []interface{}{
-						&notExpr{
-							pos: position{line: 643, col: 14, offset: 28748},
-							expr: &ruleRefExpr{
-								pos:  position{line: 643, col: 15, offset: 28749},
-								name: "EOF",
-							},
-						},
-						&zeroOrMoreExpr{
-							pos: position{line: 643, col: 19, offset: 28753},
-							expr: &ruleRefExpr{
-								pos:  position{line: 643, col: 19, offset: 28753},
-								name: "WS",
-							},
-						},
-						&ruleRefExpr{
-							pos:  position{line: 643, col: 23, offset: 28757},
-							name: "EOL",
-						},
+			name: "InlineStemCharacter",
+			pos:  position{line: 718, col: 1, offset: 34778},
+			expr: &choiceExpr{
+				pos: position{line: 718, col: 24, offset: 34801},
+				alternatives: []interface{}{
+					&litMatcher{
+						pos:        position{line: 718, col: 24, offset: 34801},
+						val:        "\\]",
+						ignoreCase: false,
 					},
-				},
-			},
-		},
-		{
-			name: "Characters",
-			pos:  position{line: 650, col: 1, offset: 28904},
-			expr: &actionExpr{
-				pos: position{line: 650, col: 15, offset: 28918},
-				run: (*parser).callonCharacters1,
-				expr: &oneOrMoreExpr{
-					pos: position{line: 650, col: 15, offset: 28918},
-					expr: &seqExpr{
-						pos: position{line: 650, col: 16, offset: 28919},
+					&seqExpr{
+						pos: position{line: 718, col: 32, offset: 34809},
 						exprs: []interface{}{
 							&notExpr{
-								pos: position{line: 650, col: 16, offset: 28919},
+								pos: position{line: 718, col: 32, offset: 34809},
 								expr: &ruleRefExpr{
-									pos:  position{line: 650, col: 17, offset: 28920},
+									pos:  position{line: 718, col: 33, offset: 34810},
 									name: "NEWLINE",
 								},
 							},
 							&notExpr{
-								pos: position{line: 650, col: 25, offset: 28928},
-								expr: &ruleRefExpr{
-									pos:  position{line: 650, col: 26, offset: 28929},
-									name: "WS",
+								pos: position{line: 718, col: 41, offset: 34818},
+								expr: &litMatcher{
+									pos:        position{line: 718, col: 42, offset: 34819},
+									val:        "]",
+									ignoreCase: false,
 								},
 							},
 							&anyMatcher{
-								line: 650, col: 29, offset: 28932,
+								line: 718, col: 46, offset: 34823,
 							},
 						},
 					},
@@ -6663,113 +7847,135 @@ var g = &grammar{
 			},
 		},
 		{
-			name: "URL",
-			pos:  position{line: 654, col: 1, offset: 28972},
-			expr: &actionExpr{
-				pos: position{line: 654, col: 8, offset: 28979},
-				run: (*parser).callonURL1,
-				expr: &oneOrMoreExpr{
-					pos: position{line: 654, col: 8, offset: 28979},
-					expr: &seqExpr{
-						pos: position{line: 654, col: 9, offset: 28980},
-						exprs: []interface{}{
-							&notExpr{
-								pos: position{line: 654, col: 9, offset: 28980},
-								expr: &ruleRefExpr{
-									pos:  position{line: 654, col: 10, offset: 28981},
-									name: "NEWLINE",
+			name: "Footnote",
+			pos:  position{line: 723, col: 1, offset: 34932},
+			expr: &choiceExpr{
+				pos: position{line: 723, col: 13, offset: 34944},
+				alternatives: []interface{}{
+					&actionExpr{
+						pos: position{line: 723, col: 13, offset: 34944},
+						run: (*parser).callonFootnote2,
+						expr: &seqExpr{
+							pos: position{line: 723, col: 13, offset: 34944},
+							exprs: []interface{}{
+								&andCodeExpr{
+									pos: position{line: 723, col: 13, offset: 34944},
+									run: (*parser).callonFootnote4,
 								},
-							},
-							&notExpr{
-								pos: position{line: 654, col: 18, offset: 28989},
-								expr: &ruleRefExpr{
-									pos:  position{line: 654, col: 19, offset: 28990},
-									name: "WS",
+								&litMatcher{
+									pos:        position{line: 723, col: 81, offset: 35012},
+									val:        "footnote:[",
+									ignoreCase: false,
+								},
+								&labeledExpr{
+									pos:   position{line: 723, col: 94, offset: 35025},
+									label: "content",
+									expr: &ruleRefExpr{
+										pos:  position{line: 723, col: 103, offset: 35034},
+										name: "FootnoteContent",
+									},
+								},
+								&litMatcher{
+									pos:        position{line: 723, col: 120, offset: 35051},
+									val:        "]",
+									ignoreCase: false,
 								},
 							},
-							&notExpr{
-								pos: position{line: 654, col: 22, offset: 28993},
-								expr: &litMatcher{
-									pos:        position{line: 654, col: 23, offset: 28994},
+						},
+					},
+					&actionExpr{
+						pos: position{line: 725, col: 5, offset: 35136},
+						run: (*parser).callonFootnote9,
+						expr: &seqExpr{
+							pos: position{line: 725, col: 5, offset: 35136},
+							exprs: []interface{}{
+								&andCodeExpr{
+									pos: position{line: 725, col: 5, offset: 35136},
+									run: (*parser).callonFootnote11,
+								},
+								&litMatcher{
+									pos:        position{line: 725, col: 73, offset: 35204},
+									val:        "footnote:",
+									ignoreCase: false,
+								},
+								&labeledExpr{
+									pos:   position{line: 725, col: 85, offset: 35216},
+									label: "ref",
+									expr: &ruleRefExpr{
+										pos:  position{line: 725, col: 90, offset: 35221},
+										name: "FootnoteRef",
+									},
+								},
+								&litMatcher{
+									pos:        position{line: 725, col: 103, offset: 35234},
 									val:        "[",
 									ignoreCase: false,
 								},
-							},
-							&notExpr{
-								pos: position{line: 654, col: 27, offset: 28998},
-								expr: &litMatcher{
-									pos:        position{line: 654, col: 28, offset: 28999},
+								&labeledExpr{
+									pos:   position{line: 725, col: 107, offset: 35238},
+									label: "content",
+									expr: &zeroOrOneExpr{
+										pos: position{line: 725, col: 115, offset: 35246},
+										expr: &ruleRefExpr{
+											pos:  position{line: 725, col: 116, offset: 35247},
+											name: "FootnoteContent",
+										},
+									},
+								},
+								&litMatcher{
+									pos:        position{line: 725, col: 134, offset: 35265},
 									val:        "]",
 									ignoreCase: false,
 								},
 							},
-							&anyMatcher{
-								line: 654, col: 32, offset: 29003,
-							},
 						},
 					},
 				},
 			},
 		},
 		{
-			name: "ID",
-			pos:  position{line: 658, col: 1, offset: 29043},
+			name: "FootnoteRef",
+			pos:  position{line: 732, col: 1, offset: 35546},
 			expr: &actionExpr{
-				pos: position{line: 658, col: 7, offset: 29049},
-				run: (*parser).callonID1,
+				pos: position{line: 732, col: 16, offset: 35561},
+				run: (*parser).callonFootnoteRef1,
 				expr: &oneOrMoreExpr{
-					pos: position{line: 658, col: 7, offset: 29049},
+					pos: position{line: 732, col: 16, offset: 35561},
 					expr: &seqExpr{
-						pos: position{line: 658, col: 8, offset: 29050},
+						pos: position{line: 732, col: 17, offset: 35562},
 						exprs: []interface{}{
 							&notExpr{
-								pos: position{line: 658, col: 8, offset: 29050},
+								pos: position{line: 732, col: 17, offset: 35562},
 								expr: &ruleRefExpr{
-									pos:  position{line: 658, col: 9, offset: 29051},
+									pos:  position{line: 732, col: 18, offset: 35563},
 									name: "NEWLINE",
 								},
 							},
 							&notExpr{
-								pos: position{line: 658, col: 17, offset: 29059},
+								pos: position{line: 732, col: 26, offset: 35571},
 								expr: &ruleRefExpr{
-									pos:  position{line: 658, col: 18, offset: 29060},
+									pos:  position{line: 732, col: 27, offset: 35572},
 									name: "WS",
 								},
 							},
 							&notExpr{
-								pos: position{line: 658, col: 21, offset: 29063},
+								pos: position{line: 732, col: 30, offset: 35575},
 								expr: &litMatcher{
-									pos:        position{line: 658, col: 22, offset: 29064},
+									pos:        position{line: 732, col: 31, offset: 35576},
 									val:        "[",
 									ignoreCase: false,
 								},
 							},
 							&notExpr{
-								pos: position{line: 658, col: 26, offset: 29068},
+								pos: position{line: 732, col: 35, offset: 35580},
 								expr: &litMatcher{
-									pos:        position{line: 658, col: 27, offset: 29069},
+									pos:        position{line: 732, col: 36, offset: 35581},
 									val:        "]",
 									ignoreCase: false,
 								},
 							},
-							&notExpr{
-								pos: position{line: 658, col: 31, offset: 29073},
-								expr: &litMatcher{
-									pos:        position{line: 658, col: 32, offset: 29074},
-									val:        "<<",
-									ignoreCase: false,
-								},
-							},
-							&notExpr{
-								pos: position{line: 658, col: 37, offset: 29079},
-								expr: &litMatcher{
-									pos:        position{line: 658, col: 38, offset: 29080},
-									val:        ">>",
-									ignoreCase: false,
-								},
-							},
 							&anyMatcher{
-								line: 658, col: 42, offset: 29084,
+								line: 732, col: 40, offset: 35585,
 							},
 						},
 					},
@@ -6777,1300 +7983,7988 @@ var g = &grammar{
 			},
 		},
 		{
-			name: "URL_TEXT",
-			pos:  position{line: 662, col: 1, offset: 29124},
+			name: "FootnoteContent",
+			pos:  position{line: 736, col: 1, offset: 35625},
 			expr: &actionExpr{
-				pos: position{line: 662, col: 13, offset: 29136},
-				run: (*parser).callonURL_TEXT1,
-				expr: &oneOrMoreExpr{
-					pos: position{line: 662, col: 13, offset: 29136},
-					expr: &seqExpr{
-						pos: position{line: 662, col: 14, offset: 29137},
-						exprs: []interface{}{
-							&notExpr{
-								pos: position{line: 662, col: 14, offset: 29137},
-								expr: &ruleRefExpr{
-									pos:  position{line: 662, col: 15, offset: 29138},
-									name: "NEWLINE",
+				pos: position{line: 736, col: 20, offset: 35644},
+				run: (*parser).callonFootnoteContent1,
+				expr: &labeledExpr{
+					pos:   position{line: 736, col: 20, offset: 35644},
+					label: "elements",
+					expr: &oneOrMoreExpr{
+						pos: position{line: 736, col: 29, offset: 35653},
+						expr: &seqExpr{
+							pos: position{line: 736, col: 30, offset: 35654},
+							exprs: []interface{}{
+								&zeroOrMoreExpr{
+									pos: position{line: 736, col: 30, offset: 35654},
+									expr: &ruleRefExpr{
+										pos:  position{line: 736, col: 30, offset: 35654},
+										name: "WS",
+									},
 								},
-							},
-							&notExpr{
-								pos: position{line: 662, col: 23, offset: 29146},
-								expr: &litMatcher{
-									pos:        position{line: 662, col: 24, offset: 29147},
-									val:        "[",
-									ignoreCase: false,
+								&notExpr{
+									pos: position{line: 736, col: 34, offset: 35658},
+									expr: &litMatcher{
+										pos:        position{line: 736, col: 35, offset: 35659},
+										val:        "]",
+										ignoreCase: false,
+									},
 								},
-							},
-							&notExpr{
-								pos: position{line: 662, col: 28, offset: 29151},
-								expr: &litMatcher{
-									pos:        position{line: 662, col: 29, offset: 29152},
-									val:        "]",
-									ignoreCase: false,
+								&notExpr{
+									pos: position{line: 736, col: 39, offset: 35663},
+									expr: &ruleRefExpr{
+										pos:  position{line: 736, col: 40, offset: 35664},
+										name: "InlineElementID",
+									},
 								},
-							},
-							&anyMatcher{
-								line: 662, col: 33, offset: 29156,
-							},
-						},
-					},
-				},
+								&ruleRefExpr{
+									pos:  position{line: 736, col: 56, offset: 35680},
+									name: "FootnoteInlineElement",
+								},
+								&zeroOrMoreExpr{
+									pos: position{line: 736, col: 78, offset: 35702},
+									expr: &ruleRefExpr{
+										pos:  position{line: 736, col: 78, offset: 35702},
+										name: "WS",
+									},
+								},
+							},
+						},
+					},
+				},
 			},
 		},
 		{
-			name: "URL_SCHEME",
-			pos:  position{line: 666, col: 1, offset: 29196},
+			name: "FootnoteInlineElement",
+			pos:  position{line: 740, col: 1, offset: 35803},
 			expr: &choiceExpr{
-				pos: position{line: 666, col: 15, offset: 29210},
+				pos: position{line: 740, col: 26, offset: 35828},
 				alternatives: []interface{}{
-					&litMatcher{
-						pos:        position{line: 666, col: 15, offset: 29210},
-						val:        "http://",
-						ignoreCase: false,
+					&ruleRefExpr{
+						pos:  position{line: 740, col: 26, offset: 35828},
+						name: "CrossReference",
 					},
-					&litMatcher{
-						pos:        position{line: 666, col: 27, offset: 29222},
-						val:        "https://",
-						ignoreCase: false,
+					&ruleRefExpr{
+						pos:  position{line: 740, col: 43, offset: 35845},
+						name: "Passthrough",
 					},
-					&litMatcher{
-						pos:        position{line: 666, col: 40, offset: 29235},
-						val:        "ftp://",
-						ignoreCase: false,
+					&ruleRefExpr{
+						pos:  position{line: 740, col: 57, offset: 35859},
+						name: "InlineImage",
 					},
-					&litMatcher{
-						pos:        position{line: 666, col: 51, offset: 29246},
-						val:        "irc://",
-						ignoreCase: false,
+					&ruleRefExpr{
+						pos:  position{line: 740, col: 71, offset: 35873},
+						name: "QuotedText",
 					},
-					&litMatcher{
-						pos:        position{line: 666, col: 62, offset: 29257},
-						val:        "mailto:",
-						ignoreCase: false,
+					&ruleRefExpr{
+						pos:  position{line: 740, col: 84, offset: 35886},
+						name: "Link",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 740, col: 91, offset: 35893},
+						name: "DocumentAttributeSubstitution",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 740, col: 123, offset: 35925},
+						name: "FootnoteCharacters",
 					},
 				},
 			},
 		},
 		{
-			name: "DIGIT",
-			pos:  position{line: 668, col: 1, offset: 29268},
-			expr: &charClassMatcher{
-				pos:        position{line: 668, col: 10, offset: 29277},
-				val:        "[0-9]",
-				ranges:     []rune{'0', '9'},
-				ignoreCase: false,
-				inverted:   false,
+			name: "FootnoteCharacters",
+			pos:  position{line: 742, col: 1, offset: 35945},
+			expr: &actionExpr{
+				pos: position{line: 742, col: 23, offset: 35967},
+				run: (*parser).callonFootnoteCharacters1,
+				expr: &oneOrMoreExpr{
+					pos: position{line: 742, col: 23, offset: 35967},
+					expr: &seqExpr{
+						pos: position{line: 742, col: 24, offset: 35968},
+						exprs: []interface{}{
+							&notExpr{
+								pos: position{line: 742, col: 24, offset: 35968},
+								expr: &ruleRefExpr{
+									pos:  position{line: 742, col: 25, offset: 35969},
+									name: "NEWLINE",
+								},
+							},
+							&notExpr{
+								pos: position{line: 742, col: 33, offset: 35977},
+								expr: &ruleRefExpr{
+									pos:  position{line: 742, col: 34, offset: 35978},
+									name: "WS",
+								},
+							},
+							&notExpr{
+								pos: position{line: 742, col: 37, offset: 35981},
+								expr: &litMatcher{
+									pos:        position{line: 742, col: 38, offset: 35982},
+									val:        "]",
+									ignoreCase: false,
+								},
+							},
+							&anyMatcher{
+								line: 742, col: 42, offset: 35986,
+							},
+						},
+					},
+				},
 			},
 		},
 		{
-			name: "NEWLINE",
-			pos:  position{line: 670, col: 1, offset: 29284},
-			expr: &choiceExpr{
-				pos: position{line: 670, col: 12, offset: 29295},
-				alternatives: []interface{}{
-					&litMatcher{
-						pos:        position{line: 670, col: 12, offset: 29295},
-						val:        "\r\n",
-						ignoreCase: false,
-					},
-					&litMatcher{
-						pos:        position{line: 670, col: 21, offset: 29304},
-						val:        "\r",
-						ignoreCase: false,
-					},
-					&litMatcher{
-						pos:        position{line: 670, col: 28, offset: 29311},
-						val:        "\n",
-						ignoreCase: false,
+			name: "CrossReference",
+			pos:  position{line: 749, col: 1, offset: 36138},
+			expr: &actionExpr{
+				pos: position{line: 749, col: 19, offset: 36156},
+				run: (*parser).callonCrossReference1,
+				expr: &seqExpr{
+					pos: position{line: 749, col: 19, offset: 36156},
+					exprs: []interface{}{
+						&andCodeExpr{
+							pos: position{line: 749, col: 19, offset: 36156},
+							run: (*parser).callonCrossReference3,
+						},
+						&labeledExpr{
+							pos:   position{line: 749, col: 87, offset: 36224},
+							label: "xref",
+							expr: &choiceExpr{
+								pos: position{line: 749, col: 93, offset: 36230},
+								alternatives: []interface{}{
+									&ruleRefExpr{
+										pos:  position{line: 749, col: 93, offset: 36230},
+										name: "InterDocumentCrossReference",
+									},
+									&ruleRefExpr{
+										pos:  position{line: 749, col: 123, offset: 36260},
+										name: "InternalCrossReference",
+									},
+								},
+							},
+						},
 					},
 				},
 			},
 		},
 		{
-			name: "WS",
-			pos:  position{line: 672, col: 1, offset: 29317},
+			name: "InternalCrossReference",
+			pos:  position{line: 754, col: 1, offset: 36423},
 			expr: &choiceExpr{
-				pos: position{line: 672, col: 7, offset: 29323},
+				pos: position{line: 754, col: 27, offset: 36449},
 				alternatives: []interface{}{
-					&litMatcher{
-						pos:        position{line: 672, col: 7, offset: 29323},
-						val:        " ",
-						ignoreCase: false,
+					&actionExpr{
+						pos: position{line: 754, col: 27, offset: 36449},
+						run: (*parser).callonInternalCrossReference2,
+						expr: &seqExpr{
+							pos: position{line: 754, col: 27, offset: 36449},
+							exprs: []interface{}{
+								&litMatcher{
+									pos:        position{line: 754, col: 27, offset: 36449},
+									val:        "<<",
+									ignoreCase: false,
+								},
+								&labeledExpr{
+									pos:   position{line: 754, col: 32, offset: 36454},
+									label: "id",
+									expr: &ruleRefExpr{
+										pos:  position{line: 754, col: 36, offset: 36458},
+										name: "CrossReferenceID",
+									},
+								},
+								&zeroOrMoreExpr{
+									pos: position{line: 754, col: 54, offset: 36476},
+									expr: &ruleRefExpr{
+										pos:  position{line: 754, col: 54, offset: 36476},
+										name: "WS",
+									},
+								},
+								&labeledExpr{
+									pos:   position{line: 754, col: 58, offset: 36480},
+									label: "label",
+									expr: &zeroOrOneExpr{
+										pos: position{line: 754, col: 64, offset: 36486},
+										expr: &ruleRefExpr{
+											pos:  position{line: 754, col: 65, offset: 36487},
+											name: "CrossReferenceLabel",
+										},
+									},
+								},
+								&litMatcher{
+									pos:        position{line: 754, col: 87, offset: 36509},
+									val:        ">>",
+									ignoreCase: false,
+								},
+							},
+						},
 					},
 					&actionExpr{
-						pos: position{line: 672, col: 13, offset: 29329},
-						run: (*parser).callonWS3,
-						expr: &litMatcher{
-							pos:        position{line: 672, col: 13, offset: 29329},
-							val:        "\t",
-							ignoreCase: false,
+						pos: position{line: 756, col: 5, offset: 36575},
+						run: (*parser).callonInternalCrossReference13,
+						expr: &seqExpr{
+							pos: position{line: 756, col: 5, offset: 36575},
+							exprs: []interface{}{
+								&litMatcher{
+									pos:        position{line: 756, col: 5, offset: 36575},
+									val:        "xref:",
+									ignoreCase: false,
+								},
+								&labeledExpr{
+									pos:   position{line: 756, col: 13, offset: 36583},
+									label: "id",
+									expr: &ruleRefExpr{
+										pos:  position{line: 756, col: 17, offset: 36587},
+										name: "CrossReferenceID",
+									},
+								},
+								&litMatcher{
+									pos:        position{line: 756, col: 35, offset: 36605},
+									val:        "[",
+									ignoreCase: false,
+								},
+								&labeledExpr{
+									pos:   position{line: 756, col: 39, offset: 36609},
+									label: "label",
+									expr: &zeroOrOneExpr{
+										pos: position{line: 756, col: 45, offset: 36615},
+										expr: &ruleRefExpr{
+											pos:  position{line: 756, col: 46, offset: 36616},
+											name: "CrossReferenceMacroLabel",
+										},
+									},
+								},
+								&litMatcher{
+									pos:        position{line: 756, col: 73, offset: 36643},
+									val:        "]",
+									ignoreCase: false,
+								},
+							},
 						},
 					},
 				},
 			},
 		},
 		{
-			name: "EOF",
-			pos:  position{line: 676, col: 1, offset: 29374},
-			expr: &notExpr{
-				pos: position{line: 676, col: 8, offset: 29381},
-				expr: &anyMatcher{
-					line: 676, col: 9, offset: 29382,
-				},
-			},
-		},
-		{
-			name: "EOL",
-			pos:  position{line: 678, col: 1, offset: 29385},
+			name: "InterDocumentCrossReference",
+			pos:  position{line: 761, col: 1, offset: 36855},
 			expr: &choiceExpr{
-				pos: position{line: 678, col: 8, offset: 29392},
+				pos: position{line: 761, col: 32, offset: 36886},
 				alternatives: []interface{}{
-					&ruleRefExpr{
-						pos:  position{line: 678, col: 8, offset: 29392},
-						name: "NEWLINE",
+					&actionExpr{
+						pos: position{line: 761, col: 32, offset: 36886},
+						run: (*parser).callonInterDocumentCrossReference2,
+						expr: &seqExpr{
+							pos: position{line: 761, col: 32, offset: 36886},
+							exprs: []interface{}{
+								&litMatcher{
+									pos:        position{line: 761, col: 32, offset: 36886},
+									val:        "<<",
+									ignoreCase: false,
+								},
+								&labeledExpr{
+									pos:   position{line: 761, col: 37, offset: 36891},
+									label: "location",
+									expr: &ruleRefExpr{
+										pos:  position{line: 761, col: 47, offset: 36901},
+										name: "CrossReferenceLocation",
+									},
+								},
+								&labeledExpr{
+									pos:   position{line: 761, col: 71, offset: 36925},
+									label: "id",
+									expr: &actionExpr{
+										pos: position{line: 761, col: 75, offset: 36929},
+										run: (*parser).callonInterDocumentCrossReference8,
+										expr: &seqExpr{
+											pos: position{line: 761, col: 75, offset: 36929},
+											exprs: []interface{}{
+												&litMatcher{
+													pos:        position{line: 761, col: 75, offset: 36929},
+													val:        "#",
+													ignoreCase: false,
+												},
+												&labeledExpr{
+													pos:   position{line: 761, col: 79, offset: 36933},
+													label: "id",
+													expr: &zeroOrOneExpr{
+														pos: position{line: 761, col: 82, offset: 36936},
+														expr: &ruleRefExpr{
+															pos:  position{line: 761, col: 83, offset: 36937},
+															name: "CrossReferenceID",
+														},
+													},
+												},
+											},
+										},
+									},
+								},
+								&zeroOrMoreExpr{
+									pos: position{line: 761, col: 122, offset: 36976},
+									expr: &ruleRefExpr{
+										pos:  position{line: 761, col: 122, offset: 36976},
+										name: "WS",
+									},
+								},
+								&labeledExpr{
+									pos:   position{line: 761, col: 126, offset: 36980},
+									label: "label",
+									expr: &zeroOrOneExpr{
+										pos: position{line: 761, col: 132, offset: 36986},
+										expr: &ruleRefExpr{
+											pos:  position{line: 761, col: 133, offset: 36987},
+											name: "CrossReferenceLabel",
+										},
+									},
+								},
+								&litMatcher{
+									pos:        position{line: 761, col: 155, offset: 37009},
+									val:        ">>",
+									ignoreCase: false,
+								},
+							},
+						},
 					},
-					&ruleRefExpr{
-						pos:  position{line: 678, col: 18, offset: 29402},
-						name: "EOF",
+					&actionExpr{
+						pos: position{line: 763, col: 5, offset: 37098},
+						run: (*parser).callonInterDocumentCrossReference20,
+						expr: &seqExpr{
+							pos: position{line: 763, col: 5, offset: 37098},
+							exprs: []interface{}{
+								&litMatcher{
+									pos:        position{line: 763, col: 5, offset: 37098},
+									val:        "<<",
+									ignoreCase: false,
+								},
+								&labeledExpr{
+									pos:   position{line: 763, col: 10, offset: 37103},
+									label: "location",
+									expr: &ruleRefExpr{
+										pos:  position{line: 763, col: 20, offset: 37113},
+										name: "CrossReferenceDocument",
+									},
+								},
+								&zeroOrMoreExpr{
+									pos: position{line: 763, col: 44, offset: 37137},
+									expr: &ruleRefExpr{
+										pos:  position{line: 763, col: 44, offset: 37137},
+										name: "WS",
+									},
+								},
+								&labeledExpr{
+									pos:   position{line: 763, col: 48, offset: 37141},
+									label: "label",
+									expr: &zeroOrOneExpr{
+										pos: position{line: 763, col: 54, offset: 37147},
+										expr: &ruleRefExpr{
+											pos:  position{line: 763, col: 55, offset: 37148},
+											name: "CrossReferenceLabel",
+										},
+									},
+								},
+								&litMatcher{
+									pos:        position{line: 763, col: 77, offset: 37170},
+									val:        ">>",
+									ignoreCase: false,
+								},
+							},
+						},
 					},
-				},
-			},
-		},
-	},
+					&actionExpr{
+						pos: position{line: 765, col: 5, offset: 37260},
+						run: (*parser).callonInterDocumentCrossReference31,
+						expr: &seqExpr{
+							pos: position{line: 765, col: 5, offset: 37260},
+							exprs: []interface{}{
+								&litMatcher{
+									pos:        position{line: 765, col: 5, offset: 37260},
+									val:        "xref:",
+									ignoreCase: false,
+								},
+								&labeledExpr{
+									pos:   position{line: 765, col: 13, offset: 37268},
+									label: "location",
+									expr: &ruleRefExpr{
+										pos:  position{line: 765, col: 23, offset: 37278},
+										name: "CrossReferenceLocation",
+									},
+								},
+								&labeledExpr{
+									pos:   position{line: 765, col: 47, offset: 37302},
+									label: "id",
+									expr: &actionExpr{
+										pos: position{line: 765, col: 51, offset: 37306},
+										run: (*parser).callonInterDocumentCrossReference37,
+										expr: &seqExpr{
+											pos: position{line: 765, col: 51, offset: 37306},
+											exprs: []interface{}{
+												&litMatcher{
+													pos:        position{line: 765, col: 51, offset: 37306},
+													val:        "#",
+													ignoreCase: false,
+												},
+												&labeledExpr{
+													pos:   position{line: 765, col: 55, offset: 37310},
+													label: "id",
+													expr: &zeroOrOneExpr{
+														pos: position{line: 765, col: 58, offset: 37313},
+														expr: &ruleRefExpr{
+															pos:  position{line: 765, col: 59, offset: 37314},
+															name: "CrossReferenceID",
+														},
+													},
+												},
+											},
+										},
+									},
+								},
+								&litMatcher{
+									pos:        position{line: 765, col: 98, offset: 37353},
+									val:        "[",
+									ignoreCase: false,
+								},
+								&labeledExpr{
+									pos:   position{line: 765, col: 102, offset: 37357},
+									label: "label",
+									expr: &zeroOrOneExpr{
+										pos: position{line: 765, col: 108, offset: 37363},
+										expr: &ruleRefExpr{
+											pos:  position{line: 765, col: 109, offset: 37364},
+											name: "CrossReferenceMacroLabel",
+										},
+									},
+								},
+								&litMatcher{
+									pos:        position{line: 765, col: 136, offset: 37391},
+									val:        "]",
+									ignoreCase: false,
+								},
+							},
+						},
+					},
+					&actionExpr{
+						pos: position{line: 767, col: 5, offset: 37479},
+						run: (*parser).callonInterDocumentCrossReference48,
+						expr: &seqExpr{
+							pos: position{line: 767, col: 5, offset: 37479},
+							exprs: []interface{}{
+								&litMatcher{
+									pos:        position{line: 767, col: 5, offset: 37479},
+									val:        "xref:",
+									ignoreCase: false,
+								},
+								&labeledExpr{
+									pos:   position{line: 767, col: 13, offset: 37487},
+									label: "location",
+									expr: &ruleRefExpr{
+										pos:  position{line: 767, col: 23, offset: 37497},
+										name: "CrossReferenceDocument",
+									},
+								},
+								&litMatcher{
+									pos:        position{line: 767, col: 47, offset: 37521},
+									val:        "[",
+									ignoreCase: false,
+								},
+								&labeledExpr{
+									pos:   position{line: 767, col: 51, offset: 37525},
+									label: "label",
+									expr: &zeroOrOneExpr{
+										pos: position{line: 767, col: 57, offset: 37531},
+										expr: &ruleRefExpr{
+											pos:  position{line: 767, col: 58, offset: 37532},
+											name: "CrossReferenceMacroLabel",
+										},
+									},
+								},
+								&litMatcher{
+									pos:        position{line: 767, col: 85, offset: 37559},
+									val:        "]",
+									ignoreCase: false,
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "CrossReferenceID",
+			pos:  position{line: 771, col: 1, offset: 37647},
+			expr: &actionExpr{
+				pos: position{line: 771, col: 21, offset: 37667},
+				run: (*parser).callonCrossReferenceID1,
+				expr: &oneOrMoreExpr{
+					pos: position{line: 771, col: 21, offset: 37667},
+					expr: &seqExpr{
+						pos: position{line: 771, col: 22, offset: 37668},
+						exprs: []interface{}{
+							&notExpr{
+								pos: position{line: 771, col: 22, offset: 37668},
+								expr: &ruleRefExpr{
+									pos:  position{line: 771, col: 23, offset: 37669},
+									name: "NEWLINE",
+								},
+							},
+							&notExpr{
+								pos: position{line: 771, col: 31, offset: 37677},
+								expr: &ruleRefExpr{
+									pos:  position{line: 771, col: 32, offset: 37678},
+									name: "WS",
+								},
+							},
+							&notExpr{
+								pos: position{line: 771, col: 35, offset: 37681},
+								expr: &litMatcher{
+									pos:        position{line: 771, col: 36, offset: 37682},
+									val:        "[",
+									ignoreCase: false,
+								},
+							},
+							&notExpr{
+								pos: position{line: 771, col: 40, offset: 37686},
+								expr: &litMatcher{
+									pos:        position{line: 771, col: 41, offset: 37687},
+									val:        "]",
+									ignoreCase: false,
+								},
+							},
+							&notExpr{
+								pos: position{line: 771, col: 45, offset: 37691},
+								expr: &litMatcher{
+									pos:        position{line: 771, col: 46, offset: 37692},
+									val:        "<<",
+									ignoreCase: false,
+								},
+							},
+							&notExpr{
+								pos: position{line: 771, col: 51, offset: 37697},
+								expr: &litMatcher{
+									pos:        position{line: 771, col: 52, offset: 37698},
+									val:        ">>",
+									ignoreCase: false,
+								},
+							},
+							&notExpr{
+								pos: position{line: 771, col: 57, offset: 37703},
+								expr: &litMatcher{
+									pos:        position{line: 771, col: 58, offset: 37704},
+									val:        ",",
+									ignoreCase: false,
+								},
+							},
+							&notExpr{
+								pos: position{line: 771, col: 62, offset: 37708},
+								expr: &litMatcher{
+									pos:        position{line: 771, col: 63, offset: 37709},
+									val:        "#",
+									ignoreCase: false,
+								},
+							},
+							&anyMatcher{
+								line: 771, col: 67, offset: 37713,
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "CrossReferenceLocation",
+			pos:  position{line: 776, col: 1, offset: 37836},
+			expr: &actionExpr{
+				pos: position{line: 776, col: 27, offset: 37862},
+				run: (*parser).callonCrossReferenceLocation1,
+				expr: &seqExpr{
+					pos: position{line: 776, col: 27, offset: 37862},
+					exprs: []interface{}{
+						&oneOrMoreExpr{
+							pos: position{line: 776, col: 27, offset: 37862},
+							expr: &seqExpr{
+								pos: position{line: 776, col: 28, offset: 37863},
+								exprs: []interface{}{
+									&notExpr{
+										pos: position{line: 776, col: 28, offset: 37863},
+										expr: &ruleRefExpr{
+											pos:  position{line: 776, col: 29, offset: 37864},
+											name: "NEWLINE",
+										},
+									},
+									&notExpr{
+										pos: position{line: 776, col: 37, offset: 37872},
+										expr: &ruleRefExpr{
+											pos:  position{line: 776, col: 38, offset: 37873},
+											name: "WS",
+										},
+									},
+									&notExpr{
+										pos: position{line: 776, col: 41, offset: 37876},
+										expr: &litMatcher{
+											pos:        position{line: 776, col: 42, offset: 37877},
+											val:        "[",
+											ignoreCase: false,
+										},
+									},
+									&notExpr{
+										pos: position{line: 776, col: 46, offset: 37881},
+										expr: &litMatcher{
+											pos:        position{line: 776, col: 47, offset: 37882},
+											val:        "]",
+											ignoreCase: false,
+										},
+									},
+									&notExpr{
+										pos: position{line: 776, col: 51, offset: 37886},
+										expr: &litMatcher{
+											pos:        position{line: 776, col: 52, offset: 37887},
+											val:        "<<",
+											ignoreCase: false,
+										},
+									},
+									&notExpr{
+										pos: position{line: 776, col: 57, offset: 37892},
+										expr: &litMatcher{
+											pos:        position{line: 776, col: 58, offset: 37893},
+											val:        ">>",
+											ignoreCase: false,
+										},
+									},
+									&notExpr{
+										pos: position{line: 776, col: 63, offset: 37898},
+										expr: &litMatcher{
+											pos:        position{line: 776, col: 64, offset: 37899},
+											val:        ",",
+											ignoreCase: false,
+										},
+									},
+									&notExpr{
+										pos: position{line: 776, col: 68, offset: 37903},
+										expr: &litMatcher{
+											pos:        position{line: 776, col: 69, offset: 37904},
+											val:        "#",
+											ignoreCase: false,
+										},
+									},
+									&anyMatcher{
+										line: 776, col: 73, offset: 37908,
+									},
+								},
+							},
+						},
+						&andExpr{
+							pos: position{line: 776, col: 77, offset: 37912},
+							expr: &litMatcher{
+								pos:        position{line: 776, col: 78, offset: 37913},
+								val:        "#",
+								ignoreCase: false,
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "CrossReferenceDocument",
+			pos:  position{line: 781, col: 1, offset: 38032},
+			expr: &actionExpr{
+				pos: position{line: 781, col: 27, offset: 38058},
+				run: (*parser).callonCrossReferenceDocument1,
+				expr: &seqExpr{
+					pos: position{line: 781, col: 27, offset: 38058},
+					exprs: []interface{}{
+						&oneOrMoreExpr{
+							pos: position{line: 781, col: 27, offset: 38058},
+							expr: &seqExpr{
+								pos: position{line: 781, col: 28, offset: 38059},
+								exprs: []interface{}{
+									&notExpr{
+										pos: position{line: 781, col: 28, offset: 38059},
+										expr: &ruleRefExpr{
+											pos:  position{line: 781, col: 29, offset: 38060},
+											name: "NEWLINE",
+										},
+									},
+									&notExpr{
+										pos: position{line: 781, col: 37, offset: 38068},
+										expr: &ruleRefExpr{
+											pos:  position{line: 781, col: 38, offset: 38069},
+											name: "WS",
+										},
+									},
+									&notExpr{
+										pos: position{line: 781, col: 41, offset: 38072},
+										expr: &litMatcher{
+											pos:        position{line: 781, col: 42, offset: 38073},
+											val:        "[",
+											ignoreCase: false,
+										},
+									},
+									&notExpr{
+										pos: position{line: 781, col: 46, offset: 38077},
+										expr: &litMatcher{
+											pos:        position{line: 781, col: 47, offset: 38078},
+											val:        "]",
+											ignoreCase: false,
+										},
+									},
+									&notExpr{
+										pos: position{line: 781, col: 51, offset: 38082},
+										expr: &litMatcher{
+											pos:        position{line: 781, col: 52, offset: 38083},
+											val:        "<<",
+											ignoreCase: false,
+										},
+									},
+									&notExpr{
+										pos: position{line: 781, col: 57, offset: 38088},
+										expr: &litMatcher{
+											pos:        position{line: 781, col: 58, offset: 38089},
+											val:        ">>",
+											ignoreCase: false,
+										},
+									},
+									&notExpr{
+										pos: position{line: 781, col: 63, offset: 38094},
+										expr: &litMatcher{
+											pos:        position{line: 781, col: 64, offset: 38095},
+											val:        ",",
+											ignoreCase: false,
+										},
+									},
+									&notExpr{
+										pos: position{line: 781, col: 68, offset: 38099},
+										expr: &litMatcher{
+											pos:        position{line: 781, col: 69, offset: 38100},
+											val:        "#",
+											ignoreCase: false,
+										},
+									},
+									&notExpr{
+										pos: position{line: 781, col: 73, offset: 38104},
+										expr: &seqExpr{
+											pos: position{line: 781, col: 75, offset: 38106},
+											exprs: []interface{}{
+												&litMatcher{
+													pos:        position{line: 781, col: 75, offset: 38106},
+													val:        ".adoc",
+													ignoreCase: false,
+												},
+												&notExpr{
+													pos: position{line: 781, col: 83, offset: 38114},
+													expr: &seqExpr{
+														pos: position{line: 781, col: 85, offset: 38116},
+														exprs: []interface{}{
+															&notExpr{
+																pos: position{line: 781, col: 85, offset: 38116},
+																expr: &ruleRefExpr{
+																	pos:  position{line: 781, col: 86, offset: 38117},
+																	name: "NEWLINE",
+																},
+															},
+															&notExpr{
+																pos: position{line: 781, col: 94, offset: 38125},
+																expr: &ruleRefExpr{
+																	pos:  position{line: 781, col: 95, offset: 38126},
+																	name: "WS",
+																},
+															},
+															&notExpr{
+																pos: position{line: 781, col: 98, offset: 38129},
+																expr: &litMatcher{
+																	pos:        position{line: 781, col: 99, offset: 38130},
+																	val:        "[",
+																	ignoreCase: false,
+																},
+															},
+															&notExpr{
+																pos: position{line: 781, col: 103, offset: 38134},
+																expr: &litMatcher{
+																	pos:        position{line: 781, col: 104, offset: 38135},
+																	val:        "]",
+																	ignoreCase: false,
+																},
+															},
+															&notExpr{
+																pos: position{line: 781, col: 108, offset: 38139},
+																expr: &litMatcher{
+																	pos:        position{line: 781, col: 109, offset: 38140},
+																	val:        ">>",
+																	ignoreCase: false,
+																},
+															},
+															&notExpr{
+																pos: position{line: 781, col: 114, offset: 38145},
+																expr: &litMatcher{
+																	pos:        position{line: 781, col: 115, offset: 38146},
+																	val:        ",",
+																	ignoreCase: false,
+																},
+															},
+															&anyMatcher{
+																line: 781, col: 119, offset: 38150,
+															},
+														},
+													},
+												},
+											},
+										},
+									},
+									&anyMatcher{
+										line: 781, col: 123, offset: 38154,
+									},
+								},
+							},
+						},
+						&litMatcher{
+							pos:        position{line: 781, col: 127, offset: 38158},
+							val:        ".adoc",
+							ignoreCase: false,
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "CrossReferenceLabel",
+			pos:  position{line: 785, col: 1, offset: 38202},
+			expr: &actionExpr{
+				pos: position{line: 785, col: 24, offset: 38225},
+				run: (*parser).callonCrossReferenceLabel1,
+				expr: &seqExpr{
+					pos: position{line: 785, col: 24, offset: 38225},
+					exprs: []interface{}{
+						&litMatcher{
+							pos:        position{line: 785, col: 24, offset: 38225},
+							val:        ",",
+							ignoreCase: false,
+						},
+						&zeroOrMoreExpr{
+							pos: position{line: 785, col: 28, offset: 38229},
+							expr: &ruleRefExpr{
+								pos:  position{line: 785, col: 28, offset: 38229},
+								name: "WS",
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 785, col: 32, offset: 38233},
+							label: "label",
+							expr: &actionExpr{
+								pos: position{line: 785, col: 39, offset: 38240},
+								run: (*parser).callonCrossReferenceLabel7,
+								expr: &oneOrMoreExpr{
+									pos: position{line: 785, col: 39, offset: 38240},
+									expr: &seqExpr{
+										pos: position{line: 785, col: 40, offset: 38241},
+										exprs: []interface{}{
+											&notExpr{
+												pos: position{line: 785, col: 40, offset: 38241},
+												expr: &litMatcher{
+													pos:        position{line: 785, col: 41, offset: 38242},
+													val:        ">>",
+													ignoreCase: false,
+												},
+											},
+											&notExpr{
+												pos: position{line: 785, col: 46, offset: 38247},
+												expr: &ruleRefExpr{
+													pos:  position{line: 785, col: 47, offset: 38248},
+													name: "NEWLINE",
+												},
+											},
+											&anyMatcher{
+												line: 785, col: 55, offset: 38256,
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "CrossReferenceMacroLabel",
+			pos:  position{line: 789, col: 1, offset: 38319},
+			expr: &actionExpr{
+				pos: position{line: 789, col: 29, offset: 38347},
+				run: (*parser).callonCrossReferenceMacroLabel1,
+				expr: &oneOrMoreExpr{
+					pos: position{line: 789, col: 29, offset: 38347},
+					expr: &seqExpr{
+						pos: position{line: 789, col: 30, offset: 38348},
+						exprs: []interface{}{
+							&notExpr{
+								pos: position{line: 789, col: 30, offset: 38348},
+								expr: &litMatcher{
+									pos:        position{line: 789, col: 31, offset: 38349},
+									val:        "]",
+									ignoreCase: false,
+								},
+							},
+							&notExpr{
+								pos: position{line: 789, col: 35, offset: 38353},
+								expr: &ruleRefExpr{
+									pos:  position{line: 789, col: 36, offset: 38354},
+									name: "NEWLINE",
+								},
+							},
+							&anyMatcher{
+								line: 789, col: 44, offset: 38362,
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "InlineAnchor",
+			pos:  position{line: 796, col: 1, offset: 38512},
+			expr: &choiceExpr{
+				pos: position{line: 796, col: 17, offset: 38528},
+				alternatives: []interface{}{
+					&actionExpr{
+						pos: position{line: 796, col: 17, offset: 38528},
+						run: (*parser).callonInlineAnchor2,
+						expr: &seqExpr{
+							pos: position{line: 796, col: 17, offset: 38528},
+							exprs: []interface{}{
+								&andCodeExpr{
+									pos: position{line: 796, col: 17, offset: 38528},
+									run: (*parser).callonInlineAnchor4,
+								},
+								&labeledExpr{
+									pos:   position{line: 796, col: 85, offset: 38596},
+									label: "anchor",
+									expr: &ruleRefExpr{
+										pos:  position{line: 796, col: 93, offset: 38604},
+										name: "BibliographyAnchor",
+									},
+								},
+							},
+						},
+					},
+					&actionExpr{
+						pos: position{line: 798, col: 5, offset: 38653},
+						run: (*parser).callonInlineAnchor7,
+						expr: &seqExpr{
+							pos: position{line: 798, col: 5, offset: 38653},
+							exprs: []interface{}{
+								&andCodeExpr{
+									pos: position{line: 798, col: 5, offset: 38653},
+									run: (*parser).callonInlineAnchor9,
+								},
+								&litMatcher{
+									pos:        position{line: 798, col: 73, offset: 38721},
+									val:        "[[",
+									ignoreCase: false,
+								},
+								&labeledExpr{
+									pos:   position{line: 798, col: 78, offset: 38726},
+									label: "id",
+									expr: &ruleRefExpr{
+										pos:  position{line: 798, col: 82, offset: 38730},
+										name: "CrossReferenceID",
+									},
+								},
+								&labeledExpr{
+									pos:   position{line: 798, col: 100, offset: 38748},
+									label: "label",
+									expr: &zeroOrOneExpr{
+										pos: position{line: 798, col: 106, offset: 38754},
+										expr: &ruleRefExpr{
+											pos:  position{line: 798, col: 107, offset: 38755},
+											name: "InlineAnchorLabel",
+										},
+									},
+								},
+								&litMatcher{
+									pos:        position{line: 798, col: 127, offset: 38775},
+									val:        "]]",
+									ignoreCase: false,
+								},
+							},
+						},
+					},
+					&actionExpr{
+						pos: position{line: 800, col: 5, offset: 38839},
+						run: (*parser).callonInlineAnchor17,
+						expr: &seqExpr{
+							pos: position{line: 800, col: 5, offset: 38839},
+							exprs: []interface{}{
+								&andCodeExpr{
+									pos: position{line: 800, col: 5, offset: 38839},
+									run: (*parser).callonInlineAnchor19,
+								},
+								&litMatcher{
+									pos:        position{line: 800, col: 73, offset: 38907},
+									val:        "anchor:",
+									ignoreCase: false,
+								},
+								&labeledExpr{
+									pos:   position{line: 800, col: 83, offset: 38917},
+									label: "id",
+									expr: &ruleRefExpr{
+										pos:  position{line: 800, col: 87, offset: 38921},
+										name: "CrossReferenceID",
+									},
+								},
+								&litMatcher{
+									pos:        position{line: 800, col: 105, offset: 38939},
+									val:        "[",
+									ignoreCase: false,
+								},
+								&labeledExpr{
+									pos:   position{line: 800, col: 109, offset: 38943},
+									label: "label",
+									expr: &actionExpr{
+										pos: position{line: 800, col: 116, offset: 38950},
+										run: (*parser).callonInlineAnchor25,
+										expr: &zeroOrMoreExpr{
+											pos: position{line: 800, col: 116, offset: 38950},
+											expr: &seqExpr{
+												pos: position{line: 800, col: 117, offset: 38951},
+												exprs: []interface{}{
+													&notExpr{
+														pos: position{line: 800, col: 117, offset: 38951},
+														expr: &litMatcher{
+															pos:        position{line: 800, col: 118, offset: 38952},
+															val:        "]",
+															ignoreCase: false,
+														},
+													},
+													&notExpr{
+														pos: position{line: 800, col: 122, offset: 38956},
+														expr: &ruleRefExpr{
+															pos:  position{line: 800, col: 123, offset: 38957},
+															name: "NEWLINE",
+														},
+													},
+													&anyMatcher{
+														line: 800, col: 131, offset: 38965,
+													},
+												},
+											},
+										},
+									},
+								},
+								&litMatcher{
+									pos:        position{line: 800, col: 167, offset: 39001},
+									val:        "]",
+									ignoreCase: false,
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "BibliographyAnchor",
+			pos:  position{line: 805, col: 1, offset: 39156},
+			expr: &actionExpr{
+				pos: position{line: 805, col: 23, offset: 39178},
+				run: (*parser).callonBibliographyAnchor1,
+				expr: &seqExpr{
+					pos: position{line: 805, col: 23, offset: 39178},
+					exprs: []interface{}{
+						&litMatcher{
+							pos:        position{line: 805, col: 23, offset: 39178},
+							val:        "[[[",
+							ignoreCase: false,
+						},
+						&labeledExpr{
+							pos:   position{line: 805, col: 29, offset: 39184},
+							label: "id",
+							expr: &ruleRefExpr{
+								pos:  position{line: 805, col: 33, offset: 39188},
+								name: "CrossReferenceID",
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 805, col: 51, offset: 39206},
+							label: "label",
+							expr: &zeroOrOneExpr{
+								pos: position{line: 805, col: 57, offset: 39212},
+								expr: &ruleRefExpr{
+									pos:  position{line: 805, col: 58, offset: 39213},
+									name: "InlineAnchorLabel",
+								},
+							},
+						},
+						&litMatcher{
+							pos:        position{line: 805, col: 78, offset: 39233},
+							val:        "]]]",
+							ignoreCase: false,
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "InlineAnchorLabel",
+			pos:  position{line: 809, col: 1, offset: 39303},
+			expr: &actionExpr{
+				pos: position{line: 809, col: 22, offset: 39324},
+				run: (*parser).callonInlineAnchorLabel1,
+				expr: &seqExpr{
+					pos: position{line: 809, col: 22, offset: 39324},
+					exprs: []interface{}{
+						&litMatcher{
+							pos:        position{line: 809, col: 22, offset: 39324},
+							val:        ",",
+							ignoreCase: false,
+						},
+						&zeroOrMoreExpr{
+							pos: position{line: 809, col: 26, offset: 39328},
+							expr: &ruleRefExpr{
+								pos:  position{line: 809, col: 26, offset: 39328},
+								name: "WS",
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 809, col: 30, offset: 39332},
+							label: "label",
+							expr: &actionExpr{
+								pos: position{line: 809, col: 37, offset: 39339},
+								run: (*parser).callonInlineAnchorLabel7,
+								expr: &oneOrMoreExpr{
+									pos: position{line: 809, col: 37, offset: 39339},
+									expr: &seqExpr{
+										pos: position{line: 809, col: 38, offset: 39340},
+										exprs: []interface{}{
+											&notExpr{
+												pos: position{line: 809, col: 38, offset: 39340},
+												expr: &litMatcher{
+													pos:        position{line: 809, col: 39, offset: 39341},
+													val:        "]",
+													ignoreCase: false,
+												},
+											},
+											&notExpr{
+												pos: position{line: 809, col: 43, offset: 39345},
+												expr: &ruleRefExpr{
+													pos:  position{line: 809, col: 44, offset: 39346},
+													name: "NEWLINE",
+												},
+											},
+											&anyMatcher{
+												line: 809, col: 52, offset: 39354,
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "Link",
+			pos:  position{line: 816, col: 1, offset: 39518},
+			expr: &actionExpr{
+				pos: position{line: 816, col: 9, offset: 39526},
+				run: (*parser).callonLink1,
+				expr: &seqExpr{
+					pos: position{line: 816, col: 9, offset: 39526},
+					exprs: []interface{}{
+						&andCodeExpr{
+							pos: position{line: 816, col: 9, offset: 39526},
+							run: (*parser).callonLink3,
+						},
+						&labeledExpr{
+							pos:   position{line: 816, col: 77, offset: 39594},
+							label: "link",
+							expr: &choiceExpr{
+								pos: position{line: 816, col: 83, offset: 39600},
+								alternatives: []interface{}{
+									&ruleRefExpr{
+										pos:  position{line: 816, col: 83, offset: 39600},
+										name: "RelativeLink",
+									},
+									&ruleRefExpr{
+										pos:  position{line: 816, col: 98, offset: 39615},
+										name: "ExternalLink",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "ExternalLink",
+			pos:  position{line: 820, col: 1, offset: 39655},
+			expr: &actionExpr{
+				pos: position{line: 820, col: 17, offset: 39671},
+				run: (*parser).callonExternalLink1,
+				expr: &seqExpr{
+					pos: position{line: 820, col: 17, offset: 39671},
+					exprs: []interface{}{
+						&labeledExpr{
+							pos:   position{line: 820, col: 17, offset: 39671},
+							label: "url",
+							expr: &seqExpr{
+								pos: position{line: 820, col: 22, offset: 39676},
+								exprs: []interface{}{
+									&ruleRefExpr{
+										pos:  position{line: 820, col: 22, offset: 39676},
+										name: "URL_SCHEME",
+									},
+									&ruleRefExpr{
+										pos:  position{line: 820, col: 33, offset: 39687},
+										name: "URL",
+									},
+								},
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 820, col: 38, offset: 39692},
+							label: "text",
+							expr: &zeroOrOneExpr{
+								pos: position{line: 820, col: 43, offset: 39697},
+								expr: &seqExpr{
+									pos: position{line: 820, col: 44, offset: 39698},
+									exprs: []interface{}{
+										&litMatcher{
+											pos:        position{line: 820, col: 44, offset: 39698},
+											val:        "[",
+											ignoreCase: false,
+										},
+										&zeroOrMoreExpr{
+											pos: position{line: 820, col: 48, offset: 39702},
+											expr: &ruleRefExpr{
+												pos:  position{line: 820, col: 49, offset: 39703},
+												name: "URL_TEXT",
+											},
+										},
+										&litMatcher{
+											pos:        position{line: 820, col: 60, offset: 39714},
+											val:        "]",
+											ignoreCase: false,
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "RelativeLink",
+			pos:  position{line: 827, col: 1, offset: 39875},
+			expr: &actionExpr{
+				pos: position{line: 827, col: 17, offset: 39891},
+				run: (*parser).callonRelativeLink1,
+				expr: &seqExpr{
+					pos: position{line: 827, col: 17, offset: 39891},
+					exprs: []interface{}{
+						&litMatcher{
+							pos:        position{line: 827, col: 17, offset: 39891},
+							val:        "link:",
+							ignoreCase: false,
+						},
+						&labeledExpr{
+							pos:   position{line: 827, col: 25, offset: 39899},
+							label: "url",
+							expr: &seqExpr{
+								pos: position{line: 827, col: 30, offset: 39904},
+								exprs: []interface{}{
+									&zeroOrOneExpr{
+										pos: position{line: 827, col: 30, offset: 39904},
+										expr: &ruleRefExpr{
+											pos:  position{line: 827, col: 30, offset: 39904},
+											name: "URL_SCHEME",
+										},
+									},
+									&ruleRefExpr{
+										pos:  position{line: 827, col: 42, offset: 39916},
+										name: "URL",
+									},
+								},
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 827, col: 47, offset: 39921},
+							label: "text",
+							expr: &seqExpr{
+								pos: position{line: 827, col: 53, offset: 39927},
+								exprs: []interface{}{
+									&litMatcher{
+										pos:        position{line: 827, col: 53, offset: 39927},
+										val:        "[",
+										ignoreCase: false,
+									},
+									&zeroOrMoreExpr{
+										pos: position{line: 827, col: 57, offset: 39931},
+										expr: &ruleRefExpr{
+											pos:  position{line: 827, col: 58, offset: 39932},
+											name: "URL_TEXT",
+										},
+									},
+									&litMatcher{
+										pos:        position{line: 827, col: 69, offset: 39943},
+										val:        "]",
+										ignoreCase: false,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "BlockImage",
+			pos:  position{line: 837, col: 1, offset: 40205},
+			expr: &actionExpr{
+				pos: position{line: 837, col: 15, offset: 40219},
+				run: (*parser).callonBlockImage1,
+				expr: &seqExpr{
+					pos: position{line: 837, col: 15, offset: 40219},
+					exprs: []interface{}{
+						&labeledExpr{
+							pos:   position{line: 837, col: 15, offset: 40219},
+							label: "attributes",
+							expr: &zeroOrMoreExpr{
+								pos: position{line: 837, col: 26, offset: 40230},
+								expr: &ruleRefExpr{
+									pos:  position{line: 837, col: 27, offset: 40231},
+									name: "ElementAttribute",
+								},
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 837, col: 46, offset: 40250},
+							label: "image",
+							expr: &ruleRefExpr{
+								pos:  position{line: 837, col: 52, offset: 40256},
+								name: "BlockImageMacro",
+							},
+						},
+						&zeroOrMoreExpr{
+							pos: position{line: 837, col: 69, offset: 40273},
+							expr: &ruleRefExpr{
+								pos:  position{line: 837, col: 69, offset: 40273},
+								name: "WS",
+							},
+						},
+						&ruleRefExpr{
+							pos:  position{line: 837, col: 73, offset: 40277},
+							name: "EOL",
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "BlockImageMacro",
+			pos:  position{line: 842, col: 1, offset: 40436},
+			expr: &actionExpr{
+				pos: position{line: 842, col: 20, offset: 40455},
+				run: (*parser).callonBlockImageMacro1,
+				expr: &seqExpr{
+					pos: position{line: 842, col: 20, offset: 40455},
+					exprs: []interface{}{
+						&litMatcher{
+							pos:        position{line: 842, col: 20, offset: 40455},
+							val:        "image::",
+							ignoreCase: false,
+						},
+						&labeledExpr{
+							pos:   position{line: 842, col: 30, offset: 40465},
+							label: "path",
+							expr: &ruleRefExpr{
+								pos:  position{line: 842, col: 36, offset: 40471},
+								name: "URL",
+							},
+						},
+						&litMatcher{
+							pos:        position{line: 842, col: 41, offset: 40476},
+							val:        "[",
+							ignoreCase: false,
+						},
+						&labeledExpr{
+							pos:   position{line: 842, col: 45, offset: 40480},
+							label: "attributes",
+							expr: &ruleRefExpr{
+								pos:  position{line: 842, col: 57, offset: 40492},
+								name: "ImageAttributes",
+							},
+						},
+						&litMatcher{
+							pos:        position{line: 842, col: 74, offset: 40509},
+							val:        "]",
+							ignoreCase: false,
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "InlineImage",
+			pos:  position{line: 846, col: 1, offset: 40592},
+			expr: &actionExpr{
+				pos: position{line: 846, col: 16, offset: 40607},
+				run: (*parser).callonInlineImage1,
+				expr: &seqExpr{
+					pos: position{line: 846, col: 16, offset: 40607},
+					exprs: []interface{}{
+						&andCodeExpr{
+							pos: position{line: 846, col: 16, offset: 40607},
+							run: (*parser).callonInlineImage3,
+						},
+						&labeledExpr{
+							pos:   position{line: 846, col: 84, offset: 40675},
+							label: "image",
+							expr: &ruleRefExpr{
+								pos:  position{line: 846, col: 90, offset: 40681},
+								name: "InlineImageMacro",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "InlineImageMacro",
+			pos:  position{line: 851, col: 1, offset: 40826},
+			expr: &actionExpr{
+				pos: position{line: 851, col: 21, offset: 40846},
+				run: (*parser).callonInlineImageMacro1,
+				expr: &seqExpr{
+					pos: position{line: 851, col: 21, offset: 40846},
+					exprs: []interface{}{
+						&litMatcher{
+							pos:        position{line: 851, col: 21, offset: 40846},
+							val:        "image:",
+							ignoreCase: false,
+						},
+						&notExpr{
+							pos: position{line: 851, col: 30, offset: 40855},
+							expr: &litMatcher{
+								pos:        position{line: 851, col: 31, offset: 40856},
+								val:        ":",
+								ignoreCase: false,
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 851, col: 35, offset: 40860},
+							label: "path",
+							expr: &ruleRefExpr{
+								pos:  position{line: 851, col: 41, offset: 40866},
+								name: "URL",
+							},
+						},
+						&litMatcher{
+							pos:        position{line: 851, col: 46, offset: 40871},
+							val:        "[",
+							ignoreCase: false,
+						},
+						&labeledExpr{
+							pos:   position{line: 851, col: 50, offset: 40875},
+							label: "attributes",
+							expr: &ruleRefExpr{
+								pos:  position{line: 851, col: 62, offset: 40887},
+								name: "ImageAttributes",
+							},
+						},
+						&litMatcher{
+							pos:        position{line: 851, col: 79, offset: 40904},
+							val:        "]",
+							ignoreCase: false,
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "ImageAttributes",
+			pos:  position{line: 857, col: 1, offset: 41212},
+			expr: &actionExpr{
+				pos: position{line: 857, col: 20, offset: 41231},
+				run: (*parser).callonImageAttributes1,
+				expr: &seqExpr{
+					pos: position{line: 857, col: 20, offset: 41231},
+					exprs: []interface{}{
+						&labeledExpr{
+							pos:   position{line: 857, col: 20, offset: 41231},
+							label: "first",
+							expr: &zeroOrOneExpr{
+								pos: position{line: 857, col: 26, offset: 41237},
+								expr: &ruleRefExpr{
+									pos:  position{line: 857, col: 27, offset: 41238},
+									name: "ImageAttribute",
+								},
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 857, col: 44, offset: 41255},
+							label: "others",
+							expr: &zeroOrMoreExpr{
+								pos: position{line: 857, col: 51, offset: 41262},
+								expr: &actionExpr{
+									pos: position{line: 857, col: 52, offset: 41263},
+									run: (*parser).callonImageAttributes8,
+									expr: &seqExpr{
+										pos: position{line: 857, col: 52, offset: 41263},
+										exprs: []interface{}{
+											&litMatcher{
+												pos:        position{line: 857, col: 52, offset: 41263},
+												val:        ",",
+												ignoreCase: false,
+											},
+											&zeroOrMoreExpr{
+												pos: position{line: 857, col: 56, offset: 41267},
+												expr: &ruleRefExpr{
+													pos:  position{line: 857, col: 56, offset: 41267},
+													name: "WS",
+												},
+											},
+											&labeledExpr{
+												pos:   position{line: 857, col: 60, offset: 41271},
+												label: "attr",
+												expr: &zeroOrOneExpr{
+													pos: position{line: 857, col: 65, offset: 41276},
+													expr: &ruleRefExpr{
+														pos:  position{line: 857, col: 66, offset: 41277},
+														name: "ImageAttribute",
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "ImageAttribute",
+			pos:  position{line: 861, col: 1, offset: 41394},
+			expr: &choiceExpr{
+				pos: position{line: 861, col: 19, offset: 41412},
+				alternatives: []interface{}{
+					&actionExpr{
+						pos: position{line: 861, col: 19, offset: 41412},
+						run: (*parser).callonImageAttribute2,
+						expr: &seqExpr{
+							pos: position{line: 861, col: 19, offset: 41412},
+							exprs: []interface{}{
+								&labeledExpr{
+									pos:   position{line: 861, col: 19, offset: 41412},
+									label: "key",
+									expr: &ruleRefExpr{
+										pos:  position{line: 861, col: 24, offset: 41417},
+										name: "AttributeKey",
+									},
+								},
+								&litMatcher{
+									pos:        position{line: 861, col: 38, offset: 41431},
+									val:        "=",
+									ignoreCase: false,
+								},
+								&labeledExpr{
+									pos:   position{line: 861, col: 42, offset: 41435},
+									label: "value",
+									expr: &ruleRefExpr{
+										pos:  position{line: 861, col: 49, offset: 41442},
+										name: "AttributeValue",
+									},
+								},
+							},
+						},
+					},
+					&actionExpr{
+						pos: position{line: 863, col: 5, offset: 41545},
+						run: (*parser).callonImageAttribute9,
+						expr: &seqExpr{
+							pos: position{line: 863, col: 5, offset: 41545},
+							exprs: []interface{}{
+								&zeroOrMoreExpr{
+									pos: position{line: 863, col: 5, offset: 41545},
+									expr: &ruleRefExpr{
+										pos:  position{line: 863, col: 5, offset: 41545},
+										name: "WS",
+									},
+								},
+								&litMatcher{
+									pos:        position{line: 863, col: 9, offset: 41549},
+									val:        "\"",
+									ignoreCase: false,
+								},
+								&labeledExpr{
+									pos:   position{line: 863, col: 14, offset: 41554},
+									label: "value",
+									expr: &actionExpr{
+										pos: position{line: 863, col: 21, offset: 41561},
+										run: (*parser).callonImageAttribute15,
+										expr: &zeroOrMoreExpr{
+											pos: position{line: 863, col: 21, offset: 41561},
+											expr: &seqExpr{
+												pos: position{line: 863, col: 22, offset: 41562},
+												exprs: []interface{}{
+													&notExpr{
+														pos: position{line: 863, col: 22, offset: 41562},
+														expr: &litMatcher{
+															pos:        position{line: 863, col: 23, offset: 41563},
+															val:        "\"",
+															ignoreCase: false,
+														},
+													},
+													&notExpr{
+														pos: position{line: 863, col: 28, offset: 41568},
+														expr: &ruleRefExpr{
+															pos:  position{line: 863, col: 29, offset: 41569},
+															name: "NEWLINE",
+														},
+													},
+													&anyMatcher{
+														line: 863, col: 37, offset: 41577,
+													},
+												},
+											},
+										},
+									},
+								},
+								&litMatcher{
+									pos:        position{line: 863, col: 73, offset: 41613},
+									val:        "\"",
+									ignoreCase: false,
+								},
+								&zeroOrMoreExpr{
+									pos: position{line: 863, col: 78, offset: 41618},
+									expr: &ruleRefExpr{
+										pos:  position{line: 863, col: 78, offset: 41618},
+										name: "WS",
+									},
+								},
+								&andExpr{
+									pos: position{line: 863, col: 82, offset: 41622},
+									expr: &choiceExpr{
+										pos: position{line: 863, col: 84, offset: 41624},
+										alternatives: []interface{}{
+											&litMatcher{
+												pos:        position{line: 863, col: 84, offset: 41624},
+												val:        ",",
+												ignoreCase: false,
+											},
+											&litMatcher{
+												pos:        position{line: 863, col: 90, offset: 41630},
+												val:        "]",
+												ignoreCase: false,
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+					&actionExpr{
+						pos: position{line: 865, col: 5, offset: 41663},
+						run: (*parser).callonImageAttribute30,
+						expr: &oneOrMoreExpr{
+							pos: position{line: 865, col: 5, offset: 41663},
+							expr: &seqExpr{
+								pos: position{line: 865, col: 6, offset: 41664},
+								exprs: []interface{}{
+									&notExpr{
+										pos: position{line: 865, col: 6, offset: 41664},
+										expr: &ruleRefExpr{
+											pos:  position{line: 865, col: 7, offset: 41665},
+											name: "NEWLINE",
+										},
+									},
+									&notExpr{
+										pos: position{line: 865, col: 15, offset: 41673},
+										expr: &litMatcher{
+											pos:        position{line: 865, col: 16, offset: 41674},
+											val:        ",",
+											ignoreCase: false,
+										},
+									},
+									&notExpr{
+										pos: position{line: 865, col: 20, offset: 41678},
+										expr: &litMatcher{
+											pos:        position{line: 865, col: 21, offset: 41679},
+											val:        "]",
+											ignoreCase: false,
+										},
+									},
+									&anyMatcher{
+										line: 865, col: 25, offset: 41683,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "InlineUIMacro",
+			pos:  position{line: 874, col: 1, offset: 42052},
+			expr: &actionExpr{
+				pos: position{line: 874, col: 18, offset: 42069},
+				run: (*parser).callonInlineUIMacro1,
+				expr: &seqExpr{
+					pos: position{line: 874, col: 18, offset: 42069},
+					exprs: []interface{}{
+						&andCodeExpr{
+							pos: position{line: 874, col: 18, offset: 42069},
+							run: (*parser).callonInlineUIMacro3,
+						},
+						&labeledExpr{
+							pos:   position{line: 874, col: 107, offset: 42158},
+							label: "macro",
+							expr: &choiceExpr{
+								pos: position{line: 874, col: 114, offset: 42165},
+								alternatives: []interface{}{
+									&ruleRefExpr{
+										pos:  position{line: 874, col: 114, offset: 42165},
+										name: "KeyboardMacro",
+									},
+									&ruleRefExpr{
+										pos:  position{line: 874, col: 130, offset: 42181},
+										name: "ButtonMacro",
+									},
+									&ruleRefExpr{
+										pos:  position{line: 874, col: 144, offset: 42195},
+										name: "MenuMacro",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "KeyboardMacro",
+			pos:  position{line: 878, col: 1, offset: 42233},
+			expr: &actionExpr{
+				pos: position{line: 878, col: 18, offset: 42250},
+				run: (*parser).callonKeyboardMacro1,
+				expr: &seqExpr{
+					pos: position{line: 878, col: 18, offset: 42250},
+					exprs: []interface{}{
+						&litMatcher{
+							pos:        position{line: 878, col: 18, offset: 42250},
+							val:        "kbd:[",
+							ignoreCase: false,
+						},
+						&labeledExpr{
+							pos:   position{line: 878, col: 26, offset: 42258},
+							label: "keys",
+							expr: &ruleRefExpr{
+								pos:  position{line: 878, col: 32, offset: 42264},
+								name: "UIMacroText",
+							},
+						},
+						&litMatcher{
+							pos:        position{line: 878, col: 45, offset: 42277},
+							val:        "]",
+							ignoreCase: false,
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "ButtonMacro",
+			pos:  position{line: 882, col: 1, offset: 42338},
+			expr: &actionExpr{
+				pos: position{line: 882, col: 16, offset: 42353},
+				run: (*parser).callonButtonMacro1,
+				expr: &seqExpr{
+					pos: position{line: 882, col: 16, offset: 42353},
+					exprs: []interface{}{
+						&litMatcher{
+							pos:        position{line: 882, col: 16, offset: 42353},
+							val:        "btn:[",
+							ignoreCase: false,
+						},
+						&labeledExpr{
+							pos:   position{line: 882, col: 24, offset: 42361},
+							label: "label",
+							expr: &ruleRefExpr{
+								pos:  position{line: 882, col: 31, offset: 42368},
+								name: "UIMacroText",
+							},
+						},
+						&litMatcher{
+							pos:        position{line: 882, col: 44, offset: 42381},
+							val:        "]",
+							ignoreCase: false,
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "MenuMacro",
+			pos:  position{line: 886, col: 1, offset: 42433},
+			expr: &actionExpr{
+				pos: position{line: 886, col: 14, offset: 42446},
+				run: (*parser).callonMenuMacro1,
+				expr: &seqExpr{
+					pos: position{line: 886, col: 14, offset: 42446},
+					exprs: []interface{}{
+						&litMatcher{
+							pos:        position{line: 886, col: 14, offset: 42446},
+							val:        "menu:",
+							ignoreCase: false,
+						},
+						&labeledExpr{
+							pos:   position{line: 886, col: 22, offset: 42454},
+							label: "menu",
+							expr: &actionExpr{
+								pos: position{line: 886, col: 28, offset: 42460},
+								run: (*parser).callonMenuMacro5,
+								expr: &oneOrMoreExpr{
+									pos: position{line: 886, col: 28, offset: 42460},
+									expr: &seqExpr{
+										pos: position{line: 886, col: 29, offset: 42461},
+										exprs: []interface{}{
+											&notExpr{
+												pos: position{line: 886, col: 29, offset: 42461},
+												expr: &ruleRefExpr{
+													pos:  position{line: 886, col: 30, offset: 42462},
+													name: "NEWLINE",
+												},
+											},
+											&notExpr{
+												pos: position{line: 886, col: 38, offset: 42470},
+												expr: &ruleRefExpr{
+													pos:  position{line: 886, col: 39, offset: 42471},
+													name: "WS",
+												},
+											},
+											&notExpr{
+												pos: position{line: 886, col: 42, offset: 42474},
+												expr: &litMatcher{
+													pos:        position{line: 886, col: 43, offset: 42475},
+													val:        "[",
+													ignoreCase: false,
+												},
+											},
+											&anyMatcher{
+												line: 886, col: 47, offset: 42479,
+											},
+										},
+									},
+								},
+							},
+						},
+						&litMatcher{
+							pos:        position{line: 886, col: 83, offset: 42515},
+							val:        "[",
+							ignoreCase: false,
+						},
+						&labeledExpr{
+							pos:   position{line: 886, col: 87, offset: 42519},
+							label: "items",
+							expr: &zeroOrOneExpr{
+								pos: position{line: 886, col: 93, offset: 42525},
+								expr: &ruleRefExpr{
+									pos:  position{line: 886, col: 94, offset: 42526},
+									name: "UIMacroText",
+								},
+							},
+						},
+						&litMatcher{
+							pos:        position{line: 886, col: 108, offset: 42540},
+							val:        "]",
+							ignoreCase: false,
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "UIMacroText",
+			pos:  position{line: 890, col: 1, offset: 42605},
+			expr: &actionExpr{
+				pos: position{line: 890, col: 16, offset: 42620},
+				run: (*parser).callonUIMacroText1,
+				expr: &oneOrMoreExpr{
+					pos: position{line: 890, col: 16, offset: 42620},
+					expr: &seqExpr{
+						pos: position{line: 890, col: 17, offset: 42621},
+						exprs: []interface{}{
+							&notExpr{
+								pos: position{line: 890, col: 17, offset: 42621},
+								expr: &ruleRefExpr{
+									pos:  position{line: 890, col: 18, offset: 42622},
+									name: "NEWLINE",
+								},
+							},
+							&notExpr{
+								pos: position{line: 890, col: 26, offset: 42630},
+								expr: &litMatcher{
+									pos:        position{line: 890, col: 27, offset: 42631},
+									val:        "]",
+									ignoreCase: false,
+								},
+							},
+							&anyMatcher{
+								line: 890, col: 31, offset: 42635,
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "VideoBlock",
+			pos:  position{line: 899, col: 1, offset: 42975},
+			expr: &actionExpr{
+				pos: position{line: 899, col: 15, offset: 42989},
+				run: (*parser).callonVideoBlock1,
+				expr: &seqExpr{
+					pos: position{line: 899, col: 15, offset: 42989},
+					exprs: []interface{}{
+						&labeledExpr{
+							pos:   position{line: 899, col: 15, offset: 42989},
+							label: "attributes",
+							expr: &zeroOrMoreExpr{
+								pos: position{line: 899, col: 26, offset: 43000},
+								expr: &ruleRefExpr{
+									pos:  position{line: 899, col: 27, offset: 43001},
+									name: "ElementAttribute",
+								},
+							},
+						},
+						&litMatcher{
+							pos:        position{line: 899, col: 46, offset: 43020},
+							val:        "video::",
+							ignoreCase: false,
+						},
+						&labeledExpr{
+							pos:   position{line: 899, col: 56, offset: 43030},
+							label: "path",
+							expr: &ruleRefExpr{
+								pos:  position{line: 899, col: 62, offset: 43036},
+								name: "URL",
+							},
+						},
+						&litMatcher{
+							pos:        position{line: 899, col: 67, offset: 43041},
+							val:        "[",
+							ignoreCase: false,
+						},
+						&labeledExpr{
+							pos:   position{line: 899, col: 71, offset: 43045},
+							label: "macroAttributes",
+							expr: &zeroOrMoreExpr{
+								pos: position{line: 899, col: 87, offset: 43061},
+								expr: &ruleRefExpr{
+									pos:  position{line: 899, col: 88, offset: 43062},
+									name: "GenericAttribute",
+								},
+							},
+						},
+						&litMatcher{
+							pos:        position{line: 899, col: 107, offset: 43081},
+							val:        "]",
+							ignoreCase: false,
+						},
+						&zeroOrMoreExpr{
+							pos: position{line: 899, col: 111, offset: 43085},
+							expr: &ruleRefExpr{
+								pos:  position{line: 899, col: 111, offset: 43085},
+								name: "WS",
+							},
+						},
+						&ruleRefExpr{
+							pos:  position{line: 899, col: 115, offset: 43089},
+							name: "EOL",
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "AudioBlock",
+			pos:  position{line: 904, col: 1, offset: 43281},
+			expr: &actionExpr{
+				pos: position{line: 904, col: 15, offset: 43295},
+				run: (*parser).callonAudioBlock1,
+				expr: &seqExpr{
+					pos: position{line: 904, col: 15, offset: 43295},
+					exprs: []interface{}{
+						&labeledExpr{
+							pos:   position{line: 904, col: 15, offset: 43295},
+							label: "attributes",
+							expr: &zeroOrMoreExpr{
+								pos: position{line: 904, col: 26, offset: 43306},
+								expr: &ruleRefExpr{
+									pos:  position{line: 904, col: 27, offset: 43307},
+									name: "ElementAttribute",
+								},
+							},
+						},
+						&litMatcher{
+							pos:        position{line: 904, col: 46, offset: 43326},
+							val:        "audio::",
+							ignoreCase: false,
+						},
+						&labeledExpr{
+							pos:   position{line: 904, col: 56, offset: 43336},
+							label: "path",
+							expr: &ruleRefExpr{
+								pos:  position{line: 904, col: 62, offset: 43342},
+								name: "URL",
+							},
+						},
+						&litMatcher{
+							pos:        position{line: 904, col: 67, offset: 43347},
+							val:        "[",
+							ignoreCase: false,
+						},
+						&labeledExpr{
+							pos:   position{line: 904, col: 71, offset: 43351},
+							label: "macroAttributes",
+							expr: &zeroOrMoreExpr{
+								pos: position{line: 904, col: 87, offset: 43367},
+								expr: &ruleRefExpr{
+									pos:  position{line: 904, col: 88, offset: 43368},
+									name: "GenericAttribute",
+								},
+							},
+						},
+						&litMatcher{
+							pos:        position{line: 904, col: 107, offset: 43387},
+							val:        "]",
+							ignoreCase: false,
+						},
+						&zeroOrMoreExpr{
+							pos: position{line: 904, col: 111, offset: 43391},
+							expr: &ruleRefExpr{
+								pos:  position{line: 904, col: 111, offset: 43391},
+								name: "WS",
+							},
+						},
+						&ruleRefExpr{
+							pos:  position{line: 904, col: 115, offset: 43395},
+							name: "EOL",
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "DelimitedBlock",
+			pos:  position{line: 911, col: 1, offset: 43774},
+			expr: &choiceExpr{
+				pos: position{line: 911, col: 19, offset: 43792},
+				alternatives: []interface{}{
+					&ruleRefExpr{
+						pos:  position{line: 911, col: 19, offset: 43792},
+						name: "FencedBlock",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 911, col: 33, offset: 43806},
+						name: "ListingBlock",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 911, col: 48, offset: 43821},
+						name: "ExampleBlock",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 911, col: 63, offset: 43836},
+						name: "SidebarBlock",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 911, col: 78, offset: 43851},
+						name: "VerseBlock",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 911, col: 91, offset: 43864},
+						name: "QuoteBlock",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 911, col: 104, offset: 43877},
+						name: "OpenBlock",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 911, col: 116, offset: 43889},
+						name: "StemBlock",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 911, col: 128, offset: 43901},
+						name: "PassthroughBlock",
+					},
+				},
+			},
+		},
+		{
+			name: "BlockDelimiter",
+			pos:  position{line: 913, col: 1, offset: 43919},
+			expr: &choiceExpr{
+				pos: position{line: 913, col: 19, offset: 43937},
+				alternatives: []interface{}{
+					&ruleRefExpr{
+						pos:  position{line: 913, col: 19, offset: 43937},
+						name: "LiteralBlockDelimiter",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 913, col: 43, offset: 43961},
+						name: "FencedBlockDelimiter",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 913, col: 66, offset: 43984},
+						name: "ListingBlockDelimiter",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 913, col: 90, offset: 44008},
+						name: "ExampleBlockDelimiter",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 913, col: 114, offset: 44032},
+						name: "CommentBlockDelimiter",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 913, col: 138, offset: 44056},
+						name: "TableDelimiter",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 913, col: 155, offset: 44073},
+						name: "SidebarBlockDelimiter",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 913, col: 179, offset: 44097},
+						name: "QuoteBlockDelimiter",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 913, col: 201, offset: 44119},
+						name: "OpenBlockDelimiter",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 913, col: 222, offset: 44140},
+						name: "PassthroughBlockDelimiter",
+					},
+				},
+			},
+		},
+		{
+			name: "FencedBlockDelimiter",
+			pos:  position{line: 915, col: 1, offset: 44167},
+			expr: &litMatcher{
+				pos:        position{line: 915, col: 25, offset: 44191},
+				val:        "```",
+				ignoreCase: false,
+			},
+		},
+		{
+			name: "FencedBlock",
+			pos:  position{line: 918, col: 1, offset: 44269},
+			expr: &actionExpr{
+				pos: position{line: 918, col: 16, offset: 44284},
+				run: (*parser).callonFencedBlock1,
+				expr: &seqExpr{
+					pos: position{line: 918, col: 16, offset: 44284},
+					exprs: []interface{}{
+						&labeledExpr{
+							pos:   position{line: 918, col: 16, offset: 44284},
+							label: "attributes",
+							expr: &zeroOrMoreExpr{
+								pos: position{line: 918, col: 27, offset: 44295},
+								expr: &ruleRefExpr{
+									pos:  position{line: 918, col: 28, offset: 44296},
+									name: "ElementAttribute",
+								},
+							},
+						},
+						&ruleRefExpr{
+							pos:  position{line: 918, col: 47, offset: 44315},
+							name: "FencedBlockDelimiter",
+						},
+						&labeledExpr{
+							pos:   position{line: 918, col: 68, offset: 44336},
+							label: "language",
+							expr: &zeroOrOneExpr{
+								pos: position{line: 918, col: 77, offset: 44345},
+								expr: &ruleRefExpr{
+									pos:  position{line: 918, col: 78, offset: 44346},
+									name: "SourceLanguage",
+								},
+							},
+						},
+						&zeroOrMoreExpr{
+							pos: position{line: 918, col: 95, offset: 44363},
+							expr: &ruleRefExpr{
+								pos:  position{line: 918, col: 95, offset: 44363},
+								name: "WS",
+							},
+						},
+						&ruleRefExpr{
+							pos:  position{line: 918, col: 99, offset: 44367},
+							name: "NEWLINE",
+						},
+						&labeledExpr{
+							pos:   position{line: 918, col: 107, offset: 44375},
+							label: "content",
+							expr: &zeroOrMoreExpr{
+								pos: position{line: 918, col: 115, offset: 44383},
+								expr: &seqExpr{
+									pos: position{line: 918, col: 116, offset: 44384},
+									exprs: []interface{}{
+										&notExpr{
+											pos: position{line: 918, col: 116, offset: 44384},
+											expr: &ruleRefExpr{
+												pos:  position{line: 918, col: 117, offset: 44385},
+												name: "FencedBlockDelimiter",
+											},
+										},
+										&anyMatcher{
+											line: 918, col: 138, offset: 44406,
+										},
+									},
+								},
+							},
+						},
+						&ruleRefExpr{
+							pos:  position{line: 918, col: 142, offset: 44410},
+							name: "FencedBlockDelimiter",
+						},
+						&zeroOrMoreExpr{
+							pos: position{line: 918, col: 163, offset: 44431},
+							expr: &ruleRefExpr{
+								pos:  position{line: 918, col: 163, offset: 44431},
+								name: "WS",
+							},
+						},
+						&ruleRefExpr{
+							pos:  position{line: 918, col: 167, offset: 44435},
+							name: "EOL",
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "ListingBlockDelimiter",
+			pos:  position{line: 932, col: 1, offset: 44864},
+			expr: &litMatcher{
+				pos:        position{line: 932, col: 26, offset: 44889},
+				val:        "----",
+				ignoreCase: false,
+			},
+		},
+		{
+			name: "ListingBlock",
+			pos:  position{line: 934, col: 1, offset: 44897},
+			expr: &actionExpr{
+				pos: position{line: 934, col: 17, offset: 44913},
+				run: (*parser).callonListingBlock1,
+				expr: &seqExpr{
+					pos: position{line: 934, col: 17, offset: 44913},
+					exprs: []interface{}{
+						&labeledExpr{
+							pos:   position{line: 934, col: 17, offset: 44913},
+							label: "attributes",
+							expr: &zeroOrMoreExpr{
+								pos: position{line: 934, col: 28, offset: 44924},
+								expr: &ruleRefExpr{
+									pos:  position{line: 934, col: 29, offset: 44925},
+									name: "ElementAttribute",
+								},
+							},
+						},
+						&ruleRefExpr{
+							pos:  position{line: 934, col: 48, offset: 44944},
+							name: "ListingBlockDelimiter",
+						},
+						&zeroOrMoreExpr{
+							pos: position{line: 934, col: 70, offset: 44966},
+							expr: &ruleRefExpr{
+								pos:  position{line: 934, col: 70, offset: 44966},
+								name: "WS",
+							},
+						},
+						&ruleRefExpr{
+							pos:  position{line: 934, col: 74, offset: 44970},
+							name: "NEWLINE",
+						},
+						&labeledExpr{
+							pos:   position{line: 934, col: 82, offset: 44978},
+							label: "content",
+							expr: &zeroOrMoreExpr{
+								pos: position{line: 934, col: 90, offset: 44986},
+								expr: &seqExpr{
+									pos: position{line: 934, col: 91, offset: 44987},
+									exprs: []interface{}{
+										&notExpr{
+											pos: position{line: 934, col: 91, offset: 44987},
+											expr: &ruleRefExpr{
+												pos:  position{line: 934, col: 92, offset: 44988},
+												name: "ListingBlockDelimiter",
+											},
+										},
+										&anyMatcher{
+											line: 934, col: 114, offset: 45010,
+										},
+									},
+								},
+							},
+						},
+						&ruleRefExpr{
+							pos:  position{line: 934, col: 118, offset: 45014},
+							name: "ListingBlockDelimiter",
+						},
+						&zeroOrMoreExpr{
+							pos: position{line: 934, col: 140, offset: 45036},
+							expr: &ruleRefExpr{
+								pos:  position{line: 934, col: 140, offset: 45036},
+								name: "WS",
+							},
+						},
+						&ruleRefExpr{
+							pos:  position{line: 934, col: 144, offset: 45040},
+							name: "EOL",
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "ExampleBlockDelimiter",
+			pos:  position{line: 942, col: 1, offset: 45252},
+			expr: &litMatcher{
+				pos:        position{line: 942, col: 26, offset: 45277},
+				val:        "====",
+				ignoreCase: false,
+			},
+		},
+		{
+			name: "ExampleBlock",
+			pos:  position{line: 945, col: 1, offset: 45382},
+			expr: &actionExpr{
+				pos: position{line: 945, col: 17, offset: 45398},
+				run: (*parser).callonExampleBlock1,
+				expr: &seqExpr{
+					pos: position{line: 945, col: 17, offset: 45398},
+					exprs: []interface{}{
+						&labeledExpr{
+							pos:   position{line: 945, col: 17, offset: 45398},
+							label: "attributes",
+							expr: &zeroOrMoreExpr{
+								pos: position{line: 945, col: 28, offset: 45409},
+								expr: &ruleRefExpr{
+									pos:  position{line: 945, col: 29, offset: 45410},
+									name: "ElementAttribute",
+								},
+							},
+						},
+						&ruleRefExpr{
+							pos:  position{line: 945, col: 48, offset: 45429},
+							name: "ExampleBlockDelimiter",
+						},
+						&zeroOrMoreExpr{
+							pos: position{line: 945, col: 70, offset: 45451},
+							expr: &ruleRefExpr{
+								pos:  position{line: 945, col: 70, offset: 45451},
+								name: "WS",
+							},
+						},
+						&ruleRefExpr{
+							pos:  position{line: 945, col: 74, offset: 45455},
+							name: "NEWLINE",
+						},
+						&labeledExpr{
+							pos:   position{line: 945, col: 82, offset: 45463},
+							label: "content",
+							expr: &zeroOrMoreExpr{
+								pos: position{line: 945, col: 90, offset: 45471},
+								expr: &seqExpr{
+									pos: position{line: 945, col: 91, offset: 45472},
+									exprs: []interface{}{
+										&notExpr{
+											pos: position{line: 945, col: 91, offset: 45472},
+											expr: &ruleRefExpr{
+												pos:  position{line: 945, col: 92, offset: 45473},
+												name: "ExampleBlockDelimiter",
+											},
+										},
+										&ruleRefExpr{
+											pos:  position{line: 945, col: 114, offset: 45495},
+											name: "BlockElement",
+										},
+									},
+								},
+							},
+						},
+						&ruleRefExpr{
+							pos:  position{line: 945, col: 129, offset: 45510},
+							name: "ExampleBlockDelimiter",
+						},
+						&zeroOrMoreExpr{
+							pos: position{line: 945, col: 151, offset: 45532},
+							expr: &ruleRefExpr{
+								pos:  position{line: 945, col: 151, offset: 45532},
+								name: "WS",
+							},
+						},
+						&ruleRefExpr{
+							pos:  position{line: 945, col: 155, offset: 45536},
+							name: "EOL",
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "SidebarBlockDelimiter",
+			pos:  position{line: 950, col: 1, offset: 45773},
+			expr: &seqExpr{
+				pos: position{line: 950, col: 26, offset: 45798},
+				exprs: []interface{}{
+					&litMatcher{
+						pos:        position{line: 950, col: 26, offset: 45798},
+						val:        "****",
+						ignoreCase: false,
+					},
+					&andExpr{
+						pos: position{line: 950, col: 33, offset: 45805},
+						expr: &seqExpr{
+							pos: position{line: 950, col: 35, offset: 45807},
+							exprs: []interface{}{
+								&zeroOrMoreExpr{
+									pos: position{line: 950, col: 35, offset: 45807},
+									expr: &ruleRefExpr{
+										pos:  position{line: 950, col: 35, offset: 45807},
+										name: "WS",
+									},
+								},
+								&ruleRefExpr{
+									pos:  position{line: 950, col: 39, offset: 45811},
+									name: "EOL",
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "SidebarBlock",
+			pos:  position{line: 952, col: 1, offset: 45817},
+			expr: &actionExpr{
+				pos: position{line: 952, col: 17, offset: 45833},
+				run: (*parser).callonSidebarBlock1,
+				expr: &seqExpr{
+					pos: position{line: 952, col: 17, offset: 45833},
+					exprs: []interface{}{
+						&labeledExpr{
+							pos:   position{line: 952, col: 17, offset: 45833},
+							label: "attributes",
+							expr: &zeroOrMoreExpr{
+								pos: position{line: 952, col: 28, offset: 45844},
+								expr: &ruleRefExpr{
+									pos:  position{line: 952, col: 29, offset: 45845},
+									name: "ElementAttribute",
+								},
+							},
+						},
+						&ruleRefExpr{
+							pos:  position{line: 952, col: 48, offset: 45864},
+							name: "SidebarBlockDelimiter",
+						},
+						&zeroOrMoreExpr{
+							pos: position{line: 952, col: 70, offset: 45886},
+							expr: &ruleRefExpr{
+								pos:  position{line: 952, col: 70, offset: 45886},
+								name: "WS",
+							},
+						},
+						&ruleRefExpr{
+							pos:  position{line: 952, col: 74, offset: 45890},
+							name: "NEWLINE",
+						},
+						&labeledExpr{
+							pos:   position{line: 952, col: 82, offset: 45898},
+							label: "content",
+							expr: &zeroOrMoreExpr{
+								pos: position{line: 952, col: 90, offset: 45906},
+								expr: &seqExpr{
+									pos: position{line: 952, col: 91, offset: 45907},
+									exprs: []interface{}{
+										&notExpr{
+											pos: position{line: 952, col: 91, offset: 45907},
+											expr: &ruleRefExpr{
+												pos:  position{line: 952, col: 92, offset: 45908},
+												name: "SidebarBlockDelimiter",
+											},
+										},
+										&ruleRefExpr{
+											pos:  position{line: 952, col: 114, offset: 45930},
+											name: "BlockElement",
+										},
+									},
+								},
+							},
+						},
+						&ruleRefExpr{
+							pos:  position{line: 952, col: 129, offset: 45945},
+							name: "SidebarBlockDelimiter",
+						},
+						&zeroOrMoreExpr{
+							pos: position{line: 952, col: 151, offset: 45967},
+							expr: &ruleRefExpr{
+								pos:  position{line: 952, col: 151, offset: 45967},
+								name: "WS",
+							},
+						},
+						&ruleRefExpr{
+							pos:  position{line: 952, col: 155, offset: 45971},
+							name: "EOL",
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "QuoteBlockDelimiter",
+			pos:  position{line: 956, col: 1, offset: 46088},
+			expr: &seqExpr{
+				pos: position{line: 956, col: 24, offset: 46111},
+				exprs: []interface{}{
+					&litMatcher{
+						pos:        position{line: 956, col: 24, offset: 46111},
+						val:        "____",
+						ignoreCase: false,
+					},
+					&andExpr{
+						pos: position{line: 956, col: 31, offset: 46118},
+						expr: &seqExpr{
+							pos: position{line: 956, col: 33, offset: 46120},
+							exprs: []interface{}{
+								&zeroOrMoreExpr{
+									pos: position{line: 956, col: 33, offset: 46120},
+									expr: &ruleRefExpr{
+										pos:  position{line: 956, col: 33, offset: 46120},
+										name: "WS",
+									},
+								},
+								&ruleRefExpr{
+									pos:  position{line: 956, col: 37, offset: 46124},
+									name: "EOL",
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "QuoteBlock",
+			pos:  position{line: 958, col: 1, offset: 46130},
+			expr: &actionExpr{
+				pos: position{line: 958, col: 15, offset: 46144},
+				run: (*parser).callonQuoteBlock1,
+				expr: &seqExpr{
+					pos: position{line: 958, col: 15, offset: 46144},
+					exprs: []interface{}{
+						&labeledExpr{
+							pos:   position{line: 958, col: 15, offset: 46144},
+							label: "attributes",
+							expr: &zeroOrMoreExpr{
+								pos: position{line: 958, col: 26, offset: 46155},
+								expr: &ruleRefExpr{
+									pos:  position{line: 958, col: 27, offset: 46156},
+									name: "ElementAttribute",
+								},
+							},
+						},
+						&ruleRefExpr{
+							pos:  position{line: 958, col: 46, offset: 46175},
+							name: "QuoteBlockDelimiter",
+						},
+						&zeroOrMoreExpr{
+							pos: position{line: 958, col: 66, offset: 46195},
+							expr: &ruleRefExpr{
+								pos:  position{line: 958, col: 66, offset: 46195},
+								name: "WS",
+							},
+						},
+						&ruleRefExpr{
+							pos:  position{line: 958, col: 70, offset: 46199},
+							name: "NEWLINE",
+						},
+						&labeledExpr{
+							pos:   position{line: 958, col: 78, offset: 46207},
+							label: "content",
+							expr: &zeroOrMoreExpr{
+								pos: position{line: 958, col: 86, offset: 46215},
+								expr: &seqExpr{
+									pos: position{line: 958, col: 87, offset: 46216},
+									exprs: []interface{}{
+										&notExpr{
+											pos: position{line: 958, col: 87, offset: 46216},
+											expr: &ruleRefExpr{
+												pos:  position{line: 958, col: 88, offset: 46217},
+												name: "QuoteBlockDelimiter",
+											},
+										},
+										&ruleRefExpr{
+											pos:  position{line: 958, col: 108, offset: 46237},
+											name: "BlockElement",
+										},
+									},
+								},
+							},
+						},
+						&ruleRefExpr{
+							pos:  position{line: 958, col: 123, offset: 46252},
+							name: "QuoteBlockDelimiter",
+						},
+						&zeroOrMoreExpr{
+							pos: position{line: 958, col: 143, offset: 46272},
+							expr: &ruleRefExpr{
+								pos:  position{line: 958, col: 143, offset: 46272},
+								name: "WS",
+							},
+						},
+						&ruleRefExpr{
+							pos:  position{line: 958, col: 147, offset: 46276},
+							name: "EOL",
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "VerseBlock",
+			pos:  position{line: 963, col: 1, offset: 46494},
+			expr: &actionExpr{
+				pos: position{line: 963, col: 15, offset: 46508},
+				run: (*parser).callonVerseBlock1,
+				expr: &seqExpr{
+					pos: position{line: 963, col: 15, offset: 46508},
+					exprs: []interface{}{
+						&labeledExpr{
+							pos:   position{line: 963, col: 15, offset: 46508},
+							label: "before",
+							expr: &zeroOrMoreExpr{
+								pos: position{line: 963, col: 22, offset: 46515},
+								expr: &actionExpr{
+									pos: position{line: 963, col: 23, offset: 46516},
+									run: (*parser).callonVerseBlock5,
+									expr: &seqExpr{
+										pos: position{line: 963, col: 23, offset: 46516},
+										exprs: []interface{}{
+											&notExpr{
+												pos: position{line: 963, col: 23, offset: 46516},
+												expr: &ruleRefExpr{
+													pos:  position{line: 963, col: 24, offset: 46517},
+													name: "VerseBlockAttribute",
+												},
+											},
+											&labeledExpr{
+												pos:   position{line: 963, col: 44, offset: 46537},
+												label: "attr",
+												expr: &ruleRefExpr{
+													pos:  position{line: 963, col: 50, offset: 46543},
+													name: "ElementAttribute",
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 963, col: 91, offset: 46584},
+							label: "verse",
+							expr: &ruleRefExpr{
+								pos:  position{line: 963, col: 98, offset: 46591},
+								name: "VerseBlockAttribute",
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 963, col: 119, offset: 46612},
+							label: "after",
+							expr: &zeroOrMoreExpr{
+								pos: position{line: 963, col: 125, offset: 46618},
+								expr: &ruleRefExpr{
+									pos:  position{line: 963, col: 126, offset: 46619},
+									name: "ElementAttribute",
+								},
+							},
+						},
+						&ruleRefExpr{
+							pos:  position{line: 963, col: 145, offset: 46638},
+							name: "QuoteBlockDelimiter",
+						},
+						&zeroOrMoreExpr{
+							pos: position{line: 963, col: 165, offset: 46658},
+							expr: &ruleRefExpr{
+								pos:  position{line: 963, col: 165, offset: 46658},
+								name: "WS",
+							},
+						},
+						&ruleRefExpr{
+							pos:  position{line: 963, col: 169, offset: 46662},
+							name: "NEWLINE",
+						},
+						&labeledExpr{
+							pos:   position{line: 963, col: 177, offset: 46670},
+							label: "content",
+							expr: &zeroOrMoreExpr{
+								pos: position{line: 963, col: 185, offset: 46678},
+								expr: &ruleRefExpr{
+									pos:  position{line: 963, col: 186, offset: 46679},
+									name: "VerseBlockLine",
+								},
+							},
+						},
+						&ruleRefExpr{
+							pos:  position{line: 963, col: 203, offset: 46696},
+							name: "QuoteBlockDelimiter",
+						},
+						&zeroOrMoreExpr{
+							pos: position{line: 963, col: 223, offset: 46716},
+							expr: &ruleRefExpr{
+								pos:  position{line: 963, col: 223, offset: 46716},
+								name: "WS",
+							},
+						},
+						&ruleRefExpr{
+							pos:  position{line: 963, col: 227, offset: 46720},
+							name: "EOL",
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "VerseBlockAttribute",
+			pos:  position{line: 969, col: 1, offset: 46937},
+			expr: &actionExpr{
+				pos: position{line: 969, col: 24, offset: 46960},
+				run: (*parser).callonVerseBlockAttribute1,
+				expr: &seqExpr{
+					pos: position{line: 969, col: 24, offset: 46960},
+					exprs: []interface{}{
+						&labeledExpr{
+							pos:   position{line: 969, col: 24, offset: 46960},
+							label: "attr",
+							expr: &ruleRefExpr{
+								pos:  position{line: 969, col: 30, offset: 46966},
+								name: "VerseAttributes",
+							},
+						},
+						&ruleRefExpr{
+							pos:  position{line: 969, col: 47, offset: 46983},
+							name: "EOL",
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "VerseBlockLine",
+			pos:  position{line: 973, col: 1, offset: 47013},
+			expr: &actionExpr{
+				pos: position{line: 973, col: 19, offset: 47031},
+				run: (*parser).callonVerseBlockLine1,
+				expr: &seqExpr{
+					pos: position{line: 973, col: 19, offset: 47031},
+					exprs: []interface{}{
+						&notExpr{
+							pos: position{line: 973, col: 19, offset: 47031},
+							expr: &ruleRefExpr{
+								pos:  position{line: 973, col: 20, offset: 47032},
+								name: "QuoteBlockDelimiter",
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 973, col: 40, offset: 47052},
+							label: "line",
+							expr: &choiceExpr{
+								pos: position{line: 973, col: 46, offset: 47058},
+								alternatives: []interface{}{
+									&ruleRefExpr{
+										pos:  position{line: 973, col: 46, offset: 47058},
+										name: "InlineContentWithTrailingSpaces",
+									},
+									&zeroOrMoreExpr{
+										pos: position{line: 973, col: 80, offset: 47092},
+										expr: &ruleRefExpr{
+											pos:  position{line: 973, col: 80, offset: 47092},
+											name: "WS",
+										},
+									},
+								},
+							},
+						},
+						&ruleRefExpr{
+							pos:  position{line: 973, col: 85, offset: 47097},
+							name: "EOL",
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "OpenBlockDelimiter",
+			pos:  position{line: 981, col: 1, offset: 47288},
+			expr: &seqExpr{
+				pos: position{line: 981, col: 23, offset: 47310},
+				exprs: []interface{}{
+					&litMatcher{
+						pos:        position{line: 981, col: 23, offset: 47310},
+						val:        "--",
+						ignoreCase: false,
+					},
+					&andExpr{
+						pos: position{line: 981, col: 28, offset: 47315},
+						expr: &seqExpr{
+							pos: position{line: 981, col: 30, offset: 47317},
+							exprs: []interface{}{
+								&zeroOrMoreExpr{
+									pos: position{line: 981, col: 30, offset: 47317},
+									expr: &ruleRefExpr{
+										pos:  position{line: 981, col: 30, offset: 47317},
+										name: "WS",
+									},
+								},
+								&ruleRefExpr{
+									pos:  position{line: 981, col: 34, offset: 47321},
+									name: "EOL",
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "OpenBlock",
+			pos:  position{line: 983, col: 1, offset: 47327},
+			expr: &actionExpr{
+				pos: position{line: 983, col: 14, offset: 47340},
+				run: (*parser).callonOpenBlock1,
+				expr: &seqExpr{
+					pos: position{line: 983, col: 14, offset: 47340},
+					exprs: []interface{}{
+						&labeledExpr{
+							pos:   position{line: 983, col: 14, offset: 47340},
+							label: "attributes",
+							expr: &zeroOrMoreExpr{
+								pos: position{line: 983, col: 25, offset: 47351},
+								expr: &ruleRefExpr{
+									pos:  position{line: 983, col: 26, offset: 47352},
+									name: "ElementAttribute",
+								},
+							},
+						},
+						&ruleRefExpr{
+							pos:  position{line: 983, col: 45, offset: 47371},
+							name: "OpenBlockDelimiter",
+						},
+						&zeroOrMoreExpr{
+							pos: position{line: 983, col: 64, offset: 47390},
+							expr: &ruleRefExpr{
+								pos:  position{line: 983, col: 64, offset: 47390},
+								name: "WS",
+							},
+						},
+						&ruleRefExpr{
+							pos:  position{line: 983, col: 68, offset: 47394},
+							name: "NEWLINE",
+						},
+						&labeledExpr{
+							pos:   position{line: 983, col: 76, offset: 47402},
+							label: "content",
+							expr: &zeroOrMoreExpr{
+								pos: position{line: 983, col: 84, offset: 47410},
+								expr: &seqExpr{
+									pos: position{line: 983, col: 85, offset: 47411},
+									exprs: []interface{}{
+										&notExpr{
+											pos: position{line: 983, col: 85, offset: 47411},
+											expr: &ruleRefExpr{
+												pos:  position{line: 983, col: 86, offset: 47412},
+												name: "OpenBlockDelimiter",
+											},
+										},
+										&ruleRefExpr{
+											pos:  position{line: 983, col: 105, offset: 47431},
+											name: "BlockElement",
+										},
+									},
+								},
+							},
+						},
+						&ruleRefExpr{
+							pos:  position{line: 983, col: 120, offset: 47446},
+							name: "OpenBlockDelimiter",
+						},
+						&zeroOrMoreExpr{
+							pos: position{line: 983, col: 139, offset: 47465},
+							expr: &ruleRefExpr{
+								pos:  position{line: 983, col: 139, offset: 47465},
+								name: "WS",
+							},
+						},
+						&ruleRefExpr{
+							pos:  position{line: 983, col: 143, offset: 47469},
+							name: "EOL",
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "PassthroughBlockDelimiter",
+			pos:  position{line: 987, col: 1, offset: 47583},
+			expr: &seqExpr{
+				pos: position{line: 987, col: 30, offset: 47612},
+				exprs: []interface{}{
+					&litMatcher{
+						pos:        position{line: 987, col: 30, offset: 47612},
+						val:        "++++",
+						ignoreCase: false,
+					},
+					&andExpr{
+						pos: position{line: 987, col: 37, offset: 47619},
+						expr: &seqExpr{
+							pos: position{line: 987, col: 39, offset: 47621},
+							exprs: []interface{}{
+								&zeroOrMoreExpr{
+									pos: position{line: 987, col: 39, offset: 47621},
+									expr: &ruleRefExpr{
+										pos:  position{line: 987, col: 39, offset: 47621},
+										name: "WS",
+									},
+								},
+								&ruleRefExpr{
+									pos:  position{line: 987, col: 43, offset: 47625},
+									name: "EOL",
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "StemBlock",
+			pos:  position{line: 990, col: 1, offset: 47744},
+			expr: &actionExpr{
+				pos: position{line: 990, col: 14, offset: 47757},
+				run: (*parser).callonStemBlock1,
+				expr: &seqExpr{
+					pos: position{line: 990, col: 14, offset: 47757},
+					exprs: []interface{}{
+						&labeledExpr{
+							pos:   position{line: 990, col: 14, offset: 47757},
+							label: "before",
+							expr: &zeroOrMoreExpr{
+								pos: position{line: 990, col: 21, offset: 47764},
+								expr: &actionExpr{
+									pos: position{line: 990, col: 22, offset: 47765},
+									run: (*parser).callonStemBlock5,
+									expr: &seqExpr{
+										pos: position{line: 990, col: 22, offset: 47765},
+										exprs: []interface{}{
+											&notExpr{
+												pos: position{line: 990, col: 22, offset: 47765},
+												expr: &ruleRefExpr{
+													pos:  position{line: 990, col: 23, offset: 47766},
+													name: "StemBlockAttribute",
+												},
+											},
+											&labeledExpr{
+												pos:   position{line: 990, col: 42, offset: 47785},
+												label: "attr",
+												expr: &ruleRefExpr{
+													pos:  position{line: 990, col: 48, offset: 47791},
+													name: "ElementAttribute",
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 990, col: 89, offset: 47832},
+							label: "stem",
+							expr: &ruleRefExpr{
+								pos:  position{line: 990, col: 95, offset: 47838},
+								name: "StemBlockAttribute",
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 990, col: 115, offset: 47858},
+							label: "after",
+							expr: &zeroOrMoreExpr{
+								pos: position{line: 990, col: 121, offset: 47864},
+								expr: &ruleRefExpr{
+									pos:  position{line: 990, col: 122, offset: 47865},
+									name: "ElementAttribute",
+								},
+							},
+						},
+						&ruleRefExpr{
+							pos:  position{line: 990, col: 141, offset: 47884},
+							name: "PassthroughBlockDelimiter",
+						},
+						&zeroOrMoreExpr{
+							pos: position{line: 990, col: 167, offset: 47910},
+							expr: &ruleRefExpr{
+								pos:  position{line: 990, col: 167, offset: 47910},
+								name: "WS",
+							},
+						},
+						&ruleRefExpr{
+							pos:  position{line: 990, col: 171, offset: 47914},
+							name: "NEWLINE",
+						},
+						&labeledExpr{
+							pos:   position{line: 990, col: 179, offset: 47922},
+							label: "content",
+							expr: &zeroOrMoreExpr{
+								pos: position{line: 990, col: 187, offset: 47930},
+								expr: &seqExpr{
+									pos: position{line: 990, col: 188, offset: 47931},
+									exprs: []interface{}{
+										&notExpr{
+											pos: position{line: 990, col: 188, offset: 47931},
+											expr: &ruleRefExpr{
+												pos:  position{line: 990, col: 189, offset: 47932},
+												name: "PassthroughBlockDelimiter",
+											},
+										},
+										&anyMatcher{
+											line: 990, col: 215, offset: 47958,
+										},
+									},
+								},
+							},
+						},
+						&ruleRefExpr{
+							pos:  position{line: 990, col: 219, offset: 47962},
+							name: "PassthroughBlockDelimiter",
+						},
+						&zeroOrMoreExpr{
+							pos: position{line: 990, col: 245, offset: 47988},
+							expr: &ruleRefExpr{
+								pos:  position{line: 990, col: 245, offset: 47988},
+								name: "WS",
+							},
+						},
+						&ruleRefExpr{
+							pos:  position{line: 990, col: 249, offset: 47992},
+							name: "EOL",
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "StemBlockAttribute",
+			pos:  position{line: 996, col: 1, offset: 48207},
+			expr: &actionExpr{
+				pos: position{line: 996, col: 23, offset: 48229},
+				run: (*parser).callonStemBlockAttribute1,
+				expr: &seqExpr{
+					pos: position{line: 996, col: 23, offset: 48229},
+					exprs: []interface{}{
+						&litMatcher{
+							pos:        position{line: 996, col: 23, offset: 48229},
+							val:        "[",
+							ignoreCase: false,
+						},
+						&labeledExpr{
+							pos:   position{line: 996, col: 27, offset: 48233},
+							label: "kind",
+							expr: &choiceExpr{
+								pos: position{line: 996, col: 33, offset: 48239},
+								alternatives: []interface{}{
+									&litMatcher{
+										pos:        position{line: 996, col: 33, offset: 48239},
+										val:        "stem",
+										ignoreCase: false,
+									},
+									&litMatcher{
+										pos:        position{line: 996, col: 42, offset: 48248},
+										val:        "latexmath",
+										ignoreCase: false,
+									},
+									&litMatcher{
+										pos:        position{line: 996, col: 56, offset: 48262},
+										val:        "asciimath",
+										ignoreCase: false,
+									},
+								},
+							},
+						},
+						&litMatcher{
+							pos:        position{line: 996, col: 69, offset: 48275},
+							val:        "]",
+							ignoreCase: false,
+						},
+						&zeroOrMoreExpr{
+							pos: position{line: 996, col: 73, offset: 48279},
+							expr: &ruleRefExpr{
+								pos:  position{line: 996, col: 73, offset: 48279},
+								name: "WS",
+							},
+						},
+						&ruleRefExpr{
+							pos:  position{line: 996, col: 77, offset: 48283},
+							name: "EOL",
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "PassthroughBlock",
+			pos:  position{line: 1000, col: 1, offset: 48356},
+			expr: &actionExpr{
+				pos: position{line: 1000, col: 21, offset: 48376},
+				run: (*parser).callonPassthroughBlock1,
+				expr: &seqExpr{
+					pos: position{line: 1000, col: 21, offset: 48376},
+					exprs: []interface{}{
+						&labeledExpr{
+							pos:   position{line: 1000, col: 21, offset: 48376},
+							label: "attributes",
+							expr: &zeroOrMoreExpr{
+								pos: position{line: 1000, col: 32, offset: 48387},
+								expr: &ruleRefExpr{
+									pos:  position{line: 1000, col: 33, offset: 48388},
+									name: "ElementAttribute",
+								},
+							},
+						},
+						&ruleRefExpr{
+							pos:  position{line: 1000, col: 52, offset: 48407},
+							name: "PassthroughBlockDelimiter",
+						},
+						&zeroOrMoreExpr{
+							pos: position{line: 1000, col: 78, offset: 48433},
+							expr: &ruleRefExpr{
+								pos:  position{line: 1000, col: 78, offset: 48433},
+								name: "WS",
+							},
+						},
+						&ruleRefExpr{
+							pos:  position{line: 1000, col: 82, offset: 48437},
+							name: "NEWLINE",
+						},
+						&labeledExpr{
+							pos:   position{line: 1000, col: 90, offset: 48445},
+							label: "content",
+							expr: &zeroOrMoreExpr{
+								pos: position{line: 1000, col: 98, offset: 48453},
+								expr: &seqExpr{
+									pos: position{line: 1000, col: 99, offset: 48454},
+									exprs: []interface{}{
+										&notExpr{
+											pos: position{line: 1000, col: 99, offset: 48454},
+											expr: &ruleRefExpr{
+												pos:  position{line: 1000, col: 100, offset: 48455},
+												name: "PassthroughBlockDelimiter",
+											},
+										},
+										&anyMatcher{
+											line: 1000, col: 126, offset: 48481,
+										},
+									},
+								},
+							},
+						},
+						&ruleRefExpr{
+							pos:  position{line: 1000, col: 130, offset: 48485},
+							name: "PassthroughBlockDelimiter",
+						},
+						&zeroOrMoreExpr{
+							pos: position{line: 1000, col: 156, offset: 48511},
+							expr: &ruleRefExpr{
+								pos:  position{line: 1000, col: 156, offset: 48511},
+								name: "WS",
+							},
+						},
+						&ruleRefExpr{
+							pos:  position{line: 1000, col: 160, offset: 48515},
+							name: "EOL",
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "Table",
+			pos:  position{line: 1011, col: 1, offset: 48833},
+			expr: &actionExpr{
+				pos: position{line: 1011, col: 10, offset: 48842},
+				run: (*parser).callonTable1,
+				expr: &seqExpr{
+					pos: position{line: 1011, col: 10, offset: 48842},
+					exprs: []interface{}{
+						&labeledExpr{
+							pos:   position{line: 1011, col: 10, offset: 48842},
+							label: "attributes",
+							expr: &zeroOrMoreExpr{
+								pos: position{line: 1011, col: 21, offset: 48853},
+								expr: &ruleRefExpr{
+									pos:  position{line: 1011, col: 22, offset: 48854},
+									name: "ElementAttribute",
+								},
+							},
+						},
+						&ruleRefExpr{
+							pos:  position{line: 1011, col: 41, offset: 48873},
+							name: "TableDelimiter",
+						},
+						&zeroOrMoreExpr{
+							pos: position{line: 1011, col: 56, offset: 48888},
+							expr: &ruleRefExpr{
+								pos:  position{line: 1011, col: 56, offset: 48888},
+								name: "WS",
+							},
+						},
+						&ruleRefExpr{
+							pos:  position{line: 1011, col: 60, offset: 48892},
+							name: "NEWLINE",
+						},
+						&labeledExpr{
+							pos:   position{line: 1011, col: 68, offset: 48900},
+							label: "header",
+							expr: &zeroOrOneExpr{
+								pos: position{line: 1011, col: 75, offset: 48907},
+								expr: &ruleRefExpr{
+									pos:  position{line: 1011, col: 76, offset: 48908},
+									name: "TableLineHeader",
+								},
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 1011, col: 94, offset: 48926},
+							label: "lines",
+							expr: &zeroOrMoreExpr{
+								pos: position{line: 1011, col: 100, offset: 48932},
+								expr: &choiceExpr{
+									pos: position{line: 1011, col: 101, offset: 48933},
+									alternatives: []interface{}{
+										&ruleRefExpr{
+											pos:  position{line: 1011, col: 101, offset: 48933},
+											name: "TableLine",
+										},
+										&ruleRefExpr{
+											pos:  position{line: 1011, col: 113, offset: 48945},
+											name: "BlankLine",
+										},
+									},
+								},
+							},
+						},
+						&ruleRefExpr{
+							pos:  position{line: 1011, col: 125, offset: 48957},
+							name: "TableDelimiter",
+						},
+						&zeroOrMoreExpr{
+							pos: position{line: 1011, col: 140, offset: 48972},
+							expr: &ruleRefExpr{
+								pos:  position{line: 1011, col: 140, offset: 48972},
+								name: "WS",
+							},
+						},
+						&ruleRefExpr{
+							pos:  position{line: 1011, col: 144, offset: 48976},
+							name: "EOL",
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "TableDelimiter",
+			pos:  position{line: 1020, col: 1, offset: 49217},
+			expr: &litMatcher{
+				pos:        position{line: 1020, col: 19, offset: 49235},
+				val:        "|===",
+				ignoreCase: false,
+			},
+		},
+		{
+			name: "TableCellSeparator",
+			pos:  position{line: 1022, col: 1, offset: 49243},
+			expr: &litMatcher{
+				pos:        position{line: 1022, col: 23, offset: 49265},
+				val:        "|",
+				ignoreCase: false,
+			},
+		},
+		{
+			name: "TableLineHeader",
+			pos:  position{line: 1025, col: 1, offset: 49363},
+			expr: &actionExpr{
+				pos: position{line: 1025, col: 20, offset: 49382},
+				run: (*parser).callonTableLineHeader1,
+				expr: &seqExpr{
+					pos: position{line: 1025, col: 20, offset: 49382},
+					exprs: []interface{}{
+						&notExpr{
+							pos: position{line: 1025, col: 20, offset: 49382},
+							expr: &ruleRefExpr{
+								pos:  position{line: 1025, col: 21, offset: 49383},
+								name: "TableDelimiter",
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 1025, col: 36, offset: 49398},
+							label: "cells",
+							expr: &oneOrMoreExpr{
+								pos: position{line: 1025, col: 42, offset: 49404},
+								expr: &ruleRefExpr{
+									pos:  position{line: 1025, col: 43, offset: 49405},
+									name: "TableCell",
+								},
+							},
+						},
+						&ruleRefExpr{
+							pos:  position{line: 1025, col: 55, offset: 49417},
+							name: "EOL",
+						},
+						&ruleRefExpr{
+							pos:  position{line: 1025, col: 59, offset: 49421},
+							name: "BlankLine",
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "TableLine",
+			pos:  position{line: 1029, col: 1, offset: 49488},
+			expr: &actionExpr{
+				pos: position{line: 1029, col: 14, offset: 49501},
+				run: (*parser).callonTableLine1,
+				expr: &seqExpr{
+					pos: position{line: 1029, col: 14, offset: 49501},
+					exprs: []interface{}{
+						&notExpr{
+							pos: position{line: 1029, col: 14, offset: 49501},
+							expr: &ruleRefExpr{
+								pos:  position{line: 1029, col: 15, offset: 49502},
+								name: "TableDelimiter",
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 1029, col: 30, offset: 49517},
+							label: "cells",
+							expr: &oneOrMoreExpr{
+								pos: position{line: 1029, col: 36, offset: 49523},
+								expr: &ruleRefExpr{
+									pos:  position{line: 1029, col: 37, offset: 49524},
+									name: "TableCell",
+								},
+							},
+						},
+						&ruleRefExpr{
+							pos:  position{line: 1029, col: 49, offset: 49536},
+							name: "EOL",
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "TableCell",
+			pos:  position{line: 1034, col: 1, offset: 49707},
+			expr: &actionExpr{
+				pos: position{line: 1034, col: 14, offset: 49720},
+				run: (*parser).callonTableCell1,
+				expr: &seqExpr{
+					pos: position{line: 1034, col: 14, offset: 49720},
+					exprs: []interface{}{
+						&ruleRefExpr{
+							pos:  position{line: 1034, col: 14, offset: 49720},
+							name: "TableCellSeparator",
+						},
+						&zeroOrMoreExpr{
+							pos: position{line: 1034, col: 33, offset: 49739},
+							expr: &ruleRefExpr{
+								pos:  position{line: 1034, col: 33, offset: 49739},
+								name: "WS",
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 1034, col: 37, offset: 49743},
+							label: "elements",
+							expr: &zeroOrMoreExpr{
+								pos: position{line: 1034, col: 46, offset: 49752},
+								expr: &seqExpr{
+									pos: position{line: 1034, col: 47, offset: 49753},
+									exprs: []interface{}{
+										&zeroOrMoreExpr{
+											pos: position{line: 1034, col: 47, offset: 49753},
+											expr: &ruleRefExpr{
+												pos:  position{line: 1034, col: 47, offset: 49753},
+												name: "WS",
+											},
+										},
+										&notExpr{
+											pos: position{line: 1034, col: 51, offset: 49757},
+											expr: &ruleRefExpr{
+												pos:  position{line: 1034, col: 52, offset: 49758},
+												name: "TableCellSeparator",
+											},
+										},
+										&notExpr{
+											pos: position{line: 1034, col: 71, offset: 49777},
+											expr: &ruleRefExpr{
+												pos:  position{line: 1034, col: 72, offset: 49778},
+												name: "NEWLINE",
+											},
+										},
+										&ruleRefExpr{
+											pos:  position{line: 1034, col: 80, offset: 49786},
+											name: "TableCellInlineElement",
+										},
+									},
+								},
+							},
+						},
+						&zeroOrMoreExpr{
+							pos: position{line: 1034, col: 105, offset: 49811},
+							expr: &ruleRefExpr{
+								pos:  position{line: 1034, col: 105, offset: 49811},
+								name: "WS",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "TableCellInlineElement",
+			pos:  position{line: 1038, col: 1, offset: 49876},
+			expr: &choiceExpr{
+				pos: position{line: 1038, col: 27, offset: 49902},
+				alternatives: []interface{}{
+					&ruleRefExpr{
+						pos:  position{line: 1038, col: 27, offset: 49902},
+						name: "CrossReference",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 1038, col: 44, offset: 49919},
+						name: "Passthrough",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 1038, col: 58, offset: 49933},
+						name: "InlineStem",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 1038, col: 71, offset: 49946},
+						name: "InlineImage",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 1038, col: 85, offset: 49960},
+						name: "Footnote",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 1038, col: 96, offset: 49971},
+						name: "InlineUIMacro",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 1038, col: 112, offset: 49987},
+						name: "QuotedText",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 1038, col: 125, offset: 50000},
+						name: "Link",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 1038, col: 132, offset: 50007},
+						name: "DocumentAttributeSubstitution",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 1038, col: 164, offset: 50039},
+						name: "TableCellCharacters",
+					},
+				},
+			},
+		},
+		{
+			name: "TableCellCharacters",
+			pos:  position{line: 1040, col: 1, offset: 50060},
+			expr: &actionExpr{
+				pos: position{line: 1040, col: 24, offset: 50083},
+				run: (*parser).callonTableCellCharacters1,
+				expr: &oneOrMoreExpr{
+					pos: position{line: 1040, col: 24, offset: 50083},
+					expr: &seqExpr{
+						pos: position{line: 1040, col: 25, offset: 50084},
+						exprs: []interface{}{
+							&notExpr{
+								pos: position{line: 1040, col: 25, offset: 50084},
+								expr: &ruleRefExpr{
+									pos:  position{line: 1040, col: 26, offset: 50085},
+									name: "NEWLINE",
+								},
+							},
+							&notExpr{
+								pos: position{line: 1040, col: 34, offset: 50093},
+								expr: &ruleRefExpr{
+									pos:  position{line: 1040, col: 35, offset: 50094},
+									name: "WS",
+								},
+							},
+							&notExpr{
+								pos: position{line: 1040, col: 38, offset: 50097},
+								expr: &ruleRefExpr{
+									pos:  position{line: 1040, col: 39, offset: 50098},
+									name: "TableCellSeparator",
+								},
+							},
+							&notExpr{
+								pos: position{line: 1040, col: 58, offset: 50117},
+								expr: &ruleRefExpr{
+									pos:  position{line: 1040, col: 59, offset: 50118},
+									name: "Footnote",
+								},
+							},
+							&anyMatcher{
+								line: 1040, col: 68, offset: 50127,
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "Comment",
+			pos:  position{line: 1047, col: 1, offset: 50271},
+			expr: &choiceExpr{
+				pos: position{line: 1047, col: 12, offset: 50282},
+				alternatives: []interface{}{
+					&ruleRefExpr{
+						pos:  position{line: 1047, col: 12, offset: 50282},
+						name: "CommentBlock",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 1047, col: 27, offset: 50297},
+						name: "SingleLineComment",
+					},
+				},
+			},
+		},
+		{
+			name: "CommentBlockDelimiter",
+			pos:  position{line: 1049, col: 1, offset: 50316},
+			expr: &litMatcher{
+				pos:        position{line: 1049, col: 26, offset: 50341},
+				val:        "////",
+				ignoreCase: false,
+			},
+		},
+		{
+			name: "CommentBlock",
+			pos:  position{line: 1051, col: 1, offset: 50349},
+			expr: &actionExpr{
+				pos: position{line: 1051, col: 17, offset: 50365},
+				run: (*parser).callonCommentBlock1,
+				expr: &seqExpr{
+					pos: position{line: 1051, col: 17, offset: 50365},
+					exprs: []interface{}{
+						&ruleRefExpr{
+							pos:  position{line: 1051, col: 17, offset: 50365},
+							name: "CommentBlockDelimiter",
+						},
+						&zeroOrMoreExpr{
+							pos: position{line: 1051, col: 39, offset: 50387},
+							expr: &ruleRefExpr{
+								pos:  position{line: 1051, col: 39, offset: 50387},
+								name: "WS",
+							},
+						},
+						&ruleRefExpr{
+							pos:  position{line: 1051, col: 43, offset: 50391},
+							name: "NEWLINE",
+						},
+						&labeledExpr{
+							pos:   position{line: 1051, col: 51, offset: 50399},
+							label: "content",
+							expr: &zeroOrMoreExpr{
+								pos: position{line: 1051, col: 59, offset: 50407},
+								expr: &seqExpr{
+									pos: position{line: 1051, col: 60, offset: 50408},
+									exprs: []interface{}{
+										&notExpr{
+											pos: position{line: 1051, col: 60, offset: 50408},
+											expr: &ruleRefExpr{
+												pos:  position{line: 1051, col: 61, offset: 50409},
+												name: "CommentBlockDelimiter",
+											},
+										},
+										&anyMatcher{
+											line: 1051, col: 83, offset: 50431,
+										},
+									},
+								},
+							},
+						},
+						&ruleRefExpr{
+							pos:  position{line: 1051, col: 87, offset: 50435},
+							name: "CommentBlockDelimiter",
+						},
+						&zeroOrMoreExpr{
+							pos: position{line: 1051, col: 109, offset: 50457},
+							expr: &ruleRefExpr{
+								pos:  position{line: 1051, col: 109, offset: 50457},
+								name: "WS",
+							},
+						},
+						&ruleRefExpr{
+							pos:  position{line: 1051, col: 113, offset: 50461},
+							name: "EOL",
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "SingleLineComment",
+			pos:  position{line: 1055, col: 1, offset: 50528},
+			expr: &actionExpr{
+				pos: position{line: 1055, col: 22, offset: 50549},
+				run: (*parser).callonSingleLineComment1,
+				expr: &seqExpr{
+					pos: position{line: 1055, col: 22, offset: 50549},
+					exprs: []interface{}{
+						&notExpr{
+							pos: position{line: 1055, col: 22, offset: 50549},
+							expr: &ruleRefExpr{
+								pos:  position{line: 1055, col: 23, offset: 50550},
+								name: "CommentBlockDelimiter",
+							},
+						},
+						&litMatcher{
+							pos:        position{line: 1055, col: 45, offset: 50572},
+							val:        "//",
+							ignoreCase: false,
+						},
+						&labeledExpr{
+							pos:   position{line: 1055, col: 50, offset: 50577},
+							label: "content",
+							expr: &zeroOrMoreExpr{
+								pos: position{line: 1055, col: 58, offset: 50585},
+								expr: &seqExpr{
+									pos: position{line: 1055, col: 59, offset: 50586},
+									exprs: []interface{}{
+										&notExpr{
+											pos: position{line: 1055, col: 59, offset: 50586},
+											expr: &ruleRefExpr{
+												pos:  position{line: 1055, col: 60, offset: 50587},
+												name: "NEWLINE",
+											},
+										},
+										&anyMatcher{
+											line: 1055, col: 68, offset: 50595,
+										},
+									},
+								},
+							},
+						},
+						&ruleRefExpr{
+							pos:  position{line: 1055, col: 72, offset: 50599},
+							name: "EOL",
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "LiteralBlock",
+			pos:  position{line: 1062, col: 1, offset: 50938},
+			expr: &choiceExpr{
+				pos: position{line: 1062, col: 17, offset: 50954},
+				alternatives: []interface{}{
+					&ruleRefExpr{
+						pos:  position{line: 1062, col: 17, offset: 50954},
+						name: "ParagraphWithSpaces",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 1062, col: 39, offset: 50976},
+						name: "ParagraphWithLiteralBlockDelimiter",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 1062, col: 76, offset: 51013},
+						name: "ParagraphWithLiteralAttribute",
+					},
+				},
+			},
+		},
+		{
+			name: "ParagraphWithSpaces",
+			pos:  position{line: 1065, col: 1, offset: 51108},
+			expr: &actionExpr{
+				pos: position{line: 1065, col: 24, offset: 51131},
+				run: (*parser).callonParagraphWithSpaces1,
+				expr: &seqExpr{
+					pos: position{line: 1065, col: 24, offset: 51131},
+					exprs: []interface{}{
+						&labeledExpr{
+							pos:   position{line: 1065, col: 24, offset: 51131},
+							label: "spaces",
+							expr: &oneOrMoreExpr{
+								pos: position{line: 1065, col: 32, offset: 51139},
+								expr: &ruleRefExpr{
+									pos:  position{line: 1065, col: 32, offset: 51139},
+									name: "WS",
+								},
+							},
+						},
+						&notExpr{
+							pos: position{line: 1065, col: 37, offset: 51144},
+							expr: &ruleRefExpr{
+								pos:  position{line: 1065, col: 38, offset: 51145},
+								name: "NEWLINE",
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 1065, col: 46, offset: 51153},
+							label: "content",
+							expr: &ruleRefExpr{
+								pos:  position{line: 1065, col: 55, offset: 51162},
+								name: "LiteralBlockContent",
+							},
+						},
+						&ruleRefExpr{
+							pos:  position{line: 1065, col: 76, offset: 51183},
+							name: "EndOfLiteralBlock",
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "LiteralBlockContent",
+			pos:  position{line: 1070, col: 1, offset: 51381},
+			expr: &actionExpr{
+				pos: position{line: 1070, col: 24, offset: 51404},
+				run: (*parser).callonLiteralBlockContent1,
+				expr: &labeledExpr{
+					pos:   position{line: 1070, col: 24, offset: 51404},
+					label: "content",
+					expr: &oneOrMoreExpr{
+						pos: position{line: 1070, col: 32, offset: 51412},
+						expr: &seqExpr{
+							pos: position{line: 1070, col: 33, offset: 51413},
+							exprs: []interface{}{
+								&notExpr{
+									pos: position{line: 1070, col: 33, offset: 51413},
+									expr: &seqExpr{
+										pos: position{line: 1070, col: 35, offset: 51415},
+										exprs: []interface{}{
+											&ruleRefExpr{
+												pos:  position{line: 1070, col: 35, offset: 51415},
+												name: "NEWLINE",
+											},
+											&ruleRefExpr{
+												pos:  position{line: 1070, col: 43, offset: 51423},
+												name: "BlankLine",
+											},
+										},
+									},
+								},
+								&anyMatcher{
+									line: 1070, col: 54, offset: 51434,
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "EndOfLiteralBlock",
+			pos:  position{line: 1075, col: 1, offset: 51519},
+			expr: &choiceExpr{
+				pos: position{line: 1075, col: 22, offset: 51540},
+				alternatives: []interface{}{
+					&seqExpr{
+						pos: position{line: 1075, col: 22, offset: 51540},
+						exprs: []interface{}{
+							&ruleRefExpr{
+								pos:  position{line: 1075, col: 22, offset: 51540},
+								name: "NEWLINE",
+							},
+							&ruleRefExpr{
+								pos:  position{line: 1075, col: 30, offset: 51548},
+								name: "BlankLine",
+							},
+						},
+					},
+					&ruleRefExpr{
+						pos:  position{line: 1075, col: 42, offset: 51560},
+						name: "NEWLINE",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 1075, col: 52, offset: 51570},
+						name: "EOF",
+					},
+				},
+			},
+		},
+		{
+			name: "ParagraphWithLiteralBlockDelimiter",
+			pos:  position{line: 1078, col: 1, offset: 51630},
+			expr: &actionExpr{
+				pos: position{line: 1078, col: 39, offset: 51668},
+				run: (*parser).callonParagraphWithLiteralBlockDelimiter1,
+				expr: &seqExpr{
+					pos: position{line: 1078, col: 39, offset: 51668},
+					exprs: []interface{}{
+						&labeledExpr{
+							pos:   position{line: 1078, col: 39, offset: 51668},
+							label: "attributes",
+							expr: &zeroOrMoreExpr{
+								pos: position{line: 1078, col: 50, offset: 51679},
+								expr: &ruleRefExpr{
+									pos:  position{line: 1078, col: 51, offset: 51680},
+									name: "ElementAttribute",
+								},
+							},
+						},
+						&ruleRefExpr{
+							pos:  position{line: 1078, col: 70, offset: 51699},
+							name: "LiteralBlockDelimiter",
+						},
+						&zeroOrMoreExpr{
+							pos: position{line: 1078, col: 92, offset: 51721},
+							expr: &ruleRefExpr{
+								pos:  position{line: 1078, col: 92, offset: 51721},
+								name: "WS",
+							},
+						},
+						&ruleRefExpr{
+							pos:  position{line: 1078, col: 96, offset: 51725},
+							name: "NEWLINE",
+						},
+						&labeledExpr{
+							pos:   position{line: 1078, col: 104, offset: 51733},
+							label: "content",
+							expr: &zeroOrMoreExpr{
+								pos: position{line: 1078, col: 112, offset: 51741},
+								expr: &seqExpr{
+									pos: position{line: 1078, col: 113, offset: 51742},
+									exprs: []interface{}{
+										&notExpr{
+											pos: position{line: 1078, col: 113, offset: 51742},
+											expr: &ruleRefExpr{
+												pos:  position{line: 1078, col: 114, offset: 51743},
+												name: "LiteralBlockDelimiter",
+											},
+										},
+										&anyMatcher{
+											line: 1078, col: 136, offset: 51765,
+										},
+									},
+								},
+							},
+						},
+						&ruleRefExpr{
+							pos:  position{line: 1078, col: 140, offset: 51769},
+							name: "LiteralBlockDelimiter",
+						},
+						&zeroOrMoreExpr{
+							pos: position{line: 1078, col: 162, offset: 51791},
+							expr: &ruleRefExpr{
+								pos:  position{line: 1078, col: 162, offset: 51791},
+								name: "WS",
+							},
+						},
+						&ruleRefExpr{
+							pos:  position{line: 1078, col: 166, offset: 51795},
+							name: "EOL",
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "LiteralBlockDelimiter",
+			pos:  position{line: 1086, col: 1, offset: 52000},
+			expr: &litMatcher{
+				pos:        position{line: 1086, col: 26, offset: 52025},
+				val:        "....",
+				ignoreCase: false,
+			},
+		},
+		{
+			name: "ParagraphWithLiteralAttribute",
+			pos:  position{line: 1089, col: 1, offset: 52087},
+			expr: &actionExpr{
+				pos: position{line: 1089, col: 34, offset: 52120},
+				run: (*parser).callonParagraphWithLiteralAttribute1,
+				expr: &seqExpr{
+					pos: position{line: 1089, col: 34, offset: 52120},
+					exprs: []interface{}{
+						&litMatcher{
+							pos:        position{line: 1089, col: 34, offset: 52120},
+							val:        "[literal]",
+							ignoreCase: false,
+						},
+						&zeroOrMoreExpr{
+							pos: position{line: 1089, col: 46, offset: 52132},
+							expr: &ruleRefExpr{
+								pos:  position{line: 1089, col: 46, offset: 52132},
+								name: "WS",
+							},
+						},
+						&ruleRefExpr{
+							pos:  position{line: 1089, col: 50, offset: 52136},
+							name: "NEWLINE",
+						},
+						&labeledExpr{
+							pos:   position{line: 1089, col: 58, offset: 52144},
+							label: "content",
+							expr: &ruleRefExpr{
+								pos:  position{line: 1089, col: 67, offset: 52153},
+								name: "LiteralBlockContent",
+							},
+						},
+						&ruleRefExpr{
+							pos:  position{line: 1089, col: 88, offset: 52174},
+							name: "EndOfLiteralBlock",
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "ElementAttribute",
+			pos:  position{line: 1096, col: 1, offset: 52403},
+			expr: &actionExpr{
+				pos: position{line: 1096, col: 21, offset: 52423},
+				run: (*parser).callonElementAttribute1,
+				expr: &seqExpr{
+					pos: position{line: 1096, col: 21, offset: 52423},
+					exprs: []interface{}{
+						&notExpr{
+							pos: position{line: 1096, col: 21, offset: 52423},
+							expr: &ruleRefExpr{
+								pos:  position{line: 1096, col: 22, offset: 52424},
+								name: "AdmonitionMarker",
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 1096, col: 39, offset: 52441},
+							label: "attr",
+							expr: &choiceExpr{
+								pos: position{line: 1096, col: 45, offset: 52447},
+								alternatives: []interface{}{
+									&ruleRefExpr{
+										pos:  position{line: 1096, col: 45, offset: 52447},
+										name: "ElementID",
+									},
+									&ruleRefExpr{
+										pos:  position{line: 1096, col: 57, offset: 52459},
+										name: "ElementTitle",
+									},
+									&ruleRefExpr{
+										pos:  position{line: 1096, col: 72, offset: 52474},
+										name: "SourceAttributes",
+									},
+									&ruleRefExpr{
+										pos:  position{line: 1096, col: 91, offset: 52493},
+										name: "QuoteAttributes",
+									},
+									&ruleRefExpr{
+										pos:  position{line: 1096, col: 109, offset: 52511},
+										name: "VerseAttributes",
+									},
+									&ruleRefExpr{
+										pos:  position{line: 1096, col: 127, offset: 52529},
+										name: "BlockStyleAttributes",
+									},
+									&ruleRefExpr{
+										pos:  position{line: 1096, col: 150, offset: 52552},
+										name: "AttributeGroup",
+									},
+									&ruleRefExpr{
+										pos:  position{line: 1096, col: 167, offset: 52569},
+										name: "InvalidElementAttribute",
+									},
+								},
+							},
+						},
+						&ruleRefExpr{
+							pos:  position{line: 1096, col: 192, offset: 52594},
+							name: "EOL",
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "ElementID",
+			pos:  position{line: 1100, col: 1, offset: 52685},
+			expr: &choiceExpr{
+				pos: position{line: 1100, col: 14, offset: 52698},
+				alternatives: []interface{}{
+					&actionExpr{
+						pos: position{line: 1100, col: 14, offset: 52698},
+						run: (*parser).callonElementID2,
+						expr: &labeledExpr{
+							pos:   position{line: 1100, col: 14, offset: 52698},
+							label: "id",
+							expr: &ruleRefExpr{
+								pos:  position{line: 1100, col: 18, offset: 52702},
+								name: "InlineElementID",
+							},
+						},
+					},
+					&actionExpr{
+						pos: position{line: 1102, col: 5, offset: 52744},
+						run: (*parser).callonElementID5,
+						expr: &seqExpr{
+							pos: position{line: 1102, col: 5, offset: 52744},
+							exprs: []interface{}{
+								&litMatcher{
+									pos:        position{line: 1102, col: 5, offset: 52744},
+									val:        "[#",
+									ignoreCase: false,
+								},
+								&labeledExpr{
+									pos:   position{line: 1102, col: 10, offset: 52749},
+									label: "id",
+									expr: &ruleRefExpr{
+										pos:  position{line: 1102, col: 14, offset: 52753},
+										name: "ID",
+									},
+								},
+								&litMatcher{
+									pos:        position{line: 1102, col: 18, offset: 52757},
+									val:        "]",
+									ignoreCase: false,
+								},
+								&zeroOrMoreExpr{
+									pos: position{line: 1102, col: 22, offset: 52761},
+									expr: &ruleRefExpr{
+										pos:  position{line: 1102, col: 22, offset: 52761},
+										name: "WS",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "InlineElementID",
+			pos:  position{line: 1106, col: 1, offset: 52813},
+			expr: &actionExpr{
+				pos: position{line: 1106, col: 20, offset: 52832},
+				run: (*parser).callonInlineElementID1,
+				expr: &seqExpr{
+					pos: position{line: 1106, col: 20, offset: 52832},
+					exprs: []interface{}{
+						&litMatcher{
+							pos:        position{line: 1106, col: 20, offset: 52832},
+							val:        "[[",
+							ignoreCase: false,
+						},
+						&labeledExpr{
+							pos:   position{line: 1106, col: 25, offset: 52837},
+							label: "id",
+							expr: &ruleRefExpr{
+								pos:  position{line: 1106, col: 29, offset: 52841},
+								name: "ID",
+							},
+						},
+						&litMatcher{
+							pos:        position{line: 1106, col: 33, offset: 52845},
+							val:        "]]",
+							ignoreCase: false,
+						},
+						&zeroOrMoreExpr{
+							pos: position{line: 1106, col: 38, offset: 52850},
+							expr: &ruleRefExpr{
+								pos:  position{line: 1106, col: 38, offset: 52850},
+								name: "WS",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "ElementTitle",
+			pos:  position{line: 1112, col: 1, offset: 53044},
+			expr: &actionExpr{
+				pos: position{line: 1112, col: 17, offset: 53060},
+				run: (*parser).callonElementTitle1,
+				expr: &seqExpr{
+					pos: position{line: 1112, col: 17, offset: 53060},
+					exprs: []interface{}{
+						&litMatcher{
+							pos:        position{line: 1112, col: 17, offset: 53060},
+							val:        ".",
+							ignoreCase: false,
+						},
+						&notExpr{
+							pos: position{line: 1112, col: 21, offset: 53064},
+							expr: &litMatcher{
+								pos:        position{line: 1112, col: 22, offset: 53065},
+								val:        ".",
+								ignoreCase: false,
+							},
+						},
+						&notExpr{
+							pos: position{line: 1112, col: 26, offset: 53069},
+							expr: &ruleRefExpr{
+								pos:  position{line: 1112, col: 27, offset: 53070},
+								name: "WS",
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 1112, col: 30, offset: 53073},
+							label: "title",
+							expr: &oneOrMoreExpr{
+								pos: position{line: 1112, col: 36, offset: 53079},
+								expr: &seqExpr{
+									pos: position{line: 1112, col: 37, offset: 53080},
+									exprs: []interface{}{
+										&notExpr{
+											pos: position{line: 1112, col: 37, offset: 53080},
+											expr: &ruleRefExpr{
+												pos:  position{line: 1112, col: 38, offset: 53081},
+												name: "NEWLINE",
+											},
+										},
+										&anyMatcher{
+											line: 1112, col: 46, offset: 53089,
+										},
+									},
+								},
+							},
+						},
+						&zeroOrMoreExpr{
+							pos: position{line: 1112, col: 50, offset: 53093},
+							expr: &ruleRefExpr{
+								pos:  position{line: 1112, col: 50, offset: 53093},
+								name: "WS",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "SourceAttributes",
+			pos:  position{line: 1117, col: 1, offset: 53238},
+			expr: &choiceExpr{
+				pos: position{line: 1117, col: 21, offset: 53258},
+				alternatives: []interface{}{
+					&actionExpr{
+						pos: position{line: 1117, col: 21, offset: 53258},
+						run: (*parser).callonSourceAttributes2,
+						expr: &seqExpr{
+							pos: position{line: 1117, col: 21, offset: 53258},
+							exprs: []interface{}{
+								&litMatcher{
+									pos:        position{line: 1117, col: 21, offset: 53258},
+									val:        "[source]",
+									ignoreCase: false,
+								},
+								&zeroOrMoreExpr{
+									pos: position{line: 1117, col: 32, offset: 53269},
+									expr: &ruleRefExpr{
+										pos:  position{line: 1117, col: 32, offset: 53269},
+										name: "WS",
+									},
+								},
+							},
+						},
+					},
+					&actionExpr{
+						pos: position{line: 1119, col: 5, offset: 53320},
+						run: (*parser).callonSourceAttributes7,
+						expr: &seqExpr{
+							pos: position{line: 1119, col: 5, offset: 53320},
+							exprs: []interface{}{
+								&litMatcher{
+									pos:        position{line: 1119, col: 5, offset: 53320},
+									val:        "[source,",
+									ignoreCase: false,
+								},
+								&zeroOrMoreExpr{
+									pos: position{line: 1119, col: 16, offset: 53331},
+									expr: &ruleRefExpr{
+										pos:  position{line: 1119, col: 16, offset: 53331},
+										name: "WS",
+									},
+								},
+								&labeledExpr{
+									pos:   position{line: 1119, col: 20, offset: 53335},
+									label: "language",
+									expr: &ruleRefExpr{
+										pos:  position{line: 1119, col: 30, offset: 53345},
+										name: "SourceLanguage",
+									},
+								},
+								&zeroOrMoreExpr{
+									pos: position{line: 1119, col: 46, offset: 53361},
+									expr: &ruleRefExpr{
+										pos:  position{line: 1119, col: 46, offset: 53361},
+										name: "WS",
+									},
+								},
+								&andExpr{
+									pos: position{line: 1119, col: 50, offset: 53365},
+									expr: &choiceExpr{
+										pos: position{line: 1119, col: 52, offset: 53367},
+										alternatives: []interface{}{
+											&litMatcher{
+												pos:        position{line: 1119, col: 52, offset: 53367},
+												val:        ",",
+												ignoreCase: false,
+											},
+											&litMatcher{
+												pos:        position{line: 1119, col: 58, offset: 53373},
+												val:        "]",
+												ignoreCase: false,
+											},
+										},
+									},
+								},
+								&zeroOrOneExpr{
+									pos: position{line: 1119, col: 63, offset: 53378},
+									expr: &seqExpr{
+										pos: position{line: 1119, col: 64, offset: 53379},
+										exprs: []interface{}{
+											&litMatcher{
+												pos:        position{line: 1119, col: 64, offset: 53379},
+												val:        ",",
+												ignoreCase: false,
+											},
+											&zeroOrMoreExpr{
+												pos: position{line: 1119, col: 68, offset: 53383},
+												expr: &ruleRefExpr{
+													pos:  position{line: 1119, col: 68, offset: 53383},
+													name: "WS",
+												},
+											},
+										},
+									},
+								},
+								&labeledExpr{
+									pos:   position{line: 1119, col: 74, offset: 53389},
+									label: "others",
+									expr: &zeroOrMoreExpr{
+										pos: position{line: 1119, col: 81, offset: 53396},
+										expr: &ruleRefExpr{
+											pos:  position{line: 1119, col: 82, offset: 53397},
+											name: "GenericAttribute",
+										},
+									},
+								},
+								&litMatcher{
+									pos:        position{line: 1119, col: 101, offset: 53416},
+									val:        "]",
+									ignoreCase: false,
+								},
+								&zeroOrMoreExpr{
+									pos: position{line: 1119, col: 105, offset: 53420},
+									expr: &ruleRefExpr{
+										pos:  position{line: 1119, col: 105, offset: 53420},
+										name: "WS",
+									},
+								},
+							},
+						},
+					},
+					&actionExpr{
+						pos: position{line: 1121, col: 5, offset: 53520},
+						run: (*parser).callonSourceAttributes31,
+						expr: &seqExpr{
+							pos: position{line: 1121, col: 5, offset: 53520},
+							exprs: []interface{}{
+								&litMatcher{
+									pos:        position{line: 1121, col: 5, offset: 53520},
+									val:        "[source,",
+									ignoreCase: false,
+								},
+								&zeroOrMoreExpr{
+									pos: position{line: 1121, col: 16, offset: 53531},
+									expr: &ruleRefExpr{
+										pos:  position{line: 1121, col: 16, offset: 53531},
+										name: "WS",
+									},
+								},
+								&labeledExpr{
+									pos:   position{line: 1121, col: 20, offset: 53535},
+									label: "others",
+									expr: &zeroOrMoreExpr{
+										pos: position{line: 1121, col: 27, offset: 53542},
+										expr: &ruleRefExpr{
+											pos:  position{line: 1121, col: 28, offset: 53543},
+											name: "GenericAttribute",
+										},
+									},
+								},
+								&litMatcher{
+									pos:        position{line: 1121, col: 47, offset: 53562},
+									val:        "]",
+									ignoreCase: false,
+								},
+								&zeroOrMoreExpr{
+									pos: position{line: 1121, col: 51, offset: 53566},
+									expr: &ruleRefExpr{
+										pos:  position{line: 1121, col: 51, offset: 53566},
+										name: "WS",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "SourceLanguage",
+			pos:  position{line: 1125, col: 1, offset: 53722},
+			expr: &actionExpr{
+				pos: position{line: 1125, col: 19, offset: 53740},
+				run: (*parser).callonSourceLanguage1,
+				expr: &oneOrMoreExpr{
+					pos: position{line: 1125, col: 19, offset: 53740},
+					expr: &seqExpr{
+						pos: position{line: 1125, col: 20, offset: 53741},
+						exprs: []interface{}{
+							&notExpr{
+								pos: position{line: 1125, col: 20, offset: 53741},
+								expr: &ruleRefExpr{
+									pos:  position{line: 1125, col: 21, offset: 53742},
+									name: "NEWLINE",
+								},
+							},
+							&notExpr{
+								pos: position{line: 1125, col: 29, offset: 53750},
+								expr: &ruleRefExpr{
+									pos:  position{line: 1125, col: 30, offset: 53751},
+									name: "WS",
+								},
+							},
+							&notExpr{
+								pos: position{line: 1125, col: 33, offset: 53754},
+								expr: &litMatcher{
+									pos:        position{line: 1125, col: 34, offset: 53755},
+									val:        "[",
+									ignoreCase: false,
+								},
+							},
+							&notExpr{
+								pos: position{line: 1125, col: 38, offset: 53759},
+								expr: &litMatcher{
+									pos:        position{line: 1125, col: 39, offset: 53760},
+									val:        "]",
+									ignoreCase: false,
+								},
+							},
+							&notExpr{
+								pos: position{line: 1125, col: 43, offset: 53764},
+								expr: &litMatcher{
+									pos:        position{line: 1125, col: 44, offset: 53765},
+									val:        ",",
+									ignoreCase: false,
+								},
+							},
+							&notExpr{
+								pos: position{line: 1125, col: 48, offset: 53769},
+								expr: &litMatcher{
+									pos:        position{line: 1125, col: 49, offset: 53770},
+									val:        "=",
+									ignoreCase: false,
+								},
+							},
+							&anyMatcher{
+								line: 1125, col: 53, offset: 53774,
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "QuoteAttributes",
+			pos:  position{line: 1130, col: 1, offset: 53936},
+			expr: &actionExpr{
+				pos: position{line: 1130, col: 20, offset: 53955},
+				run: (*parser).callonQuoteAttributes1,
+				expr: &seqExpr{
+					pos: position{line: 1130, col: 20, offset: 53955},
+					exprs: []interface{}{
+						&litMatcher{
+							pos:        position{line: 1130, col: 20, offset: 53955},
+							val:        "[quote",
+							ignoreCase: false,
+						},
+						&zeroOrMoreExpr{
+							pos: position{line: 1130, col: 29, offset: 53964},
+							expr: &ruleRefExpr{
+								pos:  position{line: 1130, col: 29, offset: 53964},
+								name: "WS",
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 1130, col: 33, offset: 53968},
+							label: "attribution",
+							expr: &zeroOrOneExpr{
+								pos: position{line: 1130, col: 45, offset: 53980},
+								expr: &actionExpr{
+									pos: position{line: 1130, col: 46, offset: 53981},
+									run: (*parser).callonQuoteAttributes8,
+									expr: &seqExpr{
+										pos: position{line: 1130, col: 46, offset: 53981},
+										exprs: []interface{}{
+											&litMatcher{
+												pos:        position{line: 1130, col: 46, offset: 53981},
+												val:        ",",
+												ignoreCase: false,
+											},
+											&labeledExpr{
+												pos:   position{line: 1130, col: 50, offset: 53985},
+												label: "attr",
+												expr: &ruleRefExpr{
+													pos:  position{line: 1130, col: 56, offset: 53991},
+													name: "QuoteAttribute",
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 1130, col: 95, offset: 54030},
+							label: "citeTitle",
+							expr: &zeroOrOneExpr{
+								pos: position{line: 1130, col: 105, offset: 54040},
+								expr: &actionExpr{
+									pos: position{line: 1130, col: 106, offset: 54041},
+									run: (*parser).callonQuoteAttributes15,
+									expr: &seqExpr{
+										pos: position{line: 1130, col: 106, offset: 54041},
+										exprs: []interface{}{
+											&litMatcher{
+												pos:        position{line: 1130, col: 106, offset: 54041},
+												val:        ",",
+												ignoreCase: false,
+											},
+											&labeledExpr{
+												pos:   position{line: 1130, col: 110, offset: 54045},
+												label: "attr",
+												expr: &ruleRefExpr{
+													pos:  position{line: 1130, col: 116, offset: 54051},
+													name: "QuoteAttribute",
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						&litMatcher{
+							pos:        position{line: 1130, col: 155, offset: 54090},
+							val:        "]",
+							ignoreCase: false,
+						},
+						&zeroOrMoreExpr{
+							pos: position{line: 1130, col: 159, offset: 54094},
+							expr: &ruleRefExpr{
+								pos:  position{line: 1130, col: 159, offset: 54094},
+								name: "WS",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "VerseAttributes",
+			pos:  position{line: 1135, col: 1, offset: 54293},
+			expr: &actionExpr{
+				pos: position{line: 1135, col: 20, offset: 54312},
+				run: (*parser).callonVerseAttributes1,
+				expr: &seqExpr{
+					pos: position{line: 1135, col: 20, offset: 54312},
+					exprs: []interface{}{
+						&litMatcher{
+							pos:        position{line: 1135, col: 20, offset: 54312},
+							val:        "[verse",
+							ignoreCase: false,
+						},
+						&zeroOrMoreExpr{
+							pos: position{line: 1135, col: 29, offset: 54321},
+							expr: &ruleRefExpr{
+								pos:  position{line: 1135, col: 29, offset: 54321},
+								name: "WS",
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 1135, col: 33, offset: 54325},
+							label: "attribution",
+							expr: &zeroOrOneExpr{
+								pos: position{line: 1135, col: 45, offset: 54337},
+								expr: &actionExpr{
+									pos: position{line: 1135, col: 46, offset: 54338},
+									run: (*parser).callonVerseAttributes8,
+									expr: &seqExpr{
+										pos: position{line: 1135, col: 46, offset: 54338},
+										exprs: []interface{}{
+											&litMatcher{
+												pos:        position{line: 1135, col: 46, offset: 54338},
+												val:        ",",
+												ignoreCase: false,
+											},
+											&labeledExpr{
+												pos:   position{line: 1135, col: 50, offset: 54342},
+												label: "attr",
+												expr: &ruleRefExpr{
+													pos:  position{line: 1135, col: 56, offset: 54348},
+													name: "QuoteAttribute",
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 1135, col: 95, offset: 54387},
+							label: "citeTitle",
+							expr: &zeroOrOneExpr{
+								pos: position{line: 1135, col: 105, offset: 54397},
+								expr: &actionExpr{
+									pos: position{line: 1135, col: 106, offset: 54398},
+									run: (*parser).callonVerseAttributes15,
+									expr: &seqExpr{
+										pos: position{line: 1135, col: 106, offset: 54398},
+										exprs: []interface{}{
+											&litMatcher{
+												pos:        position{line: 1135, col: 106, offset: 54398},
+												val:        ",",
+												ignoreCase: false,
+											},
+											&labeledExpr{
+												pos:   position{line: 1135, col: 110, offset: 54402},
+												label: "attr",
+												expr: &ruleRefExpr{
+													pos:  position{line: 1135, col: 116, offset: 54408},
+													name: "QuoteAttribute",
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						&litMatcher{
+							pos:        position{line: 1135, col: 155, offset: 54447},
+							val:        "]",
+							ignoreCase: false,
+						},
+						&zeroOrMoreExpr{
+							pos: position{line: 1135, col: 159, offset: 54451},
+							expr: &ruleRefExpr{
+								pos:  position{line: 1135, col: 159, offset: 54451},
+								name: "WS",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "QuoteAttribute",
+			pos:  position{line: 1139, col: 1, offset: 54533},
+			expr: &choiceExpr{
+				pos: position{line: 1139, col: 19, offset: 54551},
+				alternatives: []interface{}{
+					&actionExpr{
+						pos: position{line: 1139, col: 19, offset: 54551},
+						run: (*parser).callonQuoteAttribute2,
+						expr: &seqExpr{
+							pos: position{line: 1139, col: 19, offset: 54551},
+							exprs: []interface{}{
+								&zeroOrMoreExpr{
+									pos: position{line: 1139, col: 19, offset: 54551},
+									expr: &ruleRefExpr{
+										pos:  position{line: 1139, col: 19, offset: 54551},
+										name: "WS",
+									},
+								},
+								&litMatcher{
+									pos:        position{line: 1139, col: 23, offset: 54555},
+									val:        "\"",
+									ignoreCase: false,
+								},
+								&labeledExpr{
+									pos:   position{line: 1139, col: 28, offset: 54560},
+									label: "value",
+									expr: &zeroOrMoreExpr{
+										pos: position{line: 1139, col: 34, offset: 54566},
+										expr: &seqExpr{
+											pos: position{line: 1139, col: 35, offset: 54567},
+											exprs: []interface{}{
+												&notExpr{
+													pos: position{line: 1139, col: 35, offset: 54567},
+													expr: &litMatcher{
+														pos:        position{line: 1139, col: 36, offset: 54568},
+														val:        "\"",
+														ignoreCase: false,
+													},
+												},
+												&notExpr{
+													pos: position{line: 1139, col: 41, offset: 54573},
+													expr: &ruleRefExpr{
+														pos:  position{line: 1139, col: 42, offset: 54574},
+														name: "NEWLINE",
+													},
+												},
+												&anyMatcher{
+													line: 1139, col: 50, offset: 54582,
+												},
+											},
+										},
+									},
+								},
+								&litMatcher{
+									pos:        position{line: 1139, col: 54, offset: 54586},
+									val:        "\"",
+									ignoreCase: false,
+								},
+								&zeroOrMoreExpr{
+									pos: position{line: 1139, col: 59, offset: 54591},
+									expr: &ruleRefExpr{
+										pos:  position{line: 1139, col: 59, offset: 54591},
+										name: "WS",
+									},
+								},
+							},
+						},
+					},
+					&actionExpr{
+						pos: position{line: 1141, col: 5, offset: 54701},
+						run: (*parser).callonQuoteAttribute18,
+						expr: &labeledExpr{
+							pos:   position{line: 1141, col: 5, offset: 54701},
+							label: "value",
+							expr: &zeroOrMoreExpr{
+								pos: position{line: 1141, col: 11, offset: 54707},
+								expr: &seqExpr{
+									pos: position{line: 1141, col: 12, offset: 54708},
+									exprs: []interface{}{
+										&notExpr{
+											pos: position{line: 1141, col: 12, offset: 54708},
+											expr: &litMatcher{
+												pos:        position{line: 1141, col: 13, offset: 54709},
+												val:        ",",
+												ignoreCase: false,
+											},
+										},
+										&notExpr{
+											pos: position{line: 1141, col: 17, offset: 54713},
+											expr: &litMatcher{
+												pos:        position{line: 1141, col: 18, offset: 54714},
+												val:        "]",
+												ignoreCase: false,
+											},
+										},
+										&notExpr{
+											pos: position{line: 1141, col: 22, offset: 54718},
+											expr: &ruleRefExpr{
+												pos:  position{line: 1141, col: 23, offset: 54719},
+												name: "NEWLINE",
+											},
+										},
+										&anyMatcher{
+											line: 1141, col: 31, offset: 54727,
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "BlockStyleAttributes",
+			pos:  position{line: 1146, col: 1, offset: 54882},
+			expr: &actionExpr{
+				pos: position{line: 1146, col: 25, offset: 54906},
+				run: (*parser).callonBlockStyleAttributes1,
+				expr: &seqExpr{
+					pos: position{line: 1146, col: 25, offset: 54906},
+					exprs: []interface{}{
+						&litMatcher{
+							pos:        position{line: 1146, col: 25, offset: 54906},
+							val:        "[",
+							ignoreCase: false,
+						},
+						&labeledExpr{
+							pos:   position{line: 1146, col: 29, offset: 54910},
+							label: "kind",
+							expr: &choiceExpr{
+								pos: position{line: 1146, col: 35, offset: 54916},
+								alternatives: []interface{}{
+									&litMatcher{
+										pos:        position{line: 1146, col: 35, offset: 54916},
+										val:        "abstract",
+										ignoreCase: false,
+									},
+									&litMatcher{
+										pos:        position{line: 1146, col: 48, offset: 54929},
+										val:        "partintro",
+										ignoreCase: false,
+									},
+									&litMatcher{
+										pos:        position{line: 1146, col: 62, offset: 54943},
+										val:        "appendix",
+										ignoreCase: false,
+									},
+									&litMatcher{
+										pos:        position{line: 1146, col: 75, offset: 54956},
+										val:        "bibliography",
+										ignoreCase: false,
+									},
+									&litMatcher{
+										pos:        position{line: 1146, col: 92, offset: 54973},
+										val:        "glossary",
+										ignoreCase: false,
+									},
+									&litMatcher{
+										pos:        position{line: 1146, col: 105, offset: 54986},
+										val:        "index",
+										ignoreCase: false,
+									},
+									&litMatcher{
+										pos:        position{line: 1146, col: 115, offset: 54996},
+										val:        "preface",
+										ignoreCase: false,
+									},
+									&litMatcher{
+										pos:        position{line: 1146, col: 127, offset: 55008},
+										val:        "colophon",
+										ignoreCase: false,
+									},
+									&litMatcher{
+										pos:        position{line: 1146, col: 140, offset: 55021},
+										val:        "dedication",
+										ignoreCase: false,
+									},
+									&litMatcher{
+										pos:        position{line: 1146, col: 155, offset: 55036},
+										val:        "acknowledgments",
+										ignoreCase: false,
+									},
+								},
+							},
+						},
+						&litMatcher{
+							pos:        position{line: 1146, col: 174, offset: 55055},
+							val:        "]",
+							ignoreCase: false,
+						},
+						&zeroOrMoreExpr{
+							pos: position{line: 1146, col: 178, offset: 55059},
+							expr: &ruleRefExpr{
+								pos:  position{line: 1146, col: 178, offset: 55059},
+								name: "WS",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "AttributeGroup",
+			pos:  position{line: 1151, col: 1, offset: 55195},
+			expr: &actionExpr{
+				pos: position{line: 1151, col: 19, offset: 55213},
+				run: (*parser).callonAttributeGroup1,
+				expr: &seqExpr{
+					pos: position{line: 1151, col: 19, offset: 55213},
+					exprs: []interface{}{
+						&litMatcher{
+							pos:        position{line: 1151, col: 19, offset: 55213},
+							val:        "[",
+							ignoreCase: false,
+						},
+						&labeledExpr{
+							pos:   position{line: 1151, col: 23, offset: 55217},
+							label: "attributes",
+							expr: &zeroOrMoreExpr{
+								pos: position{line: 1151, col: 34, offset: 55228},
+								expr: &ruleRefExpr{
+									pos:  position{line: 1151, col: 35, offset: 55229},
+									name: "GenericAttribute",
+								},
+							},
+						},
+						&litMatcher{
+							pos:        position{line: 1151, col: 54, offset: 55248},
+							val:        "]",
+							ignoreCase: false,
+						},
+						&zeroOrMoreExpr{
+							pos: position{line: 1151, col: 58, offset: 55252},
+							expr: &ruleRefExpr{
+								pos:  position{line: 1151, col: 58, offset: 55252},
+								name: "WS",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "GenericAttribute",
+			pos:  position{line: 1155, col: 1, offset: 55324},
+			expr: &choiceExpr{
+				pos: position{line: 1155, col: 21, offset: 55344},
+				alternatives: []interface{}{
+					&actionExpr{
+						pos: position{line: 1155, col: 21, offset: 55344},
+						run: (*parser).callonGenericAttribute2,
+						expr: &seqExpr{
+							pos: position{line: 1155, col: 21, offset: 55344},
+							exprs: []interface{}{
+								&labeledExpr{
+									pos:   position{line: 1155, col: 21, offset: 55344},
+									label: "key",
+									expr: &ruleRefExpr{
+										pos:  position{line: 1155, col: 26, offset: 55349},
+										name: "AttributeKey",
+									},
+								},
+								&litMatcher{
+									pos:        position{line: 1155, col: 40, offset: 55363},
+									val:        "=",
+									ignoreCase: false,
+								},
+								&labeledExpr{
+									pos:   position{line: 1155, col: 44, offset: 55367},
+									label: "value",
+									expr: &ruleRefExpr{
+										pos:  position{line: 1155, col: 51, offset: 55374},
+										name: "AttributeValue",
+									},
+								},
+								&zeroOrOneExpr{
+									pos: position{line: 1155, col: 67, offset: 55390},
+									expr: &seqExpr{
+										pos: position{line: 1155, col: 68, offset: 55391},
+										exprs: []interface{}{
+											&litMatcher{
+												pos:        position{line: 1155, col: 68, offset: 55391},
+												val:        ",",
+												ignoreCase: false,
+											},
+											&zeroOrMoreExpr{
+												pos: position{line: 1155, col: 72, offset: 55395},
+												expr: &ruleRefExpr{
+													pos:  position{line: 1155, col: 72, offset: 55395},
+													name: "WS",
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+					&actionExpr{
+						pos: position{line: 1157, col: 5, offset: 55504},
+						run: (*parser).callonGenericAttribute14,
+						expr: &seqExpr{
+							pos: position{line: 1157, col: 5, offset: 55504},
+							exprs: []interface{}{
+								&labeledExpr{
+									pos:   position{line: 1157, col: 5, offset: 55504},
+									label: "key",
+									expr: &ruleRefExpr{
+										pos:  position{line: 1157, col: 10, offset: 55509},
+										name: "AttributeKey",
+									},
+								},
+								&zeroOrOneExpr{
+									pos: position{line: 1157, col: 24, offset: 55523},
+									expr: &seqExpr{
+										pos: position{line: 1157, col: 25, offset: 55524},
+										exprs: []interface{}{
+											&litMatcher{
+												pos:        position{line: 1157, col: 25, offset: 55524},
+												val:        ",",
+												ignoreCase: false,
+											},
+											&zeroOrMoreExpr{
+												pos: position{line: 1157, col: 29, offset: 55528},
+												expr: &ruleRefExpr{
+													pos:  position{line: 1157, col: 29, offset: 55528},
+													name: "WS",
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "AttributeKey",
+			pos:  position{line: 1161, col: 1, offset: 55622},
+			expr: &actionExpr{
+				pos: position{line: 1161, col: 17, offset: 55638},
+				run: (*parser).callonAttributeKey1,
+				expr: &seqExpr{
+					pos: position{line: 1161, col: 17, offset: 55638},
+					exprs: []interface{}{
+						&labeledExpr{
+							pos:   position{line: 1161, col: 17, offset: 55638},
+							label: "key",
+							expr: &oneOrMoreExpr{
+								pos: position{line: 1161, col: 22, offset: 55643},
+								expr: &seqExpr{
+									pos: position{line: 1161, col: 23, offset: 55644},
+									exprs: []interface{}{
+										&notExpr{
+											pos: position{line: 1161, col: 23, offset: 55644},
+											expr: &ruleRefExpr{
+												pos:  position{line: 1161, col: 24, offset: 55645},
+												name: "WS",
+											},
+										},
+										&notExpr{
+											pos: position{line: 1161, col: 27, offset: 55648},
+											expr: &litMatcher{
+												pos:        position{line: 1161, col: 28, offset: 55649},
+												val:        "=",
+												ignoreCase: false,
+											},
+										},
+										&notExpr{
+											pos: position{line: 1161, col: 32, offset: 55653},
+											expr: &litMatcher{
+												pos:        position{line: 1161, col: 33, offset: 55654},
+												val:        ",",
+												ignoreCase: false,
+											},
+										},
+										&notExpr{
+											pos: position{line: 1161, col: 37, offset: 55658},
+											expr: &litMatcher{
+												pos:        position{line: 1161, col: 38, offset: 55659},
+												val:        "]",
+												ignoreCase: false,
+											},
+										},
+										&anyMatcher{
+											line: 1161, col: 42, offset: 55663,
+										},
+									},
+								},
+							},
+						},
+						&zeroOrMoreExpr{
+							pos: position{line: 1161, col: 46, offset: 55667},
+							expr: &ruleRefExpr{
+								pos:  position{line: 1161, col: 46, offset: 55667},
+								name: "WS",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "AttributeValue",
+			pos:  position{line: 1166, col: 1, offset: 55749},
+			expr: &choiceExpr{
+				pos: position{line: 1166, col: 19, offset: 55767},
+				alternatives: []interface{}{
+					&actionExpr{
+						pos: position{line: 1166, col: 19, offset: 55767},
+						run: (*parser).callonAttributeValue2,
+						expr: &seqExpr{
+							pos: position{line: 1166, col: 19, offset: 55767},
+							exprs: []interface{}{
+								&zeroOrMoreExpr{
+									pos: position{line: 1166, col: 19, offset: 55767},
+									expr: &ruleRefExpr{
+										pos:  position{line: 1166, col: 19, offset: 55767},
+										name: "WS",
+									},
+								},
+								&litMatcher{
+									pos:        position{line: 1166, col: 23, offset: 55771},
+									val:        "\"",
+									ignoreCase: false,
+								},
+								&labeledExpr{
+									pos:   position{line: 1166, col: 28, offset: 55776},
+									label: "value",
+									expr: &zeroOrMoreExpr{
+										pos: position{line: 1166, col: 34, offset: 55782},
+										expr: &seqExpr{
+											pos: position{line: 1166, col: 35, offset: 55783},
+											exprs: []interface{}{
+												&notExpr{
+													pos: position{line: 1166, col: 35, offset: 55783},
+													expr: &litMatcher{
+														pos:        position{line: 1166, col: 36, offset: 55784},
+														val:        "\"",
+														ignoreCase: false,
+													},
+												},
+												&anyMatcher{
+													line: 1166, col: 41, offset: 55789,
+												},
+											},
+										},
+									},
+								},
+								&litMatcher{
+									pos:        position{line: 1166, col: 45, offset: 55793},
+									val:        "\"",
+									ignoreCase: false,
+								},
+								&zeroOrMoreExpr{
+									pos: position{line: 1166, col: 50, offset: 55798},
+									expr: &ruleRefExpr{
+										pos:  position{line: 1166, col: 50, offset: 55798},
+										name: "WS",
+									},
+								},
+							},
+						},
+					},
+					&actionExpr{
+						pos: position{line: 1168, col: 5, offset: 55895},
+						run: (*parser).callonAttributeValue16,
+						expr: &seqExpr{
+							pos: position{line: 1168, col: 5, offset: 55895},
+							exprs: []interface{}{
+								&zeroOrMoreExpr{
+									pos: position{line: 1168, col: 5, offset: 55895},
+									expr: &ruleRefExpr{
+										pos:  position{line: 1168, col: 5, offset: 55895},
+										name: "WS",
+									},
+								},
+								&labeledExpr{
+									pos:   position{line: 1168, col: 9, offset: 55899},
+									label: "value",
+									expr: &zeroOrMoreExpr{
+										pos: position{line: 1168, col: 15, offset: 55905},
+										expr: &seqExpr{
+											pos: position{line: 1168, col: 16, offset: 55906},
+											exprs: []interface{}{
+												&notExpr{
+													pos: position{line: 1168, col: 16, offset: 55906},
+													expr: &ruleRefExpr{
+														pos:  position{line: 1168, col: 17, offset: 55907},
+														name: "WS",
+													},
+												},
+												&notExpr{
+													pos: position{line: 1168, col: 20, offset: 55910},
+													expr: &litMatcher{
+														pos:        position{line: 1168, col: 21, offset: 55911},
+														val:        "=",
+														ignoreCase: false,
+													},
+												},
+												&notExpr{
+													pos: position{line: 1168, col: 25, offset: 55915},
+													expr: &litMatcher{
+														pos:        position{line: 1168, col: 26, offset: 55916},
+														val:        ",",
+														ignoreCase: false,
+													},
+												},
+												&notExpr{
+													pos: position{line: 1168, col: 30, offset: 55920},
+													expr: &litMatcher{
+														pos:        position{line: 1168, col: 31, offset: 55921},
+														val:        "]",
+														ignoreCase: false,
+													},
+												},
+												&anyMatcher{
+													line: 1168, col: 35, offset: 55925,
+												},
+											},
+										},
+									},
+								},
+								&zeroOrMoreExpr{
+									pos: position{line: 1168, col: 39, offset: 55929},
+									expr: &ruleRefExpr{
+										pos:  position{line: 1168, col: 39, offset: 55929},
+										name: "WS",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "InvalidElementAttribute",
+			pos:  position{line: 1173, col: 1, offset: 56016},
+			expr: &actionExpr{
+				pos: position{line: 1173, col: 28, offset: 56043},
+				run: (*parser).callonInvalidElementAttribute1,
+				expr: &seqExpr{
+					pos: position{line: 1173, col: 28, offset: 56043},
+					exprs: []interface{}{
+						&litMatcher{
+							pos:        position{line: 1173, col: 28, offset: 56043},
+							val:        "[",
+							ignoreCase: false,
+						},
+						&oneOrMoreExpr{
+							pos: position{line: 1173, col: 32, offset: 56047},
+							expr: &ruleRefExpr{
+								pos:  position{line: 1173, col: 32, offset: 56047},
+								name: "WS",
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 1173, col: 36, offset: 56051},
+							label: "content",
+							expr: &zeroOrMoreExpr{
+								pos: position{line: 1173, col: 44, offset: 56059},
+								expr: &seqExpr{
+									pos: position{line: 1173, col: 45, offset: 56060},
+									exprs: []interface{}{
+										&notExpr{
+											pos: position{line: 1173, col: 45, offset: 56060},
+											expr: &litMatcher{
+												pos:        position{line: 1173, col: 46, offset: 56061},
+												val:        "]",
+												ignoreCase: false,
+											},
+										},
+										&anyMatcher{
+											line: 1173, col: 50, offset: 56065,
+										},
+									},
+								},
+							},
+						},
+						&litMatcher{
+							pos:        position{line: 1173, col: 54, offset: 56069},
+							val:        "]",
+							ignoreCase: false,
+						},
+						&zeroOrMoreExpr{
+							pos: position{line: 1173, col: 58, offset: 56073},
+							expr: &ruleRefExpr{
+								pos:  position{line: 1173, col: 58, offset: 56073},
+								name: "WS",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "BlankLine",
+			pos:  position{line: 1180, col: 1, offset: 56239},
+			expr: &actionExpr{
+				pos: position{line: 1180, col: 14, offset: 56252},
+				run: (*parser).callonBlankLine1,
+				expr: &seqExpr{
+					pos: position{line: 1180, col: 14, offset: 56252},
+					exprs: []interface{}{
+						&notExpr{
+							pos: position{line: 1180, col: 14, offset: 56252},
+							expr: &ruleRefExpr{
+								pos:  position{line: 1180, col: 15, offset: 56253},
+								name: "EOF",
+							},
+						},
+						&zeroOrMoreExpr{
+							pos: position{line: 1180, col: 19, offset: 56257},
+							expr: &ruleRefExpr{
+								pos:  position{line: 1180, col: 19, offset: 56257},
+								name: "WS",
+							},
+						},
+						&ruleRefExpr{
+							pos:  position{line: 1180, col: 23, offset: 56261},
+							name: "EOL",
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "Characters",
+			pos:  position{line: 1187, col: 1, offset: 56408},
+			expr: &actionExpr{
+				pos: position{line: 1187, col: 15, offset: 56422},
+				run: (*parser).callonCharacters1,
+				expr: &oneOrMoreExpr{
+					pos: position{line: 1187, col: 15, offset: 56422},
+					expr: &seqExpr{
+						pos: position{line: 1187, col: 16, offset: 56423},
+						exprs: []interface{}{
+							&notExpr{
+								pos: position{line: 1187, col: 16, offset: 56423},
+								expr: &ruleRefExpr{
+									pos:  position{line: 1187, col: 17, offset: 56424},
+									name: "NEWLINE",
+								},
+							},
+							&notExpr{
+								pos: position{line: 1187, col: 25, offset: 56432},
+								expr: &ruleRefExpr{
+									pos:  position{line: 1187, col: 26, offset: 56433},
+									name: "WS",
+								},
+							},
+							&anyMatcher{
+								line: 1187, col: 29, offset: 56436,
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "URL",
+			pos:  position{line: 1191, col: 1, offset: 56476},
+			expr: &actionExpr{
+				pos: position{line: 1191, col: 8, offset: 56483},
+				run: (*parser).callonURL1,
+				expr: &oneOrMoreExpr{
+					pos: position{line: 1191, col: 8, offset: 56483},
+					expr: &seqExpr{
+						pos: position{line: 1191, col: 9, offset: 56484},
+						exprs: []interface{}{
+							&notExpr{
+								pos: position{line: 1191, col: 9, offset: 56484},
+								expr: &ruleRefExpr{
+									pos:  position{line: 1191, col: 10, offset: 56485},
+									name: "NEWLINE",
+								},
+							},
+							&notExpr{
+								pos: position{line: 1191, col: 18, offset: 56493},
+								expr: &ruleRefExpr{
+									pos:  position{line: 1191, col: 19, offset: 56494},
+									name: "WS",
+								},
+							},
+							&notExpr{
+								pos: position{line: 1191, col: 22, offset: 56497},
+								expr: &litMatcher{
+									pos:        position{line: 1191, col: 23, offset: 56498},
+									val:        "[",
+									ignoreCase: false,
+								},
+							},
+							&notExpr{
+								pos: position{line: 1191, col: 27, offset: 56502},
+								expr: &litMatcher{
+									pos:        position{line: 1191, col: 28, offset: 56503},
+									val:        "]",
+									ignoreCase: false,
+								},
+							},
+							&anyMatcher{
+								line: 1191, col: 32, offset: 56507,
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "ID",
+			pos:  position{line: 1195, col: 1, offset: 56547},
+			expr: &actionExpr{
+				pos: position{line: 1195, col: 7, offset: 56553},
+				run: (*parser).callonID1,
+				expr: &oneOrMoreExpr{
+					pos: position{line: 1195, col: 7, offset: 56553},
+					expr: &seqExpr{
+						pos: position{line: 1195, col: 8, offset: 56554},
+						exprs: []interface{}{
+							&notExpr{
+								pos: position{line: 1195, col: 8, offset: 56554},
+								expr: &ruleRefExpr{
+									pos:  position{line: 1195, col: 9, offset: 56555},
+									name: "NEWLINE",
+								},
+							},
+							&notExpr{
+								pos: position{line: 1195, col: 17, offset: 56563},
+								expr: &ruleRefExpr{
+									pos:  position{line: 1195, col: 18, offset: 56564},
+									name: "WS",
+								},
+							},
+							&notExpr{
+								pos: position{line: 1195, col: 21, offset: 56567},
+								expr: &litMatcher{
+									pos:        position{line: 1195, col: 22, offset: 56568},
+									val:        "[",
+									ignoreCase: false,
+								},
+							},
+							&notExpr{
+								pos: position{line: 1195, col: 26, offset: 56572},
+								expr: &litMatcher{
+									pos:        position{line: 1195, col: 27, offset: 56573},
+									val:        "]",
+									ignoreCase: false,
+								},
+							},
+							&notExpr{
+								pos: position{line: 1195, col: 31, offset: 56577},
+								expr: &litMatcher{
+									pos:        position{line: 1195, col: 32, offset: 56578},
+									val:        "<<",
+									ignoreCase: false,
+								},
+							},
+							&notExpr{
+								pos: position{line: 1195, col: 37, offset: 56583},
+								expr: &litMatcher{
+									pos:        position{line: 1195, col: 38, offset: 56584},
+									val:        ">>",
+									ignoreCase: false,
+								},
+							},
+							&anyMatcher{
+								line: 1195, col: 42, offset: 56588,
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "URL_TEXT",
+			pos:  position{line: 1199, col: 1, offset: 56628},
+			expr: &actionExpr{
+				pos: position{line: 1199, col: 13, offset: 56640},
+				run: (*parser).callonURL_TEXT1,
+				expr: &oneOrMoreExpr{
+					pos: position{line: 1199, col: 13, offset: 56640},
+					expr: &seqExpr{
+						pos: position{line: 1199, col: 14, offset: 56641},
+						exprs: []interface{}{
+							&notExpr{
+								pos: position{line: 1199, col: 14, offset: 56641},
+								expr: &ruleRefExpr{
+									pos:  position{line: 1199, col: 15, offset: 56642},
+									name: "NEWLINE",
+								},
+							},
+							&notExpr{
+								pos: position{line: 1199, col: 23, offset: 56650},
+								expr: &litMatcher{
+									pos:        position{line: 1199, col: 24, offset: 56651},
+									val:        "[",
+									ignoreCase: false,
+								},
+							},
+							&notExpr{
+								pos: position{line: 1199, col: 28, offset: 56655},
+								expr: &litMatcher{
+									pos:        position{line: 1199, col: 29, offset: 56656},
+									val:        "]",
+									ignoreCase: false,
+								},
+							},
+							&anyMatcher{
+								line: 1199, col: 33, offset: 56660,
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "URL_SCHEME",
+			pos:  position{line: 1203, col: 1, offset: 56700},
+			expr: &choiceExpr{
+				pos: position{line: 1203, col: 15, offset: 56714},
+				alternatives: []interface{}{
+					&litMatcher{
+						pos:        position{line: 1203, col: 15, offset: 56714},
+						val:        "http://",
+						ignoreCase: false,
+					},
+					&litMatcher{
+						pos:        position{line: 1203, col: 27, offset: 56726},
+						val:        "https://",
+						ignoreCase: false,
+					},
+					&litMatcher{
+						pos:        position{line: 1203, col: 40, offset: 56739},
+						val:        "ftp://",
+						ignoreCase: false,
+					},
+					&litMatcher{
+						pos:        position{line: 1203, col: 51, offset: 56750},
+						val:        "irc://",
+						ignoreCase: false,
+					},
+					&litMatcher{
+						pos:        position{line: 1203, col: 62, offset: 56761},
+						val:        "mailto:",
+						ignoreCase: false,
+					},
+				},
+			},
+		},
+		{
+			name: "DIGIT",
+			pos:  position{line: 1205, col: 1, offset: 56772},
+			expr: &charClassMatcher{
+				pos:        position{line: 1205, col: 10, offset: 56781},
+				val:        "[0-9]",
+				ranges:     []rune{'0', '9'},
+				ignoreCase: false,
+				inverted:   false,
+			},
+		},
+		{
+			name: "NEWLINE",
+			pos:  position{line: 1207, col: 1, offset: 56788},
+			expr: &choiceExpr{
+				pos: position{line: 1207, col: 12, offset: 56799},
+				alternatives: []interface{}{
+					&litMatcher{
+						pos:        position{line: 1207, col: 12, offset: 56799},
+						val:        "\r\n",
+						ignoreCase: false,
+					},
+					&litMatcher{
+						pos:        position{line: 1207, col: 21, offset: 56808},
+						val:        "\r",
+						ignoreCase: false,
+					},
+					&litMatcher{
+						pos:        position{line: 1207, col: 28, offset: 56815},
+						val:        "\n",
+						ignoreCase: false,
+					},
+				},
+			},
+		},
+		{
+			name: "WS",
+			pos:  position{line: 1209, col: 1, offset: 56821},
+			expr: &choiceExpr{
+				pos: position{line: 1209, col: 7, offset: 56827},
+				alternatives: []interface{}{
+					&litMatcher{
+						pos:        position{line: 1209, col: 7, offset: 56827},
+						val:        " ",
+						ignoreCase: false,
+					},
+					&actionExpr{
+						pos: position{line: 1209, col: 13, offset: 56833},
+						run: (*parser).callonWS3,
+						expr: &litMatcher{
+							pos:        position{line: 1209, col: 13, offset: 56833},
+							val:        "\t",
+							ignoreCase: false,
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "EOF",
+			pos:  position{line: 1213, col: 1, offset: 56878},
+			expr: &notExpr{
+				pos: position{line: 1213, col: 8, offset: 56885},
+				expr: &anyMatcher{
+					line: 1213, col: 9, offset: 56886,
+				},
+			},
+		},
+		{
+			name: "EOL",
+			pos:  position{line: 1215, col: 1, offset: 56889},
+			expr: &choiceExpr{
+				pos: position{line: 1215, col: 8, offset: 56896},
+				alternatives: []interface{}{
+					&ruleRefExpr{
+						pos:  position{line: 1215, col: 8, offset: 56896},
+						name: "NEWLINE",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 1215, col: 18, offset: 56906},
+						name: "EOF",
+					},
+				},
+			},
+		},
+	},
+}
+
+func (c *current) onDocument1(frontMatter, documentHeader, blocks interface{}) (interface{}, error) {
+	return types.NewDocument(frontMatter, documentHeader, blocks.([]interface{}))
+}
+
+func (p *parser) callonDocument1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onDocument1(stack["frontMatter"], stack["documentHeader"], stack["blocks"])
+}
+
+func (c *current) onDocumentBlocks7(content interface{}) (interface{}, error) {
+	return content, nil
+}
+
+func (p *parser) callonDocumentBlocks7() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onDocumentBlocks7(stack["content"])
+}
+
+func (c *current) onPreamble1(elements interface{}) (interface{}, error) {
+	return types.NewPreamble(elements.([]interface{}))
+}
+
+func (p *parser) callonPreamble1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onPreamble1(stack["elements"])
+}
+
+func (c *current) onFrontMatter1(content interface{}) (interface{}, error) {
+	return types.NewYamlFrontMatter(content.(string))
+}
+
+func (p *parser) callonFrontMatter1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onFrontMatter1(stack["content"])
+}
+
+func (c *current) onYamlFrontMatterContent1() (interface{}, error) {
+	return string(c.text), nil
+}
+
+func (p *parser) callonYamlFrontMatterContent1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onYamlFrontMatterContent1()
+}
+
+func (c *current) onDocumentHeader1(header, authors, revision, otherAttributes interface{}) (interface{}, error) {
+
+	result, err := types.NewDocumentHeader(header, authors, revision, otherAttributes.([]interface{}))
+	if err != nil {
+		return nil, err
+	}
+	// some attributes of the header (eg: `doctype`) change the way the rest of the document is parsed
+	storeDocumentAttributes(c, result)
+	return result, nil
+}
+
+func (p *parser) callonDocumentHeader1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onDocumentHeader1(stack["header"], stack["authors"], stack["revision"], stack["otherAttributes"])
+}
+
+func (c *current) onDocumentTitle1(attributes, level, content, id interface{}) (interface{}, error) {
+
+	return types.NewSectionTitle(content.(types.InlineContent), append(attributes.([]interface{}), id))
+}
+
+func (p *parser) callonDocumentTitle1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onDocumentTitle1(stack["attributes"], stack["level"], stack["content"], stack["id"])
+}
+
+func (c *current) onDocumentAuthorsInlineForm1(authors interface{}) (interface{}, error) {
+	return types.NewDocumentAuthors(authors.([]interface{}))
+}
+
+func (p *parser) callonDocumentAuthorsInlineForm1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onDocumentAuthorsInlineForm1(stack["authors"])
+}
+
+func (c *current) onDocumentAuthorsAttributeForm1(author interface{}) (interface{}, error) {
+	return []types.DocumentAuthor{author.(types.DocumentAuthor)}, nil
+}
+
+func (p *parser) callonDocumentAuthorsAttributeForm1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onDocumentAuthorsAttributeForm1(stack["author"])
+}
+
+func (c *current) onDocumentAuthor1(namePart1, namePart2, namePart3, email interface{}) (interface{}, error) {
+	return types.NewDocumentAuthor(namePart1, namePart2, namePart3, email)
+}
+
+func (p *parser) callonDocumentAuthor1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onDocumentAuthor1(stack["namePart1"], stack["namePart2"], stack["namePart3"], stack["email"])
+}
+
+func (c *current) onDocumentRevision1(revnumber, revdate, revremark interface{}) (interface{}, error) {
+	return types.NewDocumentRevision(revnumber, revdate, revremark)
+}
+
+func (p *parser) callonDocumentRevision1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onDocumentRevision1(stack["revnumber"], stack["revdate"], stack["revremark"])
+}
+
+func (c *current) onDocumentAttributeDeclarationWithNameOnly1(name interface{}) (interface{}, error) {
+	attr, err := types.NewDocumentAttributeDeclaration(name.([]interface{}), nil)
+	if err != nil {
+		return nil, err
+	}
+	storeDocumentAttributeDeclaration(c, attr)
+	return attr, nil
+}
+
+func (p *parser) callonDocumentAttributeDeclarationWithNameOnly1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onDocumentAttributeDeclarationWithNameOnly1(stack["name"])
+}
+
+func (c *current) onDocumentAttributeDeclarationWithNameAndValue1(name, value interface{}) (interface{}, error) {
+	attr, err := types.NewDocumentAttributeDeclaration(name.([]interface{}), value.([]interface{}))
+	if err != nil {
+		return nil, err
+	}
+	storeDocumentAttributeDeclaration(c, attr)
+	return attr, nil
+}
+
+func (p *parser) callonDocumentAttributeDeclarationWithNameAndValue1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onDocumentAttributeDeclarationWithNameAndValue1(stack["name"], stack["value"])
+}
+
+func (c *current) onDocumentAttributeResetWithSectionTitleBangSymbol1(name interface{}) (interface{}, error) {
+	attr, err := types.NewDocumentAttributeReset(name.([]interface{}))
+	if err != nil {
+		return nil, err
+	}
+	storeDocumentAttributeReset(c, attr)
+	return attr, nil
+}
+
+func (p *parser) callonDocumentAttributeResetWithSectionTitleBangSymbol1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onDocumentAttributeResetWithSectionTitleBangSymbol1(stack["name"])
+}
+
+func (c *current) onDocumentAttributeResetWithTrailingBangSymbol1(name interface{}) (interface{}, error) {
+	attr, err := types.NewDocumentAttributeReset(name.([]interface{}))
+	if err != nil {
+		return nil, err
+	}
+	storeDocumentAttributeReset(c, attr)
+	return attr, nil
+}
+
+func (p *parser) callonDocumentAttributeResetWithTrailingBangSymbol1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onDocumentAttributeResetWithTrailingBangSymbol1(stack["name"])
+}
+
+func (c *current) onDocumentAttributeSubstitution3() (bool, error) {
+	return isSubstitutionEnabled(c, types.AttributesSubstitution), nil
+}
+
+func (p *parser) callonDocumentAttributeSubstitution3() (bool, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onDocumentAttributeSubstitution3()
+}
+
+func (c *current) onDocumentAttributeSubstitution1(name interface{}) (interface{}, error) {
+	return types.NewDocumentAttributeSubstitution(name.([]interface{}))
+}
+
+func (p *parser) callonDocumentAttributeSubstitution1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onDocumentAttributeSubstitution1(stack["name"])
+}
+
+func (c *current) onThematicBreak1() (interface{}, error) {
+	return types.NewThematicBreak()
+}
+
+func (p *parser) callonThematicBreak1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onThematicBreak1()
+}
+
+func (c *current) onPageBreak1() (interface{}, error) {
+	return types.NewPageBreak()
+}
+
+func (p *parser) callonPageBreak1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onPageBreak1()
+}
+
+func (c *current) onSection03() (bool, error) {
+	return isBookDoctype(c), nil
+}
+
+func (p *parser) callonSection03() (bool, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onSection03()
+}
+
+func (c *current) onSection01(header, elements interface{}) (interface{}, error) {
+	return types.NewSection(0, header.(types.SectionTitle), elements.([]interface{}))
+}
+
+func (p *parser) callonSection01() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onSection01(stack["header"], stack["elements"])
+}
+
+func (c *current) onSection0Block1(content interface{}) (interface{}, error) {
+	return content.(types.DocElement), nil
+}
+
+func (p *parser) callonSection0Block1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onSection0Block1(stack["content"])
+}
+
+func (c *current) onSection11(header, elements interface{}) (interface{}, error) {
+	return types.NewSection(1, header.(types.SectionTitle), elements.([]interface{}))
+}
+
+func (p *parser) callonSection11() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onSection11(stack["header"], stack["elements"])
+}
+
+func (c *current) onSection1Block1(content interface{}) (interface{}, error) {
+	return content.(types.DocElement), nil
+}
+
+func (p *parser) callonSection1Block1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onSection1Block1(stack["content"])
+}
+
+func (c *current) onSection21(header, elements interface{}) (interface{}, error) {
+	return types.NewSection(2, header.(types.SectionTitle), elements.([]interface{}))
+}
+
+func (p *parser) callonSection21() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onSection21(stack["header"], stack["elements"])
+}
+
+func (c *current) onSection2Block1(content interface{}) (interface{}, error) {
+	return content.(types.DocElement), nil
+}
+
+func (p *parser) callonSection2Block1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onSection2Block1(stack["content"])
+}
+
+func (c *current) onSection31(header, elements interface{}) (interface{}, error) {
+	return types.NewSection(3, header.(types.SectionTitle), elements.([]interface{}))
+}
+
+func (p *parser) callonSection31() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onSection31(stack["header"], stack["elements"])
+}
+
+func (c *current) onSection3Block1(content interface{}) (interface{}, error) {
+	return content.(types.DocElement), nil
+}
+
+func (p *parser) callonSection3Block1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onSection3Block1(stack["content"])
+}
+
+func (c *current) onSection41(header, elements interface{}) (interface{}, error) {
+	return types.NewSection(4, header.(types.SectionTitle), elements.([]interface{}))
+}
+
+func (p *parser) callonSection41() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onSection41(stack["header"], stack["elements"])
+}
+
+func (c *current) onSection4Block1(content interface{}) (interface{}, error) {
+	return content.(types.DocElement), nil
+}
+
+func (p *parser) callonSection4Block1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onSection4Block1(stack["content"])
+}
+
+func (c *current) onSection51(header, elements interface{}) (interface{}, error) {
+	return types.NewSection(5, header.(types.SectionTitle), elements.([]interface{}))
+}
+
+func (p *parser) callonSection51() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onSection51(stack["header"], stack["elements"])
+}
+
+func (c *current) onSection5Block1(content interface{}) (interface{}, error) {
+	return content.(types.DocElement), nil
+}
+
+func (p *parser) callonSection5Block1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onSection5Block1(stack["content"])
+}
+
+func (c *current) onSection0Title1(attributes, level, content, id interface{}) (interface{}, error) {
+
+	return types.NewSectionTitle(content.(types.InlineContent), append(attributes.([]interface{}), id))
+}
+
+func (p *parser) callonSection0Title1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onSection0Title1(stack["attributes"], stack["level"], stack["content"], stack["id"])
+}
+
+func (c *current) onSection1Title1(attributes, level, content, id interface{}) (interface{}, error) {
+
+	return types.NewSectionTitle(content.(types.InlineContent), append(attributes.([]interface{}), id))
+}
+
+func (p *parser) callonSection1Title1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onSection1Title1(stack["attributes"], stack["level"], stack["content"], stack["id"])
+}
+
+func (c *current) onInvalidSection0Title3() (bool, error) {
+	return isBookDoctype(c), nil
+}
+
+func (p *parser) callonInvalidSection0Title3() (bool, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onInvalidSection0Title3()
+}
+
+func (c *current) onInvalidSection0Title1(title interface{}) (interface{}, error) {
+	warnInvalidSection0(c)
+	return title, nil
+}
+
+func (p *parser) callonInvalidSection0Title1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onInvalidSection0Title1(stack["title"])
+}
+
+func (c *current) onSection2Title1(attributes, level, content, id interface{}) (interface{}, error) {
+	return types.NewSectionTitle(content.(types.InlineContent), append(attributes.([]interface{}), id))
+}
+
+func (p *parser) callonSection2Title1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onSection2Title1(stack["attributes"], stack["level"], stack["content"], stack["id"])
+}
+
+func (c *current) onSection3Title1(attributes, level, content, id interface{}) (interface{}, error) {
+	return types.NewSectionTitle(content.(types.InlineContent), append(attributes.([]interface{}), id))
+}
+
+func (p *parser) callonSection3Title1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onSection3Title1(stack["attributes"], stack["level"], stack["content"], stack["id"])
+}
+
+func (c *current) onSection4Title1(attributes, level, content, id interface{}) (interface{}, error) {
+	return types.NewSectionTitle(content.(types.InlineContent), append(attributes.([]interface{}), id))
+}
+
+func (p *parser) callonSection4Title1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onSection4Title1(stack["attributes"], stack["level"], stack["content"], stack["id"])
+}
+
+func (c *current) onSection5Title1(attributes, level, content, id interface{}) (interface{}, error) {
+	return types.NewSectionTitle(content.(types.InlineContent), append(attributes.([]interface{}), id))
+}
+
+func (p *parser) callonSection5Title1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onSection5Title1(stack["attributes"], stack["level"], stack["content"], stack["id"])
+}
+
+func (c *current) onDiscreteHeading5(attr interface{}) (interface{}, error) {
+	return attr, nil
+}
+
+func (p *parser) callonDiscreteHeading5() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onDiscreteHeading5(stack["attr"])
+}
+
+func (c *current) onDiscreteHeading1(before, after, level, content, id interface{}) (interface{}, error) {
+	return types.NewDiscreteHeading(level.(int), content.(types.InlineContent), append(append(before.([]interface{}), after.([]interface{})...), id))
+}
+
+func (p *parser) callonDiscreteHeading1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onDiscreteHeading1(stack["before"], stack["after"], stack["level"], stack["content"], stack["id"])
+}
+
+func (c *current) onDiscreteHeadingLevel1() (interface{}, error) {
+	return len(c.text) - 1, nil
+}
+
+func (p *parser) callonDiscreteHeadingLevel1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onDiscreteHeadingLevel1()
+}
+
+func (c *current) onList1(attributes, elements interface{}) (interface{}, error) {
+	return types.NewList(elements.([]interface{}), attributes.([]interface{}))
+}
+
+func (p *parser) callonList1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onList1(stack["attributes"], stack["elements"])
+}
+
+func (c *current) onListAttribute1(attribute interface{}) (interface{}, error) {
+	return attribute, nil
+}
+
+func (p *parser) callonListAttribute1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onListAttribute1(stack["attribute"])
+}
+
+func (c *current) onListID1(id interface{}) (interface{}, error) {
+	return map[string]interface{}{"ID": id.(string)}, nil
+}
+
+func (p *parser) callonListID1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onListID1(stack["id"])
+}
+
+func (c *current) onHorizontalLayout1() (interface{}, error) {
+	return map[string]interface{}{"layout": "horizontal"}, nil
+}
+
+func (p *parser) callonHorizontalLayout1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onHorizontalLayout1()
+}
+
+func (c *current) onListParagraph1(lines interface{}) (interface{}, error) {
+	return types.NewListParagraph(lines.([]interface{}))
+}
+
+func (p *parser) callonListParagraph1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onListParagraph1(stack["lines"])
+}
+
+func (c *current) onListItemContinuation1() (interface{}, error) {
+	return types.NewListItemContinuation()
+}
+
+func (p *parser) callonListItemContinuation1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onListItemContinuation1()
+}
+
+func (c *current) onContinuedBlockElement1(element interface{}) (interface{}, error) {
+	return element, nil
+}
+
+func (p *parser) callonContinuedBlockElement1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onContinuedBlockElement1(stack["element"])
+}
+
+func (c *current) onOrderedListItem1(attributes, prefix, content interface{}) (interface{}, error) {
+	return types.NewOrderedListItem(prefix.(types.OrderedListItemPrefix), content.([]types.DocElement), attributes.([]interface{}))
+}
+
+func (p *parser) callonOrderedListItem1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onOrderedListItem1(stack["attributes"], stack["prefix"], stack["content"])
+}
+
+func (c *current) onOrderedListItemPrefix2(style interface{}) (interface{}, error) {
+	// numbering style: "."
+	return types.NewOrderedListItemPrefix(types.Arabic, 1)
+}
+
+func (p *parser) callonOrderedListItemPrefix2() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onOrderedListItemPrefix2(stack["style"])
+}
+
+func (c *current) onOrderedListItemPrefix10(style interface{}) (interface{}, error) {
+	// numbering style: ".."
+	return types.NewOrderedListItemPrefix(types.LowerAlpha, 2)
+}
+
+func (p *parser) callonOrderedListItemPrefix10() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onOrderedListItemPrefix10(stack["style"])
+}
+
+func (c *current) onOrderedListItemPrefix18(style interface{}) (interface{}, error) {
+	// numbering style: "..."
+	return types.NewOrderedListItemPrefix(types.LowerRoman, 3)
+}
+
+func (p *parser) callonOrderedListItemPrefix18() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onOrderedListItemPrefix18(stack["style"])
+}
+
+func (c *current) onOrderedListItemPrefix26(style interface{}) (interface{}, error) {
+	// numbering style: "...."
+	return types.NewOrderedListItemPrefix(types.UpperAlpha, 4)
+}
+
+func (p *parser) callonOrderedListItemPrefix26() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onOrderedListItemPrefix26(stack["style"])
+}
+
+func (c *current) onOrderedListItemPrefix34(style interface{}) (interface{}, error) {
+	// numbering style: "....."
+	return types.NewOrderedListItemPrefix(types.UpperRoman, 5)
+	// explicit numbering
+}
+
+func (p *parser) callonOrderedListItemPrefix34() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onOrderedListItemPrefix34(stack["style"])
+}
+
+func (c *current) onOrderedListItemPrefix42(style interface{}) (interface{}, error) {
+	// numbering style: "1."
+	return types.NewOrderedListItemPrefix(types.Arabic, 1)
+}
+
+func (p *parser) callonOrderedListItemPrefix42() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onOrderedListItemPrefix42(stack["style"])
+}
+
+func (c *current) onOrderedListItemPrefix60(style interface{}) (interface{}, error) {
+	// numbering style: "a."
+	return types.NewOrderedListItemPrefix(types.LowerAlpha, 1)
+}
+
+func (p *parser) callonOrderedListItemPrefix60() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onOrderedListItemPrefix60(stack["style"])
+}
+
+func (c *current) onOrderedListItemPrefix78(style interface{}) (interface{}, error) {
+	// numbering style: "A."
+	return types.NewOrderedListItemPrefix(types.UpperAlpha, 1)
+}
+
+func (p *parser) callonOrderedListItemPrefix78() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onOrderedListItemPrefix78(stack["style"])
+}
+
+func (c *current) onOrderedListItemPrefix96(style interface{}) (interface{}, error) {
+	// numbering style: "i)"
+	return types.NewOrderedListItemPrefix(types.LowerRoman, 1)
+}
+
+func (p *parser) callonOrderedListItemPrefix96() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onOrderedListItemPrefix96(stack["style"])
+}
+
+func (c *current) onOrderedListItemPrefix114(style interface{}) (interface{}, error) {
+	// numbering style: "I)"
+	return types.NewOrderedListItemPrefix(types.UpperRoman, 1)
+}
+
+func (p *parser) callonOrderedListItemPrefix114() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onOrderedListItemPrefix114(stack["style"])
+}
+
+func (c *current) onOrderedListItemContent1(elements interface{}) (interface{}, error) {
+	// Another list or a literal paragraph immediately following a list item will be implicitly included in the list item
+	return types.NewListItemContent(elements.([]interface{}))
+}
+
+func (p *parser) callonOrderedListItemContent1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onOrderedListItemContent1(stack["elements"])
+}
+
+func (c *current) onUnorderedListItem1(prefix, content interface{}) (interface{}, error) {
+	return types.NewUnorderedListItem(prefix.(types.UnorderedListItemPrefix), content.([]types.DocElement))
+}
+
+func (p *parser) callonUnorderedListItem1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onUnorderedListItem1(stack["prefix"], stack["content"])
+}
+
+func (c *current) onUnorderedListItemPrefix2(level interface{}) (interface{}, error) {
+	// ignore whitespaces, only return the relevant "*"/"-" characters
+	return types.NewUnorderedListItemPrefix(types.FiveAsterisks, 5)
+}
+
+func (p *parser) callonUnorderedListItemPrefix2() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onUnorderedListItemPrefix2(stack["level"])
+}
+
+func (c *current) onUnorderedListItemPrefix10(level interface{}) (interface{}, error) {
+	// ignore whitespaces, only return the relevant "*"/"-" characters
+	return types.NewUnorderedListItemPrefix(types.FourAsterisks, 4)
+}
+
+func (p *parser) callonUnorderedListItemPrefix10() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onUnorderedListItemPrefix10(stack["level"])
+}
+
+func (c *current) onUnorderedListItemPrefix18(level interface{}) (interface{}, error) {
+	// ignore whitespaces, only return the relevant "*"/"-" characters
+	return types.NewUnorderedListItemPrefix(types.ThreeAsterisks, 3)
+}
+
+func (p *parser) callonUnorderedListItemPrefix18() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onUnorderedListItemPrefix18(stack["level"])
+}
+
+func (c *current) onUnorderedListItemPrefix26(level interface{}) (interface{}, error) {
+	// ignore whitespaces, only return the relevant "*"/"-" characters
+	return types.NewUnorderedListItemPrefix(types.TwoAsterisks, 2)
+}
+
+func (p *parser) callonUnorderedListItemPrefix26() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onUnorderedListItemPrefix26(stack["level"])
+}
+
+func (c *current) onUnorderedListItemPrefix34(level interface{}) (interface{}, error) {
+	// ignore whitespaces, only return the relevant "*"/"-" characters
+	return types.NewUnorderedListItemPrefix(types.OneAsterisk, 1)
+}
+
+func (p *parser) callonUnorderedListItemPrefix34() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onUnorderedListItemPrefix34(stack["level"])
+}
+
+func (c *current) onUnorderedListItemPrefix42(level interface{}) (interface{}, error) {
+	// ignore whitespaces, only return the relevant "*"/"-" characters
+	return types.NewUnorderedListItemPrefix(types.Dash, 1)
+}
+
+func (p *parser) callonUnorderedListItemPrefix42() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onUnorderedListItemPrefix42(stack["level"])
+}
+
+func (c *current) onUnorderedListItemContent1(elements interface{}) (interface{}, error) {
+	// Another list or a literal paragraph immediately following a list item will be implicitly included in the list item
+	return types.NewListItemContent(elements.([]interface{}))
+}
+
+func (p *parser) callonUnorderedListItemContent1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onUnorderedListItemContent1(stack["elements"])
+}
+
+func (c *current) onLabeledListItem2(term, description interface{}) (interface{}, error) {
+	return types.NewLabeledListItem(term.([]interface{}), description.([]types.DocElement))
+}
+
+func (p *parser) callonLabeledListItem2() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onLabeledListItem2(stack["term"], stack["description"])
+}
+
+func (c *current) onLabeledListItem9(term interface{}) (interface{}, error) {
+	// here, WS is optional since there is no description afterwards
+	return types.NewLabeledListItem(term.([]interface{}), nil)
+}
+
+func (p *parser) callonLabeledListItem9() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onLabeledListItem9(stack["term"])
+}
+
+func (c *current) onLabeledListItemTerm1(term interface{}) (interface{}, error) {
+	return term, nil
+}
+
+func (p *parser) callonLabeledListItemTerm1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onLabeledListItemTerm1(stack["term"])
+}
+
+func (c *current) onLabeledListItemDescription1(elements interface{}) (interface{}, error) {
+	// TODO: replace with (ListParagraph+ ContinuedBlockElement*) and use a single rule for all item contents ?
+	return types.NewListItemContent(elements.([]interface{}))
+}
+
+func (p *parser) callonLabeledListItemDescription1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onLabeledListItemDescription1(stack["elements"])
+}
+
+func (c *current) onCalloutList1(attributes, items interface{}) (interface{}, error) {
+	return types.NewCalloutList(items.([]interface{}), attributes.([]interface{}))
+}
+
+func (p *parser) callonCalloutList1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onCalloutList1(stack["attributes"], stack["items"])
+}
+
+func (c *current) onCalloutListItem1(ref, content interface{}) (interface{}, error) {
+	return types.NewCalloutListItem(ref.([]interface{}), content.([]types.DocElement))
+}
+
+func (p *parser) callonCalloutListItem1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onCalloutListItem1(stack["ref"], stack["content"])
+}
+
+func (c *current) onCalloutListItemPrefix1(ref interface{}) (interface{}, error) {
+	return ref, nil
+}
+
+func (p *parser) callonCalloutListItemPrefix1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onCalloutListItemPrefix1(stack["ref"])
+}
+
+func (c *current) onCalloutListItemContent1(elements interface{}) (interface{}, error) {
+	return types.NewListItemContent(elements.([]interface{}))
+}
+
+func (p *parser) callonCalloutListItemContent1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onCalloutListItemContent1(stack["elements"])
+}
+
+func (c *current) onParagraph7(attributes interface{}) (bool, error) {
+	return hasSubstitutions(attributes), nil
+}
+
+func (p *parser) callonParagraph7() (bool, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onParagraph7(stack["attributes"])
+}
+
+func (c *current) onParagraph2(attributes, lines interface{}) (interface{}, error) {
+	// the lines are parsed with the substitutions of the paragraph
+	p, err := types.NewParagraph(lines.([]interface{}), attributes.([]interface{}))
+	if err != nil {
+		return nil, err
+	}
+	return substituteParagraph(c, p)
+}
+
+func (p *parser) callonParagraph2() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onParagraph2(stack["attributes"], stack["lines"])
+}
+
+func (c *current) onParagraph29(attributes, lines interface{}) (interface{}, error) {
+	return types.NewParagraph(lines.([]interface{}), attributes.([]interface{}))
+}
+
+func (p *parser) callonParagraph29() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onParagraph29(stack["attributes"], stack["lines"])
+}
+
+func (c *current) onRawParagraphLine1(content interface{}) (interface{}, error) {
+	return types.NewInlineContent(content.([]interface{}))
+}
+
+func (p *parser) callonRawParagraphLine1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onRawParagraphLine1(stack["content"])
+}
+
+func (c *current) onInlineContentWithTrailingSpaces1(elements interface{}) (interface{}, error) {
+	// includes heading and trailing spaces in the elements arg
+	return types.NewInlineContent(elements.([]interface{}))
+}
+
+func (p *parser) callonInlineContentWithTrailingSpaces1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onInlineContentWithTrailingSpaces1(stack["elements"])
+}
+
+func (c *current) onInlineContent1(elements interface{}) (interface{}, error) {
+	// absorbs heading and trailing spaces, but not the trailing ID (eg: in a section title)
+	return types.NewInlineContent(elements.([]interface{}))
+}
+
+func (p *parser) callonInlineContent1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onInlineContent1(stack["elements"])
+}
+
+func (c *current) onInlineCharacters1() (interface{}, error) {
+	return string(c.text), nil
+}
+
+func (p *parser) callonInlineCharacters1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onInlineCharacters1()
+}
+
+func (c *current) onLineBreak3() (bool, error) {
+	return isSubstitutionEnabled(c, types.PostReplacementsSubstitution), nil
+}
+
+func (p *parser) callonLineBreak3() (bool, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onLineBreak3()
+}
+
+func (c *current) onLineBreak1() (interface{}, error) {
+	return types.NewLineBreak()
+}
+
+func (p *parser) callonLineBreak1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onLineBreak1()
+}
+
+func (c *current) onAdmonitionBlock1(attributes, k, block interface{}) (interface{}, error) {
+	return types.NewAdmonitionBlock(k.(types.AdmonitionKind), block.(types.DelimitedBlock), attributes.([]interface{}))
+}
+
+func (p *parser) callonAdmonitionBlock1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onAdmonitionBlock1(stack["attributes"], stack["k"], stack["block"])
+}
+
+func (c *current) onAdmonitionParagraph2(attributes, t, content interface{}) (interface{}, error) {
+	// paragraph style
+	return types.NewAdmonitionParagraph(t.(types.AdmonitionKind), content, attributes.([]interface{}))
+}
+
+func (p *parser) callonAdmonitionParagraph2() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onAdmonitionParagraph2(stack["attributes"], stack["t"], stack["content"])
+}
+
+func (c *current) onAdmonitionParagraph18(attributes, k, otherAttributes, content interface{}) (interface{}, error) {
+	// block style
+	return types.NewAdmonitionParagraph(k.(types.AdmonitionKind), content, append(attributes.([]interface{}), otherAttributes.([]interface{})...))
+}
+
+func (p *parser) callonAdmonitionParagraph18() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onAdmonitionParagraph18(stack["attributes"], stack["k"], stack["otherAttributes"], stack["content"])
+}
+
+func (c *current) onAdmonitionParagraphContent1(lines interface{}) (interface{}, error) {
+	return types.NewAdmonitionParagraphContent(lines.([]interface{}))
+}
+
+func (p *parser) callonAdmonitionParagraphContent1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onAdmonitionParagraphContent1(stack["lines"])
+}
+
+func (c *current) onAdmonitionMarker1(k interface{}) (interface{}, error) {
+	return k, nil
+}
+
+func (p *parser) callonAdmonitionMarker1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onAdmonitionMarker1(stack["k"])
+}
+
+func (c *current) onAdmonitionKind2() (interface{}, error) {
+	return types.Tip, nil
+}
+
+func (p *parser) callonAdmonitionKind2() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onAdmonitionKind2()
+}
+
+func (c *current) onAdmonitionKind4() (interface{}, error) {
+	return types.Note, nil
+}
+
+func (p *parser) callonAdmonitionKind4() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onAdmonitionKind4()
+}
+
+func (c *current) onAdmonitionKind6() (interface{}, error) {
+	return types.Important, nil
+}
+
+func (p *parser) callonAdmonitionKind6() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onAdmonitionKind6()
+}
+
+func (c *current) onAdmonitionKind8() (interface{}, error) {
+	return types.Warning, nil
+}
+
+func (p *parser) callonAdmonitionKind8() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onAdmonitionKind8()
+}
+
+func (c *current) onAdmonitionKind10() (interface{}, error) {
+	return types.Caution, nil
+}
+
+func (p *parser) callonAdmonitionKind10() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onAdmonitionKind10()
+}
+
+func (c *current) onQuotedText4() (bool, error) {
+	return isSubstitutionEnabled(c, types.QuotesSubstitution), nil
+}
+
+func (p *parser) callonQuotedText4() (bool, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onQuotedText4()
+}
+
+func (c *current) onQuotedText2(attributes, text interface{}) (interface{}, error) {
+	return types.NewQuotedTextWithAttributes(text.(types.QuotedText), attributes)
+}
+
+func (p *parser) callonQuotedText2() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onQuotedText2(stack["attributes"], stack["text"])
+}
+
+func (c *current) onQuotedText14() (bool, error) {
+	return isSubstitutionEnabled(c, types.QuotesSubstitution), nil
+}
+
+func (p *parser) callonQuotedText14() (bool, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onQuotedText14()
+}
+
+func (c *current) onQuotedText12(text interface{}) (interface{}, error) {
+	return text, nil
+}
+
+func (p *parser) callonQuotedText12() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onQuotedText12(stack["text"])
+}
+
+func (c *current) onQuotedTextAttributes7(id interface{}) (interface{}, error) {
+	return id, nil
+}
+
+func (p *parser) callonQuotedTextAttributes7() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onQuotedTextAttributes7(stack["id"])
+}
+
+func (c *current) onQuotedTextAttributes14(role interface{}) (interface{}, error) {
+	return role, nil
+}
+
+func (p *parser) callonQuotedTextAttributes14() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onQuotedTextAttributes14(stack["role"])
+}
+
+func (c *current) onQuotedTextAttributes2(id, roles interface{}) (interface{}, error) {
+	return types.NewQuotedTextAttributes(id, roles.([]interface{}))
+}
+
+func (p *parser) callonQuotedTextAttributes2() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onQuotedTextAttributes2(stack["id"], stack["roles"])
+}
+
+func (c *current) onQuotedTextAttributes20(role interface{}) (interface{}, error) {
+	return types.NewQuotedTextAttributes(nil, []interface{}{role})
+}
+
+func (p *parser) callonQuotedTextAttributes20() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onQuotedTextAttributes20(stack["role"])
+}
+
+func (c *current) onQuotedTextAttributeValue1() (interface{}, error) {
+	return string(c.text), nil
+}
+
+func (p *parser) callonQuotedTextAttributeValue1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onQuotedTextAttributeValue1()
+}
+
+func (c *current) onUnconstrainedQuotedText3() (bool, error) {
+	return isSubstitutionEnabled(c, types.QuotesSubstitution), nil
+}
+
+func (p *parser) callonUnconstrainedQuotedText3() (bool, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onUnconstrainedQuotedText3()
+}
+
+func (c *current) onUnconstrainedQuotedText1(text interface{}) (interface{}, error) {
+	return text, nil
+}
+
+func (p *parser) callonUnconstrainedQuotedText1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onUnconstrainedQuotedText1(stack["text"])
+}
+
+func (c *current) onDoubleQuoteBoldText1(content interface{}) (interface{}, error) {
+	// double punctuation must be evaluated first
+	return types.NewQuotedText(types.Bold, content.([]interface{}))
+}
+
+func (p *parser) callonDoubleQuoteBoldText1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onDoubleQuoteBoldText1(stack["content"])
 }
 
-func (c *current) onDocument1(frontMatter, documentHeader, blocks interface{}) (interface{}, error) {
-	return types.NewDocument(frontMatter, documentHeader, blocks.([]interface{}))
+func (c *current) onSingleQuoteBoldText2(content interface{}) (interface{}, error) {
+	// unbalanced `**` vs `*` punctuation
+	result := append([]interface{}{"*"}, content.([]interface{}))
+	return types.NewQuotedText(types.Bold, result)
 }
 
-func (p *parser) callonDocument1() (interface{}, error) {
+func (p *parser) callonSingleQuoteBoldText2() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onDocument1(stack["frontMatter"], stack["documentHeader"], stack["blocks"])
+	return p.cur.onSingleQuoteBoldText2(stack["content"])
 }
 
-func (c *current) onDocumentBlocks7(content interface{}) (interface{}, error) {
-	return content, nil
+func (c *current) onSingleQuoteBoldText12(content interface{}) (interface{}, error) {
+	// single punctuation
+	return types.NewQuotedText(types.Bold, content.([]interface{}))
 }
 
-func (p *parser) callonDocumentBlocks7() (interface{}, error) {
+func (p *parser) callonSingleQuoteBoldText12() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onDocumentBlocks7(stack["content"])
+	return p.cur.onSingleQuoteBoldText12(stack["content"])
 }
 
-func (c *current) onPreamble1(elements interface{}) (interface{}, error) {
-	return types.NewPreamble(elements.([]interface{}))
+func (c *current) onEscapedBoldText2(backslashes, content interface{}) (interface{}, error) {
+	// double punctuation must be evaluated first
+	return types.NewEscapedQuotedText(backslashes.([]interface{}), "**", content.([]interface{}))
 }
 
-func (p *parser) callonPreamble1() (interface{}, error) {
+func (p *parser) callonEscapedBoldText2() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onPreamble1(stack["elements"])
+	return p.cur.onEscapedBoldText2(stack["backslashes"], stack["content"])
 }
 
-func (c *current) onFrontMatter1(content interface{}) (interface{}, error) {
-	return types.NewYamlFrontMatter(content.(string))
+func (c *current) onEscapedBoldText13(backslashes, content interface{}) (interface{}, error) {
+	// unbalanced `**` vs `*` punctuation
+	result := append([]interface{}{"*"}, content.([]interface{}))
+	return types.NewEscapedQuotedText(backslashes.([]interface{}), "*", result)
 }
 
-func (p *parser) callonFrontMatter1() (interface{}, error) {
+func (p *parser) callonEscapedBoldText13() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onFrontMatter1(stack["content"])
+	return p.cur.onEscapedBoldText13(stack["backslashes"], stack["content"])
 }
 
-func (c *current) onYamlFrontMatterContent1() (interface{}, error) {
-	return string(c.text), nil
+func (c *current) onEscapedBoldText24(backslashes, content interface{}) (interface{}, error) {
+	// simple punctuation must be evaluated last
+	return types.NewEscapedQuotedText(backslashes.([]interface{}), "*", content.([]interface{}))
 }
 
-func (p *parser) callonYamlFrontMatterContent1() (interface{}, error) {
+func (p *parser) callonEscapedBoldText24() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onYamlFrontMatterContent1()
+	return p.cur.onEscapedBoldText24(stack["backslashes"], stack["content"])
 }
 
-func (c *current) onDocumentHeader1(header, authors, revision, otherAttributes interface{}) (interface{}, error) {
+func (c *current) onDoubleQuoteItalicText1(content interface{}) (interface{}, error) {
+	return types.NewQuotedText(types.Italic, content.([]interface{}))
+}
 
-	return types.NewDocumentHeader(header, authors, revision, otherAttributes.([]interface{}))
+func (p *parser) callonDoubleQuoteItalicText1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onDoubleQuoteItalicText1(stack["content"])
 }
 
-func (p *parser) callonDocumentHeader1() (interface{}, error) {
+func (c *current) onSingleQuoteItalicText2(content interface{}) (interface{}, error) {
+	// unbalanced `__` vs `_` punctuation
+	result := append([]interface{}{"_"}, content.([]interface{}))
+	return types.NewQuotedText(types.Italic, result)
+}
+
+func (p *parser) callonSingleQuoteItalicText2() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onDocumentHeader1(stack["header"], stack["authors"], stack["revision"], stack["otherAttributes"])
+	return p.cur.onSingleQuoteItalicText2(stack["content"])
 }
 
-func (c *current) onDocumentTitle1(attributes, level, content, id interface{}) (interface{}, error) {
+func (c *current) onSingleQuoteItalicText12(content interface{}) (interface{}, error) {
+	return types.NewQuotedText(types.Italic, content.([]interface{}))
+}
 
-	return types.NewSectionTitle(content.(types.InlineContent), append(attributes.([]interface{}), id))
+func (p *parser) callonSingleQuoteItalicText12() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onSingleQuoteItalicText12(stack["content"])
 }
 
-func (p *parser) callonDocumentTitle1() (interface{}, error) {
+func (c *current) onEscapedItalicText2(backslashes, content interface{}) (interface{}, error) {
+	// double punctuation must be evaluated first
+	return types.NewEscapedQuotedText(backslashes.([]interface{}), "__", content.([]interface{}))
+}
+
+func (p *parser) callonEscapedItalicText2() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onDocumentTitle1(stack["attributes"], stack["level"], stack["content"], stack["id"])
+	return p.cur.onEscapedItalicText2(stack["backslashes"], stack["content"])
 }
 
-func (c *current) onDocumentAuthorsInlineForm1(authors interface{}) (interface{}, error) {
-	return types.NewDocumentAuthors(authors.([]interface{}))
+func (c *current) onEscapedItalicText13(backslashes, content interface{}) (interface{}, error) {
+	// unbalanced `__` vs `_` punctuation
+	result := append([]interface{}{"_"}, content.([]interface{}))
+	return types.NewEscapedQuotedText(backslashes.([]interface{}), "_", result)
 }
 
-func (p *parser) callonDocumentAuthorsInlineForm1() (interface{}, error) {
+func (p *parser) callonEscapedItalicText13() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onDocumentAuthorsInlineForm1(stack["authors"])
+	return p.cur.onEscapedItalicText13(stack["backslashes"], stack["content"])
 }
 
-func (c *current) onDocumentAuthorsAttributeForm1(author interface{}) (interface{}, error) {
-	return []types.DocumentAuthor{author.(types.DocumentAuthor)}, nil
+func (c *current) onEscapedItalicText24(backslashes, content interface{}) (interface{}, error) {
+	// simple punctuation must be evaluated last
+	return types.NewEscapedQuotedText(backslashes.([]interface{}), "_", content.([]interface{}))
 }
 
-func (p *parser) callonDocumentAuthorsAttributeForm1() (interface{}, error) {
+func (p *parser) callonEscapedItalicText24() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onDocumentAuthorsAttributeForm1(stack["author"])
+	return p.cur.onEscapedItalicText24(stack["backslashes"], stack["content"])
 }
 
-func (c *current) onDocumentAuthor1(namePart1, namePart2, namePart3, email interface{}) (interface{}, error) {
-	return types.NewDocumentAuthor(namePart1, namePart2, namePart3, email)
+func (c *current) onDoubleQuoteMonospaceText1(content interface{}) (interface{}, error) {
+	// double punctuation must be evaluated first
+	return types.NewQuotedText(types.Monospace, content.([]interface{}))
 }
 
-func (p *parser) callonDocumentAuthor1() (interface{}, error) {
+func (p *parser) callonDoubleQuoteMonospaceText1() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onDocumentAuthor1(stack["namePart1"], stack["namePart2"], stack["namePart3"], stack["email"])
+	return p.cur.onDoubleQuoteMonospaceText1(stack["content"])
 }
 
-func (c *current) onDocumentRevision1(revnumber, revdate, revremark interface{}) (interface{}, error) {
-	return types.NewDocumentRevision(revnumber, revdate, revremark)
+func (c *current) onSingleQuoteMonospaceText2(content interface{}) (interface{}, error) {
+	// unbalanced "``" vs "`" punctuation
+	result := append([]interface{}{"`"}, content.([]interface{}))
+	return types.NewQuotedText(types.Monospace, result)
 }
 
-func (p *parser) callonDocumentRevision1() (interface{}, error) {
+func (p *parser) callonSingleQuoteMonospaceText2() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onDocumentRevision1(stack["revnumber"], stack["revdate"], stack["revremark"])
+	return p.cur.onSingleQuoteMonospaceText2(stack["content"])
 }
 
-func (c *current) onDocumentAttributeDeclarationWithNameOnly1(name interface{}) (interface{}, error) {
-	return types.NewDocumentAttributeDeclaration(name.([]interface{}), nil)
+func (c *current) onSingleQuoteMonospaceText12(content interface{}) (interface{}, error) {
+	// simple punctuation must be evaluated last
+	return types.NewQuotedText(types.Monospace, content.([]interface{}))
 }
 
-func (p *parser) callonDocumentAttributeDeclarationWithNameOnly1() (interface{}, error) {
+func (p *parser) callonSingleQuoteMonospaceText12() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onDocumentAttributeDeclarationWithNameOnly1(stack["name"])
+	return p.cur.onSingleQuoteMonospaceText12(stack["content"])
 }
 
-func (c *current) onDocumentAttributeDeclarationWithNameAndValue1(name, value interface{}) (interface{}, error) {
-	return types.NewDocumentAttributeDeclaration(name.([]interface{}), value.([]interface{}))
+func (c *current) onEscapedMonospaceText2(backslashes, content interface{}) (interface{}, error) {
+	// double punctuation must be evaluated first
+	return types.NewEscapedQuotedText(backslashes.([]interface{}), "``", content.([]interface{}))
 }
 
-func (p *parser) callonDocumentAttributeDeclarationWithNameAndValue1() (interface{}, error) {
+func (p *parser) callonEscapedMonospaceText2() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onDocumentAttributeDeclarationWithNameAndValue1(stack["name"], stack["value"])
+	return p.cur.onEscapedMonospaceText2(stack["backslashes"], stack["content"])
 }
 
-func (c *current) onDocumentAttributeResetWithSectionTitleBangSymbol1(name interface{}) (interface{}, error) {
-	return types.NewDocumentAttributeReset(name.([]interface{}))
+func (c *current) onEscapedMonospaceText13(backslashes, content interface{}) (interface{}, error) {
+	// unbalanced "``" vs "`" punctuation
+	result := append([]interface{}{"`"}, content.([]interface{}))
+	return types.NewEscapedQuotedText(backslashes.([]interface{}), "`", result)
 }
 
-func (p *parser) callonDocumentAttributeResetWithSectionTitleBangSymbol1() (interface{}, error) {
+func (p *parser) callonEscapedMonospaceText13() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onDocumentAttributeResetWithSectionTitleBangSymbol1(stack["name"])
+	return p.cur.onEscapedMonospaceText13(stack["backslashes"], stack["content"])
 }
 
-func (c *current) onDocumentAttributeResetWithTrailingBangSymbol1(name interface{}) (interface{}, error) {
-	return types.NewDocumentAttributeReset(name.([]interface{}))
+func (c *current) onEscapedMonospaceText24(backslashes, content interface{}) (interface{}, error) {
+	// simple punctuation must be evaluated last
+	return types.NewEscapedQuotedText(backslashes.([]interface{}), "`", content.([]interface{}))
 }
 
-func (p *parser) callonDocumentAttributeResetWithTrailingBangSymbol1() (interface{}, error) {
+func (p *parser) callonEscapedMonospaceText24() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onDocumentAttributeResetWithTrailingBangSymbol1(stack["name"])
+	return p.cur.onEscapedMonospaceText24(stack["backslashes"], stack["content"])
 }
 
-func (c *current) onDocumentAttributeSubstitution1(name interface{}) (interface{}, error) {
-	return types.NewDocumentAttributeSubstitution(name.([]interface{}))
+func (c *current) onDoubleQuoteMarkedText1(content interface{}) (interface{}, error) {
+	// double punctuation must be evaluated first
+	return types.NewQuotedText(types.Marked, content.([]interface{}))
 }
 
-func (p *parser) callonDocumentAttributeSubstitution1() (interface{}, error) {
+func (p *parser) callonDoubleQuoteMarkedText1() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onDocumentAttributeSubstitution1(stack["name"])
+	return p.cur.onDoubleQuoteMarkedText1(stack["content"])
 }
 
-func (c *current) onSection11(header, elements interface{}) (interface{}, error) {
-	return types.NewSection(1, header.(types.SectionTitle), elements.([]interface{}))
+func (c *current) onSingleQuoteMarkedText2(content interface{}) (interface{}, error) {
+	// unbalanced "##" vs "#" punctuation
+	result := append([]interface{}{"#"}, content.([]interface{}))
+	return types.NewQuotedText(types.Marked, result)
 }
 
-func (p *parser) callonSection11() (interface{}, error) {
+func (p *parser) callonSingleQuoteMarkedText2() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onSection11(stack["header"], stack["elements"])
+	return p.cur.onSingleQuoteMarkedText2(stack["content"])
 }
 
-func (c *current) onSection1Block1(content interface{}) (interface{}, error) {
-	return content.(types.DocElement), nil
+func (c *current) onSingleQuoteMarkedText12(content interface{}) (interface{}, error) {
+	// simple punctuation must be evaluated last
+	return types.NewQuotedText(types.Marked, content.([]interface{}))
 }
 
-func (p *parser) callonSection1Block1() (interface{}, error) {
+func (p *parser) callonSingleQuoteMarkedText12() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onSection1Block1(stack["content"])
+	return p.cur.onSingleQuoteMarkedText12(stack["content"])
 }
 
-func (c *current) onSection21(header, elements interface{}) (interface{}, error) {
-	return types.NewSection(2, header.(types.SectionTitle), elements.([]interface{}))
+func (c *current) onEscapedMarkedText2(backslashes, content interface{}) (interface{}, error) {
+	// double punctuation must be evaluated first
+	return types.NewEscapedQuotedText(backslashes.([]interface{}), "##", content.([]interface{}))
 }
 
-func (p *parser) callonSection21() (interface{}, error) {
+func (p *parser) callonEscapedMarkedText2() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onSection21(stack["header"], stack["elements"])
+	return p.cur.onEscapedMarkedText2(stack["backslashes"], stack["content"])
 }
 
-func (c *current) onSection2Block1(content interface{}) (interface{}, error) {
-	return content.(types.DocElement), nil
+func (c *current) onEscapedMarkedText13(backslashes, content interface{}) (interface{}, error) {
+	// unbalanced "##" vs "#" punctuation
+	result := append([]interface{}{"#"}, content.([]interface{}))
+	return types.NewEscapedQuotedText(backslashes.([]interface{}), "#", result)
 }
 
-func (p *parser) callonSection2Block1() (interface{}, error) {
+func (p *parser) callonEscapedMarkedText13() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onSection2Block1(stack["content"])
+	return p.cur.onEscapedMarkedText13(stack["backslashes"], stack["content"])
 }
 
-func (c *current) onSection31(header, elements interface{}) (interface{}, error) {
-	return types.NewSection(3, header.(types.SectionTitle), elements.([]interface{}))
+func (c *current) onEscapedMarkedText24(backslashes, content interface{}) (interface{}, error) {
+	// simple punctuation must be evaluated last
+	return types.NewEscapedQuotedText(backslashes.([]interface{}), "#", content.([]interface{}))
 }
 
-func (p *parser) callonSection31() (interface{}, error) {
+func (p *parser) callonEscapedMarkedText24() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onSection31(stack["header"], stack["elements"])
+	return p.cur.onEscapedMarkedText24(stack["backslashes"], stack["content"])
 }
 
-func (c *current) onSection3Block1(content interface{}) (interface{}, error) {
-	return content.(types.DocElement), nil
+func (c *current) onCurvedQuotedText2(content interface{}) (interface{}, error) {
+	return types.NewQuotedText(types.DoubleQuoted, content.([]interface{}))
 }
 
-func (p *parser) callonSection3Block1() (interface{}, error) {
+func (p *parser) callonCurvedQuotedText2() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onSection3Block1(stack["content"])
+	return p.cur.onCurvedQuotedText2(stack["content"])
 }
 
-func (c *current) onSection41(header, elements interface{}) (interface{}, error) {
-	return types.NewSection(4, header.(types.SectionTitle), elements.([]interface{}))
+func (c *current) onCurvedQuotedText12(content interface{}) (interface{}, error) {
+	return types.NewQuotedText(types.SingleQuoted, content.([]interface{}))
 }
 
-func (p *parser) callonSection41() (interface{}, error) {
+func (p *parser) callonCurvedQuotedText12() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onSection41(stack["header"], stack["elements"])
+	return p.cur.onCurvedQuotedText12(stack["content"])
 }
 
-func (c *current) onSection4Block1(content interface{}) (interface{}, error) {
-	return content.(types.DocElement), nil
+func (c *current) onEscapedCurvedQuotedText2(backslashes, content interface{}) (interface{}, error) {
+	return types.NewEscapedCurvedQuotedText(backslashes.([]interface{}), "\"`", "`\"", content.([]interface{}))
 }
 
-func (p *parser) callonSection4Block1() (interface{}, error) {
+func (p *parser) callonEscapedCurvedQuotedText2() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onSection4Block1(stack["content"])
+	return p.cur.onEscapedCurvedQuotedText2(stack["backslashes"], stack["content"])
 }
 
-func (c *current) onSection51(header, elements interface{}) (interface{}, error) {
-	return types.NewSection(5, header.(types.SectionTitle), elements.([]interface{}))
+func (c *current) onEscapedCurvedQuotedText13(backslashes, content interface{}) (interface{}, error) {
+	return types.NewEscapedCurvedQuotedText(backslashes.([]interface{}), "'`", "`'", content.([]interface{}))
 }
 
-func (p *parser) callonSection51() (interface{}, error) {
+func (p *parser) callonEscapedCurvedQuotedText13() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onEscapedCurvedQuotedText13(stack["backslashes"], stack["content"])
+}
+
+func (c *current) onSuperscriptText1(content interface{}) (interface{}, error) {
+
+	return types.NewQuotedText(types.Superscript, []interface{}{content})
+}
+
+func (p *parser) callonSuperscriptText1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onSuperscriptText1(stack["content"])
+}
+
+func (c *current) onSuperscriptTextCharacters1() (interface{}, error) {
+	return string(c.text), nil
+}
+
+func (p *parser) callonSuperscriptTextCharacters1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onSuperscriptTextCharacters1()
+}
+
+func (c *current) onEscapedSuperscriptText1(backslashes, content interface{}) (interface{}, error) {
+
+	return types.NewEscapedQuotedText(backslashes.([]interface{}), "^", []interface{}{content})
+}
+
+func (p *parser) callonEscapedSuperscriptText1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onEscapedSuperscriptText1(stack["backslashes"], stack["content"])
+}
+
+func (c *current) onSubscriptText1(content interface{}) (interface{}, error) {
+
+	return types.NewQuotedText(types.Subscript, []interface{}{content})
+}
+
+func (p *parser) callonSubscriptText1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onSubscriptText1(stack["content"])
+}
+
+func (c *current) onSubscriptTextCharacters1() (interface{}, error) {
+	return string(c.text), nil
+}
+
+func (p *parser) callonSubscriptTextCharacters1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onSubscriptTextCharacters1()
+}
+
+func (c *current) onEscapedSubscriptText1(backslashes, content interface{}) (interface{}, error) {
+
+	return types.NewEscapedQuotedText(backslashes.([]interface{}), "~", []interface{}{content})
+}
+
+func (p *parser) callonEscapedSubscriptText1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onEscapedSubscriptText1(stack["backslashes"], stack["content"])
+}
+
+func (c *current) onCharactersWithQuotePunctuation1() (interface{}, error) {
+	// can have "*", "_", "`" or "#" within, maybe because the user inserted another quote, or made an error (extra or missing space, for example)
+	return c.text, nil
+}
+
+func (p *parser) callonCharactersWithQuotePunctuation1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onCharactersWithQuotePunctuation1()
+}
+
+func (c *current) onPassthrough3() (bool, error) {
+	return isSubstitutionEnabled(c, types.MacrosSubstitution), nil
+}
+
+func (p *parser) callonPassthrough3() (bool, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onPassthrough3()
+}
+
+func (c *current) onPassthrough1(passthrough interface{}) (interface{}, error) {
+	return passthrough, nil
+}
+
+func (p *parser) callonPassthrough1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onPassthrough1(stack["passthrough"])
+}
+
+func (c *current) onSinglePlusPassthrough1(content interface{}) (interface{}, error) {
+	return types.NewPassthrough(types.SinglePlusPassthrough, content.([]interface{}))
+}
+
+func (p *parser) callonSinglePlusPassthrough1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onSinglePlusPassthrough1(stack["content"])
+}
+
+func (c *current) onTriplePlusPassthrough1(content interface{}) (interface{}, error) {
+	return types.NewPassthrough(types.TriplePlusPassthrough, content.([]interface{}))
+}
+
+func (p *parser) callonTriplePlusPassthrough1() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onSection51(stack["header"], stack["elements"])
+	return p.cur.onTriplePlusPassthrough1(stack["content"])
 }
 
-func (c *current) onSection5Block1(content interface{}) (interface{}, error) {
-	return content.(types.DocElement), nil
+func (c *current) onPassthroughMacro2(content interface{}) (interface{}, error) {
+	return types.NewPassthrough(types.PassthroughMacro, content.([]interface{}))
 }
 
-func (p *parser) callonSection5Block1() (interface{}, error) {
+func (p *parser) callonPassthroughMacro2() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onSection5Block1(stack["content"])
+	return p.cur.onPassthroughMacro2(stack["content"])
 }
 
-func (c *current) onSection1Title1(attributes, level, content, id interface{}) (interface{}, error) {
-
-	return types.NewSectionTitle(content.(types.InlineContent), append(attributes.([]interface{}), id))
+func (c *current) onPassthroughMacro9(content interface{}) (interface{}, error) {
+	return types.NewPassthrough(types.PassthroughMacro, content.([]interface{}))
 }
 
-func (p *parser) callonSection1Title1() (interface{}, error) {
+func (p *parser) callonPassthroughMacro9() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onSection1Title1(stack["attributes"], stack["level"], stack["content"], stack["id"])
+	return p.cur.onPassthroughMacro9(stack["content"])
 }
 
-func (c *current) onSection2Title1(attributes, level, content, id interface{}) (interface{}, error) {
-	return types.NewSectionTitle(content.(types.InlineContent), append(attributes.([]interface{}), id))
+func (c *current) onInlineStem3() (bool, error) {
+	return isSubstitutionEnabled(c, types.MacrosSubstitution), nil
 }
 
-func (p *parser) callonSection2Title1() (interface{}, error) {
+func (p *parser) callonInlineStem3() (bool, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onSection2Title1(stack["attributes"], stack["level"], stack["content"], stack["id"])
+	return p.cur.onInlineStem3()
 }
 
-func (c *current) onSection3Title1(attributes, level, content, id interface{}) (interface{}, error) {
-	return types.NewSectionTitle(content.(types.InlineContent), append(attributes.([]interface{}), id))
+func (c *current) onInlineStem1(kind, content interface{}) (interface{}, error) {
+	return types.NewInlineStem(string(kind.([]byte)), content.([]interface{}))
 }
 
-func (p *parser) callonSection3Title1() (interface{}, error) {
+func (p *parser) callonInlineStem1() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onSection3Title1(stack["attributes"], stack["level"], stack["content"], stack["id"])
+	return p.cur.onInlineStem1(stack["kind"], stack["content"])
 }
 
-func (c *current) onSection4Title1(attributes, level, content, id interface{}) (interface{}, error) {
-	return types.NewSectionTitle(content.(types.InlineContent), append(attributes.([]interface{}), id))
+func (c *current) onFootnote4() (bool, error) {
+	return isSubstitutionEnabled(c, types.MacrosSubstitution), nil
 }
 
-func (p *parser) callonSection4Title1() (interface{}, error) {
+func (p *parser) callonFootnote4() (bool, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onSection4Title1(stack["attributes"], stack["level"], stack["content"], stack["id"])
+	return p.cur.onFootnote4()
 }
 
-func (c *current) onSection5Title1(attributes, level, content, id interface{}) (interface{}, error) {
-	return types.NewSectionTitle(content.(types.InlineContent), append(attributes.([]interface{}), id))
+func (c *current) onFootnote2(content interface{}) (interface{}, error) {
+	return types.NewFootnote(offset(c), "", content.(types.InlineContent))
 }
 
-func (p *parser) callonSection5Title1() (interface{}, error) {
+func (p *parser) callonFootnote2() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onSection5Title1(stack["attributes"], stack["level"], stack["content"], stack["id"])
+	return p.cur.onFootnote2(stack["content"])
 }
 
-func (c *current) onList1(attributes, elements interface{}) (interface{}, error) {
-	return types.NewList(elements.([]interface{}), attributes.([]interface{}))
+func (c *current) onFootnote11() (bool, error) {
+	return isSubstitutionEnabled(c, types.MacrosSubstitution), nil
 }
 
-func (p *parser) callonList1() (interface{}, error) {
+func (p *parser) callonFootnote11() (bool, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onList1(stack["attributes"], stack["elements"])
+	return p.cur.onFootnote11()
 }
 
-func (c *current) onListAttribute1(attribute interface{}) (interface{}, error) {
-	return attribute, nil
+func (c *current) onFootnote9(ref, content interface{}) (interface{}, error) {
+	// named footnote, or reference to a named footnote if the content is empty
+	if content == nil {
+		return types.NewFootnote(offset(c), ref.(string), types.InlineContent{})
+	}
+	return types.NewFootnote(offset(c), ref.(string), content.(types.InlineContent))
 }
 
-func (p *parser) callonListAttribute1() (interface{}, error) {
+func (p *parser) callonFootnote9() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onListAttribute1(stack["attribute"])
+	return p.cur.onFootnote9(stack["ref"], stack["content"])
 }
 
-func (c *current) onListID1(id interface{}) (interface{}, error) {
-	return map[string]interface{}{"ID": id.(string)}, nil
+func (c *current) onFootnoteRef1() (interface{}, error) {
+	return string(c.text), nil
 }
 
-func (p *parser) callonListID1() (interface{}, error) {
+func (p *parser) callonFootnoteRef1() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onListID1(stack["id"])
+	return p.cur.onFootnoteRef1()
 }
 
-func (c *current) onHorizontalLayout1() (interface{}, error) {
-	return map[string]interface{}{"layout": "horizontal"}, nil
+func (c *current) onFootnoteContent1(elements interface{}) (interface{}, error) {
+	// footnotes cannot be nested
+	return types.NewInlineContent(elements.([]interface{}))
 }
 
-func (p *parser) callonHorizontalLayout1() (interface{}, error) {
+func (p *parser) callonFootnoteContent1() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onHorizontalLayout1()
+	return p.cur.onFootnoteContent1(stack["elements"])
 }
 
-func (c *current) onListParagraph1(lines interface{}) (interface{}, error) {
-	return types.NewListParagraph(lines.([]interface{}))
+func (c *current) onFootnoteCharacters1() (interface{}, error) {
+	return string(c.text), nil
 }
 
-func (p *parser) callonListParagraph1() (interface{}, error) {
+func (p *parser) callonFootnoteCharacters1() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onListParagraph1(stack["lines"])
+	return p.cur.onFootnoteCharacters1()
 }
 
-func (c *current) onListItemContinuation1() (interface{}, error) {
-	return types.NewListItemContinuation()
+func (c *current) onCrossReference3() (bool, error) {
+	return isSubstitutionEnabled(c, types.MacrosSubstitution), nil
 }
 
-func (p *parser) callonListItemContinuation1() (interface{}, error) {
+func (p *parser) callonCrossReference3() (bool, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onListItemContinuation1()
+	return p.cur.onCrossReference3()
 }
 
-func (c *current) onContinuedBlockElement1(element interface{}) (interface{}, error) {
-	return element, nil
+func (c *current) onCrossReference1(xref interface{}) (interface{}, error) {
+	return xref, nil
 }
 
-func (p *parser) callonContinuedBlockElement1() (interface{}, error) {
+func (p *parser) callonCrossReference1() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onContinuedBlockElement1(stack["element"])
+	return p.cur.onCrossReference1(stack["xref"])
 }
 
-func (c *current) onOrderedListItem1(attributes, prefix, content interface{}) (interface{}, error) {
-	return types.NewOrderedListItem(prefix.(types.OrderedListItemPrefix), content.([]types.DocElement), attributes.([]interface{}))
+func (c *current) onInternalCrossReference2(id, label interface{}) (interface{}, error) {
+	return types.NewCrossReference(id.(string), label)
 }
 
-func (p *parser) callonOrderedListItem1() (interface{}, error) {
+func (p *parser) callonInternalCrossReference2() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onOrderedListItem1(stack["attributes"], stack["prefix"], stack["content"])
+	return p.cur.onInternalCrossReference2(stack["id"], stack["label"])
 }
 
-func (c *current) onOrderedListItemPrefix2(style interface{}) (interface{}, error) {
-	// numbering style: "."
-	return types.NewOrderedListItemPrefix(types.Arabic, 1)
+func (c *current) onInternalCrossReference13(id, label interface{}) (interface{}, error) {
+	return types.NewCrossReference(id.(string), label)
 }
 
-func (p *parser) callonOrderedListItemPrefix2() (interface{}, error) {
+func (p *parser) callonInternalCrossReference13() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onOrderedListItemPrefix2(stack["style"])
+	return p.cur.onInternalCrossReference13(stack["id"], stack["label"])
 }
 
-func (c *current) onOrderedListItemPrefix10(style interface{}) (interface{}, error) {
-	// numbering style: ".."
-	return types.NewOrderedListItemPrefix(types.LowerAlpha, 2)
+func (c *current) onInterDocumentCrossReference8(id interface{}) (interface{}, error) {
+	return id, nil
 }
 
-func (p *parser) callonOrderedListItemPrefix10() (interface{}, error) {
+func (p *parser) callonInterDocumentCrossReference8() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onOrderedListItemPrefix10(stack["style"])
+	return p.cur.onInterDocumentCrossReference8(stack["id"])
 }
 
-func (c *current) onOrderedListItemPrefix18(style interface{}) (interface{}, error) {
-	// numbering style: "..."
-	return types.NewOrderedListItemPrefix(types.LowerRoman, 3)
+func (c *current) onInterDocumentCrossReference2(location, id, label interface{}) (interface{}, error) {
+	return types.NewInterDocumentCrossReference(location.(string), id, label)
 }
 
-func (p *parser) callonOrderedListItemPrefix18() (interface{}, error) {
+func (p *parser) callonInterDocumentCrossReference2() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onOrderedListItemPrefix18(stack["style"])
+	return p.cur.onInterDocumentCrossReference2(stack["location"], stack["id"], stack["label"])
 }
 
-func (c *current) onOrderedListItemPrefix26(style interface{}) (interface{}, error) {
-	// numbering style: "...."
-	return types.NewOrderedListItemPrefix(types.UpperAlpha, 4)
+func (c *current) onInterDocumentCrossReference20(location, label interface{}) (interface{}, error) {
+	return types.NewInterDocumentCrossReference(location.(string), nil, label)
 }
 
-func (p *parser) callonOrderedListItemPrefix26() (interface{}, error) {
+func (p *parser) callonInterDocumentCrossReference20() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onOrderedListItemPrefix26(stack["style"])
+	return p.cur.onInterDocumentCrossReference20(stack["location"], stack["label"])
 }
 
-func (c *current) onOrderedListItemPrefix34(style interface{}) (interface{}, error) {
-	// numbering style: "....."
-	return types.NewOrderedListItemPrefix(types.UpperRoman, 5)
-	// explicit numbering
+func (c *current) onInterDocumentCrossReference37(id interface{}) (interface{}, error) {
+	return id, nil
 }
 
-func (p *parser) callonOrderedListItemPrefix34() (interface{}, error) {
+func (p *parser) callonInterDocumentCrossReference37() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onOrderedListItemPrefix34(stack["style"])
+	return p.cur.onInterDocumentCrossReference37(stack["id"])
 }
 
-func (c *current) onOrderedListItemPrefix42(style interface{}) (interface{}, error) {
-	// numbering style: "1."
-	return types.NewOrderedListItemPrefix(types.Arabic, 1)
+func (c *current) onInterDocumentCrossReference31(location, id, label interface{}) (interface{}, error) {
+	return types.NewInterDocumentCrossReference(location.(string), id, label)
 }
 
-func (p *parser) callonOrderedListItemPrefix42() (interface{}, error) {
+func (p *parser) callonInterDocumentCrossReference31() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onOrderedListItemPrefix42(stack["style"])
+	return p.cur.onInterDocumentCrossReference31(stack["location"], stack["id"], stack["label"])
 }
 
-func (c *current) onOrderedListItemPrefix60(style interface{}) (interface{}, error) {
-	// numbering style: "a."
-	return types.NewOrderedListItemPrefix(types.LowerAlpha, 1)
+func (c *current) onInterDocumentCrossReference48(location, label interface{}) (interface{}, error) {
+	return types.NewInterDocumentCrossReference(location.(string), nil, label)
 }
 
-func (p *parser) callonOrderedListItemPrefix60() (interface{}, error) {
+func (p *parser) callonInterDocumentCrossReference48() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onOrderedListItemPrefix60(stack["style"])
+	return p.cur.onInterDocumentCrossReference48(stack["location"], stack["label"])
 }
 
-func (c *current) onOrderedListItemPrefix78(style interface{}) (interface{}, error) {
-	// numbering style: "A."
-	return types.NewOrderedListItemPrefix(types.UpperAlpha, 1)
+func (c *current) onCrossReferenceID1() (interface{}, error) {
+	return string(c.text), nil
 }
 
-func (p *parser) callonOrderedListItemPrefix78() (interface{}, error) {
+func (p *parser) callonCrossReferenceID1() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onOrderedListItemPrefix78(stack["style"])
+	return p.cur.onCrossReferenceID1()
 }
 
-func (c *current) onOrderedListItemPrefix96(style interface{}) (interface{}, error) {
-	// numbering style: "i)"
-	return types.NewOrderedListItemPrefix(types.LowerRoman, 1)
+func (c *current) onCrossReferenceLocation1() (interface{}, error) {
+	return string(c.text), nil
 }
 
-func (p *parser) callonOrderedListItemPrefix96() (interface{}, error) {
+func (p *parser) callonCrossReferenceLocation1() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onOrderedListItemPrefix96(stack["style"])
+	return p.cur.onCrossReferenceLocation1()
 }
 
-func (c *current) onOrderedListItemPrefix114(style interface{}) (interface{}, error) {
-	// numbering style: "I)"
-	return types.NewOrderedListItemPrefix(types.UpperRoman, 1)
+func (c *current) onCrossReferenceDocument1() (interface{}, error) {
+	return string(c.text), nil
 }
 
-func (p *parser) callonOrderedListItemPrefix114() (interface{}, error) {
+func (p *parser) callonCrossReferenceDocument1() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onOrderedListItemPrefix114(stack["style"])
+	return p.cur.onCrossReferenceDocument1()
 }
 
-func (c *current) onOrderedListItemContent1(elements interface{}) (interface{}, error) {
-	// Another list or a literal paragraph immediately following a list item will be implicitly included in the list item
-	return types.NewListItemContent(elements.([]interface{}))
+func (c *current) onCrossReferenceLabel7() (interface{}, error) {
+	return string(c.text), nil
 }
 
-func (p *parser) callonOrderedListItemContent1() (interface{}, error) {
+func (p *parser) callonCrossReferenceLabel7() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onOrderedListItemContent1(stack["elements"])
+	return p.cur.onCrossReferenceLabel7()
 }
 
-func (c *current) onUnorderedListItem1(prefix, content interface{}) (interface{}, error) {
-	return types.NewUnorderedListItem(prefix.(types.UnorderedListItemPrefix), content.([]types.DocElement))
+func (c *current) onCrossReferenceLabel1(label interface{}) (interface{}, error) {
+	return label, nil
 }
 
-func (p *parser) callonUnorderedListItem1() (interface{}, error) {
+func (p *parser) callonCrossReferenceLabel1() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onUnorderedListItem1(stack["prefix"], stack["content"])
+	return p.cur.onCrossReferenceLabel1(stack["label"])
 }
 
-func (c *current) onUnorderedListItemPrefix2(level interface{}) (interface{}, error) {
-	// ignore whitespaces, only return the relevant "*"/"-" characters
-	return types.NewUnorderedListItemPrefix(types.FiveAsterisks, 5)
+func (c *current) onCrossReferenceMacroLabel1() (interface{}, error) {
+	return string(c.text), nil
 }
 
-func (p *parser) callonUnorderedListItemPrefix2() (interface{}, error) {
+func (p *parser) callonCrossReferenceMacroLabel1() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onUnorderedListItemPrefix2(stack["level"])
+	return p.cur.onCrossReferenceMacroLabel1()
 }
 
-func (c *current) onUnorderedListItemPrefix10(level interface{}) (interface{}, error) {
-	// ignore whitespaces, only return the relevant "*"/"-" characters
-	return types.NewUnorderedListItemPrefix(types.FourAsterisks, 4)
+func (c *current) onInlineAnchor4() (bool, error) {
+	return isSubstitutionEnabled(c, types.MacrosSubstitution), nil
 }
 
-func (p *parser) callonUnorderedListItemPrefix10() (interface{}, error) {
+func (p *parser) callonInlineAnchor4() (bool, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onUnorderedListItemPrefix10(stack["level"])
+	return p.cur.onInlineAnchor4()
 }
 
-func (c *current) onUnorderedListItemPrefix18(level interface{}) (interface{}, error) {
-	// ignore whitespaces, only return the relevant "*"/"-" characters
-	return types.NewUnorderedListItemPrefix(types.ThreeAsterisks, 3)
+func (c *current) onInlineAnchor2(anchor interface{}) (interface{}, error) {
+	return anchor, nil
 }
 
-func (p *parser) callonUnorderedListItemPrefix18() (interface{}, error) {
+func (p *parser) callonInlineAnchor2() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onUnorderedListItemPrefix18(stack["level"])
+	return p.cur.onInlineAnchor2(stack["anchor"])
 }
 
-func (c *current) onUnorderedListItemPrefix26(level interface{}) (interface{}, error) {
-	// ignore whitespaces, only return the relevant "*"/"-" characters
-	return types.NewUnorderedListItemPrefix(types.TwoAsterisks, 2)
+func (c *current) onInlineAnchor9() (bool, error) {
+	return isSubstitutionEnabled(c, types.MacrosSubstitution), nil
 }
 
-func (p *parser) callonUnorderedListItemPrefix26() (interface{}, error) {
+func (p *parser) callonInlineAnchor9() (bool, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onUnorderedListItemPrefix26(stack["level"])
+	return p.cur.onInlineAnchor9()
 }
 
-func (c *current) onUnorderedListItemPrefix34(level interface{}) (interface{}, error) {
-	// ignore whitespaces, only return the relevant "*"/"-" characters
-	return types.NewUnorderedListItemPrefix(types.OneAsterisk, 1)
+func (c *current) onInlineAnchor7(id, label interface{}) (interface{}, error) {
+	return types.NewInlineAnchor(id.(string), label)
 }
 
-func (p *parser) callonUnorderedListItemPrefix34() (interface{}, error) {
+func (p *parser) callonInlineAnchor7() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onUnorderedListItemPrefix34(stack["level"])
+	return p.cur.onInlineAnchor7(stack["id"], stack["label"])
 }
 
-func (c *current) onUnorderedListItemPrefix42(level interface{}) (interface{}, error) {
-	// ignore whitespaces, only return the relevant "*"/"-" characters
-	return types.NewUnorderedListItemPrefix(types.Dash, 1)
+func (c *current) onInlineAnchor19() (bool, error) {
+	return isSubstitutionEnabled(c, types.MacrosSubstitution), nil
 }
 
-func (p *parser) callonUnorderedListItemPrefix42() (interface{}, error) {
+func (p *parser) callonInlineAnchor19() (bool, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onUnorderedListItemPrefix42(stack["level"])
+	return p.cur.onInlineAnchor19()
 }
 
-func (c *current) onUnorderedListItemContent1(elements interface{}) (interface{}, error) {
-	// Another list or a literal paragraph immediately following a list item will be implicitly included in the list item
-	return types.NewListItemContent(elements.([]interface{}))
+func (c *current) onInlineAnchor25() (interface{}, error) {
+	return string(c.text), nil
 }
 
-func (p *parser) callonUnorderedListItemContent1() (interface{}, error) {
+func (p *parser) callonInlineAnchor25() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onUnorderedListItemContent1(stack["elements"])
+	return p.cur.onInlineAnchor25()
 }
 
-func (c *current) onLabeledListItem2(term, description interface{}) (interface{}, error) {
-	return types.NewLabeledListItem(term.([]interface{}), description.([]types.DocElement))
+func (c *current) onInlineAnchor17(id, label interface{}) (interface{}, error) {
+	return types.NewInlineAnchor(id.(string), label)
 }
 
-func (p *parser) callonLabeledListItem2() (interface{}, error) {
+func (p *parser) callonInlineAnchor17() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onLabeledListItem2(stack["term"], stack["description"])
+	return p.cur.onInlineAnchor17(stack["id"], stack["label"])
 }
 
-func (c *current) onLabeledListItem9(term interface{}) (interface{}, error) {
-	// here, WS is optional since there is no description afterwards
-	return types.NewLabeledListItem(term.([]interface{}), nil)
+func (c *current) onBibliographyAnchor1(id, label interface{}) (interface{}, error) {
+	return types.NewBibliographyAnchor(id.(string), label)
 }
 
-func (p *parser) callonLabeledListItem9() (interface{}, error) {
+func (p *parser) callonBibliographyAnchor1() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onLabeledListItem9(stack["term"])
+	return p.cur.onBibliographyAnchor1(stack["id"], stack["label"])
 }
 
-func (c *current) onLabeledListItemTerm1(term interface{}) (interface{}, error) {
-	return term, nil
+func (c *current) onInlineAnchorLabel7() (interface{}, error) {
+	return string(c.text), nil
 }
 
-func (p *parser) callonLabeledListItemTerm1() (interface{}, error) {
+func (p *parser) callonInlineAnchorLabel7() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onLabeledListItemTerm1(stack["term"])
+	return p.cur.onInlineAnchorLabel7()
 }
 
-func (c *current) onLabeledListItemDescription1(elements interface{}) (interface{}, error) {
-	// TODO: replace with (ListParagraph+ ContinuedBlockElement*) and use a single rule for all item contents ?
-	return types.NewListItemContent(elements.([]interface{}))
+func (c *current) onInlineAnchorLabel1(label interface{}) (interface{}, error) {
+	return label, nil
 }
 
-func (p *parser) callonLabeledListItemDescription1() (interface{}, error) {
+func (p *parser) callonInlineAnchorLabel1() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onLabeledListItemDescription1(stack["elements"])
+	return p.cur.onInlineAnchorLabel1(stack["label"])
 }
 
-func (c *current) onParagraph1(attributes, lines interface{}) (interface{}, error) {
-	return types.NewParagraph(lines.([]interface{}), attributes.([]interface{}))
+func (c *current) onLink3() (bool, error) {
+	return isSubstitutionEnabled(c, types.MacrosSubstitution), nil
 }
 
-func (p *parser) callonParagraph1() (interface{}, error) {
+func (p *parser) callonLink3() (bool, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onParagraph1(stack["attributes"], stack["lines"])
+	return p.cur.onLink3()
 }
 
-func (c *current) onInlineContentWithTrailingSpaces1(elements interface{}) (interface{}, error) {
-	// includes heading and trailing spaces in the elements arg
-	return types.NewInlineContent(elements.([]interface{}))
+func (c *current) onLink1(link interface{}) (interface{}, error) {
+	return link, nil
 }
 
-func (p *parser) callonInlineContentWithTrailingSpaces1() (interface{}, error) {
+func (p *parser) callonLink1() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onInlineContentWithTrailingSpaces1(stack["elements"])
+	return p.cur.onLink1(stack["link"])
 }
 
-func (c *current) onInlineContent1(elements interface{}) (interface{}, error) {
-	// absorbs heading and trailing spaces
-	return types.NewInlineContent(elements.([]interface{}))
+func (c *current) onExternalLink1(url, text interface{}) (interface{}, error) {
+	if text != nil {
+		return types.NewLink(url.([]interface{}), text.([]interface{}))
+	}
+	return types.NewLink(url.([]interface{}), nil)
 }
 
-func (p *parser) callonInlineContent1() (interface{}, error) {
+func (p *parser) callonExternalLink1() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onInlineContent1(stack["elements"])
+	return p.cur.onExternalLink1(stack["url"], stack["text"])
 }
 
-func (c *current) onAdmonitionParagraph2(attributes, t, content interface{}) (interface{}, error) {
-	// paragraph style
-	return types.NewAdmonitionParagraph(t.(types.AdmonitionKind), content, attributes.([]interface{}))
+func (c *current) onRelativeLink1(url, text interface{}) (interface{}, error) {
+	if text != nil {
+		return types.NewLink(url.([]interface{}), text.([]interface{}))
+	}
+	return types.NewLink(url.([]interface{}), nil)
 }
 
-func (p *parser) callonAdmonitionParagraph2() (interface{}, error) {
+func (p *parser) callonRelativeLink1() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onAdmonitionParagraph2(stack["attributes"], stack["t"], stack["content"])
+	return p.cur.onRelativeLink1(stack["url"], stack["text"])
 }
 
-func (c *current) onAdmonitionParagraph18(attributes, k, otherAttributes, content interface{}) (interface{}, error) {
-	// block style
-	return types.NewAdmonitionParagraph(k.(types.AdmonitionKind), content, append(attributes.([]interface{}), otherAttributes.([]interface{})...))
+func (c *current) onBlockImage1(attributes, image interface{}) (interface{}, error) {
+	// here we can ignore the blank line in the returned element
+	return types.NewBlockImage(image.(types.ImageMacro), attributes.([]interface{}))
 }
 
-func (p *parser) callonAdmonitionParagraph18() (interface{}, error) {
+func (p *parser) callonBlockImage1() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onAdmonitionParagraph18(stack["attributes"], stack["k"], stack["otherAttributes"], stack["content"])
+	return p.cur.onBlockImage1(stack["attributes"], stack["image"])
 }
 
-func (c *current) onAdmonitionParagraphContent1(lines interface{}) (interface{}, error) {
-	return types.NewAdmonitionParagraphContent(lines.([]interface{}))
+func (c *current) onBlockImageMacro1(path, attributes interface{}) (interface{}, error) {
+	return types.NewImageMacro(path.(string), attributes.([]interface{}))
 }
 
-func (p *parser) callonAdmonitionParagraphContent1() (interface{}, error) {
+func (p *parser) callonBlockImageMacro1() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onAdmonitionParagraphContent1(stack["lines"])
+	return p.cur.onBlockImageMacro1(stack["path"], stack["attributes"])
 }
 
-func (c *current) onAdmonitionMarker1(k interface{}) (interface{}, error) {
-	return k, nil
+func (c *current) onInlineImage3() (bool, error) {
+	return isSubstitutionEnabled(c, types.MacrosSubstitution), nil
 }
 
-func (p *parser) callonAdmonitionMarker1() (interface{}, error) {
+func (p *parser) callonInlineImage3() (bool, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onAdmonitionMarker1(stack["k"])
+	return p.cur.onInlineImage3()
 }
 
-func (c *current) onAdmonitionKind2() (interface{}, error) {
-	return types.Tip, nil
+func (c *current) onInlineImage1(image interface{}) (interface{}, error) {
+	// here we can ignore the blank line in the returned element
+	return types.NewInlineImage(image.(types.ImageMacro))
 }
 
-func (p *parser) callonAdmonitionKind2() (interface{}, error) {
+func (p *parser) callonInlineImage1() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onAdmonitionKind2()
+	return p.cur.onInlineImage1(stack["image"])
 }
 
-func (c *current) onAdmonitionKind4() (interface{}, error) {
-	return types.Note, nil
+func (c *current) onInlineImageMacro1(path, attributes interface{}) (interface{}, error) {
+	return types.NewImageMacro(path.(string), attributes.([]interface{}))
 }
 
-func (p *parser) callonAdmonitionKind4() (interface{}, error) {
+func (p *parser) callonInlineImageMacro1() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onAdmonitionKind4()
+	return p.cur.onInlineImageMacro1(stack["path"], stack["attributes"])
 }
 
-func (c *current) onAdmonitionKind6() (interface{}, error) {
-	return types.Important, nil
+func (c *current) onImageAttributes8(attr interface{}) (interface{}, error) {
+	return attr, nil
 }
 
-func (p *parser) callonAdmonitionKind6() (interface{}, error) {
+func (p *parser) callonImageAttributes8() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onAdmonitionKind6()
+	return p.cur.onImageAttributes8(stack["attr"])
 }
 
-func (c *current) onAdmonitionKind8() (interface{}, error) {
-	return types.Warning, nil
+func (c *current) onImageAttributes1(first, others interface{}) (interface{}, error) {
+	return append([]interface{}{first}, others.([]interface{})...), nil
 }
 
-func (p *parser) callonAdmonitionKind8() (interface{}, error) {
+func (p *parser) callonImageAttributes1() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onAdmonitionKind8()
+	return p.cur.onImageAttributes1(stack["first"], stack["others"])
 }
 
-func (c *current) onAdmonitionKind10() (interface{}, error) {
-	return types.Caution, nil
+func (c *current) onImageAttribute2(key, value interface{}) (interface{}, error) {
+	return types.NewGenericAttribute(key.([]interface{}), value.([]interface{}))
 }
 
-func (p *parser) callonAdmonitionKind10() (interface{}, error) {
+func (p *parser) callonImageAttribute2() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onAdmonitionKind10()
+	return p.cur.onImageAttribute2(stack["key"], stack["value"])
 }
 
-func (c *current) onBoldText2(content interface{}) (interface{}, error) {
-	// double punctuation must be evaluated first
-	return types.NewQuotedText(types.Bold, content.([]interface{}))
+func (c *current) onImageAttribute15() (interface{}, error) {
+	return string(c.text), nil
 }
 
-func (p *parser) callonBoldText2() (interface{}, error) {
+func (p *parser) callonImageAttribute15() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onBoldText2(stack["content"])
+	return p.cur.onImageAttribute15()
 }
 
-func (c *current) onBoldText10(content interface{}) (interface{}, error) {
-	// unbalanced `**` vs `*` punctuation
-	result := append([]interface{}{"*"}, content.([]interface{}))
-	return types.NewQuotedText(types.Bold, result)
+func (c *current) onImageAttribute9(value interface{}) (interface{}, error) {
+	return value, nil
 }
 
-func (p *parser) callonBoldText10() (interface{}, error) {
+func (p *parser) callonImageAttribute9() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onBoldText10(stack["content"])
+	return p.cur.onImageAttribute9(stack["value"])
 }
 
-func (c *current) onBoldText18(content interface{}) (interface{}, error) {
-	// single punctuation
-	return types.NewQuotedText(types.Bold, content.([]interface{}))
+func (c *current) onImageAttribute30() (interface{}, error) {
+	return string(c.text), nil
 }
 
-func (p *parser) callonBoldText18() (interface{}, error) {
+func (p *parser) callonImageAttribute30() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onBoldText18(stack["content"])
+	return p.cur.onImageAttribute30()
 }
 
-func (c *current) onEscapedBoldText2(backslashes, content interface{}) (interface{}, error) {
-	// double punctuation must be evaluated first
-	return types.NewEscapedQuotedText(backslashes.([]interface{}), "**", content.([]interface{}))
+func (c *current) onInlineUIMacro3() (bool, error) {
+	return isSubstitutionEnabled(c, types.MacrosSubstitution) && isExperimental(c), nil
 }
 
-func (p *parser) callonEscapedBoldText2() (interface{}, error) {
+func (p *parser) callonInlineUIMacro3() (bool, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onEscapedBoldText2(stack["backslashes"], stack["content"])
+	return p.cur.onInlineUIMacro3()
 }
 
-func (c *current) onEscapedBoldText13(backslashes, content interface{}) (interface{}, error) {
-	// unbalanced `**` vs `*` punctuation
-	result := append([]interface{}{"*"}, content.([]interface{}))
-	return types.NewEscapedQuotedText(backslashes.([]interface{}), "*", result)
+func (c *current) onInlineUIMacro1(macro interface{}) (interface{}, error) {
+	return macro, nil
 }
 
-func (p *parser) callonEscapedBoldText13() (interface{}, error) {
+func (p *parser) callonInlineUIMacro1() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onEscapedBoldText13(stack["backslashes"], stack["content"])
+	return p.cur.onInlineUIMacro1(stack["macro"])
 }
 
-func (c *current) onEscapedBoldText24(backslashes, content interface{}) (interface{}, error) {
-	// simple punctuation must be evaluated last
-	return types.NewEscapedQuotedText(backslashes.([]interface{}), "*", content.([]interface{}))
+func (c *current) onKeyboardMacro1(keys interface{}) (interface{}, error) {
+	return types.NewKeyboardShortcut(keys.(string))
 }
 
-func (p *parser) callonEscapedBoldText24() (interface{}, error) {
+func (p *parser) callonKeyboardMacro1() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onEscapedBoldText24(stack["backslashes"], stack["content"])
+	return p.cur.onKeyboardMacro1(stack["keys"])
 }
 
-func (c *current) onItalicText2(content interface{}) (interface{}, error) {
-	return types.NewQuotedText(types.Italic, content.([]interface{}))
+func (c *current) onButtonMacro1(label interface{}) (interface{}, error) {
+	return types.NewButton(label.(string))
 }
 
-func (p *parser) callonItalicText2() (interface{}, error) {
+func (p *parser) callonButtonMacro1() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onItalicText2(stack["content"])
+	return p.cur.onButtonMacro1(stack["label"])
 }
 
-func (c *current) onItalicText10(content interface{}) (interface{}, error) {
-	// unbalanced `__` vs `_` punctuation
-	result := append([]interface{}{"_"}, content.([]interface{}))
-	return types.NewQuotedText(types.Italic, result)
+func (c *current) onMenuMacro5() (interface{}, error) {
+	return string(c.text), nil
 }
 
-func (p *parser) callonItalicText10() (interface{}, error) {
+func (p *parser) callonMenuMacro5() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onItalicText10(stack["content"])
+	return p.cur.onMenuMacro5()
 }
 
-func (c *current) onItalicText18(content interface{}) (interface{}, error) {
-	return types.NewQuotedText(types.Italic, content.([]interface{}))
+func (c *current) onMenuMacro1(menu, items interface{}) (interface{}, error) {
+	return types.NewMenuSelection(menu.(string), items)
 }
 
-func (p *parser) callonItalicText18() (interface{}, error) {
+func (p *parser) callonMenuMacro1() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onItalicText18(stack["content"])
+	return p.cur.onMenuMacro1(stack["menu"], stack["items"])
 }
 
-func (c *current) onEscapedItalicText2(backslashes, content interface{}) (interface{}, error) {
-	// double punctuation must be evaluated first
-	return types.NewEscapedQuotedText(backslashes.([]interface{}), "__", content.([]interface{}))
+func (c *current) onUIMacroText1() (interface{}, error) {
+	return string(c.text), nil
 }
 
-func (p *parser) callonEscapedItalicText2() (interface{}, error) {
+func (p *parser) callonUIMacroText1() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onEscapedItalicText2(stack["backslashes"], stack["content"])
+	return p.cur.onUIMacroText1()
 }
 
-func (c *current) onEscapedItalicText13(backslashes, content interface{}) (interface{}, error) {
-	// unbalanced `__` vs `_` punctuation
-	result := append([]interface{}{"_"}, content.([]interface{}))
-	return types.NewEscapedQuotedText(backslashes.([]interface{}), "_", result)
+func (c *current) onVideoBlock1(attributes, path, macroAttributes interface{}) (interface{}, error) {
+	return types.NewVideoBlock(path.(string), macroAttributes.([]interface{}), attributes.([]interface{}))
 }
 
-func (p *parser) callonEscapedItalicText13() (interface{}, error) {
+func (p *parser) callonVideoBlock1() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onEscapedItalicText13(stack["backslashes"], stack["content"])
+	return p.cur.onVideoBlock1(stack["attributes"], stack["path"], stack["macroAttributes"])
 }
 
-func (c *current) onEscapedItalicText24(backslashes, content interface{}) (interface{}, error) {
-	// simple punctuation must be evaluated last
-	return types.NewEscapedQuotedText(backslashes.([]interface{}), "_", content.([]interface{}))
+func (c *current) onAudioBlock1(attributes, path, macroAttributes interface{}) (interface{}, error) {
+	return types.NewAudioBlock(path.(string), macroAttributes.([]interface{}), attributes.([]interface{}))
 }
 
-func (p *parser) callonEscapedItalicText24() (interface{}, error) {
+func (p *parser) callonAudioBlock1() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onEscapedItalicText24(stack["backslashes"], stack["content"])
+	return p.cur.onAudioBlock1(stack["attributes"], stack["path"], stack["macroAttributes"])
 }
 
-func (c *current) onMonospaceText2(content interface{}) (interface{}, error) {
-	// double punctuation must be evaluated first
-	return types.NewQuotedText(types.Monospace, content.([]interface{}))
+func (c *current) onFencedBlock1(attributes, language, content interface{}) (interface{}, error) {
+	var b types.DelimitedBlock
+	var err error
+	if language != nil {
+		b, err = types.NewFencedBlockWithLanguage(language.(string), content.([]interface{}), attributes.([]interface{}))
+	} else {
+		b, err = types.NewDelimitedBlock(types.FencedBlock, content.([]interface{}), attributes.([]interface{}))
+	}
+	if err != nil {
+		return nil, err
+	}
+	return substituteDelimitedBlock(c, b)
 }
 
-func (p *parser) callonMonospaceText2() (interface{}, error) {
+func (p *parser) callonFencedBlock1() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onMonospaceText2(stack["content"])
+	return p.cur.onFencedBlock1(stack["attributes"], stack["language"], stack["content"])
 }
 
-func (c *current) onMonospaceText10(content interface{}) (interface{}, error) {
-	// unbalanced "``" vs "`" punctuation
-	result := append([]interface{}{"`"}, content.([]interface{}))
-	return types.NewQuotedText(types.Monospace, result)
+func (c *current) onListingBlock1(attributes, content interface{}) (interface{}, error) {
+	b, err := types.NewDelimitedBlock(types.ListingBlock, content.([]interface{}), attributes.([]interface{}))
+	if err != nil {
+		return nil, err
+	}
+	return substituteDelimitedBlock(c, b)
 }
 
-func (p *parser) callonMonospaceText10() (interface{}, error) {
+func (p *parser) callonListingBlock1() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onMonospaceText10(stack["content"])
+	return p.cur.onListingBlock1(stack["attributes"], stack["content"])
 }
 
-func (c *current) onMonospaceText18(content interface{}) (interface{}, error) {
-	// simple punctuation must be evaluated last
-	return types.NewQuotedText(types.Monospace, content.([]interface{}))
+func (c *current) onExampleBlock1(attributes, content interface{}) (interface{}, error) {
+	return types.NewDelimitedBlock(types.ExampleBlock, content.([]interface{}), attributes.([]interface{}))
 }
 
-func (p *parser) callonMonospaceText18() (interface{}, error) {
+func (p *parser) callonExampleBlock1() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onMonospaceText18(stack["content"])
+	return p.cur.onExampleBlock1(stack["attributes"], stack["content"])
 }
 
-func (c *current) onEscapedMonospaceText2(backslashes, content interface{}) (interface{}, error) {
-	// double punctuation must be evaluated first
-	return types.NewEscapedQuotedText(backslashes.([]interface{}), "``", content.([]interface{}))
+func (c *current) onSidebarBlock1(attributes, content interface{}) (interface{}, error) {
+	return types.NewDelimitedBlock(types.SidebarBlock, content.([]interface{}), attributes.([]interface{}))
 }
 
-func (p *parser) callonEscapedMonospaceText2() (interface{}, error) {
+func (p *parser) callonSidebarBlock1() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onEscapedMonospaceText2(stack["backslashes"], stack["content"])
+	return p.cur.onSidebarBlock1(stack["attributes"], stack["content"])
 }
 
-func (c *current) onEscapedMonospaceText13(backslashes, content interface{}) (interface{}, error) {
-	// unbalanced "``" vs "`" punctuation
-	result := append([]interface{}{"`"}, content.([]interface{}))
-	return types.NewEscapedQuotedText(backslashes.([]interface{}), "`", result)
+func (c *current) onQuoteBlock1(attributes, content interface{}) (interface{}, error) {
+	return types.NewDelimitedBlock(types.QuoteBlock, content.([]interface{}), attributes.([]interface{}))
 }
 
-func (p *parser) callonEscapedMonospaceText13() (interface{}, error) {
+func (p *parser) callonQuoteBlock1() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onEscapedMonospaceText13(stack["backslashes"], stack["content"])
+	return p.cur.onQuoteBlock1(stack["attributes"], stack["content"])
 }
 
-func (c *current) onEscapedMonospaceText24(backslashes, content interface{}) (interface{}, error) {
-	// simple punctuation must be evaluated last
-	return types.NewEscapedQuotedText(backslashes.([]interface{}), "`", content.([]interface{}))
+func (c *current) onVerseBlock5(attr interface{}) (interface{}, error) {
+	return attr, nil
 }
 
-func (p *parser) callonEscapedMonospaceText24() (interface{}, error) {
+func (p *parser) callonVerseBlock5() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onEscapedMonospaceText24(stack["backslashes"], stack["content"])
+	return p.cur.onVerseBlock5(stack["attr"])
 }
 
-func (c *current) onCharactersWithQuotePunctuation1() (interface{}, error) {
-	// can have "*", "_" or "`" within, maybe because the user inserted another quote, or made an error (extra or missing space, for example)
-	return c.text, nil
+func (c *current) onVerseBlock1(before, verse, after, content interface{}) (interface{}, error) {
+	attributes := append(before.([]interface{}), verse)
+	attributes = append(attributes, after.([]interface{})...)
+	return types.NewDelimitedBlock(types.VerseBlock, content.([]interface{}), attributes)
 }
 
-func (p *parser) callonCharactersWithQuotePunctuation1() (interface{}, error) {
+func (p *parser) callonVerseBlock1() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onCharactersWithQuotePunctuation1()
+	return p.cur.onVerseBlock1(stack["before"], stack["verse"], stack["after"], stack["content"])
 }
 
-func (c *current) onSinglePlusPassthrough1(content interface{}) (interface{}, error) {
-	return types.NewPassthrough(types.SinglePlusPassthrough, content.([]interface{}))
+func (c *current) onVerseBlockAttribute1(attr interface{}) (interface{}, error) {
+	return attr, nil
 }
 
-func (p *parser) callonSinglePlusPassthrough1() (interface{}, error) {
+func (p *parser) callonVerseBlockAttribute1() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onSinglePlusPassthrough1(stack["content"])
+	return p.cur.onVerseBlockAttribute1(stack["attr"])
 }
 
-func (c *current) onTriplePlusPassthrough1(content interface{}) (interface{}, error) {
-	return types.NewPassthrough(types.TriplePlusPassthrough, content.([]interface{}))
+func (c *current) onVerseBlockLine1(line interface{}) (interface{}, error) {
+	if line, ok := line.(types.InlineContent); ok {
+		return line, nil
+	}
+	// blank lines are retained in verse blocks
+	return types.NewInlineContent([]interface{}{})
 }
 
-func (p *parser) callonTriplePlusPassthrough1() (interface{}, error) {
+func (p *parser) callonVerseBlockLine1() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onTriplePlusPassthrough1(stack["content"])
+	return p.cur.onVerseBlockLine1(stack["line"])
 }
 
-func (c *current) onPassthroughMacro2(content interface{}) (interface{}, error) {
-	return types.NewPassthrough(types.PassthroughMacro, content.([]interface{}))
+func (c *current) onOpenBlock1(attributes, content interface{}) (interface{}, error) {
+	return types.NewDelimitedBlock(types.OpenBlock, content.([]interface{}), attributes.([]interface{}))
 }
 
-func (p *parser) callonPassthroughMacro2() (interface{}, error) {
+func (p *parser) callonOpenBlock1() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onPassthroughMacro2(stack["content"])
+	return p.cur.onOpenBlock1(stack["attributes"], stack["content"])
 }
 
-func (c *current) onPassthroughMacro9(content interface{}) (interface{}, error) {
-	return types.NewPassthrough(types.PassthroughMacro, content.([]interface{}))
+func (c *current) onStemBlock5(attr interface{}) (interface{}, error) {
+	return attr, nil
 }
 
-func (p *parser) callonPassthroughMacro9() (interface{}, error) {
+func (p *parser) callonStemBlock5() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onPassthroughMacro9(stack["content"])
+	return p.cur.onStemBlock5(stack["attr"])
 }
 
-func (c *current) onCrossReference1(id interface{}) (interface{}, error) {
-	return types.NewCrossReference(id.(string))
+func (c *current) onStemBlock1(before, stem, after, content interface{}) (interface{}, error) {
+	attributes := append(before.([]interface{}), stem)
+	attributes = append(attributes, after.([]interface{})...)
+	return types.NewDelimitedBlock(types.StemBlock, content.([]interface{}), attributes)
 }
 
-func (p *parser) callonCrossReference1() (interface{}, error) {
+func (p *parser) callonStemBlock1() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onCrossReference1(stack["id"])
+	return p.cur.onStemBlock1(stack["before"], stack["stem"], stack["after"], stack["content"])
 }
 
-func (c *current) onExternalLink1(url, text interface{}) (interface{}, error) {
-	if text != nil {
-		return types.NewLink(url.([]interface{}), text.([]interface{}))
-	}
-	return types.NewLink(url.([]interface{}), nil)
+func (c *current) onStemBlockAttribute1(kind interface{}) (interface{}, error) {
+	return types.NewBlockStyleAttributes(string(kind.([]byte)))
 }
 
-func (p *parser) callonExternalLink1() (interface{}, error) {
+func (p *parser) callonStemBlockAttribute1() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onExternalLink1(stack["url"], stack["text"])
+	return p.cur.onStemBlockAttribute1(stack["kind"])
 }
 
-func (c *current) onRelativeLink1(url, text interface{}) (interface{}, error) {
-	if text != nil {
-		return types.NewLink(url.([]interface{}), text.([]interface{}))
+func (c *current) onPassthroughBlock1(attributes, content interface{}) (interface{}, error) {
+	b, err := types.NewDelimitedBlock(types.PassthroughBlock, content.([]interface{}), attributes.([]interface{}))
+	if err != nil {
+		return nil, err
 	}
-	return types.NewLink(url.([]interface{}), nil)
+	return substituteDelimitedBlock(c, b)
 }
 
-func (p *parser) callonRelativeLink1() (interface{}, error) {
+func (p *parser) callonPassthroughBlock1() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onRelativeLink1(stack["url"], stack["text"])
+	return p.cur.onPassthroughBlock1(stack["attributes"], stack["content"])
 }
 
-func (c *current) onBlockImage1(attributes, image interface{}) (interface{}, error) {
-	// here we can ignore the blank line in the returned element
-	return types.NewBlockImage(image.(types.ImageMacro), attributes.([]interface{}))
+func (c *current) onTable1(attributes, header, lines interface{}) (interface{}, error) {
+	table, err := types.NewTable(header, lines.([]interface{}), attributes.([]interface{}))
+	if err != nil {
+		return nil, err
+	}
+	warnIncompleteTableRow(c, header, lines.([]interface{}), table)
+	return table, nil
 }
 
-func (p *parser) callonBlockImage1() (interface{}, error) {
+func (p *parser) callonTable1() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onBlockImage1(stack["attributes"], stack["image"])
+	return p.cur.onTable1(stack["attributes"], stack["header"], stack["lines"])
 }
 
-func (c *current) onBlockImageMacro1(path, attributes interface{}) (interface{}, error) {
-	return types.NewImageMacro(path.(string), attributes)
+func (c *current) onTableLineHeader1(cells interface{}) (interface{}, error) {
+	return types.NewTableRow(cells.([]interface{}))
 }
 
-func (p *parser) callonBlockImageMacro1() (interface{}, error) {
+func (p *parser) callonTableLineHeader1() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onBlockImageMacro1(stack["path"], stack["attributes"])
+	return p.cur.onTableLineHeader1(stack["cells"])
 }
 
-func (c *current) onInlineImage1(image interface{}) (interface{}, error) {
-	// here we can ignore the blank line in the returned element
-	return types.NewInlineImage(image.(types.ImageMacro))
+func (c *current) onTableLine1(cells interface{}) (interface{}, error) {
+	return types.NewTableRow(cells.([]interface{}))
 }
 
-func (p *parser) callonInlineImage1() (interface{}, error) {
+func (p *parser) callonTableLine1() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onInlineImage1(stack["image"])
+	return p.cur.onTableLine1(stack["cells"])
 }
 
-func (c *current) onInlineImageMacro1(path, attributes interface{}) (interface{}, error) {
-	return types.NewImageMacro(path.(string), attributes)
+func (c *current) onTableCell1(elements interface{}) (interface{}, error) {
+	return types.NewTableCell(elements.([]interface{}))
 }
 
-func (p *parser) callonInlineImageMacro1() (interface{}, error) {
+func (p *parser) callonTableCell1() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onInlineImageMacro1(stack["path"], stack["attributes"])
+	return p.cur.onTableCell1(stack["elements"])
 }
 
-func (c *current) onFencedBlock1(content interface{}) (interface{}, error) {
-	return types.NewDelimitedBlock(types.FencedBlock, content.([]interface{}), nil)
+func (c *current) onTableCellCharacters1() (interface{}, error) {
+	return string(c.text), nil
 }
 
-func (p *parser) callonFencedBlock1() (interface{}, error) {
+func (p *parser) callonTableCellCharacters1() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onFencedBlock1(stack["content"])
+	return p.cur.onTableCellCharacters1()
 }
 
-func (c *current) onListingBlock1(content interface{}) (interface{}, error) {
-	return types.NewDelimitedBlock(types.ListingBlock, content.([]interface{}), nil)
+func (c *current) onCommentBlock1(content interface{}) (interface{}, error) {
+	return types.NewCommentBlock(content.([]interface{}))
 }
 
-func (p *parser) callonListingBlock1() (interface{}, error) {
+func (p *parser) callonCommentBlock1() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onListingBlock1(stack["content"])
+	return p.cur.onCommentBlock1(stack["content"])
 }
 
-func (c *current) onExampleBlock1(attributes, content interface{}) (interface{}, error) {
-	return types.NewDelimitedBlock(types.ExampleBlock, content.([]interface{}), attributes.([]interface{}))
+func (c *current) onSingleLineComment1(content interface{}) (interface{}, error) {
+	return types.NewSingleLineComment(content.([]interface{}))
 }
 
-func (p *parser) callonExampleBlock1() (interface{}, error) {
+func (p *parser) callonSingleLineComment1() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onExampleBlock1(stack["attributes"], stack["content"])
+	return p.cur.onSingleLineComment1(stack["content"])
 }
 
 func (c *current) onParagraphWithSpaces1(spaces, content interface{}) (interface{}, error) {
-	return types.NewLiteralBlock(spaces.([]interface{}), content.([]interface{}))
+	return types.NewLiteralBlock(spaces.([]interface{}), content.([]interface{}), []interface{}{})
 }
 
 func (p *parser) callonParagraphWithSpaces1() (interface{}, error) {
@@ -8090,18 +15984,22 @@ func (p *parser) callonLiteralBlockContent1() (interface{}, error) {
 	return p.cur.onLiteralBlockContent1(stack["content"])
 }
 
-func (c *current) onParagraphWithLiteralBlockDelimiter1(content interface{}) (interface{}, error) {
-	return types.NewLiteralBlock([]interface{}{}, content.([]interface{}))
+func (c *current) onParagraphWithLiteralBlockDelimiter1(attributes, content interface{}) (interface{}, error) {
+	b, err := types.NewLiteralBlock([]interface{}{}, content.([]interface{}), attributes.([]interface{}))
+	if err != nil {
+		return nil, err
+	}
+	return substituteLiteralBlock(c, b)
 }
 
 func (p *parser) callonParagraphWithLiteralBlockDelimiter1() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onParagraphWithLiteralBlockDelimiter1(stack["content"])
+	return p.cur.onParagraphWithLiteralBlockDelimiter1(stack["attributes"], stack["content"])
 }
 
 func (c *current) onParagraphWithLiteralAttribute1(content interface{}) (interface{}, error) {
-	return types.NewLiteralBlock([]interface{}{}, content.([]interface{}))
+	return types.NewLiteralBlock([]interface{}{}, content.([]interface{}), []interface{}{})
 }
 
 func (p *parser) callonParagraphWithLiteralAttribute1() (interface{}, error) {
@@ -8160,6 +16058,138 @@ func (p *parser) callonElementTitle1() (interface{}, error) {
 	return p.cur.onElementTitle1(stack["title"])
 }
 
+func (c *current) onSourceAttributes2() (interface{}, error) {
+	return types.NewSourceAttributes("")
+}
+
+func (p *parser) callonSourceAttributes2() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onSourceAttributes2()
+}
+
+func (c *current) onSourceAttributes7(language, others interface{}) (interface{}, error) {
+	return types.NewSourceAttributesWithOthers(language.(string), others.([]interface{}))
+}
+
+func (p *parser) callonSourceAttributes7() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onSourceAttributes7(stack["language"], stack["others"])
+}
+
+func (c *current) onSourceAttributes31(others interface{}) (interface{}, error) {
+	// no language, but other attributes. eg: `[source,subs="attributes+"]`
+	return types.NewSourceAttributesWithOthers("", others.([]interface{}))
+}
+
+func (p *parser) callonSourceAttributes31() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onSourceAttributes31(stack["others"])
+}
+
+func (c *current) onSourceLanguage1() (interface{}, error) {
+	return string(c.text), nil
+}
+
+func (p *parser) callonSourceLanguage1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onSourceLanguage1()
+}
+
+func (c *current) onQuoteAttributes8(attr interface{}) (interface{}, error) {
+	return attr, nil
+}
+
+func (p *parser) callonQuoteAttributes8() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onQuoteAttributes8(stack["attr"])
+}
+
+func (c *current) onQuoteAttributes15(attr interface{}) (interface{}, error) {
+	return attr, nil
+}
+
+func (p *parser) callonQuoteAttributes15() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onQuoteAttributes15(stack["attr"])
+}
+
+func (c *current) onQuoteAttributes1(attribution, citeTitle interface{}) (interface{}, error) {
+	return types.NewQuoteAttributes(types.Quote, attribution, citeTitle)
+}
+
+func (p *parser) callonQuoteAttributes1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onQuoteAttributes1(stack["attribution"], stack["citeTitle"])
+}
+
+func (c *current) onVerseAttributes8(attr interface{}) (interface{}, error) {
+	return attr, nil
+}
+
+func (p *parser) callonVerseAttributes8() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onVerseAttributes8(stack["attr"])
+}
+
+func (c *current) onVerseAttributes15(attr interface{}) (interface{}, error) {
+	return attr, nil
+}
+
+func (p *parser) callonVerseAttributes15() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onVerseAttributes15(stack["attr"])
+}
+
+func (c *current) onVerseAttributes1(attribution, citeTitle interface{}) (interface{}, error) {
+	return types.NewQuoteAttributes(types.Verse, attribution, citeTitle)
+}
+
+func (p *parser) callonVerseAttributes1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onVerseAttributes1(stack["attribution"], stack["citeTitle"])
+}
+
+func (c *current) onQuoteAttribute2(value interface{}) (interface{}, error) {
+	// quoted value, which may contain commas
+	return types.NewQuoteAttribute(value.([]interface{}))
+}
+
+func (p *parser) callonQuoteAttribute2() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onQuoteAttribute2(stack["value"])
+}
+
+func (c *current) onQuoteAttribute18(value interface{}) (interface{}, error) {
+	return types.NewQuoteAttribute(value.([]interface{}))
+}
+
+func (p *parser) callonQuoteAttribute18() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onQuoteAttribute18(stack["value"])
+}
+
+func (c *current) onBlockStyleAttributes1(kind interface{}) (interface{}, error) {
+	return types.NewBlockStyleAttributes(string(kind.([]byte)))
+}
+
+func (p *parser) callonBlockStyleAttributes1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onBlockStyleAttributes1(stack["kind"])
+}
+
 func (c *current) onAttributeGroup1(attributes interface{}) (interface{}, error) {
 	return types.NewAttributeGroup(attributes.([]interface{}))
 }
@@ -8203,15 +16233,26 @@ func (p *parser) callonAttributeKey1() (interface{}, error) {
 	return p.cur.onAttributeKey1(stack["key"])
 }
 
-func (c *current) onAttributeValue1(value interface{}) (interface{}, error) {
+func (c *current) onAttributeValue2(value interface{}) (interface{}, error) {
+	// quoted value, which may contain spaces, commas or equal signs
+	return value, nil
+}
+
+func (p *parser) callonAttributeValue2() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onAttributeValue2(stack["value"])
+}
+
+func (c *current) onAttributeValue16(value interface{}) (interface{}, error) {
 	// fmt.Printf("found attribute value: %v\n", value)
 	return value, nil
 }
 
-func (p *parser) callonAttributeValue1() (interface{}, error) {
+func (p *parser) callonAttributeValue16() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onAttributeValue1(stack["value"])
+	return p.cur.onAttributeValue16(stack["value"])
 }
 
 func (c *current) onInvalidElementAttribute1(content interface{}) (interface{}, error) {
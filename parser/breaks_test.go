@@ -0,0 +1,121 @@
+package parser_test
+
+import (
+	"github.com/bytesparadise/libasciidoc/parser"
+	"github.com/bytesparadise/libasciidoc/types"
+	. "github.com/onsi/ginkgo"
+)
+
+var _ = Describe("Breaks", func() {
+
+	Context("thematic breaks", func() {
+
+		It("thematic break with single quotes", func() {
+			actualContent := "'''"
+			expectedResult := types.ThematicBreak{}
+			verify(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("BlockElement"))
+		})
+
+		It("thematic break with markdown-style dashes", func() {
+			actualContent := "---"
+			expectedResult := types.ThematicBreak{}
+			verify(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("BlockElement"))
+		})
+
+		It("thematic break with markdown-style spaced asterisks", func() {
+			actualContent := "* * *"
+			expectedResult := types.ThematicBreak{}
+			verify(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("BlockElement"))
+		})
+
+		It("thematic break between paragraphs", func() {
+			actualContent := `a paragraph
+
+'''
+
+another paragraph`
+			expectedResult := types.Document{
+				Attributes:        map[string]interface{}{},
+				ElementReferences: map[string]interface{}{},
+				Elements: []types.DocElement{
+					types.Paragraph{
+						Attributes: map[string]interface{}{},
+						Lines: []types.InlineContent{
+							{
+								Elements: []types.InlineElement{
+									types.StringElement{Content: "a paragraph"},
+								},
+							},
+						},
+					},
+					types.ThematicBreak{},
+					types.Paragraph{
+						Attributes: map[string]interface{}{},
+						Lines: []types.InlineContent{
+							{
+								Elements: []types.InlineElement{
+									types.StringElement{Content: "another paragraph"},
+								},
+							},
+						},
+					},
+				},
+			}
+			verify(GinkgoT(), expectedResult, actualContent)
+		})
+	})
+
+	Context("page breaks", func() {
+
+		It("page break", func() {
+			actualContent := "<<<"
+			expectedResult := types.PageBreak{}
+			verify(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("BlockElement"))
+		})
+	})
+
+	Context("line breaks", func() {
+
+		It("line break at the end of a line", func() {
+			actualContent := `line one +
+line two`
+			expectedResult := types.Paragraph{
+				Attributes: map[string]interface{}{},
+				Lines: []types.InlineContent{
+					{
+						Elements: []types.InlineElement{
+							types.StringElement{Content: "line one"},
+							types.LineBreak{},
+						},
+					},
+					{
+						Elements: []types.InlineElement{
+							types.StringElement{Content: "line two"},
+						},
+					},
+				},
+			}
+			verify(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("BlockElement"))
+		})
+
+		It("plus sign in the middle of a line", func() {
+			actualContent := `1 + 1 = 2`
+			expectedResult := types.InlineContent{
+				Elements: []types.InlineElement{
+					types.StringElement{Content: "1 + 1 = 2"},
+				},
+			}
+			verify(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("InlineContent"))
+		})
+
+		It("plus sign attached to a word at the end of a line", func() {
+			actualContent := `C++`
+			expectedResult := types.InlineContent{
+				Elements: []types.InlineElement{
+					types.StringElement{Content: "C++"},
+				},
+			}
+			verify(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("InlineContent"))
+		})
+	})
+})
@@ -0,0 +1,88 @@
+package parser_test
+
+import (
+	"github.com/bytesparadise/libasciidoc/parser"
+	"github.com/bytesparadise/libasciidoc/types"
+	. "github.com/onsi/ginkgo"
+)
+
+var _ = Describe("callouts", func() {
+
+	It("listing block with callouts", func() {
+		actualContent := `----
+import "fmt" <1>
+
+func main() {
+	fmt.Println("hello") // <2> <3>
+}
+# <4>
+----`
+		expectedResult := types.DelimitedBlock{
+			Kind:       types.ListingBlock,
+			Attributes: map[string]interface{}{},
+			Elements: []types.DocElement{
+				types.StringElement{Content: `import "fmt" `},
+				types.Callout{Ref: 1},
+				types.StringElement{Content: "\n\nfunc main() {\n\tfmt.Println(\"hello\") "},
+				types.Callout{Ref: 2},
+				types.StringElement{Content: " "},
+				types.Callout{Ref: 3},
+				types.StringElement{Content: "\n}\n"},
+				types.Callout{Ref: 4},
+			},
+		}
+		verify(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("BlockElement"))
+	})
+
+	It("callout list", func() {
+		actualContent := `<1> the *first* item
+<2> the second item
+on 2 lines`
+		expectedResult := types.CalloutList{
+			Attributes: map[string]interface{}{},
+			Items: []types.CalloutListItem{
+				{
+					Ref: 1,
+					Elements: []types.DocElement{
+						types.ListParagraph{
+							Lines: []types.InlineContent{
+								{
+									Elements: []types.InlineElement{
+										types.StringElement{Content: "the "},
+										types.QuotedText{
+											Kind: types.Bold,
+											Elements: []types.InlineElement{
+												types.StringElement{Content: "first"},
+											},
+										},
+										types.StringElement{Content: " item"},
+									},
+								},
+							},
+						},
+					},
+				},
+				{
+					Ref: 2,
+					Elements: []types.DocElement{
+						types.ListParagraph{
+							Lines: []types.InlineContent{
+								{
+									Elements: []types.InlineElement{
+										types.StringElement{Content: "the second item"},
+									},
+								},
+								{
+									Elements: []types.InlineElement{
+										types.StringElement{Content: "on 2 lines"},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		}
+		verify(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("BlockElement"))
+	})
+})
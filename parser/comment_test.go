@@ -0,0 +1,197 @@
+package parser_test
+
+import (
+	"github.com/bytesparadise/libasciidoc/parser"
+	"github.com/bytesparadise/libasciidoc/types"
+	. "github.com/onsi/ginkgo"
+)
+
+var _ = Describe("comments", func() {
+
+	Context("single line comments", func() {
+
+		It("single line comment alone", func() {
+			actualContent := `// A single-line comment.`
+			expectedResult := types.Comment{
+				Kind:    types.SingleLineComment,
+				Content: " A single-line comment.",
+			}
+			verify(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("BlockElement"))
+		})
+
+		It("single line comment within a paragraph", func() {
+			actualContent := `a first line
+// A single-line comment.
+another line`
+			expectedResult := types.Paragraph{
+				Attributes: map[string]interface{}{},
+				Lines: []types.InlineContent{
+					{
+						Elements: []types.InlineElement{
+							types.StringElement{Content: "a first line"},
+						},
+					},
+					{
+						Elements: []types.InlineElement{
+							types.StringElement{Content: "another line"},
+						},
+					},
+				},
+			}
+			verify(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("BlockElement"))
+		})
+
+		It("single line comment between list items", func() {
+			actualContent := `* item 1
+// A single-line comment.
+* item 2`
+			expectedResult := types.UnorderedList{
+				Attributes: map[string]interface{}{},
+				Items: []types.UnorderedListItem{
+					{
+						Level:       1,
+						BulletStyle: types.OneAsterisk,
+						Elements: []types.DocElement{
+							types.ListParagraph{
+								Lines: []types.InlineContent{
+									{
+										Elements: []types.InlineElement{
+											types.StringElement{Content: "item 1"},
+										},
+									},
+								},
+							},
+						},
+					},
+					{
+						Level:       1,
+						BulletStyle: types.OneAsterisk,
+						Elements: []types.DocElement{
+							types.ListParagraph{
+								Lines: []types.InlineContent{
+									{
+										Elements: []types.InlineElement{
+											types.StringElement{Content: "item 2"},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			}
+			verify(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("BlockElement"))
+		})
+
+		It("single line comment in a section", func() {
+			actualContent := `== section title
+
+// A single-line comment.
+a paragraph`
+			expectedResult := types.Section{
+				Level: 1,
+				Title: types.SectionTitle{
+					Attributes: map[string]interface{}{
+						types.AttrID: "_section_title",
+					},
+					Content: types.InlineContent{
+						Elements: []types.InlineElement{
+							types.StringElement{Content: "section title"},
+						},
+					},
+				},
+				Elements: []types.DocElement{
+					types.Comment{
+						Kind:    types.SingleLineComment,
+						Content: " A single-line comment.",
+					},
+					types.Paragraph{
+						Attributes: map[string]interface{}{},
+						Lines: []types.InlineContent{
+							{
+								Elements: []types.InlineElement{
+									types.StringElement{Content: "a paragraph"},
+								},
+							},
+						},
+					},
+				},
+			}
+			verify(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("Section"))
+		})
+	})
+
+	Context("comment blocks", func() {
+
+		It("comment block alone", func() {
+			actualContent := `////
+a *comment* block
+
+with multiple lines
+////`
+			expectedResult := types.Comment{
+				Kind:    types.CommentBlock,
+				Content: "a *comment* block\n\nwith multiple lines",
+			}
+			verify(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("BlockElement"))
+		})
+
+		It("comment block after a paragraph", func() {
+			actualContent := `a paragraph
+////
+a comment block
+////`
+			expectedResult := types.Document{
+				Attributes:        map[string]interface{}{},
+				ElementReferences: map[string]interface{}{},
+				Elements: []types.DocElement{
+					types.Paragraph{
+						Attributes: map[string]interface{}{},
+						Lines: []types.InlineContent{
+							{
+								Elements: []types.InlineElement{
+									types.StringElement{Content: "a paragraph"},
+								},
+							},
+						},
+					},
+					types.Comment{
+						Kind:    types.CommentBlock,
+						Content: "a comment block",
+					},
+				},
+			}
+			verify(GinkgoT(), expectedResult, actualContent)
+		})
+
+		It("comment block in an example block", func() {
+			actualContent := `====
+////
+a comment block
+////
+a paragraph
+====`
+			expectedResult := types.DelimitedBlock{
+				Kind:       types.ExampleBlock,
+				Attributes: map[string]interface{}{},
+				Elements: []types.DocElement{
+					types.Comment{
+						Kind:    types.CommentBlock,
+						Content: "a comment block",
+					},
+					types.Paragraph{
+						Attributes: map[string]interface{}{},
+						Lines: []types.InlineContent{
+							{
+								Elements: []types.InlineElement{
+									types.StringElement{Content: "a paragraph"},
+								},
+							},
+						},
+					},
+				},
+			}
+			verify(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("BlockElement"))
+		})
+	})
+})
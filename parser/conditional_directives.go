@@ -0,0 +1,182 @@
+package parser
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/bytesparadise/libasciidoc/types"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+)
+
+var conditionalDirectiveRegexp = regexp.MustCompile(`^(\\?)(ifdef|ifndef|ifeval|endif)::(\S*?)\[(.*)\]\s*$`)
+var attributeSubstitutionRegexp = regexp.MustCompile(`\{([A-Za-z0-9_][A-Za-z0-9-]*)\}`)
+var conditionalExpressionRegexp = regexp.MustCompile(`^\s*(.+?)\s*(==|!=|<=|>=|<|>)\s*(.+?)\s*$`)
+
+// conditionalBlock a block of content enclosed in a conditional directive and its matching `endif::[]`
+type conditionalBlock struct {
+	directive string
+	target    string
+	// skip is true if the content of the block must be discarded
+	skip bool
+}
+
+// conditionalBlocks the stack of the (nested) conditional blocks in which the current line is
+type conditionalBlocks []conditionalBlock
+
+// skipping returns true if the current line must be discarded
+func (c conditionalBlocks) skipping() bool {
+	return len(c) > 0 && c[len(c)-1].skip
+}
+
+// process processes the given conditional directive. Returns the content to retain and `true` if the directive
+// is a single-line `ifdef::attr[content]` or `ifndef::attr[content]` whose condition is satisfied,
+// `false` otherwise.
+func (c *conditionalBlocks) process(filename string, attributes types.DocumentAttributes, directive, target, content string) (string, bool) {
+	if directive == "endif" {
+		if len(*c) == 0 {
+			log.Warnf("unmatched 'endif::%s[]' conditional directive in '%s'", target, filename)
+			return "", false
+		}
+		last := (*c)[len(*c)-1]
+		if target != "" && target != last.target {
+			log.Warnf("mismatched 'endif::%s[]' conditional directive in '%s' (expected 'endif::%s[]')", target, filename, last.target)
+			return "", false
+		}
+		*c = (*c)[:len(*c)-1]
+		return "", false
+	}
+	// no need to evaluate the nested conditions when the enclosing block is discarded
+	if c.skipping() {
+		if directive == "ifeval" || content == "" {
+			*c = append(*c, conditionalBlock{directive: directive, target: target, skip: true})
+		}
+		return "", false
+	}
+	var satisfied bool
+	switch directive {
+	case "ifeval":
+		if target != "" {
+			log.Warnf("invalid 'ifeval::%s[%s]' conditional directive in '%s': target is not allowed", target, content, filename)
+		}
+		var err error
+		satisfied, err = evaluateExpression(attributes, content)
+		if err != nil {
+			log.Warnf("invalid 'ifeval::[%s]' conditional directive in '%s': %v", content, filename, err)
+		}
+		*c = append(*c, conditionalBlock{directive: directive, target: target, skip: !satisfied})
+		return "", false
+	case "ifdef":
+		satisfied = evaluateDefined(attributes, target)
+	case "ifndef":
+		satisfied = !evaluateDefined(attributes, target)
+	}
+	if content != "" {
+		// single-line directive
+		return content, satisfied
+	}
+	*c = append(*c, conditionalBlock{directive: directive, target: target, skip: !satisfied})
+	return "", false
+}
+
+// evaluateDefined returns true if the given attribute is defined. If the target contains multiple attribute names,
+// returns true if any attribute is defined when the names are separated with `,`, or if all attributes are defined
+// when the names are separated with `+`
+func evaluateDefined(attributes types.DocumentAttributes, target string) bool {
+	if strings.Contains(target, "+") {
+		for _, name := range strings.Split(target, "+") {
+			if _, found := attributes[name]; !found {
+				return false
+			}
+		}
+		return true
+	}
+	for _, name := range strings.Split(target, ",") {
+		if _, found := attributes[name]; found {
+			return true
+		}
+	}
+	return false
+}
+
+// evaluateExpression evaluates the expression of an `ifeval::[]` directive, eg: `{sectnumlevels} > 2`,
+// after substituting the attributes with their values
+func evaluateExpression(attributes types.DocumentAttributes, expression string) (bool, error) {
+	expression = attributeSubstitutionRegexp.ReplaceAllStringFunc(expression, func(s string) string {
+		if value, found := attributes[s[1:len(s)-1]]; found {
+			return fmt.Sprintf("%v", value)
+		}
+		return ""
+	})
+	groups := conditionalExpressionRegexp.FindStringSubmatch(expression)
+	if groups == nil {
+		return false, errors.Errorf("invalid expression: '%s'", expression)
+	}
+	left := parseOperand(groups[1])
+	right := parseOperand(groups[3])
+	operator := groups[2]
+	switch left := left.(type) {
+	case float64:
+		if right, ok := right.(float64); ok {
+			return compare(operator, left-right)
+		}
+	case string:
+		if right, ok := right.(string); ok {
+			return compare(operator, float64(strings.Compare(left, right)))
+		}
+	case bool:
+		if right, ok := right.(bool); ok {
+			switch operator {
+			case "==":
+				return left == right, nil
+			case "!=":
+				return left != right, nil
+			}
+			return false, errors.Errorf("invalid operator for boolean values: '%s'", operator)
+		}
+	}
+	// operands of different types
+	switch operator {
+	case "==":
+		return false, nil
+	case "!=":
+		return true, nil
+	}
+	return false, errors.Errorf("unable to compare values of different types: '%s'", expression)
+}
+
+// parseOperand converts the given operand into a string (if quoted), a boolean or a number.
+// Any other value is considered as a string.
+func parseOperand(operand string) interface{} {
+	if len(operand) >= 2 && (operand[0] == '"' || operand[0] == '\'') && operand[len(operand)-1] == operand[0] {
+		return operand[1 : len(operand)-1]
+	}
+	if operand == "true" || operand == "false" {
+		return operand == "true"
+	}
+	if f, err := strconv.ParseFloat(operand, 64); err == nil {
+		return f
+	}
+	return operand
+}
+
+// compare applies the given operator on the given difference between the left and right operands
+func compare(operator string, diff float64) (bool, error) {
+	switch operator {
+	case "==":
+		return diff == 0, nil
+	case "!=":
+		return diff != 0, nil
+	case "<":
+		return diff < 0, nil
+	case "<=":
+		return diff <= 0, nil
+	case ">":
+		return diff > 0, nil
+	case ">=":
+		return diff >= 0, nil
+	}
+	return false, errors.Errorf("invalid operator: '%s'", operator)
+}
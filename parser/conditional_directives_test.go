@@ -0,0 +1,184 @@
+package parser_test
+
+import (
+	"strings"
+
+	"github.com/bytesparadise/libasciidoc/parser"
+	"github.com/bytesparadise/libasciidoc/types"
+	. "github.com/onsi/ginkgo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var _ = Describe("conditional directives", func() {
+
+	Context("ifdef and ifndef", func() {
+
+		It("ifdef with attribute declared in header", func() {
+			actualContent := `= Document
+:edition: enterprise
+
+ifdef::edition[]
+enterprise content
+endif::edition[]
+ifndef::edition[]
+community content
+endif::[]`
+			expectedResult := `= Document
+:edition: enterprise
+
+enterprise content
+`
+			verifyConditionalPreprocessing(GinkgoT(), expectedResult, actualContent, nil)
+		})
+
+		It("ifdef with attribute reset in body", func() {
+			actualContent := `:edition:
+:edition!:
+
+ifdef::edition[]
+enterprise content
+endif::edition[]
+ifndef::edition[]
+community content
+endif::edition[]`
+			expectedResult := `:edition:
+:edition!:
+
+community content
+`
+			verifyConditionalPreprocessing(GinkgoT(), expectedResult, actualContent, nil)
+		})
+
+		It("ifdef with attribute defined via the API", func() {
+			actualContent := `ifdef::edition[]
+enterprise content
+endif::[]`
+			expectedResult := `enterprise content
+`
+			verifyConditionalPreprocessing(GinkgoT(), expectedResult, actualContent, types.DocumentAttributes{"edition": ""})
+		})
+
+		It("single-line ifdef and ifndef", func() {
+			actualContent := `ifdef::edition[enterprise content]
+ifndef::edition[community content]
+end`
+			expectedResult := `community content
+end`
+			verifyConditionalPreprocessing(GinkgoT(), expectedResult, actualContent, nil)
+		})
+
+		It("ifdef with any attribute", func() {
+			actualContent := `:b:
+
+ifdef::a,b[]
+any content
+endif::[]
+ifndef::a,b[]
+none content
+endif::[]`
+			expectedResult := `:b:
+
+any content
+`
+			verifyConditionalPreprocessing(GinkgoT(), expectedResult, actualContent, nil)
+		})
+
+		It("ifdef with all attributes", func() {
+			actualContent := `:b:
+
+ifdef::a+b[]
+all content
+endif::[]
+ifndef::a+b[]
+not all content
+endif::[]`
+			expectedResult := `:b:
+
+not all content
+`
+			verifyConditionalPreprocessing(GinkgoT(), expectedResult, actualContent, nil)
+		})
+
+		It("nested conditional blocks", func() {
+			actualContent := `ifndef::a[]
+outer content
+ifdef::b[]
+inner content
+endif::b[]
+ifndef::b[]
+other inner content
+endif::b[]
+endif::a[]`
+			expectedResult := `outer content
+other inner content
+`
+			verifyConditionalPreprocessing(GinkgoT(), expectedResult, actualContent, nil)
+		})
+
+		It("attribute declarations and includes in discarded blocks are ignored", func() {
+			actualContent := `ifdef::a[]
+:b:
+include::unknown.adoc[]
+endif::[]
+ifdef::b[]
+b content
+endif::[]`
+			expectedResult := ``
+			verifyConditionalPreprocessing(GinkgoT(), expectedResult, actualContent, nil)
+		})
+
+		It("escaped directive", func() {
+			actualContent := `\ifdef::a[]`
+			expectedResult := `ifdef::a[]`
+			verifyConditionalPreprocessing(GinkgoT(), expectedResult, actualContent, nil)
+		})
+	})
+
+	Context("ifeval", func() {
+
+		It("ifeval with number comparison", func() {
+			actualContent := `:level: 3
+
+ifeval::[{level} > 2]
+high level
+endif::[]
+ifeval::[{level} <= 2]
+low level
+endif::[]`
+			expectedResult := `:level: 3
+
+high level
+`
+			verifyConditionalPreprocessing(GinkgoT(), expectedResult, actualContent, nil)
+		})
+
+		It("ifeval with string comparison", func() {
+			actualContent := `ifeval::["{backend}" == "html5"]
+html content
+endif::[]
+ifeval::["{backend}" != "html5"]
+other content
+endif::[]`
+			expectedResult := `html content
+`
+			verifyConditionalPreprocessing(GinkgoT(), expectedResult, actualContent, types.DocumentAttributes{"backend": "html5"})
+		})
+
+		It("ifeval with invalid expression", func() {
+			actualContent := `ifeval::[{level}]
+content
+endif::[]`
+			expectedResult := ``
+			verifyConditionalPreprocessing(GinkgoT(), expectedResult, actualContent, nil)
+		})
+	})
+})
+
+func verifyConditionalPreprocessing(t GinkgoTInterface, expectedResult, content string, attributes types.DocumentAttributes) {
+	t.Logf("preprocessing '%s'", content)
+	result, err := parser.Preprocess("index.adoc", strings.NewReader(content), mapIncludeResolver{}, attributes)
+	require.NoError(t, err)
+	t.Logf("actual result:\n`%s`", string(result))
+	assert.Equal(t, expectedResult, string(result))
+}
@@ -23,6 +23,105 @@ var _ = Describe("Cross References", func() {
 				}
 				verify(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("InlineContent"))
 			})
+
+			It("xref with custom id and custom text", func() {
+				actualContent := `a link to <<thetitle,the title>>.`
+				expectedResult := types.InlineContent{
+					Elements: []types.InlineElement{
+						types.StringElement{Content: "a link to "},
+						types.CrossReference{ID: "thetitle", Label: "the title"},
+						types.StringElement{Content: "."},
+					},
+				}
+				verify(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("InlineContent"))
+			})
+
+			It("xref macro with custom id", func() {
+				actualContent := `a link to xref:thetitle[].`
+				expectedResult := types.InlineContent{
+					Elements: []types.InlineElement{
+						types.StringElement{Content: "a link to "},
+						types.CrossReference{ID: "thetitle"},
+						types.StringElement{Content: "."},
+					},
+				}
+				verify(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("InlineContent"))
+			})
+
+			It("xref macro with custom id and custom text", func() {
+				actualContent := `a link to xref:thetitle[the title].`
+				expectedResult := types.InlineContent{
+					Elements: []types.InlineElement{
+						types.StringElement{Content: "a link to "},
+						types.CrossReference{ID: "thetitle", Label: "the title"},
+						types.StringElement{Content: "."},
+					},
+				}
+				verify(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("InlineContent"))
+			})
+		})
+	})
+
+	Context("Reference to other documents", func() {
+
+		It("xref to other document", func() {
+			actualContent := `a link to <<other.adoc>>.`
+			expectedResult := types.InlineContent{
+				Elements: []types.InlineElement{
+					types.StringElement{Content: "a link to "},
+					types.CrossReference{Location: "other.adoc"},
+					types.StringElement{Content: "."},
+				},
+			}
+			verify(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("InlineContent"))
+		})
+
+		It("xref to section in other document with custom text", func() {
+			actualContent := `a link to <<other.adoc#section,the section>>.`
+			expectedResult := types.InlineContent{
+				Elements: []types.InlineElement{
+					types.StringElement{Content: "a link to "},
+					types.CrossReference{Location: "other.adoc", ID: "section", Label: "the section"},
+					types.StringElement{Content: "."},
+				},
+			}
+			verify(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("InlineContent"))
+		})
+
+		It("xref to other document without extension", func() {
+			actualContent := `a link to <<docs/other#>>.`
+			expectedResult := types.InlineContent{
+				Elements: []types.InlineElement{
+					types.StringElement{Content: "a link to "},
+					types.CrossReference{Location: "docs/other"},
+					types.StringElement{Content: "."},
+				},
+			}
+			verify(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("InlineContent"))
+		})
+
+		It("xref macro to section in other document", func() {
+			actualContent := `a link to xref:other.adoc#section[the section].`
+			expectedResult := types.InlineContent{
+				Elements: []types.InlineElement{
+					types.StringElement{Content: "a link to "},
+					types.CrossReference{Location: "other.adoc", ID: "section", Label: "the section"},
+					types.StringElement{Content: "."},
+				},
+			}
+			verify(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("InlineContent"))
+		})
+
+		It("xref macro to other document", func() {
+			actualContent := `a link to xref:other.adoc[].`
+			expectedResult := types.InlineContent{
+				Elements: []types.InlineElement{
+					types.StringElement{Content: "a link to "},
+					types.CrossReference{Location: "other.adoc"},
+					types.StringElement{Content: "."},
+				},
+			}
+			verify(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("InlineContent"))
 		})
 	})
 })
@@ -232,12 +232,149 @@ some listing code
 		})
 	})
 
+	Context("source blocks", func() {
+
+		It("fenced block with language", func() {
+			actualContent := "```go\nfunc main() {}\n```"
+			expectedResult := types.DelimitedBlock{
+				Kind: types.FencedBlock,
+				Attributes: map[string]interface{}{
+					types.AttrKind:     types.Source,
+					types.AttrLanguage: "go",
+				},
+				Elements: []types.DocElement{
+					types.StringElement{
+						Content: "func main() {}",
+					},
+				},
+			}
+			verify(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("BlockElement"))
+		})
+
+		It("source block with language and title", func() {
+			actualContent := `.a title
+[source,go]
+----
+func main() {}
+----`
+			expectedResult := types.DelimitedBlock{
+				Kind: types.ListingBlock,
+				Attributes: map[string]interface{}{
+					types.AttrTitle:    "a title",
+					types.AttrKind:     types.Source,
+					types.AttrLanguage: "go",
+				},
+				Elements: []types.DocElement{
+					types.StringElement{
+						Content: "func main() {}",
+					},
+				},
+			}
+			verify(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("BlockElement"))
+		})
+
+		It("source block with language and other attributes", func() {
+			actualContent := `[source, java, linenums]
+----
+class Main {}
+----`
+			expectedResult := types.DelimitedBlock{
+				Kind: types.ListingBlock,
+				Attributes: map[string]interface{}{
+					types.AttrKind:     types.Source,
+					types.AttrLanguage: "java",
+					"linenums":         nil,
+				},
+				Elements: []types.DocElement{
+					types.StringElement{
+						Content: "class Main {}",
+					},
+				},
+			}
+			verify(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("BlockElement"))
+		})
+
+		It("source block without language", func() {
+			actualContent := `[source]
+----
+some source code
+----`
+			expectedResult := types.DelimitedBlock{
+				Kind: types.ListingBlock,
+				Attributes: map[string]interface{}{
+					types.AttrKind: types.Source,
+				},
+				Elements: []types.DocElement{
+					types.StringElement{
+						Content: "some source code",
+					},
+				},
+			}
+			verify(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("BlockElement"))
+		})
+
+		It("source block with substitutions", func() {
+			actualContent := `[source,subs="attributes+"]
+----
+some {source} code
+----`
+			expectedResult := types.DelimitedBlock{
+				Kind: types.ListingBlock,
+				Attributes: map[string]interface{}{
+					types.AttrKind:          types.Source,
+					types.AttrSubstitutions: "attributes+",
+				},
+				Elements: []types.DocElement{
+					types.InlineContent{
+						Elements: []types.InlineElement{
+							types.StringElement{Content: "some "},
+							types.DocumentAttributeSubstitution{Name: "source"},
+							types.StringElement{Content: " code"},
+						},
+					},
+				},
+			}
+			verify(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("BlockElement"))
+		})
+
+		It("source block with language and substitutions", func() {
+			actualContent := `[source,go,subs="quotes"]
+----
+some *source* code
+----`
+			expectedResult := types.DelimitedBlock{
+				Kind: types.ListingBlock,
+				Attributes: map[string]interface{}{
+					types.AttrKind:          types.Source,
+					types.AttrLanguage:      "go",
+					types.AttrSubstitutions: "quotes",
+				},
+				Elements: []types.DocElement{
+					types.InlineContent{
+						Elements: []types.InlineElement{
+							types.StringElement{Content: "some "},
+							types.QuotedText{
+								Kind: types.Bold,
+								Elements: []types.InlineElement{
+									types.StringElement{Content: "source"},
+								},
+							},
+							types.StringElement{Content: " code"},
+						},
+					},
+				},
+			}
+			verify(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("BlockElement"))
+		})
+	})
+
 	Context("Literal blocks with spaces indentation", func() {
 
 		It("literal block from 1-line paragraph with single space", func() {
 			actualContent := ` some literal content`
 			expectedResult := types.LiteralBlock{
-				Content: " some literal content",
+				Attributes: map[string]interface{}{},
+				Content:    " some literal content",
 			}
 			verify(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("BlockElement"))
 		})
@@ -246,7 +383,8 @@ some listing code
 			actualContent := ` some literal content
 on 2 lines.`
 			expectedResult := types.LiteralBlock{
-				Content: " some literal content\non 2 lines.",
+				Attributes: map[string]interface{}{},
+				Content:    " some literal content\non 2 lines.",
 			}
 			verify(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("BlockElement"))
 		})
@@ -260,7 +398,8 @@ a normal paragraph.`
 				ElementReferences: map[string]interface{}{},
 				Elements: []types.DocElement{
 					types.LiteralBlock{
-						Content: "   some literal content",
+						Attributes: map[string]interface{}{},
+						Content:    "   some literal content",
 					},
 					types.Paragraph{
 						Attributes: map[string]interface{}{},
@@ -290,7 +429,8 @@ a normal paragraph.`
 				ElementReferences: map[string]interface{}{},
 				Elements: []types.DocElement{
 					types.LiteralBlock{
-						Content: "some literal content",
+						Attributes: map[string]interface{}{},
+						Content:    "some literal content",
 					},
 					types.Paragraph{
 						Attributes: map[string]interface{}{},
@@ -321,7 +461,8 @@ a normal paragraph.`
 				ElementReferences: map[string]interface{}{},
 				Elements: []types.DocElement{
 					types.LiteralBlock{
-						Content: "some literal content",
+						Attributes: map[string]interface{}{},
+						Content:    "some literal content",
 					},
 					types.Paragraph{
 						Attributes: map[string]interface{}{},
@@ -432,4 +573,203 @@ with *bold content*
 		})
 	})
 
+	Context("sidebar blocks", func() {
+
+		It("sidebar block with nested example block", func() {
+			actualContent := `****
+some content
+
+====
+an example
+====
+****`
+			expectedResult := types.DelimitedBlock{
+				Kind:       types.SidebarBlock,
+				Attributes: map[string]interface{}{},
+				Elements: []types.DocElement{
+					types.Paragraph{
+						Attributes: map[string]interface{}{},
+						Lines: []types.InlineContent{
+							{
+								Elements: []types.InlineElement{
+									types.StringElement{Content: "some content"},
+								},
+							},
+						},
+					},
+					types.DelimitedBlock{
+						Kind:       types.ExampleBlock,
+						Attributes: map[string]interface{}{},
+						Elements: []types.DocElement{
+							types.Paragraph{
+								Attributes: map[string]interface{}{},
+								Lines: []types.InlineContent{
+									{
+										Elements: []types.InlineElement{
+											types.StringElement{Content: "an example"},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			}
+			verify(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("BlockElement"))
+		})
+	})
+
+	Context("quote blocks", func() {
+
+		It("quote block with attribution and citation title", func() {
+			actualContent := `[quote, Albert Einstein, "Letter, 1936"]
+____
+some quote
+____`
+			expectedResult := types.DelimitedBlock{
+				Kind: types.QuoteBlock,
+				Attributes: map[string]interface{}{
+					types.AttrKind:        types.Quote,
+					types.AttrAttribution: "Albert Einstein",
+					types.AttrCiteTitle:   "Letter, 1936",
+				},
+				Elements: []types.DocElement{
+					types.Paragraph{
+						Attributes: map[string]interface{}{},
+						Lines: []types.InlineContent{
+							{
+								Elements: []types.InlineElement{
+									types.StringElement{Content: "some quote"},
+								},
+							},
+						},
+					},
+				},
+			}
+			verify(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("BlockElement"))
+		})
+
+		It("quote block without attributes", func() {
+			actualContent := `____
+some quote
+____`
+			expectedResult := types.DelimitedBlock{
+				Kind:       types.QuoteBlock,
+				Attributes: map[string]interface{}{},
+				Elements: []types.DocElement{
+					types.Paragraph{
+						Attributes: map[string]interface{}{},
+						Lines: []types.InlineContent{
+							{
+								Elements: []types.InlineElement{
+									types.StringElement{Content: "some quote"},
+								},
+							},
+						},
+					},
+				},
+			}
+			verify(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("BlockElement"))
+		})
+	})
+
+	Context("verse blocks", func() {
+
+		It("verse block with title, attribution and blank line", func() {
+			actualContent := `.Verse title
+[verse, Carl Sandburg]
+____
+The fog comes
+
+on little cat feet.
+____`
+			expectedResult := types.DelimitedBlock{
+				Kind: types.VerseBlock,
+				Attributes: map[string]interface{}{
+					types.AttrTitle:       "Verse title",
+					types.AttrKind:        types.Verse,
+					types.AttrAttribution: "Carl Sandburg",
+				},
+				Elements: []types.DocElement{
+					types.InlineContent{
+						Elements: []types.InlineElement{
+							types.StringElement{Content: "The fog comes"},
+						},
+					},
+					types.InlineContent{
+						Elements: []types.InlineElement{},
+					},
+					types.InlineContent{
+						Elements: []types.InlineElement{
+							types.StringElement{Content: "on little cat feet."},
+						},
+					},
+				},
+			}
+			verify(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("BlockElement"))
+		})
+	})
+
+	Context("open blocks", func() {
+
+		It("open block with abstract style", func() {
+			actualContent := `[abstract]
+--
+some content
+--`
+			expectedResult := types.DelimitedBlock{
+				Kind: types.OpenBlock,
+				Attributes: map[string]interface{}{
+					types.AttrKind: types.Abstract,
+				},
+				Elements: []types.DocElement{
+					types.Paragraph{
+						Attributes: map[string]interface{}{},
+						Lines: []types.InlineContent{
+							{
+								Elements: []types.InlineElement{
+									types.StringElement{Content: "some content"},
+								},
+							},
+						},
+					},
+				},
+			}
+			verify(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("BlockElement"))
+		})
+
+		It("paragraph with double hyphens is not an open block", func() {
+			actualContent := `-- not an open block`
+			expectedResult := types.Paragraph{
+				Attributes: map[string]interface{}{},
+				Lines: []types.InlineContent{
+					{
+						Elements: []types.InlineElement{
+							types.StringElement{Content: "-- not an open block"},
+						},
+					},
+				},
+			}
+			verify(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("BlockElement"))
+		})
+	})
+
+	Context("passthrough blocks", func() {
+
+		It("passthrough block with multiple lines", func() {
+			actualContent := `++++
+<b>raw</b>
+*content*
+++++`
+			expectedResult := types.DelimitedBlock{
+				Kind:       types.PassthroughBlock,
+				Attributes: map[string]interface{}{},
+				Elements: []types.DocElement{
+					types.StringElement{Content: "<b>raw</b>\n*content*"},
+				},
+			}
+			verify(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("BlockElement"))
+		})
+	})
+
 })
@@ -0,0 +1,94 @@
+package parser
+
+import (
+	"github.com/bytesparadise/libasciidoc/types"
+	log "github.com/sirupsen/logrus"
+)
+
+// the key of the document attributes in the global store of the parser
+const documentAttributesKey = "documentAttributes"
+
+// the key of the positions of the level-0 sections which were already reported as invalid, in the global store of the parser
+const invalidSection0Key = "invalidSection0"
+
+// DocumentAttributes returns an option to parse a document with the given attributes (eg: defined via the API),
+// which are completed by the attributes declared in the document header.
+// Some attributes change the way the document is parsed, such as `doctype` or `experimental`.
+func DocumentAttributes(attributes types.DocumentAttributes) Option {
+	return GlobalStore(documentAttributesKey, attributes)
+}
+
+// storeDocumentAttributes adds the attributes declared in the document header to the attributes in the global store
+// of the parser. The attributes given with the `DocumentAttributes` option are copied, not modified.
+func storeDocumentAttributes(c *current, header types.DocumentHeader) {
+	updateDocumentAttributes(c, func(attributes types.DocumentAttributes) {
+		for k, v := range header.Content {
+			attributes[k] = v
+		}
+	})
+}
+
+// storeDocumentAttributeDeclaration adds the given attribute, declared in the body of the document, to the attributes
+// in the global store of the parser, so it applies on the rest of the document
+func storeDocumentAttributeDeclaration(c *current, attr types.DocumentAttributeDeclaration) {
+	updateDocumentAttributes(c, func(attributes types.DocumentAttributes) {
+		attributes.AddAttribute(attr)
+	})
+}
+
+// storeDocumentAttributeReset removes the given attribute from the attributes in the global store of the parser,
+// so it does not apply on the rest of the document
+func storeDocumentAttributeReset(c *current, attr types.DocumentAttributeReset) {
+	updateDocumentAttributes(c, func(attributes types.DocumentAttributes) {
+		attributes.Reset(attr)
+	})
+}
+
+// updateDocumentAttributes applies the given update on a copy of the attributes in the global store of the parser,
+// then replaces them with the copy
+func updateDocumentAttributes(c *current, update func(attributes types.DocumentAttributes)) {
+	attributes := types.DocumentAttributes{}
+	if existing, ok := c.globalStore[documentAttributesKey].(types.DocumentAttributes); ok {
+		for k, v := range existing {
+			attributes[k] = v
+		}
+	}
+	update(attributes)
+	c.globalStore[documentAttributesKey] = attributes
+}
+
+// documentAttribute returns the value of the document attribute with the given name, and a flag to indicate
+// if the attribute was set with the `DocumentAttributes` option or declared in the document before the current position
+func documentAttribute(c *current, name string) (interface{}, bool) {
+	if attributes, ok := c.globalStore[documentAttributesKey].(types.DocumentAttributes); ok {
+		value, found := attributes[name]
+		return value, found
+	}
+	return nil, false
+}
+
+// isBookDoctype returns true if the `doctype` document attribute is `book`
+func isBookDoctype(c *current) bool {
+	doctype, _ := documentAttribute(c, "doctype")
+	return doctype == "book"
+}
+
+// isExperimental returns true if the `experimental` document attribute is set
+func isExperimental(c *current) bool {
+	_, found := documentAttribute(c, "experimental")
+	return found
+}
+
+// warnInvalidSection0 logs a warning about the level-0 section at the current position, in a document which is
+// not a book. Since the section title can be parsed several times, the warning is logged only once.
+func warnInvalidSection0(c *current) {
+	reported, ok := c.globalStore[invalidSection0Key].(map[int]bool)
+	if !ok {
+		reported = map[int]bool{}
+		c.globalStore[invalidSection0Key] = reported
+	}
+	if !reported[c.pos.offset] {
+		reported[c.pos.offset] = true
+		log.Warnf("level 0 sections can only be used when doctype is book, processing the section at line %d as a level 1 section", c.pos.line)
+	}
+}
@@ -615,7 +615,9 @@ a paragraph with *bold content*`
 							},
 							{
 								Elements: []types.InlineElement{
-									types.StringElement{Content: ":{author}: Xavier"},
+									types.StringElement{Content: ":"},
+									types.DocumentAttributeSubstitution{Name: "author"},
+									types.StringElement{Content: ": Xavier"},
 								},
 							},
 						},
@@ -146,4 +146,25 @@ var _ = Describe("Element Attributes", func() {
 			})
 		})
 	})
+
+	Context("attribute group", func() {
+
+		It("quoted values with commas and spaces", func() {
+			actualContent := `[cols="1, 2", options="header,footer"]`
+			expectedResult := map[string]interface{}{
+				"cols":    "1, 2",
+				"options": "header,footer",
+			}
+			verify(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("ElementAttribute"))
+		})
+
+		It("unquoted values separated with commas", func() {
+			actualContent := "[width=100,height=50]"
+			expectedResult := map[string]interface{}{
+				"width":  "100",
+				"height": "50",
+			}
+			verify(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("ElementAttribute"))
+		})
+	})
 })
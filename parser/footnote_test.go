@@ -0,0 +1,168 @@
+package parser_test
+
+import (
+	"github.com/bytesparadise/libasciidoc/parser"
+	"github.com/bytesparadise/libasciidoc/types"
+	. "github.com/onsi/ginkgo"
+)
+
+var _ = Describe("footnotes", func() {
+
+	It("footnote after a word", func() {
+		actualContent := `a paragraph.footnote:[a *footnote*] with more content`
+		expectedResult := types.Paragraph{
+			Attributes: map[string]interface{}{},
+			Lines: []types.InlineContent{
+				{
+					Elements: []types.InlineElement{
+						types.StringElement{Content: "a paragraph."},
+						types.Footnote{
+							ID: 12,
+							Elements: types.InlineContent{
+								Elements: []types.InlineElement{
+									types.StringElement{Content: "a "},
+									types.QuotedText{
+										Kind: types.Bold,
+										Elements: []types.InlineElement{
+											types.StringElement{Content: "footnote"},
+										},
+									},
+								},
+							},
+						},
+						types.StringElement{Content: " with more content"},
+					},
+				},
+			},
+		}
+		verify(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("BlockElement"))
+	})
+
+	It("named footnote and its reference", func() {
+		actualContent := `a footnote:disclaimer[a disclaimer] and a reference footnote:disclaimer[]`
+		expectedResult := types.Paragraph{
+			Attributes: map[string]interface{}{},
+			Lines: []types.InlineContent{
+				{
+					Elements: []types.InlineElement{
+						types.StringElement{Content: "a "},
+						types.Footnote{
+							ID:  2,
+							Ref: "disclaimer",
+							Elements: types.InlineContent{
+								Elements: []types.InlineElement{
+									types.StringElement{Content: "a disclaimer"},
+								},
+							},
+						},
+						types.StringElement{Content: " and a reference "},
+						types.Footnote{
+							ID:       52,
+							Ref:      "disclaimer",
+							Elements: types.InlineContent{},
+						},
+					},
+				},
+			},
+		}
+		verify(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("BlockElement"))
+	})
+
+	It("invalid footnote macro", func() {
+		actualContent := `a footnote: without brackets`
+		expectedResult := types.Paragraph{
+			Attributes: map[string]interface{}{},
+			Lines: []types.InlineContent{
+				{
+					Elements: []types.InlineElement{
+						types.StringElement{Content: "a footnote: without brackets"},
+					},
+				},
+			},
+		}
+		verify(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("BlockElement"))
+	})
+
+	It("footnotes collected in the document", func() {
+		actualContent := `first footnote:[first] and footnote:named[second]
+
+* item footnote:named[] and footnote:[third]`
+		first := types.Footnote{
+			ID: 6,
+			Elements: types.InlineContent{
+				Elements: []types.InlineElement{
+					types.StringElement{Content: "first"},
+				},
+			},
+		}
+		second := types.Footnote{
+			ID:  27,
+			Ref: "named",
+			Elements: types.InlineContent{
+				Elements: []types.InlineElement{
+					types.StringElement{Content: "second"},
+				},
+			},
+		}
+		ref := types.Footnote{
+			ID:       58,
+			Ref:      "named",
+			Elements: types.InlineContent{},
+		}
+		third := types.Footnote{
+			ID: 79,
+			Elements: types.InlineContent{
+				Elements: []types.InlineElement{
+					types.StringElement{Content: "third"},
+				},
+			},
+		}
+		expectedResult := types.Document{
+			Attributes:        map[string]interface{}{},
+			ElementReferences: map[string]interface{}{},
+			Footnotes:         types.Footnotes{first, second, third},
+			FootnoteReferences: types.FootnoteReferences{
+				"named": 2,
+			},
+			Elements: []types.DocElement{
+				types.Paragraph{
+					Attributes: map[string]interface{}{},
+					Lines: []types.InlineContent{
+						{
+							Elements: []types.InlineElement{
+								types.StringElement{Content: "first "},
+								first,
+								types.StringElement{Content: " and "},
+								second,
+							},
+						},
+					},
+				},
+				types.UnorderedList{
+					Attributes: map[string]interface{}{},
+					Items: []types.UnorderedListItem{
+						{
+							Level:       1,
+							BulletStyle: types.OneAsterisk,
+							Elements: []types.DocElement{
+								types.ListParagraph{
+									Lines: []types.InlineContent{
+										{
+											Elements: []types.InlineElement{
+												types.StringElement{Content: "item "},
+												ref,
+												types.StringElement{Content: " and "},
+												third,
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		}
+		verify(GinkgoT(), expectedResult, actualContent)
+	})
+})
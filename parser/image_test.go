@@ -111,6 +111,59 @@ var _ = Describe("Images", func() {
 				}
 				verify(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("BlockImage"))
 			})
+
+			It("block image with alt and named attributes", func() {
+				actualContent := "image::images/foo.png[Diagram, width=300, role=thumb, align=center]"
+				width := "300"
+				expectedResult := types.BlockImage{
+					Attributes: map[string]interface{}{
+						types.AttrRole: "thumb",
+						"align":        "center",
+					},
+					Macro: types.ImageMacro{
+						Path:  "images/foo.png",
+						Alt:   "Diagram",
+						Width: &width,
+					},
+				}
+				verify(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("BlockImage"))
+			})
+
+			It("block image with quoted alt, empty width and named attributes", func() {
+				actualContent := `[float=right]
+image::images/foo.png["Diagram, v2",,200,id=diagram,title="The diagram",link=http://foo.bar]`
+				height := "200"
+				expectedResult := types.BlockImage{
+					Attributes: map[string]interface{}{
+						types.AttrID:    "diagram",
+						types.AttrTitle: "The diagram",
+						types.AttrLink:  "http://foo.bar",
+						"float":         "right",
+					},
+					Macro: types.ImageMacro{
+						Path:   "images/foo.png",
+						Alt:    "Diagram, v2",
+						Height: &height,
+					},
+				}
+				verify(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("BlockImage"))
+			})
+
+			It("block image with named attributes only", func() {
+				actualContent := "image::images/foo.png[alt=Diagram,width=300,height=200]"
+				width := "300"
+				height := "200"
+				expectedResult := types.BlockImage{
+					Attributes: map[string]interface{}{},
+					Macro: types.ImageMacro{
+						Path:   "images/foo.png",
+						Alt:    "Diagram",
+						Width:  &width,
+						Height: &height,
+					},
+				}
+				verify(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("BlockImage"))
+			})
 		})
 
 		Context("Errors", func() {
@@ -241,6 +294,27 @@ var _ = Describe("Images", func() {
 				}
 				verify(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("InlineContent"))
 			})
+
+			It("inline image with alt, width and named attributes", func() {
+				actualContent := "image:images/foo.png[the foo.png image, 16, role=icon, title=Foo]"
+				width := "16"
+				expectedResult := types.InlineContent{
+					Elements: []types.InlineElement{
+						types.InlineImage{
+							Macro: types.ImageMacro{
+								Path:  "images/foo.png",
+								Alt:   "the foo.png image",
+								Width: &width,
+								Attributes: map[string]interface{}{
+									types.AttrRole:  "icon",
+									types.AttrTitle: "Foo",
+								},
+							},
+						},
+					},
+				}
+				verify(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("InlineContent"))
+			})
 		})
 		Context("Errors", func() {
 			It("inline image appending inline content", func() {
@@ -0,0 +1,315 @@
+package parser
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+)
+
+// IncludeResolver resolves the content of the files included with the `include::` directive
+type IncludeResolver interface {
+	// Resolve returns the content of the file at the given path
+	Resolve(path string) ([]byte, error)
+}
+
+// FileIncludeResolver the default IncludeResolver, which reads the included files on the local filesystem.
+// Only the files in the base directory (or in its subdirectories) can be included, so that the conversion
+// of a document does not disclose the content of other files on the host.
+type FileIncludeResolver struct {
+	// BaseDir the directory of the files which can be included (the current directory if empty)
+	BaseDir string
+}
+
+// Resolve implements IncludeResolver#Resolve(string)
+func (r FileIncludeResolver) Resolve(path string) ([]byte, error) {
+	within, err := isWithinDir(r.BaseDir, path)
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to resolve '%s'", path)
+	}
+	if !within {
+		return nil, errors.Errorf("'%s' is outside of the base directory", path)
+	}
+	return ioutil.ReadFile(path)
+}
+
+// isWithinDir returns true if the given path is in the given directory (the current directory if empty)
+// or in one of its subdirectories
+func isWithinDir(dir, path string) (bool, error) {
+	if dir == "" {
+		dir = "."
+	}
+	absDir, err := filepath.Abs(dir)
+	if err != nil {
+		return false, err
+	}
+	absPath, err := filepath.Abs(path)
+	if err != nil {
+		return false, err
+	}
+	rel, err := filepath.Rel(absDir, absPath)
+	if err != nil {
+		return false, err
+	}
+	return rel != ".." && !strings.HasPrefix(rel, ".."+string(filepath.Separator)), nil
+}
+
+var includeDirectiveRegexp = regexp.MustCompile(`^(\\?)include::([^\[\s]+)\[(.*)\]\s*$`)
+var tagDirectiveRegexp = regexp.MustCompile(`\b(tag|end)::(\S+?)\[\]`)
+
+// include returns the content of the target file, once its lines have been selected and its own include
+// directives have been processed
+func (p *preprocessor) include(filename, target, attrs string, levelOffset int) ([]byte, error) {
+	path := target
+	if !filepath.IsAbs(target) {
+		path = filepath.Join(filepath.Dir(filename), target)
+	}
+	path = filepath.Clean(path)
+	for _, f := range p.chain {
+		if f == path {
+			log.Warnf("include cycle detected: %s -> %s", strings.Join(p.chain, " -> "), path)
+			return unresolvedInclude(filename, target, attrs), nil
+		}
+	}
+	attributes := parseIncludeAttributes(attrs)
+	content, err := p.resolver.Resolve(path)
+	if err != nil {
+		log.Warnf("unable to read included file '%s': %v", path, err)
+		return unresolvedInclude(filename, target, attrs), nil
+	}
+	log.Debugf("including '%s' with attributes %v", path, attributes)
+	lines := splitLines(content)
+	if ranges, found := attributes["lines"]; found {
+		lines, err = selectLineRanges(lines, ranges)
+		if err != nil {
+			return nil, errors.Wrapf(err, "unable to include '%s'", path)
+		}
+	} else if tags, found := attributes["tags"]; found {
+		lines = selectTags(path, lines, tags)
+	} else if tag, found := attributes["tag"]; found {
+		lines = selectTags(path, lines, tag)
+	}
+	if offset, found := attributes["leveloffset"]; found {
+		o, err := strconv.Atoi(strings.TrimPrefix(offset, "+"))
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid 'leveloffset' value while including '%s'", path)
+		}
+		if strings.HasPrefix(offset, "+") || strings.HasPrefix(offset, "-") {
+			levelOffset += o
+		} else {
+			levelOffset = o
+		}
+	}
+	p.chain = append(p.chain, path)
+	result, err := p.process(path, []byte(strings.Join(lines, "")), levelOffset)
+	p.chain = p.chain[:len(p.chain)-1]
+	if err != nil {
+		return nil, err
+	}
+	if indent, found := attributes["indent"]; found {
+		i, err := strconv.Atoi(indent)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid 'indent' value while including '%s'", path)
+		}
+		if i < 0 {
+			log.Warnf("invalid negative 'indent' value while including '%s': %d, keeping the original indentation", path, i)
+		} else {
+			result = reindent(result, i)
+		}
+	}
+	// make sure that the included content ends with a newline, so it is not merged with the next line
+	if len(result) > 0 && result[len(result)-1] != '\n' {
+		result = append(result, '\n')
+	}
+	return result, nil
+}
+
+// unresolvedInclude returns the line which replaces an include directive whose target could not be included
+func unresolvedInclude(filename, target, attrs string) []byte {
+	return []byte(fmt.Sprintf("Unresolved directive in %s - include::%s[%s]\n", filename, target, attrs))
+}
+
+// parseIncludeAttributes parses the attributes of an include directive, eg: `lines="1..5;8", leveloffset=+1`
+func parseIncludeAttributes(attrs string) map[string]string {
+	result := make(map[string]string)
+	for len(strings.TrimSpace(attrs)) > 0 {
+		attrs = strings.TrimLeft(attrs, " ,")
+		var key, value string
+		i := strings.IndexAny(attrs, "=,")
+		if i == -1 || attrs[i] == ',' {
+			// attribute without value
+			if i == -1 {
+				i = len(attrs)
+			}
+			key = strings.TrimSpace(attrs[:i])
+			attrs = attrs[i:]
+		} else {
+			key = strings.TrimSpace(attrs[:i])
+			attrs = strings.TrimLeft(attrs[i+1:], " ")
+			if strings.HasPrefix(attrs, `"`) {
+				end := strings.Index(attrs[1:], `"`)
+				if end == -1 {
+					end = len(attrs) - 1
+				}
+				value = attrs[1 : end+1]
+				attrs = strings.TrimPrefix(attrs[end+1:], `"`)
+			} else {
+				end := strings.Index(attrs, ",")
+				if end == -1 {
+					end = len(attrs)
+				}
+				value = strings.TrimSpace(attrs[:end])
+				attrs = attrs[end:]
+			}
+		}
+		if key != "" {
+			result[key] = value
+		}
+	}
+	return result
+}
+
+// selectLineRanges returns the lines in the given ranges (eg: `1..5;8;10..-1`), where the line numbers start at 1
+// and `-1` means the last line. Ranges can be separated by `;` or `,`
+func selectLineRanges(lines []string, ranges string) ([]string, error) {
+	selected := make(map[int]bool)
+	for _, r := range strings.FieldsFunc(ranges, func(r rune) bool { return r == ';' || r == ',' }) {
+		bounds := strings.SplitN(strings.TrimSpace(r), "..", 2)
+		start, err := strconv.Atoi(bounds[0])
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid line range: '%s'", r)
+		}
+		end := start
+		if len(bounds) == 2 {
+			end, err = strconv.Atoi(bounds[1])
+			if err != nil {
+				return nil, errors.Wrapf(err, "invalid line range: '%s'", r)
+			}
+			if end == -1 {
+				end = len(lines)
+			}
+		}
+		// no need to go beyond the actual lines
+		if start < 1 {
+			start = 1
+		}
+		if end > len(lines) {
+			end = len(lines)
+		}
+		for i := start; i <= end; i++ {
+			selected[i] = true
+		}
+	}
+	result := make([]string, 0, len(selected))
+	for i, line := range lines {
+		if selected[i+1] {
+			result = append(result, line)
+		}
+	}
+	return result, nil
+}
+
+// selectTags returns the lines in the tagged regions (delimited with `tag::name[]` and `end::name[]`) selected
+// by the given tags (eg: `foo;!bar`), where `*` means all tagged regions and `**` means all lines.
+// The lines containing the tag directives are always excluded.
+func selectTags(path string, lines []string, tags string) []string {
+	selection := make(map[string]bool)
+	var wildcard *bool
+	// lines outside of tagged regions are selected only when the tags are all negated or when `**` is used
+	selectUntagged := true
+	for _, tag := range strings.FieldsFunc(tags, func(r rune) bool { return r == ';' || r == ',' }) {
+		tag = strings.TrimSpace(tag)
+		include := !strings.HasPrefix(tag, "!")
+		tag = strings.TrimPrefix(tag, "!")
+		switch tag {
+		case "**":
+			selectUntagged = include
+			if wildcard == nil {
+				wildcard = &include
+			}
+		case "*":
+			wildcard = &include
+			if include {
+				selectUntagged = false
+			}
+		default:
+			selection[tag] = include
+			if include {
+				selectUntagged = false
+			}
+		}
+	}
+	result := make([]string, 0, len(lines))
+	// the stack of tagged regions in which the current line is, along with their selection
+	type region struct {
+		name     string
+		selected bool
+	}
+	stack := []region{}
+	found := make(map[string]bool)
+	for _, line := range lines {
+		if groups := tagDirectiveRegexp.FindStringSubmatch(line); groups != nil {
+			name := groups[2]
+			if groups[1] == "tag" {
+				var selected bool
+				if s, ok := selection[name]; ok {
+					selected = s
+					found[name] = true
+				} else if wildcard != nil {
+					// regions nested in an unselected region remain unselected
+					selected = *wildcard && (len(stack) == 0 || stack[len(stack)-1].selected)
+				} else if len(stack) > 0 {
+					selected = stack[len(stack)-1].selected
+				} else {
+					selected = selectUntagged
+				}
+				stack = append(stack, region{name: name, selected: selected})
+			} else if len(stack) > 0 && stack[len(stack)-1].name == name {
+				stack = stack[:len(stack)-1]
+			} else {
+				log.Warnf("unexpected end tag '%s' in included file '%s'", name, path)
+			}
+			continue
+		}
+		if (len(stack) == 0 && selectUntagged) || (len(stack) > 0 && stack[len(stack)-1].selected) {
+			result = append(result, line)
+		}
+	}
+	for name, include := range selection {
+		if include && !found[name] {
+			log.Warnf("tag '%s' not found in included file '%s'", name, path)
+		}
+	}
+	return result
+}
+
+// reindent removes the common indentation of the given lines, then indents them with the given number of spaces
+func reindent(content []byte, indent int) []byte {
+	lines := splitLines(content)
+	common := -1
+	for _, line := range lines {
+		if strings.TrimSpace(line) == "" {
+			continue
+		}
+		spaces := len(line) - len(strings.TrimLeft(line, " \t"))
+		if common == -1 || spaces < common {
+			common = spaces
+		}
+	}
+	result := bytes.NewBuffer(nil)
+	for _, line := range lines {
+		if strings.TrimSpace(line) == "" {
+			result.WriteString(strings.TrimLeft(line, " \t"))
+			continue
+		}
+		result.WriteString(strings.Repeat(" ", indent))
+		result.WriteString(line[common:])
+	}
+	return result.Bytes()
+}
@@ -0,0 +1,255 @@
+package parser_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/bytesparadise/libasciidoc/parser"
+	. "github.com/onsi/ginkgo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// mapIncludeResolver an include resolver which serves the content of the included files from a map
+type mapIncludeResolver map[string]string
+
+func (r mapIncludeResolver) Resolve(path string) ([]byte, error) {
+	if content, found := r[path]; found {
+		return []byte(content), nil
+	}
+	return nil, os.ErrNotExist
+}
+
+var _ = Describe("include directives", func() {
+
+	resolver := mapIncludeResolver{
+		"docs/chapter.adoc": `== Chapter
+
+content of the chapter
+`,
+		"docs/nested.adoc": `= Nested
+
+include::sub/leaf.adoc[]`,
+		"docs/sub/leaf.adoc": `== Leaf
+
+leaf content`,
+		"docs/lines.txt": `line 1
+line 2
+line 3
+line 4
+line 5
+`,
+		"docs/tagged.go": `package main
+
+// tag::imports[]
+import "fmt"
+// end::imports[]
+
+// tag::main[]
+func main() {
+	// tag::print[]
+	fmt.Println("hello")
+	// end::print[]
+}
+// end::main[]
+`,
+		"docs/indented.txt": `    func main() {
+        fmt.Println("hello")
+    }
+`,
+		"docs/listing.adoc": `== Section
+
+----
+= not a title
+----
+`,
+		"docs/cycle1.adoc": `include::cycle2.adoc[]`,
+		"docs/cycle2.adoc": `include::cycle1.adoc[]`,
+		"docs/root.adoc": `root content
+include::index.adoc[]`,
+	}
+
+	It("include file relative to the including file", func() {
+		actualContent := `= Document
+
+include::chapter.adoc[]
+
+end`
+		expectedResult := `= Document
+
+== Chapter
+
+content of the chapter
+
+end`
+		verifyPreprocessing(GinkgoT(), expectedResult, actualContent, resolver)
+	})
+
+	It("include nested files with relative paths", func() {
+		actualContent := `include::nested.adoc[]`
+		expectedResult := `= Nested
+
+== Leaf
+
+leaf content
+`
+		verifyPreprocessing(GinkgoT(), expectedResult, actualContent, resolver)
+	})
+
+	It("escaped include directive", func() {
+		actualContent := `\include::chapter.adoc[]`
+		expectedResult := `include::chapter.adoc[]`
+		verifyPreprocessing(GinkgoT(), expectedResult, actualContent, resolver)
+	})
+
+	It("include directive with missing file", func() {
+		actualContent := `include::unknown.adoc[]`
+		expectedResult := "Unresolved directive in docs/index.adoc - include::unknown.adoc[]\n"
+		verifyPreprocessing(GinkgoT(), expectedResult, actualContent, resolver)
+	})
+
+	It("include line ranges", func() {
+		actualContent := `include::lines.txt[lines="1..2;4..-1"]`
+		expectedResult := `line 1
+line 2
+line 4
+line 5
+`
+		verifyPreprocessing(GinkgoT(), expectedResult, actualContent, resolver)
+	})
+
+	It("include single lines separated with commas", func() {
+		actualContent := `include::lines.txt[lines="1,3"]`
+		expectedResult := `line 1
+line 3
+`
+		verifyPreprocessing(GinkgoT(), expectedResult, actualContent, resolver)
+	})
+
+	It("include line ranges beyond the end of the file", func() {
+		actualContent := `include::lines.txt[lines="0..2;4..100000000"]`
+		expectedResult := `line 1
+line 2
+line 4
+line 5
+`
+		verifyPreprocessing(GinkgoT(), expectedResult, actualContent, resolver)
+	})
+
+	It("include a single tag", func() {
+		actualContent := `include::tagged.go[tag=imports]`
+		expectedResult := `import "fmt"
+`
+		verifyPreprocessing(GinkgoT(), expectedResult, actualContent, resolver)
+	})
+
+	It("include multiple tags with negation", func() {
+		actualContent := `include::tagged.go[tags="imports;main;!print"]`
+		expectedResult := `import "fmt"
+func main() {
+}
+`
+		verifyPreprocessing(GinkgoT(), expectedResult, actualContent, resolver)
+	})
+
+	It("include all lines except a tag", func() {
+		actualContent := `include::tagged.go[tags="**;!main"]`
+		expectedResult := `package main
+
+import "fmt"
+
+`
+		verifyPreprocessing(GinkgoT(), expectedResult, actualContent, resolver)
+	})
+
+	It("include with relative and absolute level offsets", func() {
+		actualContent := `include::nested.adoc[leveloffset=+1]
+
+include::chapter.adoc[leveloffset=1]`
+		expectedResult := `== Nested
+
+=== Leaf
+
+leaf content
+
+=== Chapter
+
+content of the chapter
+`
+		verifyPreprocessing(GinkgoT(), expectedResult, actualContent, resolver)
+	})
+
+	It("include with level offset does not shift lines in listing blocks", func() {
+		actualContent := `include::listing.adoc[leveloffset=+1]`
+		expectedResult := `=== Section
+
+----
+= not a title
+----
+`
+		verifyPreprocessing(GinkgoT(), expectedResult, actualContent, resolver)
+	})
+
+	It("include with indent", func() {
+		actualContent := `include::indented.txt[indent=2]`
+		expectedResult := `  func main() {
+      fmt.Println("hello")
+  }
+`
+		verifyPreprocessing(GinkgoT(), expectedResult, actualContent, resolver)
+	})
+
+	It("include with negative indent", func() {
+		actualContent := `include::indented.txt[indent=-1]`
+		expectedResult := `    func main() {
+        fmt.Println("hello")
+    }
+`
+		verifyPreprocessing(GinkgoT(), expectedResult, actualContent, resolver)
+	})
+
+	It("include cycle", func() {
+		actualContent := `include::cycle1.adoc[]`
+		expectedResult := `Unresolved directive in docs/cycle2.adoc - include::cycle1.adoc[]
+`
+		verifyPreprocessing(GinkgoT(), expectedResult, actualContent, resolver)
+	})
+
+	It("include of the document itself", func() {
+		actualContent := `include::root.adoc[]`
+		expectedResult := `root content
+Unresolved directive in docs/root.adoc - include::index.adoc[]
+`
+		verifyPreprocessing(GinkgoT(), expectedResult, actualContent, resolver)
+	})
+
+	It("include outside of the directory of the document", func() {
+		dir, err := ioutil.TempDir("", "libasciidoc")
+		require.NoError(GinkgoT(), err)
+		defer os.RemoveAll(dir)
+		require.NoError(GinkgoT(), os.Mkdir(filepath.Join(dir, "docs"), 0700))
+		require.NoError(GinkgoT(), ioutil.WriteFile(filepath.Join(dir, "docs", "inside.adoc"), []byte("inside content\n"), 0600))
+		require.NoError(GinkgoT(), ioutil.WriteFile(filepath.Join(dir, "outside.adoc"), []byte("outside content\n"), 0600))
+		filename := filepath.Join(dir, "docs", "index.adoc")
+		actualContent := `include::inside.adoc[]
+include::../outside.adoc[]
+include::` + filepath.Join(dir, "outside.adoc") + `[]`
+		expectedResult := `inside content
+Unresolved directive in ` + filename + ` - include::../outside.adoc[]
+Unresolved directive in ` + filename + ` - include::` + filepath.Join(dir, "outside.adoc") + `[]
+`
+		result, err := parser.Preprocess(filename, strings.NewReader(actualContent), nil, nil)
+		require.NoError(GinkgoT(), err)
+		assert.Equal(GinkgoT(), expectedResult, string(result))
+	})
+})
+
+func verifyPreprocessing(t GinkgoTInterface, expectedResult, content string, resolver parser.IncludeResolver) {
+	t.Logf("preprocessing '%s'", content)
+	result, err := parser.Preprocess("docs/index.adoc", strings.NewReader(content), resolver, nil)
+	require.NoError(t, err)
+	t.Logf("actual result:\n`%s`", string(result))
+	assert.Equal(t, expectedResult, string(result))
+}
@@ -0,0 +1,100 @@
+package parser_test
+
+import (
+	"github.com/bytesparadise/libasciidoc/parser"
+	"github.com/bytesparadise/libasciidoc/types"
+	. "github.com/onsi/ginkgo"
+)
+
+var _ = Describe("Inline Anchors", func() {
+
+	Context("inline anchors", func() {
+
+		It("anchor in the middle of a paragraph", func() {
+			actualContent := `a paragraph with [[an-anchor]]an anchor.`
+			expectedResult := types.InlineContent{
+				Elements: []types.InlineElement{
+					types.StringElement{Content: "a paragraph with "},
+					types.InlineAnchor{ID: "an-anchor"},
+					types.StringElement{Content: "an anchor."},
+				},
+			}
+			verify(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("InlineContent"))
+		})
+
+		It("anchor with a label", func() {
+			actualContent := `a paragraph with [[an-anchor,an anchor]]an anchor.`
+			expectedResult := types.InlineContent{
+				Elements: []types.InlineElement{
+					types.StringElement{Content: "a paragraph with "},
+					types.InlineAnchor{ID: "an-anchor", Label: "an anchor"},
+					types.StringElement{Content: "an anchor."},
+				},
+			}
+			verify(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("InlineContent"))
+		})
+
+		It("anchor macro with a label", func() {
+			actualContent := `a paragraph with anchor:an-anchor[an anchor]an anchor.`
+			expectedResult := types.InlineContent{
+				Elements: []types.InlineElement{
+					types.StringElement{Content: "a paragraph with "},
+					types.InlineAnchor{ID: "an-anchor", Label: "an anchor"},
+					types.StringElement{Content: "an anchor."},
+				},
+			}
+			verify(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("InlineContent"))
+		})
+
+		It("anchor in a paragraph is registered as an element reference", func() {
+			actualContent := `a paragraph with [[an-anchor]]an anchor.`
+			anchor := types.InlineAnchor{ID: "an-anchor"}
+			expectedResult := types.Document{
+				Attributes: map[string]interface{}{},
+				ElementReferences: map[string]interface{}{
+					"an-anchor": anchor,
+				},
+				Elements: []types.DocElement{
+					types.Paragraph{
+						Attributes: map[string]interface{}{},
+						Lines: []types.InlineContent{
+							{
+								Elements: []types.InlineElement{
+									types.StringElement{Content: "a paragraph with "},
+									anchor,
+									types.StringElement{Content: "an anchor."},
+								},
+							},
+						},
+					},
+				},
+			}
+			verify(GinkgoT(), expectedResult, actualContent)
+		})
+	})
+
+	Context("bibliography anchors", func() {
+
+		It("bibliography anchor", func() {
+			actualContent := `[[[pp]]] Andy Hunt & Dave Thomas. The Pragmatic Programmer.`
+			expectedResult := types.InlineContent{
+				Elements: []types.InlineElement{
+					types.InlineAnchor{ID: "pp", Bibliography: true},
+					types.StringElement{Content: " Andy Hunt & Dave Thomas. The Pragmatic Programmer."},
+				},
+			}
+			verify(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("InlineContent"))
+		})
+
+		It("bibliography anchor with a label", func() {
+			actualContent := `[[[pp,PP]]] Andy Hunt & Dave Thomas. The Pragmatic Programmer.`
+			expectedResult := types.InlineContent{
+				Elements: []types.InlineElement{
+					types.InlineAnchor{ID: "pp", Label: "PP", Bibliography: true},
+					types.StringElement{Content: " Andy Hunt & Dave Thomas. The Pragmatic Programmer."},
+				},
+			}
+			verify(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("InlineContent"))
+		})
+	})
+})
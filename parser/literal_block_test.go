@@ -13,7 +13,8 @@ var _ = Describe("Literal Blocks", func() {
 		It("literal block from 1-line paragraph with single space", func() {
 			actualContent := ` some literal content`
 			expectedResult := types.LiteralBlock{
-				Content: " some literal content",
+				Attributes: map[string]interface{}{},
+				Content:    " some literal content",
 			}
 			verify(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("BlockElement"))
 		})
@@ -22,7 +23,8 @@ var _ = Describe("Literal Blocks", func() {
 			actualContent := ` some literal content
 on 2 lines.`
 			expectedResult := types.LiteralBlock{
-				Content: " some literal content\non 2 lines.",
+				Attributes: map[string]interface{}{},
+				Content:    " some literal content\non 2 lines.",
 			}
 			verify(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("BlockElement"))
 		})
@@ -36,7 +38,8 @@ a normal paragraph.`
 				ElementReferences: map[string]interface{}{},
 				Elements: []types.DocElement{
 					types.LiteralBlock{
-						Content: "   some literal content",
+						Attributes: map[string]interface{}{},
+						Content:    "   some literal content",
 					},
 					types.Paragraph{
 						Attributes: map[string]interface{}{},
@@ -66,7 +69,8 @@ a normal paragraph.`
 				ElementReferences: map[string]interface{}{},
 				Elements: []types.DocElement{
 					types.LiteralBlock{
-						Content: "some literal content",
+						Attributes: map[string]interface{}{},
+						Content:    "some literal content",
 					},
 					types.Paragraph{
 						Attributes: map[string]interface{}{},
@@ -83,6 +87,34 @@ a normal paragraph.`
 			verify(GinkgoT(), expectedResult, actualContent)
 		})
 
+		It("literal block with delimiter and substitutions", func() {
+			actualContent := `[subs="quotes"]
+....
+some *literal* content
+....`
+			expectedResult := types.LiteralBlock{
+				Attributes: map[string]interface{}{
+					types.AttrSubstitutions: "quotes",
+				},
+				Content: "some *literal* content",
+				Lines: []types.InlineContent{
+					{
+						Elements: []types.InlineElement{
+							types.StringElement{Content: "some "},
+							types.QuotedText{
+								Kind: types.Bold,
+								Elements: []types.InlineElement{
+									types.StringElement{Content: "literal"},
+								},
+							},
+							types.StringElement{Content: " content"},
+						},
+					},
+				},
+			}
+			verify(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("BlockElement"))
+		})
+
 	})
 
 	Context("Literal blocks with attribute", func() {
@@ -97,7 +129,8 @@ a normal paragraph.`
 				ElementReferences: map[string]interface{}{},
 				Elements: []types.DocElement{
 					types.LiteralBlock{
-						Content: "some literal content",
+						Attributes: map[string]interface{}{},
+						Content:    "some literal content",
 					},
 					types.Paragraph{
 						Attributes: map[string]interface{}{},
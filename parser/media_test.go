@@ -0,0 +1,90 @@
+package parser_test
+
+import (
+	"github.com/bytesparadise/libasciidoc/parser"
+	"github.com/bytesparadise/libasciidoc/types"
+	. "github.com/onsi/ginkgo"
+)
+
+var _ = Describe("Video and Audio", func() {
+
+	Context("Video Blocks", func() {
+
+		It("video block without attributes", func() {
+			actualContent := "video::videos/intro.mp4[]"
+			expectedResult := types.VideoBlock{
+				Path:       "videos/intro.mp4",
+				Attributes: map[string]interface{}{},
+			}
+			verify(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("BlockElement"))
+		})
+
+		It("video block with positional and named attributes", func() {
+			actualContent := `.Introduction
+video::intro.mp4[poster.png,640,480,start=10,end=60,options="autoplay,loop"]`
+			expectedResult := types.VideoBlock{
+				Path: "intro.mp4",
+				Attributes: map[string]interface{}{
+					types.AttrTitle:   "Introduction",
+					types.AttrPoster:  "poster.png",
+					types.AttrWidth:   "640",
+					types.AttrHeight:  "480",
+					types.AttrStart:   "10",
+					types.AttrEnd:     "60",
+					types.AttrOptions: "autoplay,loop",
+				},
+			}
+			verify(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("BlockElement"))
+		})
+
+		It("youtube video block", func() {
+			actualContent := "video::RvRhUHTV_8k[youtube,width=640,height=360,%autoplay]"
+			expectedResult := types.VideoBlock{
+				Path:     "RvRhUHTV_8k",
+				Provider: types.YouTube,
+				Attributes: map[string]interface{}{
+					types.AttrWidth:  "640",
+					types.AttrHeight: "360",
+					"%autoplay":      nil,
+				},
+			}
+			verify(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("BlockElement"))
+		})
+
+		It("vimeo video block", func() {
+			actualContent := "video::67480300[vimeo]"
+			expectedResult := types.VideoBlock{
+				Path:       "67480300",
+				Provider:   types.Vimeo,
+				Attributes: map[string]interface{}{},
+			}
+			verify(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("BlockElement"))
+		})
+	})
+
+	Context("Audio Blocks", func() {
+
+		It("audio block without attributes", func() {
+			actualContent := "audio::ocean-waves.wav[]"
+			expectedResult := types.AudioBlock{
+				Path:       "ocean-waves.wav",
+				Attributes: map[string]interface{}{},
+			}
+			verify(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("BlockElement"))
+		})
+
+		It("audio block with ID and attributes", func() {
+			actualContent := `[#waves]
+audio::ocean-waves.wav[start=30,options=loop]`
+			expectedResult := types.AudioBlock{
+				Path: "ocean-waves.wav",
+				Attributes: map[string]interface{}{
+					types.AttrID:      "waves",
+					types.AttrStart:   "30",
+					types.AttrOptions: "loop",
+				},
+			}
+			verify(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("BlockElement"))
+		})
+	})
+})
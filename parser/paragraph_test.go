@@ -78,4 +78,113 @@ a paragraph`
 		}
 		verify(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("BlockElement"))
 	})
+
+	Context("paragraphs with substitutions", func() {
+
+		It("paragraph with the quotes substitution only", func() {
+			actualContent := `[subs="quotes"]
+a paragraph with *bold* and {attribute}
+on two lines`
+			expectedResult := types.Paragraph{
+				Attributes: map[string]interface{}{
+					types.AttrSubstitutions: "quotes",
+				},
+				Lines: []types.InlineContent{
+					{
+						Elements: []types.InlineElement{
+							types.StringElement{Content: "a paragraph with "},
+							types.QuotedText{
+								Kind: types.Bold,
+								Elements: []types.InlineElement{
+									types.StringElement{Content: "bold"},
+								},
+							},
+							types.StringElement{Content: " and {attribute}"},
+						},
+					},
+					{
+						Elements: []types.InlineElement{
+							types.StringElement{Content: "on two lines"},
+						},
+					},
+				},
+			}
+			verify(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("BlockElement"))
+		})
+
+		It("paragraph with the attributes substitution only", func() {
+			actualContent := `[subs="attributes"]
+*bold* and {attribute}`
+			expectedResult := types.Paragraph{
+				Attributes: map[string]interface{}{
+					types.AttrSubstitutions: "attributes",
+				},
+				Lines: []types.InlineContent{
+					{
+						Elements: []types.InlineElement{
+							types.StringElement{Content: "*bold* and "},
+							types.DocumentAttributeSubstitution{Name: "attribute"},
+						},
+					},
+				},
+			}
+			verify(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("BlockElement"))
+		})
+
+		It("paragraph with the attributes substitution before the quotes substitution", func() {
+			actualContent := `[subs="attributes,quotes"]
+{name} and *more*`
+			expectedResult := types.Paragraph{
+				Attributes: map[string]interface{}{
+					types.AttrSubstitutions: "attributes,quotes",
+				},
+				Lines: []types.InlineContent{
+					{
+						Elements: []types.InlineElement{
+							types.QuotedText{
+								Kind: types.Bold,
+								Elements: []types.InlineElement{
+									types.StringElement{Content: "bold"},
+								},
+							},
+							types.StringElement{Content: " value and "},
+							types.QuotedText{
+								Kind: types.Bold,
+								Elements: []types.InlineElement{
+									types.StringElement{Content: "more"},
+								},
+							},
+						},
+					},
+				},
+			}
+			verify(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("BlockElement"),
+				parser.DocumentAttributes(types.DocumentAttributes{"name": "*bold* value"}))
+		})
+
+		It("paragraph with the quotes substitution before the attributes substitution", func() {
+			actualContent := `[subs="quotes,attributes"]
+{name} and *more*`
+			expectedResult := types.Paragraph{
+				Attributes: map[string]interface{}{
+					types.AttrSubstitutions: "quotes,attributes",
+				},
+				Lines: []types.InlineContent{
+					{
+						Elements: []types.InlineElement{
+							types.StringElement{Content: "*bold* value and "},
+							types.QuotedText{
+								Kind: types.Bold,
+								Elements: []types.InlineElement{
+									types.StringElement{Content: "more"},
+								},
+							},
+						},
+					},
+				},
+			}
+			verify(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("BlockElement"),
+				parser.DocumentAttributes(types.DocumentAttributes{"name": "*bold* value"}))
+		})
+	})
 })
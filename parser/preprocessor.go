@@ -0,0 +1,148 @@
+package parser
+
+import (
+	"bytes"
+	"io"
+	"io/ioutil"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/bytesparadise/libasciidoc/types"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+)
+
+var sectionTitleRegexp = regexp.MustCompile(`^(=+)(\s+\S.*)$`)
+var attributeDeclarationRegexp = regexp.MustCompile(`^:(!?)([A-Za-z0-9_][A-Za-z0-9-]*)(!?):(?:\s+(.*?))?\s*$`)
+
+// verbatimBlockDelimiters the delimiters of the blocks in which the section titles must not be shifted
+// when a `leveloffset` is applied, and in which the attribute declarations are ignored
+var verbatimBlockDelimiters = []string{"----", "....", "```", "++++", "////"}
+
+// Preprocess reads the given `r` reader and processes the preprocessor directives:
+// - the `include::` directives are replaced with the content of the target files, which are resolved relative
+// to the given `filename` and read using the given resolver (or from the directory of the given `filename` and its
+// subdirectories on the local filesystem if the resolver is nil).
+// - the `ifdef::`, `ifndef::` and `ifeval::` conditional directives are evaluated against the given attributes
+// and the attributes declared or reset in the document until the directive, and the content is retained or
+// discarded accordingly.
+func Preprocess(filename string, r io.Reader, resolver IncludeResolver, attributes types.DocumentAttributes) ([]byte, error) {
+	content, err := ioutil.ReadAll(r)
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to preprocess the document")
+	}
+	if resolver == nil {
+		resolver = FileIncludeResolver{
+			BaseDir: filepath.Dir(filename),
+		}
+	}
+	p := preprocessor{
+		resolver:   resolver,
+		chain:      []string{},
+		attributes: types.DocumentAttributes{},
+	}
+	if filename != "" {
+		// the document itself cannot be included
+		p.chain = append(p.chain, filepath.Clean(filename))
+	}
+	for k, v := range attributes {
+		p.attributes[k] = v
+	}
+	return p.process(filename, content, 0)
+}
+
+type preprocessor struct {
+	resolver IncludeResolver
+	// the chain of files being included, to detect cycles
+	chain []string
+	// the attributes in effect at the current line
+	attributes types.DocumentAttributes
+}
+
+// process processes the preprocessor directives in the given content, and shifts the section titles
+// with the given level offset
+func (p *preprocessor) process(filename string, content []byte, levelOffset int) ([]byte, error) {
+	result := bytes.NewBuffer(nil)
+	delimiter := ""
+	conditions := conditionalBlocks{}
+	for _, line := range splitLines(content) {
+		trimmedLine := strings.TrimRight(line, "\r\n")
+		if groups := conditionalDirectiveRegexp.FindStringSubmatch(trimmedLine); groups != nil {
+			if groups[1] == `\` {
+				// escaped directive: keep the line, without the backslash
+				if !conditions.skipping() {
+					result.WriteString(line[1:])
+				}
+				continue
+			}
+			if content, ok := conditions.process(filename, p.attributes, groups[2], groups[3], groups[4]); ok {
+				result.WriteString(content + line[len(trimmedLine):])
+			}
+			continue
+		}
+		if conditions.skipping() {
+			continue
+		}
+		if groups := includeDirectiveRegexp.FindStringSubmatch(trimmedLine); groups != nil {
+			if groups[1] == `\` {
+				// escaped directive: keep the line, without the backslash
+				result.WriteString(line[1:])
+				continue
+			}
+			included, err := p.include(filename, groups[2], groups[3], levelOffset)
+			if err != nil {
+				return nil, err
+			}
+			result.Write(included)
+			continue
+		}
+		// do not shift the section titles nor process the attribute declarations in verbatim blocks
+		if delimiter == "" {
+			for _, d := range verbatimBlockDelimiters {
+				if trimmedLine == d {
+					delimiter = d
+				}
+			}
+		} else if trimmedLine == delimiter {
+			delimiter = ""
+		}
+		if delimiter == "" {
+			if groups := attributeDeclarationRegexp.FindStringSubmatch(trimmedLine); groups != nil {
+				if groups[1] == "!" || groups[3] == "!" {
+					delete(p.attributes, groups[2])
+				} else {
+					p.attributes.Add(groups[2], groups[4])
+				}
+			}
+		}
+		if levelOffset != 0 && delimiter == "" {
+			if groups := sectionTitleRegexp.FindStringSubmatch(trimmedLine); groups != nil {
+				level := len(groups[1]) + levelOffset
+				if level < 1 {
+					level = 1
+				} else if level > 6 {
+					level = 6
+				}
+				line = strings.Repeat("=", level) + line[len(groups[1]):]
+			}
+		}
+		result.WriteString(line)
+	}
+	if len(conditions) > 0 {
+		log.Warnf("unterminated '%s::%s[]' conditional directive in '%s'", conditions[len(conditions)-1].directive, conditions[len(conditions)-1].target, filename)
+	}
+	return result.Bytes(), nil
+}
+
+// splitLines splits the given content in lines, retaining the line endings
+func splitLines(content []byte) []string {
+	if len(content) == 0 {
+		return []string{}
+	}
+	lines := strings.SplitAfter(string(content), "\n")
+	if lines[len(lines)-1] == "" {
+		lines = lines[:len(lines)-1]
+	}
+	return lines
+}
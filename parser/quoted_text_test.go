@@ -1017,4 +1017,253 @@ var _ = Describe("Quoted Texts", func() {
 		})
 	})
 
+	Context("Superscript, subscript and marked text", func() {
+
+		It("superscript text within a word", func() {
+			actualContent := "E=mc^2^ is famous"
+			expectedResult := types.InlineContent{
+				Elements: []types.InlineElement{
+					types.StringElement{Content: "E=mc"},
+					types.QuotedText{
+						Kind: types.Superscript,
+						Elements: []types.InlineElement{
+							types.StringElement{Content: "2"},
+						},
+					},
+					types.StringElement{Content: " is famous"},
+				},
+			}
+			verify(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("InlineContent"))
+		})
+
+		It("subscript text within a word", func() {
+			actualContent := "H~2~O"
+			expectedResult := types.InlineContent{
+				Elements: []types.InlineElement{
+					types.StringElement{Content: "H"},
+					types.QuotedText{
+						Kind: types.Subscript,
+						Elements: []types.InlineElement{
+							types.StringElement{Content: "2"},
+						},
+					},
+					types.StringElement{Content: "O"},
+				},
+			}
+			verify(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("InlineContent"))
+		})
+
+		It("superscript with spaces is not substituted", func() {
+			actualContent := "a ^b c^ d"
+			expectedResult := types.InlineContent{
+				Elements: []types.InlineElement{
+					types.StringElement{Content: "a ^b c^ d"},
+				},
+			}
+			verify(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("InlineContent"))
+		})
+
+		It("superscript within bold text", func() {
+			actualContent := "*E=mc^2^*"
+			expectedResult := types.QuotedText{
+				Kind: types.Bold,
+				Elements: []types.InlineElement{
+					types.StringElement{Content: "E=mc"},
+					types.QuotedText{
+						Kind: types.Superscript,
+						Elements: []types.InlineElement{
+							types.StringElement{Content: "2"},
+						},
+					},
+				},
+			}
+			verify(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("QuotedText"))
+		})
+
+		It("marked text with single punctuation", func() {
+			actualContent := "#marked content#"
+			expectedResult := types.QuotedText{
+				Kind: types.Marked,
+				Elements: []types.InlineElement{
+					types.StringElement{Content: "marked content"},
+				},
+			}
+			verify(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("QuotedText"))
+		})
+
+		It("marked text with double punctuation within a word", func() {
+			actualContent := "un##marked##text"
+			expectedResult := types.InlineContent{
+				Elements: []types.InlineElement{
+					types.StringElement{Content: "un"},
+					types.QuotedText{
+						Kind: types.Marked,
+						Elements: []types.InlineElement{
+							types.StringElement{Content: "marked"},
+						},
+					},
+					types.StringElement{Content: "text"},
+				},
+			}
+			verify(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("InlineContent"))
+		})
+
+		It("escaped superscript text", func() {
+			actualContent := `E=mc\^2^`
+			expectedResult := types.InlineContent{
+				Elements: []types.InlineElement{
+					types.StringElement{Content: "E=mc^2^"},
+				},
+			}
+			verify(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("InlineContent"))
+		})
+	})
+
+	Context("Constrained and unconstrained quoted text", func() {
+
+		It("unconstrained bold text within a word", func() {
+			actualContent := "**b**old"
+			expectedResult := types.InlineContent{
+				Elements: []types.InlineElement{
+					types.QuotedText{
+						Kind: types.Bold,
+						Elements: []types.InlineElement{
+							types.StringElement{Content: "b"},
+						},
+					},
+					types.StringElement{Content: "old"},
+				},
+			}
+			verify(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("InlineContent"))
+		})
+
+		It("unconstrained italic text in the middle of a word", func() {
+			actualContent := "some__thing__else"
+			expectedResult := types.InlineContent{
+				Elements: []types.InlineElement{
+					types.StringElement{Content: "some"},
+					types.QuotedText{
+						Kind: types.Italic,
+						Elements: []types.InlineElement{
+							types.StringElement{Content: "thing"},
+						},
+					},
+					types.StringElement{Content: "else"},
+				},
+			}
+			verify(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("InlineContent"))
+		})
+
+		It("constrained bold text within a word is not substituted", func() {
+			actualContent := "a*b*c and *d*e"
+			expectedResult := types.InlineContent{
+				Elements: []types.InlineElement{
+					types.StringElement{Content: "a*b*c and *d*e"},
+				},
+			}
+			verify(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("InlineContent"))
+		})
+	})
+
+	Context("Quoted text with attributes", func() {
+
+		It("marked text with a role", func() {
+			actualContent := "[.underline]#underlined content#"
+			expectedResult := types.QuotedText{
+				Kind: types.Marked,
+				Attributes: map[string]interface{}{
+					types.AttrRole: "underline",
+				},
+				Elements: []types.InlineElement{
+					types.StringElement{Content: "underlined content"},
+				},
+			}
+			verify(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("QuotedText"))
+		})
+
+		It("bold text with an ID and roles", func() {
+			actualContent := "[#foo.role1.role2]*bold content*"
+			expectedResult := types.QuotedText{
+				Kind: types.Bold,
+				Attributes: map[string]interface{}{
+					types.AttrID:   "foo",
+					types.AttrRole: "role1 role2",
+				},
+				Elements: []types.InlineElement{
+					types.StringElement{Content: "bold content"},
+				},
+			}
+			verify(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("QuotedText"))
+		})
+
+		It("marked text with a legacy role", func() {
+			actualContent := "[line-through]#deleted#"
+			expectedResult := types.QuotedText{
+				Kind: types.Marked,
+				Attributes: map[string]interface{}{
+					types.AttrRole: "line-through",
+				},
+				Elements: []types.InlineElement{
+					types.StringElement{Content: "deleted"},
+				},
+			}
+			verify(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("QuotedText"))
+		})
+
+		It("quoted text with role in a sentence", func() {
+			actualContent := "a [.role]##mark##ed word"
+			expectedResult := types.InlineContent{
+				Elements: []types.InlineElement{
+					types.StringElement{Content: "a "},
+					types.QuotedText{
+						Kind: types.Marked,
+						Attributes: map[string]interface{}{
+							types.AttrRole: "role",
+						},
+						Elements: []types.InlineElement{
+							types.StringElement{Content: "mark"},
+						},
+					},
+					types.StringElement{Content: "ed word"},
+				},
+			}
+			verify(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("InlineContent"))
+		})
+	})
+
+	Context("Curved quotes", func() {
+
+		It("text in curved double quotes", func() {
+			actualContent := "\"`curved quotes`\""
+			expectedResult := types.QuotedText{
+				Kind: types.DoubleQuoted,
+				Elements: []types.InlineElement{
+					types.StringElement{Content: "curved quotes"},
+				},
+			}
+			verify(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("QuotedText"))
+		})
+
+		It("text in curved single quotes", func() {
+			actualContent := "'`curved quotes`'"
+			expectedResult := types.QuotedText{
+				Kind: types.SingleQuoted,
+				Elements: []types.InlineElement{
+					types.StringElement{Content: "curved quotes"},
+				},
+			}
+			verify(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("QuotedText"))
+		})
+
+		It("escaped text in curved double quotes", func() {
+			actualContent := "\\\"`curved quotes`\""
+			expectedResult := types.InlineContent{
+				Elements: []types.InlineElement{
+					types.StringElement{Content: "\"`curved quotes`\""},
+				},
+			}
+			verify(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("InlineContent"))
+		})
+	})
+
 })
@@ -1,6 +1,7 @@
 package parser_test
 
 import (
+	"github.com/bytesparadise/libasciidoc/parser"
 	"github.com/bytesparadise/libasciidoc/types"
 	. "github.com/onsi/ginkgo"
 )
@@ -127,6 +128,211 @@ and a paragraph`
 			verify(GinkgoT(), expectedResult, actualContent)
 		})
 
+		It("section level 1 with marked text", func() {
+			actualContent := `== A #marked# title`
+			sectionTitle := types.SectionTitle{
+				Attributes: map[string]interface{}{
+					types.AttrID: "_a_marked_title",
+				},
+				Content: types.InlineContent{
+					Elements: []types.InlineElement{
+						types.StringElement{Content: "A "},
+						types.QuotedText{
+							Kind: types.Marked,
+							Elements: []types.InlineElement{
+								types.StringElement{Content: "marked"},
+							},
+						},
+						types.StringElement{Content: " title"},
+					},
+				},
+			}
+			expectedResult := types.Document{
+				Attributes: map[string]interface{}{},
+				ElementReferences: map[string]interface{}{
+					"_a_marked_title": sectionTitle,
+				},
+				Elements: []types.DocElement{
+					types.Section{
+						Level:    1,
+						Title:    sectionTitle,
+						Elements: []types.DocElement{},
+					},
+				},
+			}
+			verify(GinkgoT(), expectedResult, actualContent)
+		})
+
+		It("section level 1 with superscript text", func() {
+			actualContent := `== E=mc^2^ title`
+			sectionTitle := types.SectionTitle{
+				Attributes: map[string]interface{}{
+					types.AttrID: "_emc2_title",
+				},
+				Content: types.InlineContent{
+					Elements: []types.InlineElement{
+						types.StringElement{Content: "E=mc"},
+						types.QuotedText{
+							Kind: types.Superscript,
+							Elements: []types.InlineElement{
+								types.StringElement{Content: "2"},
+							},
+						},
+						types.StringElement{Content: " title"},
+					},
+				},
+			}
+			expectedResult := types.Document{
+				Attributes: map[string]interface{}{},
+				ElementReferences: map[string]interface{}{
+					"_emc2_title": sectionTitle,
+				},
+				Elements: []types.DocElement{
+					types.Section{
+						Level:    1,
+						Title:    sectionTitle,
+						Elements: []types.DocElement{},
+					},
+				},
+			}
+			verify(GinkgoT(), expectedResult, actualContent)
+		})
+
+		It("section level 1 with subscript text", func() {
+			actualContent := `== H~2~O title`
+			sectionTitle := types.SectionTitle{
+				Attributes: map[string]interface{}{
+					types.AttrID: "_h2o_title",
+				},
+				Content: types.InlineContent{
+					Elements: []types.InlineElement{
+						types.StringElement{Content: "H"},
+						types.QuotedText{
+							Kind: types.Subscript,
+							Elements: []types.InlineElement{
+								types.StringElement{Content: "2"},
+							},
+						},
+						types.StringElement{Content: "O title"},
+					},
+				},
+			}
+			expectedResult := types.Document{
+				Attributes: map[string]interface{}{},
+				ElementReferences: map[string]interface{}{
+					"_h2o_title": sectionTitle,
+				},
+				Elements: []types.DocElement{
+					types.Section{
+						Level:    1,
+						Title:    sectionTitle,
+						Elements: []types.DocElement{},
+					},
+				},
+			}
+			verify(GinkgoT(), expectedResult, actualContent)
+		})
+
+		It("section level 1 with text in curved double quotes", func() {
+			actualContent := "== The \"`quoted`\" title"
+			sectionTitle := types.SectionTitle{
+				Attributes: map[string]interface{}{
+					types.AttrID: "_the_quoted_title",
+				},
+				Content: types.InlineContent{
+					Elements: []types.InlineElement{
+						types.StringElement{Content: "The "},
+						types.QuotedText{
+							Kind: types.DoubleQuoted,
+							Elements: []types.InlineElement{
+								types.StringElement{Content: "quoted"},
+							},
+						},
+						types.StringElement{Content: " title"},
+					},
+				},
+			}
+			expectedResult := types.Document{
+				Attributes: map[string]interface{}{},
+				ElementReferences: map[string]interface{}{
+					"_the_quoted_title": sectionTitle,
+				},
+				Elements: []types.DocElement{
+					types.Section{
+						Level:    1,
+						Title:    sectionTitle,
+						Elements: []types.DocElement{},
+					},
+				},
+			}
+			verify(GinkgoT(), expectedResult, actualContent)
+		})
+
+		It("section level 1 with text in curved single quotes", func() {
+			actualContent := "== The '`quoted`' title"
+			sectionTitle := types.SectionTitle{
+				Attributes: map[string]interface{}{
+					types.AttrID: "_the_quoted_title",
+				},
+				Content: types.InlineContent{
+					Elements: []types.InlineElement{
+						types.StringElement{Content: "The "},
+						types.QuotedText{
+							Kind: types.SingleQuoted,
+							Elements: []types.InlineElement{
+								types.StringElement{Content: "quoted"},
+							},
+						},
+						types.StringElement{Content: " title"},
+					},
+				},
+			}
+			expectedResult := types.Document{
+				Attributes: map[string]interface{}{},
+				ElementReferences: map[string]interface{}{
+					"_the_quoted_title": sectionTitle,
+				},
+				Elements: []types.DocElement{
+					types.Section{
+						Level:    1,
+						Title:    sectionTitle,
+						Elements: []types.DocElement{},
+					},
+				},
+			}
+			verify(GinkgoT(), expectedResult, actualContent)
+		})
+
+		It("section level 1 with a footnote", func() {
+			actualContent := "== A title footnote:[a *note*] here"
+			expectedResult := types.SectionTitle{
+				Attributes: map[string]interface{}{
+					types.AttrID: "_a_title_here",
+				},
+				Content: types.InlineContent{
+					Elements: []types.InlineElement{
+						types.StringElement{Content: "A title "},
+						types.Footnote{
+							ID: 11,
+							Elements: types.InlineContent{
+								Elements: []types.InlineElement{
+									types.StringElement{Content: "a "},
+									types.QuotedText{
+										Kind: types.Bold,
+										Elements: []types.InlineElement{
+											types.StringElement{Content: "note"},
+										},
+									},
+								},
+							},
+						},
+						types.StringElement{Content: " here"},
+					},
+				},
+			}
+			verify(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("Section1Title"))
+		})
+
 		It("section level 0 with nested section level 1", func() {
 			actualContent := `= a header
 
@@ -575,6 +781,98 @@ a paragraph`
 			}
 			verify(GinkgoT(), expectedResult, actualContent)
 		})
+		It("sections with duplicate titles", func() {
+			actualContent := `== Intro
+
+== Intro`
+			introTitle := types.SectionTitle{
+				Attributes: map[string]interface{}{
+					types.AttrID: "_intro",
+				},
+				Content: types.InlineContent{
+					Elements: []types.InlineElement{
+						types.StringElement{Content: "Intro"},
+					},
+				},
+			}
+			otherIntroTitle := types.SectionTitle{
+				Attributes: map[string]interface{}{
+					types.AttrID: "_intro_2",
+				},
+				Content: types.InlineContent{
+					Elements: []types.InlineElement{
+						types.StringElement{Content: "Intro"},
+					},
+				},
+			}
+			expectedResult := types.Document{
+				Attributes: map[string]interface{}{},
+				ElementReferences: map[string]interface{}{
+					"_intro":   introTitle,
+					"_intro_2": otherIntroTitle,
+				},
+				Elements: []types.DocElement{
+					types.Section{
+						Level:    1,
+						Title:    introTitle,
+						Elements: []types.DocElement{},
+					},
+					types.Section{
+						Level:    1,
+						Title:    otherIntroTitle,
+						Elements: []types.DocElement{},
+					},
+				},
+			}
+			verify(GinkgoT(), expectedResult, actualContent)
+		})
+
+		It("section with generated ID colliding with a custom ID", func() {
+			actualContent := `== Intro
+
+[[_intro]]
+== Introduction`
+			introTitle := types.SectionTitle{
+				Attributes: map[string]interface{}{
+					types.AttrID: "_intro_2",
+				},
+				Content: types.InlineContent{
+					Elements: []types.InlineElement{
+						types.StringElement{Content: "Intro"},
+					},
+				},
+			}
+			introductionTitle := types.SectionTitle{
+				Attributes: map[string]interface{}{
+					types.AttrID: "_intro",
+				},
+				Content: types.InlineContent{
+					Elements: []types.InlineElement{
+						types.StringElement{Content: "Introduction"},
+					},
+				},
+			}
+			expectedResult := types.Document{
+				Attributes: map[string]interface{}{},
+				ElementReferences: map[string]interface{}{
+					"_intro_2": introTitle,
+					"_intro":   introductionTitle,
+				},
+				Elements: []types.DocElement{
+					types.Section{
+						Level:    1,
+						Title:    introTitle,
+						Elements: []types.DocElement{},
+					},
+					types.Section{
+						Level:    1,
+						Title:    introductionTitle,
+						Elements: []types.DocElement{},
+					},
+				},
+			}
+			verify(GinkgoT(), expectedResult, actualContent)
+		})
 	})
 
 	Context("invalid sections", func() {
@@ -605,7 +903,8 @@ a paragraph`
 				ElementReferences: map[string]interface{}{},
 				Elements: []types.DocElement{
 					types.LiteralBlock{
-						Content: " = a header",
+						Attributes: map[string]interface{}{},
+						Content:    " = a header",
 					},
 				},
 			}
@@ -632,7 +931,8 @@ a paragraph`
 				ElementReferences: map[string]interface{}{},
 				Elements: []types.DocElement{
 					types.LiteralBlock{
-						Content: " == section 1",
+						Attributes: map[string]interface{}{},
+						Content:    " == section 1",
 					},
 				},
 			}
@@ -640,4 +940,317 @@ a paragraph`
 		})
 
 	})
+
+	Context("special sections", func() {
+
+		It("appendix section", func() {
+			actualContent := `[appendix]
+== Additional content`
+			title := types.SectionTitle{
+				Attributes: map[string]interface{}{
+					types.AttrKind: types.Appendix,
+					types.AttrID:   "_additional_content",
+				},
+				Content: types.InlineContent{
+					Elements: []types.InlineElement{
+						types.StringElement{Content: "Additional content"},
+					},
+				},
+			}
+			expectedResult := types.Document{
+				Attributes: map[string]interface{}{},
+				ElementReferences: map[string]interface{}{
+					"_additional_content": title,
+				},
+				Elements: []types.DocElement{
+					types.Section{
+						Level:    1,
+						Title:    title,
+						Elements: []types.DocElement{},
+					},
+				},
+			}
+			verify(GinkgoT(), expectedResult, actualContent)
+		})
+	})
+
+	Context("discrete headings", func() {
+
+		It("discrete heading in a section", func() {
+			actualContent := `== Section A
+
+[discrete]
+=== A heading
+
+a paragraph`
+			sectionTitle := types.SectionTitle{
+				Attributes: map[string]interface{}{
+					types.AttrID: "_section_a",
+				},
+				Content: types.InlineContent{
+					Elements: []types.InlineElement{
+						types.StringElement{Content: "Section A"},
+					},
+				},
+			}
+			heading := types.DiscreteHeading{
+				Level: 2,
+				Attributes: map[string]interface{}{
+					types.AttrID: "_a_heading",
+				},
+				Content: types.InlineContent{
+					Elements: []types.InlineElement{
+						types.StringElement{Content: "A heading"},
+					},
+				},
+			}
+			expectedResult := types.Document{
+				Attributes: map[string]interface{}{},
+				ElementReferences: map[string]interface{}{
+					"_section_a": sectionTitle,
+					"_a_heading": heading,
+				},
+				Elements: []types.DocElement{
+					types.Section{
+						Level: 1,
+						Title: sectionTitle,
+						Elements: []types.DocElement{
+							heading,
+							types.Paragraph{
+								Attributes: map[string]interface{}{},
+								Lines: []types.InlineContent{
+									{
+										Elements: []types.InlineElement{
+											types.StringElement{Content: "a paragraph"},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			}
+			verify(GinkgoT(), expectedResult, actualContent)
+		})
+
+		It("floating heading with ID", func() {
+			actualContent := `[float]
+[[custom]]
+== A heading`
+			expectedResult := types.DiscreteHeading{
+				Level: 1,
+				Attributes: map[string]interface{}{
+					types.AttrID: "custom",
+				},
+				Content: types.InlineContent{
+					Elements: []types.InlineElement{
+						types.StringElement{Content: "A heading"},
+					},
+				},
+			}
+			verify(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("BlockElement"))
+		})
+
+		It("discrete heading with 6 markers", func() {
+			actualContent := `[discrete]
+====== A heading`
+			expectedResult := types.DiscreteHeading{
+				Level: 5,
+				Attributes: map[string]interface{}{
+					types.AttrID: "_a_heading",
+				},
+				Content: types.InlineContent{
+					Elements: []types.InlineElement{
+						types.StringElement{Content: "A heading"},
+					},
+				},
+			}
+			verify(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("DiscreteHeading"))
+		})
+
+		It("discrete heading with too many markers", func() {
+			actualContent := `[discrete]
+======= A heading`
+			expectError(GinkgoT(), actualContent, parser.Entrypoint("DiscreteHeading"))
+		})
+	})
+
+	Context("markdown-style sections", func() {
+
+		It("header and sections", func() {
+			actualContent := `# A title
+
+## Section A
+
+### Section A.a`
+			doctitle := types.SectionTitle{
+				Attributes: map[string]interface{}{
+					types.AttrID: "_a_title",
+				},
+				Content: types.InlineContent{
+					Elements: []types.InlineElement{
+						types.StringElement{Content: "A title"},
+					},
+				},
+			}
+			sectionATitle := types.SectionTitle{
+				Attributes: map[string]interface{}{
+					types.AttrID: "_section_a",
+				},
+				Content: types.InlineContent{
+					Elements: []types.InlineElement{
+						types.StringElement{Content: "Section A"},
+					},
+				},
+			}
+			sectionAaTitle := types.SectionTitle{
+				Attributes: map[string]interface{}{
+					types.AttrID: "_section_a_a",
+				},
+				Content: types.InlineContent{
+					Elements: []types.InlineElement{
+						types.StringElement{Content: "Section A.a"},
+					},
+				},
+			}
+			expectedResult := types.Document{
+				Attributes: map[string]interface{}{
+					"doctitle": doctitle,
+				},
+				ElementReferences: map[string]interface{}{
+					"_section_a":   sectionATitle,
+					"_section_a_a": sectionAaTitle,
+				},
+				Elements: []types.DocElement{
+					types.Section{
+						Level: 1,
+						Title: sectionATitle,
+						Elements: []types.DocElement{
+							types.Section{
+								Level:    2,
+								Title:    sectionAaTitle,
+								Elements: []types.DocElement{},
+							},
+						},
+					},
+				},
+			}
+			verify(GinkgoT(), expectedResult, actualContent)
+		})
+	})
+
+	Context("parts", func() {
+
+		It("parts with intro and chapters", func() {
+			actualContent := `= A book
+:doctype: book
+
+= Part 1
+
+an intro
+
+== Chapter 1
+
+= Part 2
+
+== Chapter 2`
+			doctitle := types.SectionTitle{
+				Attributes: map[string]interface{}{
+					types.AttrID: "_a_book",
+				},
+				Content: types.InlineContent{
+					Elements: []types.InlineElement{
+						types.StringElement{Content: "A book"},
+					},
+				},
+			}
+			part1Title := types.SectionTitle{
+				Attributes: map[string]interface{}{
+					types.AttrID: "_part_1",
+				},
+				Content: types.InlineContent{
+					Elements: []types.InlineElement{
+						types.StringElement{Content: "Part 1"},
+					},
+				},
+			}
+			chapter1Title := types.SectionTitle{
+				Attributes: map[string]interface{}{
+					types.AttrID: "_chapter_1",
+				},
+				Content: types.InlineContent{
+					Elements: []types.InlineElement{
+						types.StringElement{Content: "Chapter 1"},
+					},
+				},
+			}
+			part2Title := types.SectionTitle{
+				Attributes: map[string]interface{}{
+					types.AttrID: "_part_2",
+				},
+				Content: types.InlineContent{
+					Elements: []types.InlineElement{
+						types.StringElement{Content: "Part 2"},
+					},
+				},
+			}
+			chapter2Title := types.SectionTitle{
+				Attributes: map[string]interface{}{
+					types.AttrID: "_chapter_2",
+				},
+				Content: types.InlineContent{
+					Elements: []types.InlineElement{
+						types.StringElement{Content: "Chapter 2"},
+					},
+				},
+			}
+			expectedResult := types.Document{
+				Attributes: map[string]interface{}{
+					"doctitle": doctitle,
+					"doctype":  "book",
+				},
+				ElementReferences: map[string]interface{}{
+					"_part_1":    part1Title,
+					"_chapter_1": chapter1Title,
+					"_part_2":    part2Title,
+					"_chapter_2": chapter2Title,
+				},
+				Elements: []types.DocElement{
+					types.Section{
+						Level: 0,
+						Title: part1Title,
+						Elements: []types.DocElement{
+							types.Paragraph{
+								Attributes: map[string]interface{}{},
+								Lines: []types.InlineContent{
+									{
+										Elements: []types.InlineElement{
+											types.StringElement{Content: "an intro"},
+										},
+									},
+								},
+							},
+							types.Section{
+								Level:    1,
+								Title:    chapter1Title,
+								Elements: []types.DocElement{},
+							},
+						},
+					},
+					types.Section{
+						Level: 0,
+						Title: part2Title,
+						Elements: []types.DocElement{
+							types.Section{
+								Level:    1,
+								Title:    chapter2Title,
+								Elements: []types.DocElement{},
+							},
+						},
+					},
+				},
+			}
+			verify(GinkgoT(), expectedResult, actualContent)
+		})
+	})
 })
@@ -0,0 +1,98 @@
+package parser_test
+
+import (
+	"github.com/bytesparadise/libasciidoc/parser"
+	"github.com/bytesparadise/libasciidoc/types"
+	. "github.com/onsi/ginkgo"
+)
+
+var _ = Describe("STEM", func() {
+
+	Context("inline STEM expressions", func() {
+
+		It("stem macro", func() {
+			actualContent := "the answer is stem:[sqrt(4) = 2]."
+			expectedResult := types.InlineContent{
+				Elements: []types.InlineElement{
+					types.StringElement{Content: "the answer is "},
+					types.InlineStem{Kind: types.Stem, Content: "sqrt(4) = 2"},
+					types.StringElement{Content: "."},
+				},
+			}
+			verify(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("InlineContent"))
+		})
+
+		It("latexmath macro with quoted text characters", func() {
+			actualContent := `latexmath:[C = \alpha + \beta Y^{\gamma} + *\epsilon*]`
+			expectedResult := types.InlineContent{
+				Elements: []types.InlineElement{
+					types.InlineStem{Kind: types.LatexMath, Content: `C = \alpha + \beta Y^{\gamma} + *\epsilon*`},
+				},
+			}
+			verify(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("InlineContent"))
+		})
+
+		It("asciimath macro within parenthesis", func() {
+			actualContent := "(asciimath:[a^2^ + {b}])"
+			expectedResult := types.InlineContent{
+				Elements: []types.InlineElement{
+					types.StringElement{Content: "("},
+					types.InlineStem{Kind: types.AsciiMath, Content: "a^2^ + {b}"},
+					types.StringElement{Content: ")"},
+				},
+			}
+			verify(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("InlineContent"))
+		})
+
+		It("latexmath macro with escaped closing bracket", func() {
+			actualContent := `latexmath:[[a, b\]]`
+			expectedResult := types.InlineContent{
+				Elements: []types.InlineElement{
+					types.InlineStem{Kind: types.LatexMath, Content: "[a, b]"},
+				},
+			}
+			verify(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("InlineContent"))
+		})
+	})
+
+	Context("STEM blocks", func() {
+
+		It("stem block", func() {
+			actualContent := `[stem]
+++++
+sqrt(4) = 2
+++++`
+			expectedResult := types.DelimitedBlock{
+				Kind: types.StemBlock,
+				Attributes: map[string]interface{}{
+					types.AttrKind: types.Stem,
+				},
+				Elements: []types.DocElement{
+					types.StringElement{Content: "sqrt(4) = 2"},
+				},
+			}
+			verify(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("DelimitedBlock"))
+		})
+
+		It("latexmath block with ID and title", func() {
+			actualContent := `[#equation]
+.An equation
+[latexmath]
+++++
+C = \alpha + *\beta*
+++++`
+			expectedResult := types.DelimitedBlock{
+				Kind: types.StemBlock,
+				Attributes: map[string]interface{}{
+					types.AttrKind:  types.LatexMath,
+					types.AttrID:    "equation",
+					types.AttrTitle: "An equation",
+				},
+				Elements: []types.DocElement{
+					types.StringElement{Content: `C = \alpha + *\beta*`},
+				},
+			}
+			verify(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("DelimitedBlock"))
+		})
+	})
+})
@@ -0,0 +1,311 @@
+package parser
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+
+	"github.com/bytesparadise/libasciidoc/types"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+)
+
+// the key of the offset of the content being parsed in the document, in the global store of the parser
+// (ie, when the lines of a block are parsed with their own substitutions)
+const offsetKey = "offset"
+
+// the substitutions which require the content to be parsed. The other substitutions (special characters and
+// replacements) apply when the content is rendered.
+var parsedSubstitutions = []types.Substitution{
+	types.QuotesSubstitution,
+	types.AttributesSubstitution,
+	types.MacrosSubstitution,
+	types.PostReplacementsSubstitution,
+}
+
+// parseInlineContent parses the given content with the `InlineContentWithTrailingSpaces` entrypoint.
+// Since the grammar actions parse the content of the blocks with their own substitutions, the function is
+// initialized afterwards, to avoid an initialization cycle with the grammar.
+var parseInlineContent func(content []byte, opts ...Option) (interface{}, error)
+
+func init() {
+	parseInlineContent = func(content []byte, opts ...Option) (interface{}, error) {
+		return Parse("", content, append(opts, Entrypoint("InlineContentWithTrailingSpaces"))...)
+	}
+}
+
+// isSubstitutionEnabled returns true if the given substitution applies on the content being parsed.
+// All substitutions are enabled unless specified otherwise in the global store of the parser.
+func isSubstitutionEnabled(c *current, s types.Substitution) bool {
+	if enabled, ok := c.globalStore[string(s)].(bool); ok {
+		return enabled
+	}
+	return true
+}
+
+// hasSubstitutions returns true if the given element attributes contain a `subs` entry (eg: `[subs="quotes"]`)
+func hasSubstitutions(attributes interface{}) bool {
+	if attributes, ok := attributes.([]interface{}); ok {
+		_, found := types.NewElementAttributes(attributes)[types.AttrSubstitutions]
+		return found
+	}
+	return false
+}
+
+// offset returns the offset of the current position in the document
+func offset(c *current) int {
+	if base, ok := c.globalStore[offsetKey].(int); ok {
+		return base + c.pos.offset
+	}
+	return c.pos.offset
+}
+
+// substituteParagraph parses the lines of the given paragraph, which were retained as-is by the grammar because
+// the paragraph has its own substitutions
+func substituteParagraph(c *current, p types.Paragraph) (types.Paragraph, error) {
+	lines := make([]string, len(p.Lines))
+	for i, line := range p.Lines {
+		lines[i] = rawContent(line)
+	}
+	result, _, err := substituteLines(c, lines, p.Substitutions(), 0)
+	if err != nil {
+		return types.Paragraph{}, errors.Wrapf(err, "unable to apply the substitutions on the paragraph")
+	}
+	p.Lines = result
+	return p, nil
+}
+
+// substituteDelimitedBlock parses the raw content of the given listing, fenced or passthrough block if its
+// substitutions include the quotes, attributes, macros or post replacements. The callouts are retained as-is.
+func substituteDelimitedBlock(c *current, b types.DelimitedBlock) (types.DelimitedBlock, error) {
+	subs := b.Substitutions()
+	if !requiresParsing(subs) {
+		return b, nil
+	}
+	elements := make([]types.DocElement, 0, len(b.Elements))
+	position := 0
+	for _, element := range b.Elements {
+		s, ok := element.(types.StringElement)
+		if !ok {
+			elements = append(elements, element)
+			continue
+		}
+		var lines []types.InlineContent
+		var err error
+		lines, position, err = substituteLines(c, strings.Split(s.Content, "\n"), subs, position)
+		if err != nil {
+			return types.DelimitedBlock{}, errors.Wrapf(err, "unable to apply the substitutions on the delimited block")
+		}
+		content := types.InlineContent{
+			Elements: []types.InlineElement{},
+		}
+		for i, line := range lines {
+			if i > 0 {
+				content.Elements = append(content.Elements, types.StringElement{Content: "\n"})
+			}
+			content.Elements = append(content.Elements, line.Elements...)
+		}
+		elements = append(elements, content)
+	}
+	b.Elements = elements
+	return b, nil
+}
+
+// substituteLiteralBlock parses the lines of the given literal block if it has its own substitutions
+func substituteLiteralBlock(c *current, b types.LiteralBlock) (types.LiteralBlock, error) {
+	if _, found := b.Attributes[types.AttrSubstitutions]; !found {
+		return b, nil
+	}
+	lines, _, err := substituteLines(c, strings.Split(b.Content, "\n"), b.Substitutions(), 0)
+	if err != nil {
+		return types.LiteralBlock{}, errors.Wrapf(err, "unable to apply the substitutions on the literal block")
+	}
+	b.Lines = lines
+	return b, nil
+}
+
+// substituteLines parses the given lines, in which only the given substitutions apply. The lines are located in the
+// text matched by the current rule, starting at the given position, so that the elements which are identified by their
+// offset in the document (eg: the footnotes) are not mixed up. Returns the parsed lines along with the position
+// following the last line.
+func substituteLines(c *current, lines []string, subs []types.Substitution, position int) ([]types.InlineContent, int, error) {
+	result := make([]types.InlineContent, len(lines))
+	text := string(c.text)
+	for i, line := range lines {
+		if index := strings.Index(text[position:], line); index >= 0 {
+			position += index
+		}
+		content, err := parseWithSubstitutions(c, line, offset(c)+position, subs)
+		if err != nil {
+			return nil, position, err
+		}
+		result[i] = content
+		position += len(line)
+	}
+	return result, position, nil
+}
+
+// parseWithSubstitutions parses the given line, located at the given offset in the document, in which only the given
+// substitutions apply (eg: `[subs="quotes"]` on a listing block: the quoted text is parsed, but not the macros nor the
+// attribute substitutions). Returns a single string element if the line contains nothing to substitute.
+// The substitutions apply in the given order: the substitutions which precede the `attributes` substitution apply on the
+// line, then the document attributes are replaced with their value, then the following substitutions apply on the line
+// and on the values of the attributes. For example, with `subs="attributes,quotes"`, the quoted text in the value of an
+// attribute is parsed, whereas it is retained as-is with `subs="quotes,attributes"`.
+func parseWithSubstitutions(c *current, line string, lineOffset int, subs []types.Substitution) (types.InlineContent, error) {
+	i := indexOfSubstitution(subs, types.AttributesSubstitution)
+	if i < 0 {
+		return parseInline(c, line, lineOffset, subs)
+	}
+	before, after := subs[:i], subs[i+1:]
+	content, err := parseInline(c, line, lineOffset, before)
+	if err != nil {
+		return types.InlineContent{}, err
+	}
+	// the value of the attributes is not escaped if the special characters substitution was already applied
+	raw := types.HasSubstitution(before, types.SpecialCharactersSubstitution)
+	elements, err := substituteStrings(content.Elements, func(s types.StringElement) ([]types.InlineElement, error) {
+		return substituteAttributes(c, s.Content, raw)
+	})
+	if err != nil {
+		return types.InlineContent{}, err
+	}
+	if requiresParsing(after) {
+		elements, err = substituteStrings(elements, func(s types.StringElement) ([]types.InlineElement, error) {
+			segmentOffset := lineOffset
+			if index := strings.Index(line, s.Content); index >= 0 {
+				segmentOffset += index
+			}
+			result, err := parseInline(c, s.Content, segmentOffset, after)
+			if err != nil {
+				return nil, err
+			}
+			return result.Elements, nil
+		})
+		if err != nil {
+			return types.InlineContent{}, err
+		}
+	}
+	return newInlineContent(elements)
+}
+
+// parseInline parses the given content, located at the given offset in the document, in which only the given
+// substitutions apply. Returns a single string element if the content contains nothing to substitute.
+func parseInline(c *current, content string, contentOffset int, subs []types.Substitution) (types.InlineContent, error) {
+	raw := types.InlineContent{
+		Elements: []types.InlineElement{
+			types.StringElement{Content: content},
+		},
+	}
+	if !requiresParsing(subs) {
+		return raw, nil
+	}
+	opts := []Option{GlobalStore(offsetKey, contentOffset)}
+	if attributes, found := c.globalStore[documentAttributesKey]; found {
+		opts = append(opts, GlobalStore(documentAttributesKey, attributes))
+	}
+	for _, s := range parsedSubstitutions {
+		opts = append(opts, GlobalStore(string(s), types.HasSubstitution(subs, s)))
+	}
+	result, err := parseInlineContent([]byte(content), opts...)
+	if err != nil {
+		// eg: the content is empty or only contains spaces
+		log.Debugf("unable to parse '%s' with substitutions %v: %v", content, subs, err)
+		return raw, nil
+	}
+	inlineContent, ok := result.(types.InlineContent)
+	if !ok {
+		return types.InlineContent{}, errors.Errorf("unexpected type of content: %T", result)
+	}
+	return inlineContent, nil
+}
+
+// substituteAttributes replaces the document attributes in the given content with their value, as a string element which
+// is subject to the following substitutions, or as-is if the value must not be escaped. The attributes which are not defined
+// are retained.
+func substituteAttributes(c *current, content string, raw bool) ([]types.InlineElement, error) {
+	parsed, err := parseInline(c, content, offset(c), []types.Substitution{types.AttributesSubstitution})
+	if err != nil {
+		return nil, err
+	}
+	result := make([]types.InlineElement, len(parsed.Elements))
+	for i, element := range parsed.Elements {
+		result[i] = element
+		if attr, ok := element.(types.DocumentAttributeSubstitution); ok && !raw {
+			if value, found := documentAttribute(c, attr.Name); found {
+				result[i] = types.StringElement{Content: fmt.Sprintf("%v", value)}
+			}
+		}
+	}
+	return result, nil
+}
+
+// substituteStrings replaces the string elements of the given elements (including in the quoted texts) with the
+// result of the given substitution
+func substituteStrings(elements []types.InlineElement, substitute func(types.StringElement) ([]types.InlineElement, error)) ([]types.InlineElement, error) {
+	result := make([]types.InlineElement, 0, len(elements))
+	for _, element := range elements {
+		switch e := element.(type) {
+		case types.StringElement:
+			substituted, err := substitute(e)
+			if err != nil {
+				return nil, err
+			}
+			result = append(result, substituted...)
+		case types.QuotedText:
+			substituted, err := substituteStrings(e.Elements, substitute)
+			if err != nil {
+				return nil, err
+			}
+			content, err := newInlineContent(substituted)
+			if err != nil {
+				return nil, err
+			}
+			e.Elements = content.Elements
+			result = append(result, e)
+		default:
+			result = append(result, element)
+		}
+	}
+	return result, nil
+}
+
+// newInlineContent returns a new inline content with the given elements, in which the consecutive string elements are merged
+func newInlineContent(elements []types.InlineElement) (types.InlineContent, error) {
+	content := make([]interface{}, len(elements))
+	for i, element := range elements {
+		content[i] = element
+	}
+	return types.NewInlineContent(content)
+}
+
+// indexOfSubstitution returns the index of the given substitution in the given substitutions, or -1 if it is not found
+func indexOfSubstitution(subs []types.Substitution, s types.Substitution) int {
+	for i, sub := range subs {
+		if sub == s {
+			return i
+		}
+	}
+	return -1
+}
+
+// requiresParsing returns true if the given substitutions include the quotes, attributes, macros or post replacements
+func requiresParsing(subs []types.Substitution) bool {
+	for _, s := range parsedSubstitutions {
+		if types.HasSubstitution(subs, s) {
+			return true
+		}
+	}
+	return false
+}
+
+// rawContent returns the content of the string elements of the given line
+func rawContent(line types.InlineContent) string {
+	result := bytes.NewBuffer(nil)
+	for _, element := range line.Elements {
+		if s, ok := element.(types.StringElement); ok {
+			result.WriteString(s.Content)
+		}
+	}
+	return result.String()
+}
@@ -0,0 +1,248 @@
+package parser_test
+
+import (
+	"github.com/bytesparadise/libasciidoc/parser"
+	"github.com/bytesparadise/libasciidoc/types"
+	. "github.com/onsi/ginkgo"
+)
+
+var _ = Describe("tables", func() {
+
+	It("1-line table with 2 cells", func() {
+		actualContent := `|===
+| *cookie* cake | pasta
+|===`
+		expectedResult := types.Table{
+			Attributes: map[string]interface{}{},
+			Columns: []types.TableColumn{
+				{Weight: 1, HAlign: types.HAlignLeft, VAlign: types.VAlignTop},
+				{Weight: 1, HAlign: types.HAlignLeft, VAlign: types.VAlignTop},
+			},
+			Rows: []types.TableRow{
+				{
+					Cells: []types.TableCell{
+						{
+							Elements: []types.InlineElement{
+								types.QuotedText{
+									Kind: types.Bold,
+									Elements: []types.InlineElement{
+										types.StringElement{Content: "cookie"},
+									},
+								},
+								types.StringElement{Content: " cake"},
+							},
+						},
+						{
+							Elements: []types.InlineElement{
+								types.StringElement{Content: "pasta"},
+							},
+						},
+					},
+				},
+			},
+		}
+		verify(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("Table"))
+	})
+
+	It("table with implicit header and cells on multiple lines", func() {
+		actualContent := `.table title
+|===
+|Column 1 |Column 2
+
+|a
+|b
+|c|
+|===`
+		expectedResult := types.Table{
+			Attributes: map[string]interface{}{
+				types.AttrTitle: "table title",
+			},
+			Columns: []types.TableColumn{
+				{Weight: 1, HAlign: types.HAlignLeft, VAlign: types.VAlignTop},
+				{Weight: 1, HAlign: types.HAlignLeft, VAlign: types.VAlignTop},
+			},
+			Header: &types.TableRow{
+				Cells: []types.TableCell{
+					{
+						Elements: []types.InlineElement{
+							types.StringElement{Content: "Column 1"},
+						},
+					},
+					{
+						Elements: []types.InlineElement{
+							types.StringElement{Content: "Column 2"},
+						},
+					},
+				},
+			},
+			Rows: []types.TableRow{
+				{
+					Cells: []types.TableCell{
+						{
+							Elements: []types.InlineElement{
+								types.StringElement{Content: "a"},
+							},
+						},
+						{
+							Elements: []types.InlineElement{
+								types.StringElement{Content: "b"},
+							},
+						},
+					},
+				},
+				{
+					Cells: []types.TableCell{
+						{
+							Elements: []types.InlineElement{
+								types.StringElement{Content: "c"},
+							},
+						},
+						{
+							Elements: []types.InlineElement{},
+						},
+					},
+				},
+			},
+		}
+		verify(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("Table"))
+	})
+
+	It("table with cols and options attributes", func() {
+		actualContent := `[cols="2*^.>2,<1", options="header,footer"]
+|===
+|a |b |c
+|d |e |f
+|g |h |i
+|===`
+		expectedResult := types.Table{
+			Attributes: map[string]interface{}{
+				types.AttrCols:    "2*^.>2,<1",
+				types.AttrOptions: "header,footer",
+			},
+			Columns: []types.TableColumn{
+				{Weight: 2, HAlign: types.HAlignCenter, VAlign: types.VAlignBottom},
+				{Weight: 2, HAlign: types.HAlignCenter, VAlign: types.VAlignBottom},
+				{Weight: 1, HAlign: types.HAlignLeft, VAlign: types.VAlignTop},
+			},
+			Header: &types.TableRow{
+				Cells: []types.TableCell{
+					{Elements: []types.InlineElement{types.StringElement{Content: "a"}}},
+					{Elements: []types.InlineElement{types.StringElement{Content: "b"}}},
+					{Elements: []types.InlineElement{types.StringElement{Content: "c"}}},
+				},
+			},
+			Rows: []types.TableRow{
+				{
+					Cells: []types.TableCell{
+						{Elements: []types.InlineElement{types.StringElement{Content: "d"}}},
+						{Elements: []types.InlineElement{types.StringElement{Content: "e"}}},
+						{Elements: []types.InlineElement{types.StringElement{Content: "f"}}},
+					},
+				},
+			},
+			Footer: &types.TableRow{
+				Cells: []types.TableCell{
+					{Elements: []types.InlineElement{types.StringElement{Content: "g"}}},
+					{Elements: []types.InlineElement{types.StringElement{Content: "h"}}},
+					{Elements: []types.InlineElement{types.StringElement{Content: "i"}}},
+				},
+			},
+		}
+		verify(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("Table"))
+	})
+
+	It("table with number of columns and noheader option", func() {
+		actualContent := `[cols="2", options="noheader"]
+|===
+|a |b
+
+|c |d
+|===`
+		expectedResult := types.Table{
+			Attributes: map[string]interface{}{
+				types.AttrCols:    "2",
+				types.AttrOptions: "noheader",
+			},
+			Columns: []types.TableColumn{
+				{Weight: 1, HAlign: types.HAlignLeft, VAlign: types.VAlignTop},
+				{Weight: 1, HAlign: types.HAlignLeft, VAlign: types.VAlignTop},
+			},
+			Rows: []types.TableRow{
+				{
+					Cells: []types.TableCell{
+						{Elements: []types.InlineElement{types.StringElement{Content: "a"}}},
+						{Elements: []types.InlineElement{types.StringElement{Content: "b"}}},
+					},
+				},
+				{
+					Cells: []types.TableCell{
+						{Elements: []types.InlineElement{types.StringElement{Content: "c"}}},
+						{Elements: []types.InlineElement{types.StringElement{Content: "d"}}},
+					},
+				},
+			},
+		}
+		verify(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("Table"))
+	})
+
+	It("table with invalid number of columns", func() {
+		actualContent := `[cols="-1"]
+|===
+|a |b
+|===`
+		expectedResult := types.Table{
+			Attributes: map[string]interface{}{
+				types.AttrCols: "-1",
+			},
+			Columns: []types.TableColumn{
+				{Weight: 1, HAlign: types.HAlignLeft, VAlign: types.VAlignTop},
+				{Weight: 1, HAlign: types.HAlignLeft, VAlign: types.VAlignTop},
+			},
+			Rows: []types.TableRow{
+				{
+					Cells: []types.TableCell{
+						{Elements: []types.InlineElement{types.StringElement{Content: "a"}}},
+						{Elements: []types.InlineElement{types.StringElement{Content: "b"}}},
+					},
+				},
+			},
+		}
+		verify(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("Table"))
+	})
+
+	It("empty table", func() {
+		actualContent := `|===
+|===`
+		expectedResult := types.Table{
+			Attributes: map[string]interface{}{},
+			Columns:    []types.TableColumn{},
+			Rows:       []types.TableRow{},
+		}
+		verify(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("Table"))
+	})
+
+	It("invalid column specification", func() {
+		actualContent := `[cols="1,foo"]
+|===
+|a |b
+|===`
+		expectedResult := types.Table{
+			Attributes: map[string]interface{}{
+				types.AttrCols: "1,foo",
+			},
+			Columns: []types.TableColumn{
+				{Weight: 1, HAlign: types.HAlignLeft, VAlign: types.VAlignTop},
+				{Weight: 1, HAlign: types.HAlignLeft, VAlign: types.VAlignTop},
+			},
+			Rows: []types.TableRow{
+				{
+					Cells: []types.TableCell{
+						{Elements: []types.InlineElement{types.StringElement{Content: "a"}}},
+						{Elements: []types.InlineElement{types.StringElement{Content: "b"}}},
+					},
+				},
+			},
+		}
+		verify(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("Table"))
+	})
+})
@@ -0,0 +1,39 @@
+package parser
+
+import (
+	"github.com/bytesparadise/libasciidoc/types"
+	log "github.com/sirupsen/logrus"
+)
+
+// the key of the positions of the tables which were already reported with an incomplete row, in the global store of the parser
+const incompleteTableRowKey = "incompleteTableRow"
+
+// warnIncompleteTableRow logs a warning if the cells of the given header and lines do not fill the last row of the given table,
+// in which case the remaining cells were dropped. Since the table can be parsed several times, the warning is logged only once.
+func warnIncompleteTableRow(c *current, header interface{}, lines []interface{}, table types.Table) {
+	if len(table.Columns) == 0 {
+		return
+	}
+	cells := 0
+	if header, ok := header.(types.TableRow); ok {
+		cells += len(header.Cells)
+	}
+	for _, line := range lines {
+		if line, ok := line.(types.TableRow); ok {
+			cells += len(line.Cells)
+		}
+	}
+	remainder := cells % len(table.Columns)
+	if remainder == 0 {
+		return
+	}
+	reported, ok := c.globalStore[incompleteTableRowKey].(map[int]bool)
+	if !ok {
+		reported = map[int]bool{}
+		c.globalStore[incompleteTableRowKey] = reported
+	}
+	if !reported[offset(c)] {
+		reported[offset(c)] = true
+		log.Warnf("dropping %d cell(s) from incomplete row in table at line %d", remainder, c.pos.line)
+	}
+}
@@ -0,0 +1,118 @@
+package parser_test
+
+import (
+	"github.com/bytesparadise/libasciidoc/parser"
+	"github.com/bytesparadise/libasciidoc/types"
+	. "github.com/onsi/ginkgo"
+)
+
+var _ = Describe("UI Macros", func() {
+
+	experimental := parser.DocumentAttributes(types.DocumentAttributes{"experimental": ""})
+
+	Context("keyboard macros", func() {
+
+		It("single key", func() {
+			actualContent := "kbd:[F11]"
+			expectedResult := types.InlineContent{
+				Elements: []types.InlineElement{
+					types.KeyboardShortcut{Keys: []string{"F11"}},
+				},
+			}
+			verify(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("InlineContent"), experimental)
+		})
+
+		It("keys separated by plus signs", func() {
+			actualContent := "press kbd:[Ctrl + Shift+T] to reopen the tab"
+			expectedResult := types.InlineContent{
+				Elements: []types.InlineElement{
+					types.StringElement{Content: "press "},
+					types.KeyboardShortcut{Keys: []string{"Ctrl", "Shift", "T"}},
+					types.StringElement{Content: " to reopen the tab"},
+				},
+			}
+			verify(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("InlineContent"), experimental)
+		})
+
+		It("keys separated by commas", func() {
+			actualContent := "kbd:[Ctrl,+]"
+			expectedResult := types.InlineContent{
+				Elements: []types.InlineElement{
+					types.KeyboardShortcut{Keys: []string{"Ctrl", "+"}},
+				},
+			}
+			verify(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("InlineContent"), experimental)
+		})
+
+		It("plus sign as the last key", func() {
+			actualContent := "kbd:[Ctrl++]"
+			expectedResult := types.InlineContent{
+				Elements: []types.InlineElement{
+					types.KeyboardShortcut{Keys: []string{"Ctrl", "+"}},
+				},
+			}
+			verify(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("InlineContent"), experimental)
+		})
+	})
+
+	Context("button macros", func() {
+
+		It("button", func() {
+			actualContent := "click btn:[Save As] to continue"
+			expectedResult := types.InlineContent{
+				Elements: []types.InlineElement{
+					types.StringElement{Content: "click "},
+					types.Button{Label: "Save As"},
+					types.StringElement{Content: " to continue"},
+				},
+			}
+			verify(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("InlineContent"), experimental)
+		})
+	})
+
+	Context("menu macros", func() {
+
+		It("menu only", func() {
+			actualContent := "menu:File[]"
+			expectedResult := types.InlineContent{
+				Elements: []types.InlineElement{
+					types.MenuSelection{Menu: "File", Items: []string{}},
+				},
+			}
+			verify(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("InlineContent"), experimental)
+		})
+
+		It("menu with item", func() {
+			actualContent := "menu:File[Save As]"
+			expectedResult := types.InlineContent{
+				Elements: []types.InlineElement{
+					types.MenuSelection{Menu: "File", Items: []string{"Save As"}},
+				},
+			}
+			verify(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("InlineContent"), experimental)
+		})
+
+		It("menu with submenu and item", func() {
+			actualContent := "menu:View[Zoom > Reset]"
+			expectedResult := types.InlineContent{
+				Elements: []types.InlineElement{
+					types.MenuSelection{Menu: "View", Items: []string{"Zoom", "Reset"}},
+				},
+			}
+			verify(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("InlineContent"), experimental)
+		})
+	})
+
+	Context("without the experimental attribute", func() {
+
+		It("keyboard macro as text", func() {
+			actualContent := "kbd:[Ctrl , T]"
+			expectedResult := types.InlineContent{
+				Elements: []types.InlineElement{
+					types.StringElement{Content: "kbd:[Ctrl , T]"},
+				},
+			}
+			verify(GinkgoT(), expectedResult, actualContent, parser.Entrypoint("InlineContent"))
+		})
+	})
+})
@@ -13,6 +13,8 @@ type Context struct {
 	context  context.Context
 	Document types.Document
 	options  map[string]interface{}
+	// the substitutions which apply on the content being rendered (the normal substitutions if not set)
+	substitutions []types.Substitution
 }
 
 // Wrap wraps the given `ctx` context into a new context which will contain the given `document` document.
@@ -28,6 +30,22 @@ func Wrap(ctx context.Context, document types.Document, options ...Option) *Cont
 	return result
 }
 
+// Substitutions returns the substitutions which apply on the content being rendered
+func (ctx *Context) Substitutions() []types.Substitution {
+	if ctx.substitutions == nil {
+		return types.NormalSubstitutions
+	}
+	return ctx.substitutions
+}
+
+// SetSubstitutions sets the substitutions which apply on the content being rendered (eg: the content of a listing block),
+// and returns the previous substitutions, so they can be restored afterwards
+func (ctx *Context) SetSubstitutions(substitutions []types.Substitution) []types.Substitution {
+	previous := ctx.substitutions
+	ctx.substitutions = substitutions
+	return previous
+}
+
 // Deadline wrapper implementation of context.Context.Deadline()
 func (ctx *Context) Deadline() (deadline time.Time, ok bool) {
 	return ctx.context.Deadline()
@@ -0,0 +1,15 @@
+package highlight_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"testing"
+
+	_ "github.com/bytesparadise/libasciidoc/log"
+)
+
+func TestHighlight(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Highlight Suite")
+}
@@ -0,0 +1,210 @@
+package highlight
+
+import (
+	"bytes"
+	"html"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Highlighter the interface for the syntax highlighters of source blocks
+type Highlighter interface {
+	// Highlight returns the HTML content of the given source code in the given language,
+	// or the escaped source code if the language is not supported
+	Highlight(source, language string) (string, error)
+}
+
+// Mode the way the highlighted tokens are styled
+type Mode int
+
+const (
+	// ClassMode the tokens are wrapped in `<span>` elements with a CSS class (eg: `<span class="k">func</span>`)
+	ClassMode Mode = iota
+	// StyleMode the tokens are wrapped in `<span>` elements with an inline style (eg: `<span style="color:#008000;font-weight:bold">func</span>`)
+	StyleMode
+)
+
+// TokenType the type of a token in the source code
+type TokenType string
+
+const (
+	// Text plain text, which is not styled
+	Text TokenType = ""
+	// Keyword a language keyword (eg: `func`, `return`)
+	Keyword TokenType = "k"
+	// KeywordType a built-in type (eg: `int`, `string`)
+	KeywordType TokenType = "kt"
+	// NameBuiltin a built-in function or constant (eg: `len`, `nil`)
+	NameBuiltin TokenType = "nb"
+	// String a string or character literal
+	String TokenType = "s"
+	// Number a numeric literal
+	Number TokenType = "m"
+	// Comment a single line or multiline comment
+	Comment TokenType = "c"
+	// Operator an operator (eg: `+`, `:=`)
+	Operator TokenType = "o"
+)
+
+// styles the inline styles of the tokens when the highlighter is in the `StyleMode` mode
+var styles = map[TokenType]string{
+	Keyword:     "color:#008000;font-weight:bold",
+	KeywordType: "color:#b00040",
+	NameBuiltin: "color:#008000",
+	String:      "color:#ba2121",
+	Number:      "color:#666666",
+	Comment:     "color:#408080;font-style:italic",
+	Operator:    "color:#666666",
+}
+
+// Token a token in the source code
+type Token struct {
+	Type  TokenType
+	Value string
+}
+
+// BuiltinHighlighterName the value of the `source-highlighter` document attribute to use the built-in highlighter
+const BuiltinHighlighterName = "builtin"
+
+// BuiltinHighlighter the built-in syntax highlighter, which supports a limited set of languages
+type BuiltinHighlighter struct {
+	mode Mode
+}
+
+// NewBuiltinHighlighter returns a new built-in syntax highlighter which styles the tokens with the given mode
+func NewBuiltinHighlighter(mode Mode) BuiltinHighlighter {
+	return BuiltinHighlighter{
+		mode: mode,
+	}
+}
+
+// Highlight implements Highlighter#Highlight(string, string)
+func (h BuiltinHighlighter) Highlight(source, language string) (string, error) {
+	result := bytes.NewBuffer(nil)
+	for _, t := range Tokenize(source, language) {
+		if t.Type == Text {
+			result.WriteString(html.EscapeString(t.Value))
+			continue
+		}
+		// tokens spanning multiple lines are split, so that each line remains well-formed
+		for i, line := range strings.Split(t.Value, "\n") {
+			if i > 0 {
+				result.WriteString("\n")
+			}
+			if line == "" {
+				continue
+			}
+			switch h.mode {
+			case StyleMode:
+				result.WriteString(`<span style="` + styles[t.Type] + `">`)
+			default:
+				result.WriteString(`<span class="` + string(t.Type) + `">`)
+			}
+			result.WriteString(html.EscapeString(line))
+			result.WriteString("</span>")
+		}
+	}
+	return result.String(), nil
+}
+
+// Tokenize splits the given source code into tokens, using the lexical rules of the given language.
+// The whole source code is returned in a single `Text` token if the language is not supported.
+func Tokenize(source, language string) []Token {
+	l, found := lookupLanguage(language)
+	if !found {
+		return []Token{{Type: Text, Value: source}}
+	}
+	tokens := make([]Token, 0)
+	text := bytes.NewBuffer(nil)
+	// appends the given token, after flushing the pending text
+	appendToken := func(t Token) {
+		if text.Len() > 0 {
+			tokens = append(tokens, Token{Type: Text, Value: text.String()})
+			text.Reset()
+		}
+		tokens = append(tokens, t)
+	}
+	for i := 0; i < len(source); {
+		rest := source[i:]
+		if c, found := l.matchComment(rest); found {
+			appendToken(Token{Type: Comment, Value: c})
+			i += len(c)
+			continue
+		}
+		r, size := utf8.DecodeRuneInString(rest)
+		switch {
+		case strings.ContainsRune(l.stringDelimiters, r):
+			s := scanString(rest, r)
+			appendToken(Token{Type: String, Value: s})
+			i += len(s)
+		case unicode.IsDigit(r):
+			n := scanWhile(rest, isNumberRune)
+			appendToken(Token{Type: Number, Value: n})
+			i += len(n)
+		case r == '_' || unicode.IsLetter(r):
+			w := scanWhile(rest, l.isIdentifierRune)
+			switch {
+			case l.keywords[w]:
+				appendToken(Token{Type: Keyword, Value: w})
+			case l.types[w]:
+				appendToken(Token{Type: KeywordType, Value: w})
+			case l.builtins[w]:
+				appendToken(Token{Type: NameBuiltin, Value: w})
+			default:
+				text.WriteString(w)
+			}
+			i += len(w)
+		case strings.ContainsRune(operators, r):
+			o := scanWhile(rest, func(r rune) bool { return strings.ContainsRune(operators, r) })
+			appendToken(Token{Type: Operator, Value: o})
+			i += len(o)
+		default:
+			text.WriteRune(r)
+			i += size
+		}
+	}
+	if text.Len() > 0 {
+		tokens = append(tokens, Token{Type: Text, Value: text.String()})
+	}
+	return tokens
+}
+
+const operators = "+-*/%=&|<>!^~?:"
+
+// scanString returns the string literal at the beginning of the given source, including its delimiters.
+// Backslash-escaped delimiters are skipped. Returns the rest of the source if the string is not terminated.
+func scanString(source string, delimiter rune) string {
+	escaped := false
+	for i, r := range source {
+		if i == 0 {
+			continue
+		}
+		switch {
+		case escaped:
+			escaped = false
+		case r == '\\':
+			escaped = true
+		case r == delimiter:
+			return source[:i+utf8.RuneLen(r)]
+		case r == '\n' && delimiter != '`':
+			// only raw strings can span multiple lines
+			return source[:i]
+		}
+	}
+	return source
+}
+
+// scanWhile returns the longest prefix of the given source whose runes all satisfy the given predicate
+func scanWhile(source string, predicate func(rune) bool) string {
+	for i, r := range source {
+		if !predicate(r) {
+			return source[:i]
+		}
+	}
+	return source
+}
+
+func isNumberRune(r rune) bool {
+	return unicode.IsDigit(r) || unicode.IsLetter(r) || r == '.' || r == '_'
+}
@@ -0,0 +1,72 @@
+package highlight_test
+
+import (
+	"github.com/bytesparadise/libasciidoc/renderer/highlight"
+	. "github.com/onsi/ginkgo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var _ = Describe("built-in highlighter", func() {
+
+	Context("tokenizer", func() {
+
+		It("go source code", func() {
+			source := "/* a\ncomment */\nvar s string = `raw\nstring` // end"
+			expectedResult := []highlight.Token{
+				{Type: highlight.Comment, Value: "/* a\ncomment */"},
+				{Type: highlight.Text, Value: "\n"},
+				{Type: highlight.Keyword, Value: "var"},
+				{Type: highlight.Text, Value: " s "},
+				{Type: highlight.KeywordType, Value: "string"},
+				{Type: highlight.Text, Value: " "},
+				{Type: highlight.Operator, Value: "="},
+				{Type: highlight.Text, Value: " "},
+				{Type: highlight.String, Value: "`raw\nstring`"},
+				{Type: highlight.Text, Value: " "},
+				{Type: highlight.Comment, Value: "// end"},
+			}
+			assert.Equal(GinkgoT(), expectedResult, highlight.Tokenize(source, "go"))
+		})
+
+		It("shell source code with escaped quote", func() {
+			source := `echo "say \"hi\"" # greet`
+			expectedResult := []highlight.Token{
+				{Type: highlight.NameBuiltin, Value: "echo"},
+				{Type: highlight.Text, Value: " "},
+				{Type: highlight.String, Value: `"say \"hi\""`},
+				{Type: highlight.Text, Value: " "},
+				{Type: highlight.Comment, Value: "# greet"},
+			}
+			assert.Equal(GinkgoT(), expectedResult, highlight.Tokenize(source, "bash"))
+		})
+
+		It("unsupported language", func() {
+			source := "some <code>"
+			expectedResult := []highlight.Token{
+				{Type: highlight.Text, Value: "some <code>"},
+			}
+			assert.Equal(GinkgoT(), expectedResult, highlight.Tokenize(source, "cobol"))
+		})
+	})
+
+	Context("highlighter", func() {
+
+		It("multiline tokens with CSS classes", func() {
+			source := "/* a\ncomment */ x < 1"
+			expectedResult := `<span class="c">/* a</span>
+<span class="c">comment */</span> x <span class="o">&lt;</span> <span class="m">1</span>`
+			result, err := highlight.NewBuiltinHighlighter(highlight.ClassMode).Highlight(source, "c")
+			require.NoError(GinkgoT(), err)
+			assert.Equal(GinkgoT(), expectedResult, result)
+		})
+
+		It("tokens with inline styles", func() {
+			source := `{"a": true}`
+			expectedResult := `{<span style="color:#ba2121">&#34;a&#34;</span><span style="color:#666666">:</span> <span style="color:#008000">true</span>}`
+			result, err := highlight.NewBuiltinHighlighter(highlight.StyleMode).Highlight(source, "json")
+			require.NoError(GinkgoT(), err)
+			assert.Equal(GinkgoT(), expectedResult, result)
+		})
+	})
+})
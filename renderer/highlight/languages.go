@@ -0,0 +1,143 @@
+package highlight
+
+import (
+	"strings"
+	"unicode"
+)
+
+// language the lexical rules of a language supported by the built-in highlighter
+type language struct {
+	// the prefixes of the single line comments (eg: `//`)
+	lineComments []string
+	// the start and end delimiters of the multiline comments (eg: `/*` and `*/`)
+	blockComments [][2]string
+	// the characters that delimit the string literals
+	stringDelimiters string
+	// extra characters allowed in identifiers, in addition to letters, digits and `_`
+	identifierRunes string
+	keywords        map[string]bool
+	types           map[string]bool
+	builtins        map[string]bool
+}
+
+// matchComment returns the comment at the beginning of the given source, if any
+func (l language) matchComment(source string) (string, bool) {
+	for _, c := range l.blockComments {
+		if strings.HasPrefix(source, c[0]) {
+			if end := strings.Index(source[len(c[0]):], c[1]); end != -1 {
+				return source[:len(c[0])+end+len(c[1])], true
+			}
+			// unterminated comment
+			return source, true
+		}
+	}
+	for _, c := range l.lineComments {
+		if strings.HasPrefix(source, c) {
+			if end := strings.Index(source, "\n"); end != -1 {
+				return source[:end], true
+			}
+			return source, true
+		}
+	}
+	return "", false
+}
+
+func (l language) isIdentifierRune(r rune) bool {
+	return r == '_' || unicode.IsLetter(r) || unicode.IsDigit(r) || strings.ContainsRune(l.identifierRunes, r)
+}
+
+func words(s string) map[string]bool {
+	result := make(map[string]bool)
+	for _, w := range strings.Fields(s) {
+		result[w] = true
+	}
+	return result
+}
+
+var golang = language{
+	lineComments:     []string{"//"},
+	blockComments:    [][2]string{{"/*", "*/"}},
+	stringDelimiters: "\"'`",
+	keywords: words(`break case chan const continue default defer else fallthrough for func go goto if import
+		interface map package range return select struct switch type var`),
+	types: words(`bool byte complex64 complex128 error float32 float64 int int8 int16 int32 int64 rune string
+		uint uint8 uint16 uint32 uint64 uintptr`),
+	builtins: words(`append cap close complex copy delete imag len make new panic print println real recover
+		true false iota nil`),
+}
+
+var java = language{
+	lineComments:     []string{"//"},
+	blockComments:    [][2]string{{"/*", "*/"}},
+	stringDelimiters: "\"'",
+	keywords: words(`abstract assert break case catch class const continue default do else enum extends final
+		finally for goto if implements import instanceof interface native new package private protected public
+		return static strictfp super switch synchronized this throw throws transient try volatile while var`),
+	types:    words(`boolean byte char double float int long short void String Object`),
+	builtins: words(`true false null`),
+}
+
+var javascript = language{
+	lineComments:     []string{"//"},
+	blockComments:    [][2]string{{"/*", "*/"}},
+	stringDelimiters: "\"'`",
+	identifierRunes:  "$",
+	keywords: words(`async await break case catch class const continue debugger default delete do else export
+		extends finally for function if import in instanceof let new of return static super switch this throw try
+		typeof var void while with yield`),
+	builtins: words(`true false null undefined NaN Infinity console window document`),
+}
+
+var python = language{
+	lineComments:     []string{"#"},
+	stringDelimiters: "\"'",
+	keywords: words(`and as assert async await break class continue def del elif else except finally for from
+		global if import in is lambda nonlocal not or pass raise return try while with yield`),
+	types:    words(`bool bytes dict float int list object set str tuple`),
+	builtins: words(`True False None print len range open self`),
+}
+
+var shell = language{
+	lineComments:     []string{"#"},
+	stringDelimiters: "\"'",
+	identifierRunes:  "-",
+	keywords: words(`case do done elif else esac fi for function if in select then until while export local
+		return`),
+	builtins: words(`cd echo exit printf read set shift source test unset`),
+}
+
+var c = language{
+	lineComments:     []string{"//"},
+	blockComments:    [][2]string{{"/*", "*/"}},
+	stringDelimiters: "\"'",
+	keywords: words(`break case const continue default do else enum extern for goto if inline register return
+		sizeof static struct switch typedef union volatile while`),
+	types:    words(`char double float int long short signed unsigned void size_t`),
+	builtins: words(`NULL true false`),
+}
+
+var json = language{
+	stringDelimiters: "\"",
+	builtins:         words(`true false null`),
+}
+
+// languages the languages supported by the built-in highlighter, indexed by their name and aliases
+var languages = map[string]language{
+	"go":         golang,
+	"golang":     golang,
+	"java":       java,
+	"javascript": javascript,
+	"js":         javascript,
+	"python":     python,
+	"py":         python,
+	"bash":       shell,
+	"sh":         shell,
+	"shell":      shell,
+	"c":          c,
+	"json":       json,
+}
+
+func lookupLanguage(name string) (language, bool) {
+	l, found := languages[strings.ToLower(name)]
+	return l, found
+}
@@ -107,4 +107,71 @@ this is a
 		})
 	})
 
+	Context("admonitions on delimited blocks", func() {
+
+		It("warning admonition on example block with title and multiple elements", func() {
+			actualContent := `[#warn]
+.Watch out
+[WARNING]
+====
+some *content*
+
+* an item
+
+----
+some code
+----
+====`
+			expectedResult := `<div id="warn" class="admonitionblock warning">
+<table>
+<tr>
+<td class="icon">
+<div class="title">Warning</div>
+</td>
+<td class="content">
+<div class="title">Watch out</div>
+<div class="paragraph">
+<p>some <strong>content</strong></p>
+</div>
+<div class="ulist">
+<ul>
+<li>
+<p>an item</p>
+</li>
+</ul>
+</div>
+<div class="listingblock">
+<div class="content">
+<pre class="highlight"><code>some code</code></pre>
+</div>
+</div>
+</td>
+</tr>
+</table>
+</div>`
+			verify(GinkgoT(), expectedResult, actualContent)
+		})
+
+		It("note admonition on open block", func() {
+			actualContent := `[NOTE]
+--
+a note
+--`
+			expectedResult := `<div class="admonitionblock note">
+<table>
+<tr>
+<td class="icon">
+<div class="title">Note</div>
+</td>
+<td class="content">
+<div class="paragraph">
+<p>a note</p>
+</div>
+</td>
+</tr>
+</table>
+</div>`
+			verify(GinkgoT(), expectedResult, actualContent)
+		})
+	})
 })
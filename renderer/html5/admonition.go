@@ -39,19 +39,34 @@ func init() {
 
 func renderAdmonitionParagraph(ctx *renderer.Context, a types.AdmonitionParagraph) ([]byte, error) {
 	log.Debugf("rendering admonition")
+	renderedContent, err := renderElement(ctx, a.Content)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to render admonition")
+	}
+	return renderAdmonition(a.Kind, a.Attributes, renderedContent)
+}
+
+func renderAdmonitionBlock(ctx *renderer.Context, b types.AdmonitionBlock) ([]byte, error) {
+	log.Debugf("rendering admonition block")
+	renderedContent, err := renderElements(ctx, b.Elements)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to render admonition block")
+	}
+	return renderAdmonition(b.Kind, b.Attributes, renderedContent)
+}
+
+// renderAdmonition renders an admonition of the given kind with the given (already rendered) content,
+// using the ID and title in the given attributes
+func renderAdmonition(kind types.AdmonitionKind, attributes map[string]interface{}, renderedContent []byte) ([]byte, error) {
 	result := bytes.NewBuffer(nil)
 	var id, title string
-	if i, ok := a.Attributes[types.AttrID].(string); ok {
+	if i, ok := attributes[types.AttrID].(string); ok {
 		id = i
 	}
-	if t, ok := a.Attributes[types.AttrTitle].(string); ok {
+	if t, ok := attributes[types.AttrTitle].(string); ok {
 		title = t
 	}
-	renderedContent, err := renderElement(ctx, a.Content)
-	if err != nil {
-		return nil, errors.Wrapf(err, "failed to render admonition")
-	}
-	err = admonitionParagraphTmpl.Execute(result, struct {
+	err := admonitionParagraphTmpl.Execute(result, struct {
 		ID      string
 		Class   string
 		Icon    string
@@ -59,8 +74,8 @@ func renderAdmonitionParagraph(ctx *renderer.Context, a types.AdmonitionParagrap
 		Content string
 	}{
 		ID:      id,
-		Class:   getClass(a.Kind),
-		Icon:    getIcon(a.Kind),
+		Class:   getClass(kind),
+		Icon:    getIcon(kind),
 		Title:   title,
 		Content: string(renderedContent),
 	})
@@ -0,0 +1,45 @@
+package html5
+
+import (
+	"bytes"
+	"html/template"
+
+	"github.com/bytesparadise/libasciidoc/renderer"
+	"github.com/bytesparadise/libasciidoc/types"
+	"github.com/pkg/errors"
+)
+
+var thematicBreakTmpl template.Template
+var pageBreakTmpl template.Template
+var lineBreakTmpl template.Template
+
+// initializes the templates
+func init() {
+	thematicBreakTmpl = newHTMLTemplate("thematic break", `<hr>`)
+	pageBreakTmpl = newHTMLTemplate("page break", `<div style="page-break-after: always;"></div>`)
+	lineBreakTmpl = newHTMLTemplate("line break", `<br>`)
+}
+
+func renderThematicBreak(ctx *renderer.Context, b types.ThematicBreak) ([]byte, error) {
+	result := bytes.NewBuffer(nil)
+	if err := thematicBreakTmpl.Execute(result, nil); err != nil {
+		return nil, errors.Wrapf(err, "unable to render thematic break")
+	}
+	return result.Bytes(), nil
+}
+
+func renderPageBreak(ctx *renderer.Context, b types.PageBreak) ([]byte, error) {
+	result := bytes.NewBuffer(nil)
+	if err := pageBreakTmpl.Execute(result, nil); err != nil {
+		return nil, errors.Wrapf(err, "unable to render page break")
+	}
+	return result.Bytes(), nil
+}
+
+func renderLineBreak(ctx *renderer.Context, b types.LineBreak) ([]byte, error) {
+	result := bytes.NewBuffer(nil)
+	if err := lineBreakTmpl.Execute(result, nil); err != nil {
+		return nil, errors.Wrapf(err, "unable to render line break")
+	}
+	return result.Bytes(), nil
+}
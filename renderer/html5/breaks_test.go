@@ -0,0 +1,74 @@
+package html5_test
+
+import . "github.com/onsi/ginkgo"
+
+var _ = Describe("Breaks", func() {
+
+	It("thematic break", func() {
+		actualContent := `a paragraph
+
+'''
+
+another paragraph`
+		expectedResult := `<div class="paragraph">
+<p>a paragraph</p>
+</div>
+<hr>
+<div class="paragraph">
+<p>another paragraph</p>
+</div>`
+		verify(GinkgoT(), expectedResult, actualContent)
+	})
+
+	It("page break", func() {
+		actualContent := `a paragraph
+
+<<<
+
+another paragraph`
+		expectedResult := `<div class="paragraph">
+<p>a paragraph</p>
+</div>
+<div style="page-break-after: always;"></div>
+<div class="paragraph">
+<p>another paragraph</p>
+</div>`
+		verify(GinkgoT(), expectedResult, actualContent)
+	})
+
+	It("line break", func() {
+		actualContent := `line one +
+line two`
+		expectedResult := `<div class="paragraph">
+<p>line one<br>
+line two</p>
+</div>`
+		verify(GinkgoT(), expectedResult, actualContent)
+	})
+
+	It("paragraph with the hardbreaks option", func() {
+		actualContent := `[%hardbreaks]
+line one
+line two +
+line three`
+		expectedResult := `<div class="paragraph">
+<p>line one<br>
+line two<br>
+line three</p>
+</div>`
+		verify(GinkgoT(), expectedResult, actualContent)
+	})
+
+	It("paragraphs with the hardbreaks document attribute", func() {
+		actualContent := `= Title
+:hardbreaks:
+
+line one
+line two`
+		expectedResult := `<div class="paragraph">
+<p>line one<br>
+line two</p>
+</div>`
+		verify(GinkgoT(), expectedResult, actualContent)
+	})
+})
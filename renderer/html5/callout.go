@@ -0,0 +1,102 @@
+package html5
+
+import (
+	"bytes"
+	"fmt"
+	"html/template"
+
+	"github.com/bytesparadise/libasciidoc/renderer"
+	"github.com/bytesparadise/libasciidoc/types"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+)
+
+var calloutListTmpl template.Template
+
+// initializes the templates
+func init() {
+	calloutListTmpl = newHTMLTemplate("callout list", `<div{{ if .ID }} id="{{ .ID }}"{{ end }} class="colist arabic">{{ if .Title }}
+<div class="title">{{ .Title }}</div>{{ end }}
+<table>{{ range .Items }}
+<tr>
+<td><b>{{ .Ref }}</b></td>
+<td>{{ .Content }}</td>
+</tr>{{ end }}
+</table>
+</div>`)
+}
+
+func renderCallout(ctx *renderer.Context, c types.Callout) ([]byte, error) {
+	return []byte(fmt.Sprintf(`<b class="conum">(%d)</b>`, c.Ref)), nil
+}
+
+type renderedCalloutListItem struct {
+	Ref     int
+	Content template.HTML
+}
+
+func renderCalloutList(ctx *renderer.Context, l types.CalloutList) ([]byte, error) {
+	log.Debugf("rendering callout list with %d item(s)", len(l.Items))
+	result := bytes.NewBuffer(nil)
+	var id, title string
+	if i, ok := l.Attributes[types.AttrID].(string); ok {
+		id = i
+	}
+	if t, ok := l.Attributes[types.AttrTitle].(string); ok {
+		title = t
+	}
+	items := make([]renderedCalloutListItem, len(l.Items))
+	for i, item := range l.Items {
+		content := bytes.NewBuffer(nil)
+		for j, element := range item.Elements {
+			if j > 0 {
+				content.WriteString("\n")
+			}
+			var renderedElement []byte
+			var err error
+			if p, ok := element.(types.ListParagraph); ok {
+				// the lines of the list paragraphs are rendered without the `<p>` element
+				renderedElement, err = renderLines(ctx, p.Lines)
+			} else {
+				renderedElement, err = renderElement(ctx, element)
+			}
+			if err != nil {
+				return nil, errors.Wrapf(err, "unable to render callout list")
+			}
+			content.Write(renderedElement)
+		}
+		items[i] = renderedCalloutListItem{
+			Ref:     item.Ref,
+			Content: template.HTML(content.String()),
+		}
+	}
+	err := calloutListTmpl.Execute(result, struct {
+		ID    string
+		Title string
+		Items []renderedCalloutListItem
+	}{
+		ID:    id,
+		Title: title,
+		Items: items,
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to render callout list")
+	}
+	return result.Bytes(), nil
+}
+
+// renderLines renders the given lines, separated with a newline
+func renderLines(ctx *renderer.Context, lines []types.InlineContent) ([]byte, error) {
+	result := bytes.NewBuffer(nil)
+	for i, line := range lines {
+		if i > 0 {
+			result.WriteString("\n")
+		}
+		renderedLine, err := renderInlineContent(ctx, line)
+		if err != nil {
+			return nil, errors.Wrapf(err, "unable to render lines")
+		}
+		result.Write(renderedLine)
+	}
+	return result.Bytes(), nil
+}
@@ -0,0 +1,65 @@
+package html5_test
+
+import . "github.com/onsi/ginkgo"
+
+var _ = Describe("Callouts", func() {
+
+	It("source block with callouts and callout list", func() {
+		actualContent := `[source,go]
+----
+import "fmt" <1>
+
+func main() {
+  fmt.Println("hello") // <2>
+}
+----
+<1> import the *fmt* package
+<2> print a message`
+		expectedResult := `<div class="listingblock">
+<div class="content">
+<pre class="highlight"><code class="language-go" data-lang="go">import &#34;fmt&#34; <b class="conum">(1)</b>
+
+func main() {
+  fmt.Println(&#34;hello&#34;) <b class="conum">(2)</b>
+}</code></pre>
+</div>
+</div>
+<div class="colist arabic">
+<table>
+<tr>
+<td><b>1</b></td>
+<td>import the <strong>fmt</strong> package</td>
+</tr>
+<tr>
+<td><b>2</b></td>
+<td>print a message</td>
+</tr>
+</table>
+</div>`
+		verify(GinkgoT(), expectedResult, actualContent)
+	})
+
+	It("highlighted source block with callouts", func() {
+		actualContent := `:source-highlighter: builtin
+
+[source,python]
+----
+return None # <1>
+----
+<1> nothing`
+		expectedResult := `<div class="listingblock">
+<div class="content">
+<pre class="builtin highlight"><code class="language-python" data-lang="python"><span class="k">return</span> <span class="nb">None</span> <b class="conum">(1)</b></code></pre>
+</div>
+</div>
+<div class="colist arabic">
+<table>
+<tr>
+<td><b>1</b></td>
+<td>nothing</td>
+</tr>
+</table>
+</div>`
+		verify(GinkgoT(), expectedResult, actualContent)
+	})
+})
@@ -0,0 +1,35 @@
+package html5_test
+
+import . "github.com/onsi/ginkgo"
+
+var _ = Describe("Comments", func() {
+
+	It("single line comments are not rendered", func() {
+		actualContent := `// a comment
+a paragraph
+// another comment
+with 2 lines`
+		expectedResult := `<div class="paragraph">
+<p>a paragraph
+with 2 lines</p>
+</div>`
+		verify(GinkgoT(), expectedResult, actualContent)
+	})
+
+	It("comment blocks are not rendered", func() {
+		actualContent := `a paragraph
+
+////
+a *comment* block
+////
+
+another paragraph`
+		expectedResult := `<div class="paragraph">
+<p>a paragraph</p>
+</div>
+<div class="paragraph">
+<p>another paragraph</p>
+</div>`
+		verify(GinkgoT(), expectedResult, actualContent)
+	})
+})
@@ -4,6 +4,8 @@ import (
 	"bytes"
 	"fmt"
 	"html/template"
+	"path/filepath"
+	"strings"
 
 	"github.com/bytesparadise/libasciidoc/renderer"
 	"github.com/bytesparadise/libasciidoc/types"
@@ -15,34 +17,118 @@ var crossReferenceTmpl template.Template
 
 // initializes the templates
 func init() {
-	crossReferenceTmpl = newHTMLTemplate("cross reference", `<a href="#{{ .ID }}">{{ .Content }}</a>`)
+	crossReferenceTmpl = newHTMLTemplate("cross reference", `<a href="{{ .Href }}">{{ .Content }}</a>`)
 }
 
 func renderCrossReference(ctx *renderer.Context, xref types.CrossReference) ([]byte, error) {
 	log.Debugf("rendering cross reference with ID: %s", xref.ID)
 	result := bytes.NewBuffer(nil)
-	renderedContentStr := fmt.Sprintf("[%s]", xref.ID)
-	if target, found := ctx.Document.ElementReferences[xref.ID]; found {
-		switch t := target.(type) {
-		case types.SectionTitle:
-			renderedContent, err := renderElement(ctx, t.Content)
+	var href string
+	var content template.HTML
+	if xref.IsInterDocument() {
+		// link to the HTML document which is generated from the target document
+		document := strings.TrimSuffix(xref.Location, filepath.Ext(xref.Location)) + ".html"
+		href = document
+		if xref.ID != "" {
+			href = href + "#" + xref.ID
+		}
+		content = template.HTML(template.HTMLEscapeString(document))
+	} else {
+		href = "#" + xref.ID
+		content = template.HTML(template.HTMLEscapeString(fmt.Sprintf("[%s]", xref.ID)))
+		if target, found := ctx.Document.ElementReferences[xref.ID]; found && xref.Label == "" {
+			text, err := renderCrossReferenceText(ctx, xref.ID, target)
 			if err != nil {
-				return nil, errors.Wrapf(err, "error while rendering sectionTitle content")
+				return nil, errors.Wrapf(err, "unable to render cross reference")
+			}
+			if text != "" {
+				content = text
 			}
-			renderedContentStr = string(renderedContent)
-		default:
-			return nil, errors.Errorf("unable to process cross-reference to element of type %T", target)
 		}
 	}
+	if xref.Label != "" {
+		content = template.HTML(template.HTMLEscapeString(xref.Label))
+	}
 	err := crossReferenceTmpl.Execute(result, struct {
-		ID      string
-		Content string
+		Href    string
+		Content template.HTML
 	}{
-		ID:      xref.ID,
-		Content: renderedContentStr,
+		Href:    href,
+		Content: content,
 	})
 	if err != nil {
 		return nil, errors.Wrapf(err, "unable to render cross reference")
 	}
 	return result.Bytes(), nil
 }
+
+// renderCrossReferenceText renders the default text of a cross reference to the given target, which depends on the
+// `xrefstyle` document attribute:
+// - by default (or with the `basic` style), the title of the target
+// - with the `short` style, the caption of the target (eg: `Figure 2` or `Section 1.2`)
+// - with the `full` style, the caption followed by the title of the target (eg: `Figure 2, "A title"`)
+// Returns an empty result if the target has no title and no caption
+func renderCrossReferenceText(ctx *renderer.Context, id string, target interface{}) (template.HTML, error) {
+	var title template.HTML
+	var caption string
+	switch t := target.(type) {
+	case types.SectionTitle:
+		renderedContent, err := renderElement(ctx, t.Content)
+		if err != nil {
+			return "", errors.Wrapf(err, "error while rendering sectionTitle content")
+		}
+		title = template.HTML(string(renderedContent))
+		if number := strings.TrimRight(t.Number, ".: "); number != "" {
+			if strings.HasPrefix(number, "Appendix") {
+				caption = number
+			} else {
+				caption = "Section " + number
+			}
+		}
+	case types.DiscreteHeading:
+		renderedContent, err := renderElement(ctx, t.Content)
+		if err != nil {
+			return "", errors.Wrapf(err, "error while rendering discrete heading content")
+		}
+		title = template.HTML(string(renderedContent))
+	case types.BlockImage:
+		title = elementTitle(t.Attributes)
+		caption, _ = t.Attributes[types.AttrCaption].(string)
+	case types.Table:
+		title = elementTitle(t.Attributes)
+		caption, _ = t.Attributes[types.AttrCaption].(string)
+	case types.DelimitedBlock:
+		title = elementTitle(t.Attributes)
+	case types.Paragraph:
+		title = elementTitle(t.Attributes)
+	case types.OrderedList:
+		title = elementTitle(t.Attributes)
+	case types.UnorderedList:
+		title = elementTitle(t.Attributes)
+	case types.LabeledList:
+		title = elementTitle(t.Attributes)
+	case types.InlineAnchor:
+		title = template.HTML(template.HTMLEscapeString(t.ReferenceText()))
+	default:
+		return "", errors.Errorf("unable to process cross-reference to element of type %T", target)
+	}
+	switch ctx.Document.Attributes["xrefstyle"] {
+	case "full":
+		if caption != "" && title != "" {
+			return template.HTML(template.HTMLEscapeString(caption)) + ", &#8220;" + title + "&#8221;", nil
+		}
+	case "short":
+		if caption != "" {
+			return template.HTML(template.HTMLEscapeString(caption)), nil
+		}
+	}
+	return title, nil
+}
+
+// elementTitle returns the escaped title in the given element attributes, or an empty value if there is no title
+func elementTitle(attributes map[string]interface{}) template.HTML {
+	if title, ok := attributes[types.AttrTitle].(string); ok {
+		return template.HTML(template.HTMLEscapeString(title))
+	}
+	return ""
+}
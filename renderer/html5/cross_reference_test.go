@@ -20,6 +20,57 @@ with some content linked to <<thetitle>>!`
 <p>with some content linked to <a href="#thetitle">a title</a>!</p>
 </div>
 </div>
+</div>`
+				verify(GinkgoT(), expectedResult, actualContent)
+			})
+
+			It("custom id and custom text", func() {
+				actualContent := `[[thetitle]]
+== a title
+
+with some content linked to <<thetitle,the *title*>> and xref:thetitle[that title]!`
+				expectedResult := `<div class="sect1">
+<h2 id="thetitle">a title</h2>
+<div class="sectionbody">
+<div class="paragraph">
+<p>with some content linked to <a href="#thetitle">the *title*</a> and <a href="#thetitle">that title</a>!</p>
+</div>
+</div>
+</div>`
+				verify(GinkgoT(), expectedResult, actualContent)
+			})
+
+			It("section with formatted title", func() {
+				actualContent := `[[thetitle]]
+== a *bold* title
+
+with some content linked to <<thetitle>>!`
+				expectedResult := `<div class="sect1">
+<h2 id="thetitle">a <strong>bold</strong> title</h2>
+<div class="sectionbody">
+<div class="paragraph">
+<p>with some content linked to <a href="#thetitle">a <strong>bold</strong> title</a>!</p>
+</div>
+</div>
+</div>`
+				verify(GinkgoT(), expectedResult, actualContent)
+			})
+
+			It("numbered section with full style", func() {
+				actualContent := `= A document
+:sectnums:
+:xrefstyle: full
+
+== a title
+
+see <<_a_title>>.`
+				expectedResult := `<div class="sect1">
+<h2 id="_a_title">1. a title</h2>
+<div class="sectionbody">
+<div class="paragraph">
+<p>see <a href="#_a_title">Section 1, &#8220;a title&#8221;</a>.</p>
+</div>
+</div>
 </div>`
 				verify(GinkgoT(), expectedResult, actualContent)
 			})
@@ -44,4 +95,111 @@ with some content linked to <<thewrongtitle>>!`
 			})
 		})
 	})
+
+	Context("Reference to other elements", func() {
+
+		It("reference to block image with title", func() {
+			actualContent := `see <<img-foo>>.
+
+[#img-foo]
+.A foo image
+image::foo.png[]`
+			expectedResult := `<div class="paragraph">
+<p>see <a href="#img-foo">A foo image</a>.</p>
+</div>
+<div id="img-foo" class="imageblock">
+<div class="content">
+<img src="foo.png" alt="foo">
+</div>
+<div class="title">Figure 1. A foo image</div>
+</div>`
+			verify(GinkgoT(), expectedResult, actualContent)
+		})
+
+		It("reference to block image with short style", func() {
+			actualContent := `:xrefstyle: short
+
+.A bar image
+image::bar.png[]
+
+[#img-foo]
+.A foo image
+image::foo.png[]
+
+see <<img-foo>>.`
+			expectedResult := `<div class="imageblock">
+<div class="content">
+<img src="bar.png" alt="bar">
+</div>
+<div class="title">Figure 1. A bar image</div>
+</div>
+<div id="img-foo" class="imageblock">
+<div class="content">
+<img src="foo.png" alt="foo">
+</div>
+<div class="title">Figure 2. A foo image</div>
+</div>
+<div class="paragraph">
+<p>see <a href="#img-foo">Figure 2</a>.</p>
+</div>`
+			verify(GinkgoT(), expectedResult, actualContent)
+		})
+
+		It("reference to listing block with title", func() {
+			actualContent := `[#code]
+.Some code
+----
+foo
+----
+
+see <<code>>.`
+			expectedResult := `<div id="code" class="listingblock">
+<div class="title">Some code</div>
+<div class="content">
+<pre class="highlight"><code>foo</code></pre>
+</div>
+</div>
+<div class="paragraph">
+<p>see <a href="#code">Some code</a>.</p>
+</div>`
+			verify(GinkgoT(), expectedResult, actualContent)
+		})
+
+		It("reference to list without title", func() {
+			actualContent := `[#items]
+* an item
+
+see <<items>>.`
+			expectedResult := `<div id="items" class="ulist">
+<ul>
+<li>
+<p>an item</p>
+</li>
+</ul>
+</div>
+<div class="paragraph">
+<p>see <a href="#items">[items]</a>.</p>
+</div>`
+			verify(GinkgoT(), expectedResult, actualContent)
+		})
+	})
+
+	Context("Reference to other documents", func() {
+
+		It("reference to other document", func() {
+			actualContent := `see <<other.adoc>>.`
+			expectedResult := `<div class="paragraph">
+<p>see <a href="other.html">other.html</a>.</p>
+</div>`
+			verify(GinkgoT(), expectedResult, actualContent)
+		})
+
+		It("reference to section in other document with custom text", func() {
+			actualContent := `see <<docs/other.adoc#a-section,a section>> and xref:docs/other.adoc#a-section[].`
+			expectedResult := `<div class="paragraph">
+<p>see <a href="docs/other.html#a-section">a section</a> and <a href="docs/other.html#a-section">docs/other.html</a>.</p>
+</div>`
+			verify(GinkgoT(), expectedResult, actualContent)
+		})
+	})
 })
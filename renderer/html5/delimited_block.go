@@ -2,6 +2,7 @@ package html5
 
 import (
 	"bytes"
+	"html"
 	texttemplate "text/template"
 
 	"github.com/bytesparadise/libasciidoc/renderer"
@@ -12,17 +13,21 @@ import (
 
 var listingBlockTmpl texttemplate.Template
 var exampleBlockTmpl texttemplate.Template
+var sidebarBlockTmpl texttemplate.Template
+var quoteBlockTmpl texttemplate.Template
+var verseBlockTmpl texttemplate.Template
+var openBlockTmpl texttemplate.Template
 
 // initializes the templates
 func init() {
-	listingBlockTmpl = newTextTemplate("listing block", `<div class="listingblock">
+	listingBlockTmpl = newTextTemplate("listing block", `<div{{ if .ID }} id="{{ .ID }}"{{ end }} class="listingblock">{{ if .Title }}
+<div class="title">{{ escape .Title }}</div>{{ end }}
 <div class="content">
-<pre class="highlight"><code>{{ $ctx := .Context }}{{ with .Data }}{{ $elements := .Elements }}{{ range $index, $element := $elements }}{{ renderElement $ctx $element | printf "%s" }}{{ if notLastItem $index $elements }}{{ print "\n" }}{{ end }}{{ end }}{{ end }}</code></pre>
+<pre class="{{ if .Highlighter }}{{ .Highlighter }} {{ end }}highlight"><code{{ if .Language }} class="language-{{ escape .Language }}" data-lang="{{ escape .Language }}"{{ end }}>{{ .Content }}</code></pre>
 </div>
 </div>`,
 		texttemplate.FuncMap{
-			"renderElement": renderElement,
-			"notLastItem":   notLastItem,
+			"escape": html.EscapeString,
 		})
 	exampleBlockTmpl = newTextTemplate("example block", `<div class="exampleblock">
 <div class="content">
@@ -33,19 +38,116 @@ func init() {
 			"renderElement": renderElement,
 			"notLastItem":   notLastItem,
 		})
+	sidebarBlockTmpl = newTextTemplate("sidebar block", `{{ $ctx := .Context }}{{ with .Data }}<div{{ if .ID }} id="{{ .ID }}"{{ end }} class="sidebarblock">
+<div class="content">{{ if .Title }}
+<div class="title">{{ escape .Title }}</div>{{ end }}
+{{ $elements := .Elements }}{{ range $index, $element := $elements }}{{ renderElement $ctx $element | printf "%s" }}{{ if notLastItem $index $elements }}{{ print "\n" }}{{ end }}{{ end }}
+</div>
+</div>{{ end }}`,
+		texttemplate.FuncMap{
+			"renderElement": renderElement,
+			"notLastItem":   notLastItem,
+			"escape":        html.EscapeString,
+		})
+	quoteBlockTmpl = newTextTemplate("quote block", `{{ $ctx := .Context }}{{ with .Data }}<div{{ if .ID }} id="{{ .ID }}"{{ end }} class="quoteblock{{ if eq .Kind "abstract" }} abstract{{ end }}">{{ if .Title }}
+<div class="title">{{ escape .Title }}</div>{{ end }}
+<blockquote>
+{{ $elements := .Elements }}{{ range $index, $element := $elements }}{{ renderElement $ctx $element | printf "%s" }}{{ if notLastItem $index $elements }}{{ print "\n" }}{{ end }}{{ end }}
+</blockquote>{{ template "attribution" . }}
+</div>{{ end }}`,
+		texttemplate.FuncMap{
+			"renderElement": renderElement,
+			"notLastItem":   notLastItem,
+			"escape":        html.EscapeString,
+		})
+	verseBlockTmpl = newTextTemplate("verse block", `{{ $ctx := .Context }}{{ with .Data }}<div{{ if .ID }} id="{{ .ID }}"{{ end }} class="verseblock">{{ if .Title }}
+<div class="title">{{ escape .Title }}</div>{{ end }}
+<pre class="content">{{ $elements := .Elements }}{{ range $index, $element := $elements }}{{ renderElement $ctx $element | printf "%s" }}{{ if notLastItem $index $elements }}{{ print "\n" }}{{ end }}{{ end }}</pre>{{ template "attribution" . }}
+</div>{{ end }}`,
+		texttemplate.FuncMap{
+			"renderElement": renderElement,
+			"notLastItem":   notLastItem,
+			"escape":        html.EscapeString,
+		})
+	openBlockTmpl = newTextTemplate("open block", `{{ $ctx := .Context }}{{ with .Data }}<div{{ if .ID }} id="{{ .ID }}"{{ end }} class="openblock{{ if eq .Kind "partintro" }} partintro{{ end }}">{{ if .Title }}
+<div class="title">{{ escape .Title }}</div>{{ end }}
+<div class="content">
+{{ $elements := .Elements }}{{ range $index, $element := $elements }}{{ renderElement $ctx $element | printf "%s" }}{{ if notLastItem $index $elements }}{{ print "\n" }}{{ end }}{{ end }}
+</div>
+</div>{{ end }}`,
+		texttemplate.FuncMap{
+			"renderElement": renderElement,
+			"notLastItem":   notLastItem,
+			"escape":        html.EscapeString,
+		})
+	// the attribution of the quote and verse blocks
+	attributionTmpl := `{{ define "attribution" }}{{ if or .Attribution .CiteTitle }}
+<div class="attribution">
+{{ if .Attribution }}&#8212; {{ escape .Attribution }}{{ if .CiteTitle }}<br>
+{{ end }}{{ end }}{{ if .CiteTitle }}<cite>{{ escape .CiteTitle }}</cite>{{ end }}
+</div>{{ end }}{{ end }}`
+	for _, tmpl := range []*texttemplate.Template{&quoteBlockTmpl, &verseBlockTmpl} {
+		if _, err := tmpl.Parse(attributionTmpl); err != nil {
+			log.Fatalf("failed to initialize '%s' template: %s", tmpl.Name(), err.Error())
+		}
+	}
+}
+
+// delimitedBlockData the data of the sidebar, quote, verse and open blocks, to use in their templates
+type delimitedBlockData struct {
+	ID          string
+	Title       string
+	Kind        string
+	Attribution string
+	CiteTitle   string
+	Elements    []types.DocElement
+}
+
+func newDelimitedBlockData(b types.DelimitedBlock) delimitedBlockData {
+	data := delimitedBlockData{
+		Elements: b.Elements,
+	}
+	if id, ok := b.Attributes[types.AttrID].(string); ok {
+		data.ID = id
+	}
+	if title, ok := b.Attributes[types.AttrTitle].(string); ok {
+		data.Title = title
+	}
+	if kind, ok := b.Attributes[types.AttrKind].(string); ok {
+		data.Kind = kind
+	}
+	if attribution, ok := b.Attributes[types.AttrAttribution].(string); ok {
+		data.Attribution = attribution
+	}
+	if citeTitle, ok := b.Attributes[types.AttrCiteTitle].(string); ok {
+		data.CiteTitle = citeTitle
+	}
+	return data
 }
 
 func renderDelimitedBlock(ctx *renderer.Context, b types.DelimitedBlock) ([]byte, error) {
 	log.Debugf("rendering delimited block")
+	if b.Kind == types.FencedBlock || b.Kind == types.ListingBlock {
+		return renderListingBlock(ctx, b)
+	}
+	if b.Kind == types.PassthroughBlock {
+		return renderPassthroughBlock(ctx, b)
+	}
+	if b.Kind == types.StemBlock {
+		return renderStemBlock(ctx, b)
+	}
 	result := bytes.NewBuffer(nil)
 	tmpl, err := selectDelimitedBlockTemplate(b)
 	if err != nil {
 		return nil, errors.Wrapf(err, "unable to render delimited block")
 	}
-
+	var data interface{} = b
+	if b.Kind != types.ExampleBlock {
+		data = newDelimitedBlockData(b)
+	}
 	err = tmpl.Execute(result, ContextualPipeline{
 		Context: ctx,
-		Data:    b,
+		Data:    data,
 	})
 	if err != nil {
 		return nil, errors.Wrapf(err, "unable to render delimited block")
@@ -56,11 +158,120 @@ func renderDelimitedBlock(ctx *renderer.Context, b types.DelimitedBlock) ([]byte
 
 func selectDelimitedBlockTemplate(b types.DelimitedBlock) (texttemplate.Template, error) {
 	switch b.Kind {
-	case types.FencedBlock, types.ListingBlock:
-		return listingBlockTmpl, nil
 	case types.ExampleBlock:
 		return exampleBlockTmpl, nil
+	case types.SidebarBlock:
+		return sidebarBlockTmpl, nil
+	case types.QuoteBlock:
+		return quoteBlockTmpl, nil
+	case types.VerseBlock:
+		return verseBlockTmpl, nil
+	case types.OpenBlock:
+		// an open block with the `abstract` style is rendered as a quote block
+		if b.Attributes[types.AttrKind] == types.Abstract {
+			return quoteBlockTmpl, nil
+		}
+		return openBlockTmpl, nil
 	default:
 		return texttemplate.Template{}, errors.Errorf("no template for block of kind %v", b.Kind)
 	}
 }
+
+// renderListingBlock renders a listing or fenced block. If the block is a source block with a language and if the
+// `source-highlighter` document attribute matches a known highlighter, then its content is highlighted.
+func renderListingBlock(ctx *renderer.Context, b types.DelimitedBlock) ([]byte, error) {
+	result := bytes.NewBuffer(nil)
+	var id, title, language, highlighterName string
+	if i, ok := b.Attributes[types.AttrID].(string); ok {
+		id = i
+	}
+	if t, ok := b.Attributes[types.AttrTitle].(string); ok {
+		title = t
+	}
+	if l, ok := b.Attributes[types.AttrLanguage].(string); ok && b.Attributes[types.AttrKind] == types.Source {
+		language = l
+	}
+	// by default, the content of the listing block is escaped, but not subject to the other substitutions
+	subs := b.Substitutions()
+	previous := ctx.SetSubstitutions(subs)
+	defer ctx.SetSubstitutions(previous)
+	content := bytes.NewBuffer(nil)
+	if h := ctx.Document.Attributes.GetAsString("source-highlighter"); h != nil && language != "" {
+		if highlighter, found := ctx.SourceHighlighter(*h); found {
+			highlighterName = *h
+			// the source code is highlighted between the callouts, once the substitutions were applied.
+			// The special characters are escaped by the highlighter itself.
+			ctx.SetSubstitutions(withoutSpecialCharacters(subs))
+			for _, element := range b.Elements {
+				renderedElement, err := renderElement(ctx, element)
+				if err != nil {
+					return nil, errors.Wrapf(err, "unable to render listing block")
+				}
+				if _, ok := element.(types.Callout); !ok {
+					var highlighted string
+					highlighted, err = highlighter.Highlight(string(renderedElement), language)
+					if err != nil {
+						return nil, errors.Wrapf(err, "unable to render listing block")
+					}
+					renderedElement = []byte(highlighted)
+				}
+				content.Write(renderedElement)
+			}
+		} else {
+			log.Warnf("unknown source highlighter: '%s'", *h)
+		}
+	}
+	if highlighterName == "" {
+		for _, element := range b.Elements {
+			renderedElement, err := renderElement(ctx, element)
+			if err != nil {
+				return nil, errors.Wrapf(err, "unable to render listing block")
+			}
+			content.Write(renderedElement)
+		}
+	}
+	err := listingBlockTmpl.Execute(result, struct {
+		ID          string
+		Title       string
+		Language    string
+		Highlighter string
+		Content     string
+	}{
+		ID:          id,
+		Title:       title,
+		Language:    language,
+		Highlighter: highlighterName,
+		Content:     content.String(),
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to render listing block")
+	}
+	return result.Bytes(), nil
+}
+
+// renderPassthroughBlock renders the content of the given passthrough block, without any substitution unless
+// specified otherwise with the `subs` attribute
+func renderPassthroughBlock(ctx *renderer.Context, b types.DelimitedBlock) ([]byte, error) {
+	result := bytes.NewBuffer(nil)
+	previous := ctx.SetSubstitutions(b.Substitutions())
+	defer ctx.SetSubstitutions(previous)
+	for _, element := range b.Elements {
+		renderedElement, err := renderElement(ctx, element)
+		if err != nil {
+			return nil, errors.Wrapf(err, "unable to render passthrough block")
+		}
+		result.Write(renderedElement)
+	}
+	return result.Bytes(), nil
+}
+
+// withoutSpecialCharacters returns a copy of the given substitutions, without the `specialcharacters` substitution
+func withoutSpecialCharacters(subs []types.Substitution) []types.Substitution {
+	result := make([]types.Substitution, 0, len(subs))
+	for _, s := range subs {
+		if s != types.SpecialCharactersSubstitution {
+			result = append(result, s)
+		}
+	}
+	return result
+}
@@ -1,6 +1,11 @@
 package html5_test
 
-import . "github.com/onsi/ginkgo"
+import (
+	"strings"
+
+	"github.com/bytesparadise/libasciidoc/renderer"
+	. "github.com/onsi/ginkgo"
+)
 
 var _ = Describe("Delimited Blocks", func() {
 
@@ -38,6 +43,106 @@ here
 		})
 	})
 
+	Context("Source blocks", func() {
+
+		It("fenced block with language", func() {
+			actualContent := "```go\nfunc main() {\n  fmt.Println(\"<hello>\")\n}\n```"
+			expectedResult := `<div class="listingblock">
+<div class="content">
+<pre class="highlight"><code class="language-go" data-lang="go">func main() {
+  fmt.Println(&#34;&lt;hello&gt;&#34;)
+}</code></pre>
+</div>
+</div>`
+			verify(GinkgoT(), expectedResult, actualContent)
+		})
+
+		It("source block with ID, title and language", func() {
+			actualContent := `[[hello]]
+.Hello, world
+[source,go]
+----
+func main() {}
+----`
+			expectedResult := `<div id="hello" class="listingblock">
+<div class="title">Hello, world</div>
+<div class="content">
+<pre class="highlight"><code class="language-go" data-lang="go">func main() {}</code></pre>
+</div>
+</div>`
+			verify(GinkgoT(), expectedResult, actualContent)
+		})
+
+		It("source block with built-in highlighter and CSS classes", func() {
+			actualContent := `:source-highlighter: builtin
+
+[source,go]
+----
+// main function
+func main() {
+  s := "hello"
+  return len(s) + 1
+}
+----`
+			expectedResult := `<div class="listingblock">
+<div class="content">
+<pre class="builtin highlight"><code class="language-go" data-lang="go"><span class="c">// main function</span>
+<span class="k">func</span> main() {
+  s <span class="o">:=</span> <span class="s">&#34;hello&#34;</span>
+  <span class="k">return</span> <span class="nb">len</span>(s) <span class="o">+</span> <span class="m">1</span>
+}</code></pre>
+</div>
+</div>`
+			verify(GinkgoT(), expectedResult, actualContent)
+		})
+
+		It("source block with built-in highlighter and inline styles", func() {
+			actualContent := `:source-highlighter: builtin
+:builtin-css: style
+
+[source,python]
+----
+return None
+----`
+			expectedResult := `<div class="listingblock">
+<div class="content">
+<pre class="builtin highlight"><code class="language-python" data-lang="python"><span style="color:#008000;font-weight:bold">return</span> <span style="color:#008000">None</span></code></pre>
+</div>
+</div>`
+			verify(GinkgoT(), expectedResult, actualContent)
+		})
+
+		It("source block with custom highlighter", func() {
+			actualContent := `:source-highlighter: custom
+
+[source,go]
+----
+func main() {}
+----`
+			expectedResult := `<div class="listingblock">
+<div class="content">
+<pre class="custom highlight"><code class="language-go" data-lang="go">GO:func main() {}</code></pre>
+</div>
+</div>`
+			verify(GinkgoT(), expectedResult, actualContent, renderer.SourceHighlighter("custom", customHighlighter{}))
+		})
+
+		It("source block with unknown highlighter", func() {
+			actualContent := `:source-highlighter: unknown
+
+[source,go]
+----
+func main() {}
+----`
+			expectedResult := `<div class="listingblock">
+<div class="content">
+<pre class="highlight"><code class="language-go" data-lang="go">func main() {}</code></pre>
+</div>
+</div>`
+			verify(GinkgoT(), expectedResult, actualContent)
+		})
+	})
+
 	Context("Literal blocks", func() {
 
 		It("literal block with multiple lines", func() {
@@ -80,4 +185,191 @@ with <strong>bold content</strong></p>
 			verify(GinkgoT(), expectedResult, actualContent)
 		})
 	})
+
+	Context("Sidebar blocks", func() {
+
+		It("sidebar block with ID, title and nested listing block", func() {
+			actualContent := `[#sidebar]
+.Sidebar title
+****
+some *content*
+
+----
+some code
+----
+****`
+			expectedResult := `<div id="sidebar" class="sidebarblock">
+<div class="content">
+<div class="title">Sidebar title</div>
+<div class="paragraph">
+<p>some <strong>content</strong></p>
+</div>
+<div class="listingblock">
+<div class="content">
+<pre class="highlight"><code>some code</code></pre>
+</div>
+</div>
+</div>
+</div>`
+			verify(GinkgoT(), expectedResult, actualContent)
+		})
+	})
+
+	Context("Quote blocks", func() {
+
+		It("quote block with attribution and citation title", func() {
+			actualContent := `.Quote title
+[quote, Albert Einstein, "Letter, 1936"]
+____
+A person who never made a mistake never tried anything new.
+____`
+			expectedResult := `<div class="quoteblock">
+<div class="title">Quote title</div>
+<blockquote>
+<div class="paragraph">
+<p>A person who never made a mistake never tried anything new.</p>
+</div>
+</blockquote>
+<div class="attribution">
+&#8212; Albert Einstein<br>
+<cite>Letter, 1936</cite>
+</div>
+</div>`
+			verify(GinkgoT(), expectedResult, actualContent)
+		})
+
+		It("quote block with attribution only", func() {
+			actualContent := `[quote, Albert Einstein]
+____
+A person who never made a mistake never tried anything new.
+____`
+			expectedResult := `<div class="quoteblock">
+<blockquote>
+<div class="paragraph">
+<p>A person who never made a mistake never tried anything new.</p>
+</div>
+</blockquote>
+<div class="attribution">
+&#8212; Albert Einstein
+</div>
+</div>`
+			verify(GinkgoT(), expectedResult, actualContent)
+		})
+
+		It("quote block without attributes", func() {
+			actualContent := `____
+some *quoted* content
+____`
+			expectedResult := `<div class="quoteblock">
+<blockquote>
+<div class="paragraph">
+<p>some <strong>quoted</strong> content</p>
+</div>
+</blockquote>
+</div>`
+			verify(GinkgoT(), expectedResult, actualContent)
+		})
+	})
+
+	Context("Verse blocks", func() {
+
+		It("verse block with attribution, citation title and blank line", func() {
+			actualContent := `[verse, Carl Sandburg, Fog]
+____
+The fog comes
+on *little* cat feet.
+
+It sits looking
+____`
+			expectedResult := `<div class="verseblock">
+<pre class="content">The fog comes
+on <strong>little</strong> cat feet.
+
+It sits looking</pre>
+<div class="attribution">
+&#8212; Carl Sandburg<br>
+<cite>Fog</cite>
+</div>
+</div>`
+			verify(GinkgoT(), expectedResult, actualContent)
+		})
+	})
+
+	Context("Open blocks", func() {
+
+		It("open block with title", func() {
+			actualContent := `.Open title
+--
+some content
+
+* an item
+--`
+			expectedResult := `<div class="openblock">
+<div class="title">Open title</div>
+<div class="content">
+<div class="paragraph">
+<p>some content</p>
+</div>
+<div class="ulist">
+<ul>
+<li>
+<p>an item</p>
+</li>
+</ul>
+</div>
+</div>
+</div>`
+			verify(GinkgoT(), expectedResult, actualContent)
+		})
+
+		It("open block with abstract style", func() {
+			actualContent := `[abstract]
+--
+some content
+--`
+			expectedResult := `<div class="quoteblock abstract">
+<blockquote>
+<div class="paragraph">
+<p>some content</p>
+</div>
+</blockquote>
+</div>`
+			verify(GinkgoT(), expectedResult, actualContent)
+		})
+
+		It("open block with partintro style", func() {
+			actualContent := `[partintro]
+--
+some content
+--`
+			expectedResult := `<div class="openblock partintro">
+<div class="content">
+<div class="paragraph">
+<p>some content</p>
+</div>
+</div>
+</div>`
+			verify(GinkgoT(), expectedResult, actualContent)
+		})
+	})
+
+	Context("Passthrough blocks", func() {
+
+		It("passthrough block with raw content", func() {
+			actualContent := `++++
+<video src="video.mp4" />
+*not bold*
+++++`
+			expectedResult := `<video src="video.mp4" />
+*not bold*`
+			verify(GinkgoT(), expectedResult, actualContent)
+		})
+	})
 })
+
+// customHighlighter a highlighter which prefixes the source code with the language in upper case
+type customHighlighter struct{}
+
+func (h customHighlighter) Highlight(source, language string) (string, error) {
+	return strings.ToUpper(language) + ":" + source, nil
+}
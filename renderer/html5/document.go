@@ -4,6 +4,7 @@ import (
 	"bytes"
 	htmltemplate "html/template"
 	"io"
+	"strings"
 	texttemplate "text/template"
 
 	"github.com/bytesparadise/libasciidoc/renderer"
@@ -23,15 +24,32 @@ func init() {
 <!--[if IE]><meta http-equiv="X-UA-Compatible" content="IE=edge"><![endif]-->
 <meta name="viewport" content="width=device-width, initial-scale=1.0">{{ if .Generator }}
 <meta name="generator" content="{{.Generator}}">{{ end }}
-<title>{{.Title}}</title>
-<body class="article">
-<div id="header">
-<h1>{{.Title}}</h1>{{ if .Details }}
+<title>{{.Title}}</title>{{ if .MathJax }}
+<script type="text/x-mathjax-config">
+MathJax.Hub.Config({
+  messageStyle: "none",
+  tex2jax: {
+    inlineMath: [["\\(", "\\)"]],
+    displayMath: [["\\[", "\\]"]],
+    ignoreClass: "nostem|nolatexmath"
+  },
+  asciimath2jax: {
+    delimiters: [["\\$", "\\$"]],
+    ignoreClass: "nostem|noasciimath"
+  },
+  TeX: { equationNumbers: { autoNumber: "none" } }
+});
+</script>
+<script src="https://cdnjs.cloudflare.com/ajax/libs/mathjax/2.7.4/MathJax.js?config=TeX-MML-AM_HTMLorMML"></script>{{ end }}
+<body class="{{.Doctype}}">
+<div id="header">{{ if .ShowTitle }}
+<h1>{{.Heading}}</h1>{{ end }}{{ if .Details }}
 {{ .Details }}{{ end }}
 </div>
 <div id="content">
 {{.Content}}
-</div>
+</div>{{ if .Footnotes }}
+{{ .Footnotes }}{{ end }}
 <div id="footer">
 <div id="footer-text">{{ if .RevNumber }}
 Version {{.RevNumber}}<br>{{ end }}
@@ -49,7 +67,13 @@ func renderDocument(ctx *renderer.Context, output io.Writer) (map[string]interfa
 	if err != nil {
 		return nil, errors.Wrapf(err, "unable to render full document")
 	}
+	renderedHeading, err := renderDocumentHeading(ctx)
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to render full document")
+	}
 
+	_, notitle := ctx.Document.Attributes["notitle"]
+	_, showtitle := ctx.Document.Attributes["showtitle"]
 	if ctx.IncludeHeaderFooter() {
 		log.Debugf("Rendering full document...")
 		// use a temporary writer for the document's content
@@ -61,20 +85,34 @@ func renderDocument(ctx *renderer.Context, output io.Writer) (map[string]interfa
 		if err != nil {
 			return nil, errors.Wrapf(err, "unable to render full document")
 		}
+		renderedFootnotes, err := renderFootnotes(ctx)
+		if err != nil {
+			return nil, errors.Wrapf(err, "unable to render full document")
+		}
 		err = documentTmpl.Execute(output, struct {
 			Generator   string
 			Title       string
+			Heading     htmltemplate.HTML
+			ShowTitle   bool
+			Doctype     string
 			Content     htmltemplate.HTML
+			Footnotes   htmltemplate.HTML
 			RevNumber   *string
 			LastUpdated string
 			Details     *htmltemplate.HTML
+			MathJax     bool
 		}{
 			Generator:   "libasciidoc", // TODO: externalize this value and include the lib version ?
 			Title:       string(renderedTitle),
+			Heading:     htmltemplate.HTML(string(renderedHeading)),
+			ShowTitle:   !notitle && len(renderedTitle) > 0,
+			Doctype:     doctype(ctx),
 			Content:     htmltemplate.HTML(string(renderedElements)),
+			Footnotes:   htmltemplate.HTML(string(renderedFootnotes)),
 			RevNumber:   ctx.Document.Attributes.GetAsString("revnumber"),
 			LastUpdated: ctx.LastUpdated(),
 			Details:     documentDetails,
+			MathJax:     includeMathJax(ctx),
 		})
 		if err != nil {
 			return nil, errors.Wrapf(err, "unable to render full document")
@@ -84,20 +122,62 @@ func renderDocument(ctx *renderer.Context, output io.Writer) (map[string]interfa
 		if err != nil {
 			return nil, errors.Wrapf(err, "unable to render full document")
 		}
+		// the title is not rendered in an embedded document, unless the `showtitle` attribute is set
+		if showtitle && !notitle && len(renderedTitle) > 0 {
+			output.Write([]byte("<h1>"))
+			output.Write(renderedHeading)
+			output.Write([]byte("</h1>"))
+			if len(renderedElements) > 0 {
+				output.Write([]byte("\n"))
+			}
+		}
 		output.Write(renderedElements)
+		renderedFootnotes, err := renderFootnotes(ctx)
+		if err != nil {
+			return nil, errors.Wrapf(err, "unable to render full document")
+		}
+		if len(renderedFootnotes) > 0 {
+			output.Write([]byte("\n"))
+			output.Write(renderedFootnotes)
+		}
 	}
 	// copy all document attributes, and override the title with its rendered value instead of the `types.Section` struct
 	for k, v := range ctx.Document.Attributes {
-		switch k {
-		case "doctitle":
-			metadata[k] = string(renderedTitle)
-		default:
-			metadata[k] = v
+		metadata[k] = v
+	}
+	if _, found := ctx.Document.Attributes["doctitle"]; found {
+		metadata["doctitle"] = string(renderedTitle)
+		// also include the main title and the subtitle, which take precedence over the `title` and `subtitle` attributes
+		mainTitle, subtitle := splitDocumentTitle(string(renderedTitle), ctx.Document.Attributes.GetAsString("title-separator"))
+		metadata["title"] = mainTitle
+		if subtitle != "" {
+			metadata["subtitle"] = subtitle
 		}
 	}
 	return metadata, nil
 }
 
+// doctype returns the value of the `doctype` attribute, or `article` by default
+func doctype(ctx *renderer.Context) string {
+	if doctype := ctx.Document.Attributes.GetAsString("doctype"); doctype != nil && *doctype != "" {
+		return *doctype
+	}
+	return "article"
+}
+
+// splitDocumentTitle splits the given document title into a main title and a subtitle, on the last occurrence of the
+// separator (`:` by default) followed by a space. The subtitle is empty if the title contains no such separator
+func splitDocumentTitle(title string, separator *string) (string, string) {
+	sep := ":"
+	if separator != nil && *separator != "" {
+		sep = *separator
+	}
+	if i := strings.LastIndex(title, sep+" "); i >= 0 {
+		return strings.TrimSpace(title[:i]), strings.TrimSpace(title[i+len(sep)+1:])
+	}
+	return title, ""
+}
+
 func renderElements(ctx *renderer.Context, elements []types.DocElement) ([]byte, error) {
 	renderedElementsBuff := bytes.NewBuffer(nil)
 	hasContent := false
@@ -126,7 +206,7 @@ func renderDocumentTitle(ctx *renderer.Context) ([]byte, error) {
 	if err != nil {
 		return nil, errors.Wrapf(err, "unable to render document title")
 	}
-	if _, found := documentTitle.Attributes[types.AttrID]; found { // ignore if no ID was set, ie, title is not defined
+	if len(documentTitle.Content.Elements) > 0 { // ignore if the title is not defined
 		title, err := renderPlainString(ctx, documentTitle)
 		if err != nil {
 			return nil, errors.Wrapf(err, "unable to render document title")
@@ -135,3 +215,33 @@ func renderDocumentTitle(ctx *renderer.Context) ([]byte, error) {
 	}
 	return nil, nil
 }
+
+// renderDocumentHeading renders the document title displayed in the header, which, unlike the title
+// of the HTML page, includes the footnotes of the title
+func renderDocumentHeading(ctx *renderer.Context) ([]byte, error) {
+	documentTitle, err := ctx.Document.Attributes.GetTitle()
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to render document heading")
+	}
+	result := bytes.NewBuffer(nil)
+	for _, element := range documentTitle.Content.Elements {
+		var rendered []byte
+		if f, ok := element.(types.Footnote); ok {
+			rendered, err = renderFootnote(ctx, f)
+		} else {
+			rendered, err = renderPlainString(ctx, element)
+		}
+		if err != nil {
+			return nil, errors.Wrapf(err, "unable to render document heading")
+		}
+		result.Write(rendered)
+	}
+	return result.Bytes(), nil
+}
+
+// includeMathJax returns true if the MathJax script must be included in the header of the document, ie, if the
+// `IncludeMathJax` option is enabled and the `stem` document attribute is set
+func includeMathJax(ctx *renderer.Context) bool {
+	_, stem := ctx.Document.Attributes[types.Stem]
+	return ctx.IncludeMathJax() && stem
+}
@@ -0,0 +1,86 @@
+package html5
+
+import (
+	"bytes"
+	"html"
+	texttemplate "text/template"
+
+	"github.com/bytesparadise/libasciidoc/renderer"
+	"github.com/bytesparadise/libasciidoc/types"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+)
+
+var footnoteTmpl texttemplate.Template
+var footnoteRefTmpl texttemplate.Template
+var invalidFootnoteRefTmpl texttemplate.Template
+var footnotesTmpl texttemplate.Template
+
+// initializes the templates
+func init() {
+	footnoteTmpl = newTextTemplate("footnote", `<sup class="footnote"{{ if .Ref }} id="_footnote_{{ escape .Ref }}"{{ end }}>[<a id="_footnoteref_{{ .Number }}" class="footnote" href="#_footnotedef_{{ .Number }}" title="View footnote.">{{ .Number }}</a>]</sup>`,
+		texttemplate.FuncMap{
+			"escape": html.EscapeString,
+		})
+	footnoteRefTmpl = newTextTemplate("footnote ref", `<sup class="footnoteref">[<a class="footnote" href="#_footnotedef_{{ .Number }}" title="View footnote.">{{ .Number }}</a>]</sup>`)
+	invalidFootnoteRefTmpl = newTextTemplate("invalid footnote ref", `<sup class="footnoteref red" title="Unresolved footnote reference.">[{{ escape .Ref }}]</sup>`,
+		texttemplate.FuncMap{
+			"escape": html.EscapeString,
+		})
+	footnotesTmpl = newTextTemplate("footnotes", `{{ $ctx := .Context }}{{ with .Data }}<div id="footnotes">
+<hr>{{ range $index, $footnote := . }}
+<div class="footnote" id="_footnotedef_{{ inc $index }}">
+<a href="#_footnoteref_{{ inc $index }}">{{ inc $index }}</a>. {{ renderElement $ctx $footnote.Elements | printf "%s" }}
+</div>{{ end }}
+</div>{{ end }}`,
+		texttemplate.FuncMap{
+			"renderElement": renderElement,
+			"inc":           func(i int) int { return i + 1 },
+		})
+}
+
+// renderFootnote renders the anchor of the given footnote. The footnotes were numbered when the document was initialized,
+// in the order in which they are defined, so the footnotes of a title rendered several times (eg: in the table of contents)
+// keep the same number.
+func renderFootnote(ctx *renderer.Context, f types.Footnote) ([]byte, error) {
+	result := bytes.NewBuffer(nil)
+	var err error
+	if number, found := ctx.Document.Footnotes.Number(f.ID); found {
+		err = footnoteTmpl.Execute(result, struct {
+			Ref    string
+			Number int
+		}{
+			Ref:    f.Ref,
+			Number: number,
+		})
+	} else if number, found := ctx.Document.FootnoteReferences[f.Ref]; found {
+		err = footnoteRefTmpl.Execute(result, struct {
+			Number int
+		}{
+			Number: number,
+		})
+	} else {
+		log.Debugf("no footnote defined with ID '%s'", f.Ref)
+		err = invalidFootnoteRefTmpl.Execute(result, f)
+	}
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to render footnote")
+	}
+	return result.Bytes(), nil
+}
+
+// renderFootnotes renders the section with all the footnotes of the document, if any
+func renderFootnotes(ctx *renderer.Context) ([]byte, error) {
+	if len(ctx.Document.Footnotes) == 0 {
+		return nil, nil
+	}
+	result := bytes.NewBuffer(nil)
+	err := footnotesTmpl.Execute(result, ContextualPipeline{
+		Context: ctx,
+		Data:    ctx.Document.Footnotes,
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to render footnotes")
+	}
+	return result.Bytes(), nil
+}
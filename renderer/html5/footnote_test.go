@@ -0,0 +1,90 @@
+package html5_test
+
+import . "github.com/onsi/ginkgo"
+
+var _ = Describe("Footnotes", func() {
+
+	It("paragraph with footnotes", func() {
+		actualContent := `a paragraph.footnote:[a *footnote*]
+
+another paragraph footnote:disclaimer[a disclaimer] with a reference footnote:disclaimer[]`
+		expectedResult := `<div class="paragraph">
+<p>a paragraph.<sup class="footnote">[<a id="_footnoteref_1" class="footnote" href="#_footnotedef_1" title="View footnote.">1</a>]</sup></p>
+</div>
+<div class="paragraph">
+<p>another paragraph <sup class="footnote" id="_footnote_disclaimer">[<a id="_footnoteref_2" class="footnote" href="#_footnotedef_2" title="View footnote.">2</a>]</sup> with a reference <sup class="footnoteref">[<a class="footnote" href="#_footnotedef_2" title="View footnote.">2</a>]</sup></p>
+</div>
+<div id="footnotes">
+<hr>
+<div class="footnote" id="_footnotedef_1">
+<a href="#_footnoteref_1">1</a>. a <strong>footnote</strong>
+</div>
+<div class="footnote" id="_footnotedef_2">
+<a href="#_footnoteref_2">2</a>. a disclaimer
+</div>
+</div>`
+		verify(GinkgoT(), expectedResult, actualContent)
+	})
+
+	It("footnote in a section title rendered in the table of contents", func() {
+		actualContent := `= Document
+:toc:
+
+== Section footnote:[one]
+
+a paragraph footnote:[two]`
+		expectedResult := `<div id="toc" class="toc">
+<div id="toctitle">Table of Contents</div>
+<ul class="sectlevel1">
+<li><a href="#_section">Section <sup class="footnote">[<a id="_footnoteref_1" class="footnote" href="#_footnotedef_1" title="View footnote.">1</a>]</sup></a></li>
+</ul>
+</div>
+<div class="sect1">
+<h2 id="_section">Section <sup class="footnote">[<a id="_footnoteref_1" class="footnote" href="#_footnotedef_1" title="View footnote.">1</a>]</sup></h2>
+<div class="sectionbody">
+<div class="paragraph">
+<p>a paragraph <sup class="footnote">[<a id="_footnoteref_2" class="footnote" href="#_footnotedef_2" title="View footnote.">2</a>]</sup></p>
+</div>
+</div>
+</div>
+<div id="footnotes">
+<hr>
+<div class="footnote" id="_footnotedef_1">
+<a href="#_footnoteref_1">1</a>. one
+</div>
+<div class="footnote" id="_footnotedef_2">
+<a href="#_footnoteref_2">2</a>. two
+</div>
+</div>`
+		verify(GinkgoT(), expectedResult, actualContent)
+	})
+
+	It("footnote in the document title", func() {
+		actualContent := `= Document footnote:[one]
+:showtitle:
+
+a paragraph footnote:[two]`
+		expectedResult := `<h1>Document <sup class="footnote">[<a id="_footnoteref_1" class="footnote" href="#_footnotedef_1" title="View footnote.">1</a>]</sup></h1>
+<div class="paragraph">
+<p>a paragraph <sup class="footnote">[<a id="_footnoteref_2" class="footnote" href="#_footnotedef_2" title="View footnote.">2</a>]</sup></p>
+</div>
+<div id="footnotes">
+<hr>
+<div class="footnote" id="_footnotedef_1">
+<a href="#_footnoteref_1">1</a>. one
+</div>
+<div class="footnote" id="_footnotedef_2">
+<a href="#_footnoteref_2">2</a>. two
+</div>
+</div>`
+		verify(GinkgoT(), expectedResult, actualContent)
+	})
+
+	It("reference to an undefined footnote", func() {
+		actualContent := `a paragraph footnote:unknown[]`
+		expectedResult := `<div class="paragraph">
+<p>a paragraph <sup class="footnoteref red" title="Unresolved footnote reference.">[unknown]</sup></p>
+</div>`
+		verify(GinkgoT(), expectedResult, actualContent)
+	})
+})
@@ -2,7 +2,14 @@ package html5
 
 import (
 	"bytes"
+	"encoding/base64"
+	"fmt"
 	"html/template"
+	"mime"
+	"net/http"
+	"path"
+	"path/filepath"
+	"strings"
 
 	"github.com/bytesparadise/libasciidoc/renderer"
 	"github.com/bytesparadise/libasciidoc/types"
@@ -15,14 +22,14 @@ var inlineImageTmpl template.Template
 
 // initializes the templates
 func init() {
-	blockImageTmpl = newHTMLTemplate("block image", `<div{{ if ne .ID "" }} id="{{ .ID }}"{{ end }} class="imageblock">
+	blockImageTmpl = newHTMLTemplate("block image", `<div{{ if ne .ID "" }} id="{{ .ID }}"{{ end }} class="{{ .Class }}">
 <div class="content">
-{{ if ne .Link "" }}<a class="image" href="{{ .Link }}">{{ end}}<img src="{{ .Macro.Path }}" alt="{{ .Macro.Alt }}"{{ if .Macro.Width }} width="{{ .Macro.Width }}"{{ end }}{{ if .Macro.Height }} height="{{ .Macro.Height }}"{{ end }}>{{ if ne .Link "" }}</a>{{ end }}
+{{ if ne .Link "" }}<a class="image" href="{{ .Link }}">{{ end}}<img src="{{ .Path }}" alt="{{ .Macro.Alt }}"{{ if .Macro.Width }} width="{{ .Macro.Width }}"{{ end }}{{ if .Macro.Height }} height="{{ .Macro.Height }}"{{ end }}>{{ if ne .Link "" }}</a>{{ end }}
 </div>{{ if ne .Title "" }}
-<div class="doctitle">{{ .Title }}</div>
+<div class="title">{{ .Title }}</div>
 {{ else }}
 {{ end }}</div>`)
-	inlineImageTmpl = newHTMLTemplate("inline image", `<span class="image"><img src="{{.Macro.Path}}" alt="{{.Macro.Alt}}"{{if .Macro.Width}} width="{{.Macro.Width}}"{{end}}{{if .Macro.Height}} height="{{.Macro.Height}}"{{end}}></span>`)
+	inlineImageTmpl = newHTMLTemplate("inline image", `<span class="{{ .Class }}">{{ if ne .Link "" }}<a class="image" href="{{ .Link }}">{{ end }}<img src="{{ .Path }}" alt="{{ .Macro.Alt }}"{{ if .Macro.Width }} width="{{ .Macro.Width }}"{{ end }}{{ if .Macro.Height }} height="{{ .Macro.Height }}"{{ end }}{{ if ne .Title "" }} title="{{ .Title }}"{{ end }}>{{ if ne .Link "" }}</a>{{ end }}</span>`)
 }
 
 func renderBlockImage(ctx *renderer.Context, img types.BlockImage) ([]byte, error) {
@@ -33,19 +40,36 @@ func renderBlockImage(ctx *renderer.Context, img types.BlockImage) ([]byte, erro
 	}
 	if t, ok := img.Attributes[types.AttrTitle].(string); ok {
 		title = t
+		if caption, ok := img.Attributes[types.AttrCaption].(string); ok {
+			title = caption + ". " + t
+		}
 	}
 	if l, ok := img.Attributes[types.AttrLink].(string); ok {
 		link = l
 	}
+	class := "imageblock"
+	if float, ok := img.Attributes["float"].(string); ok && float != "" {
+		class = class + " " + float
+	}
+	if align, ok := img.Attributes["align"].(string); ok && align != "" {
+		class = class + " text-" + align
+	}
+	if role, ok := img.Attributes[types.AttrRole].(string); ok && role != "" {
+		class = class + " " + role
+	}
 	err := blockImageTmpl.Execute(result, struct {
 		ID    string
+		Class string
 		Title string
 		Link  string
+		Path  interface{}
 		Macro types.ImageMacro
 	}{
 		ID:    id,
+		Class: class,
 		Title: title,
 		Link:  link,
+		Path:  imageSource(ctx, img.Macro.Path),
 		Macro: img.Macro,
 	})
 	if err != nil {
@@ -57,10 +81,97 @@ func renderBlockImage(ctx *renderer.Context, img types.BlockImage) ([]byte, erro
 
 func renderInlineImage(ctx *renderer.Context, img types.InlineImage) ([]byte, error) {
 	result := bytes.NewBuffer(nil)
-	err := inlineImageTmpl.Execute(result, img)
+	var title, link string
+	if t, ok := img.Macro.Attributes[types.AttrTitle].(string); ok {
+		title = t
+	}
+	if l, ok := img.Macro.Attributes[types.AttrLink].(string); ok {
+		link = l
+	}
+	class := "image"
+	if float, ok := img.Macro.Attributes["float"].(string); ok && float != "" {
+		class = class + " " + float
+	}
+	if role, ok := img.Macro.Attributes[types.AttrRole].(string); ok && role != "" {
+		class = class + " " + role
+	}
+	err := inlineImageTmpl.Execute(result, struct {
+		Class string
+		Title string
+		Link  string
+		Path  interface{}
+		Macro types.ImageMacro
+	}{
+		Class: class,
+		Title: title,
+		Link:  link,
+		Path:  imageSource(ctx, img.Macro.Path),
+		Macro: img.Macro,
+	})
 	if err != nil {
 		return nil, errors.Wrapf(err, "unable to render inline image")
 	}
 	log.Debugf("rendered inline image: %s", result.Bytes())
 	return result.Bytes(), nil
 }
+
+// imageSource returns the source of the image to render: a `data:` URI with the base64-encoded content of the image file
+// if the `data-uri` document attribute is set (as a `template.URL`, which is not sanitized by the template), or
+// the path of the image otherwise (or if the image could not be embedded)
+func imageSource(ctx *renderer.Context, p string) interface{} {
+	p = imagePath(ctx, p)
+	if _, found := ctx.Document.Attributes["data-uri"]; !found || strings.Contains(p, "://") || strings.HasPrefix(p, "data:") {
+		return p
+	}
+	dataURI, err := newDataURI(ctx, p)
+	if err != nil {
+		log.Warnf("unable to embed image '%s' as a data URI: %v", p, err)
+		return p
+	}
+	return template.URL(dataURI)
+}
+
+// newDataURI returns a `data:` URI with the base64-encoded content of the image at the given path, which is resolved
+// relative to the file being rendered. The MIME type of the image is determined by its extension or by its content.
+func newDataURI(ctx *renderer.Context, p string) (string, error) {
+	filename := p
+	if !filepath.IsAbs(p) && ctx.Filename() != "" {
+		filename = filepath.Join(filepath.Dir(ctx.Filename()), p)
+	}
+	resolver := ctx.DataURIResolver()
+	// the size of the image is checked before its content is read
+	if limit := ctx.DataURISizeLimit(); limit > 0 {
+		size, err := resolver.Size(filename)
+		if err != nil {
+			return "", errors.Wrapf(err, "unable to read image file")
+		}
+		if size > int64(limit) {
+			return "", errors.Errorf("image size (%d bytes) exceeds the limit of %d bytes", size, limit)
+		}
+	}
+	content, err := resolver.Resolve(filename)
+	if err != nil {
+		return "", errors.Wrapf(err, "unable to read image file")
+	}
+	mimeType := mime.TypeByExtension(filepath.Ext(p))
+	if mimeType == "" {
+		mimeType = http.DetectContentType(content)
+	}
+	// remove the optional parameters (eg: `; charset=utf-8`)
+	mimeType = strings.TrimSpace(strings.Split(mimeType, ";")[0])
+	return fmt.Sprintf("data:%s;base64,%s", mimeType, base64.StdEncoding.EncodeToString(content)), nil
+}
+
+// imagePath returns the path of the image, prefixed with the value of the `imagesdir` document attribute,
+// unless the path is absolute or is a URL
+func imagePath(ctx *renderer.Context, p string) string {
+	imagesdir, ok := ctx.Document.Attributes["imagesdir"].(string)
+	if !ok || imagesdir == "" || strings.HasPrefix(p, "/") || strings.Contains(p, "://") || strings.HasPrefix(p, "data:") {
+		return p
+	}
+	if strings.Contains(imagesdir, "://") {
+		// do not clean the URL (`path.Join` would remove a slash in `http://`)
+		return strings.TrimSuffix(imagesdir, "/") + "/" + p
+	}
+	return path.Join(imagesdir, p)
+}
@@ -1,6 +1,16 @@
 package html5_test
 
-import . "github.com/onsi/ginkgo"
+import (
+	"encoding/base64"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"github.com/bytesparadise/libasciidoc/renderer"
+	. "github.com/onsi/ginkgo"
+	"github.com/stretchr/testify/require"
+)
 
 var _ = Describe("Images", func() {
 	Context("Block Images", func() {
@@ -44,12 +54,233 @@ var _ = Describe("Images", func() {
 			<div class="content">
 			<a class="image" href="http://foo.bar"><img src="images/foo.png" alt="the foo.png image" width="600" height="400"></a>
 			</div>
-			<div class="doctitle">A title to foobar</div>
+			<div class="title">Figure 1. A title to foobar</div>
 			</div>`
 			verify(GinkgoT(), expectedResult, actualContent)
 		})
 	})
 
+	Context("Block Images with attributes", func() {
+
+		It("block image with role, alignment and float", func() {
+			actualContent := "image::foo.png[Diagram, width=300, role=thumb, align=center, float=right]"
+			expectedResult := `<div class="imageblock right text-center thumb">
+<div class="content">
+<img src="foo.png" alt="Diagram" width="300">
+</div>
+</div>`
+			verify(GinkgoT(), expectedResult, actualContent)
+		})
+
+		It("block image with link and title in the macro", func() {
+			actualContent := `image::foo.png[Diagram, link=http://foo.bar, title="The diagram"]`
+			expectedResult := `<div class="imageblock">
+<div class="content">
+<a class="image" href="http://foo.bar"><img src="foo.png" alt="Diagram"></a>
+</div>
+<div class="title">Figure 1. The diagram</div>
+</div>`
+			verify(GinkgoT(), expectedResult, actualContent)
+		})
+
+		It("block images with imagesdir", func() {
+			actualContent := `:imagesdir: assets/images
+
+image::foo.png[]
+
+image::/tmp/bar.png[]
+
+image::https://example.com/baz.png[]`
+			expectedResult := `<div class="imageblock">
+<div class="content">
+<img src="assets/images/foo.png" alt="foo">
+</div>
+</div>
+<div class="imageblock">
+<div class="content">
+<img src="/tmp/bar.png" alt="bar">
+</div>
+</div>
+<div class="imageblock">
+<div class="content">
+<img src="https://example.com/baz.png" alt="baz">
+</div>
+</div>`
+			verify(GinkgoT(), expectedResult, actualContent)
+		})
+
+		It("block images with custom figure caption", func() {
+			actualContent := `:figure-caption: Fig.
+
+.First
+image::foo.png[]
+
+.Second
+image::bar.png[]`
+			expectedResult := `<div class="imageblock">
+<div class="content">
+<img src="foo.png" alt="foo">
+</div>
+<div class="title">Fig. 1. First</div>
+</div>
+<div class="imageblock">
+<div class="content">
+<img src="bar.png" alt="bar">
+</div>
+<div class="title">Fig. 2. Second</div>
+</div>`
+			verify(GinkgoT(), expectedResult, actualContent)
+		})
+
+		It("block image with empty figure caption", func() {
+			actualContent := `:figure-caption:
+
+.First
+image::foo.png[]`
+			expectedResult := `<div class="imageblock">
+<div class="content">
+<img src="foo.png" alt="foo">
+</div>
+<div class="title">First</div>
+</div>`
+			verify(GinkgoT(), expectedResult, actualContent)
+		})
+
+		It("block images with figure caption reset", func() {
+			actualContent := `.First
+image::foo.png[]
+
+:figure-caption!:
+
+.Second
+image::bar.png[]`
+			expectedResult := `<div class="imageblock">
+<div class="content">
+<img src="foo.png" alt="foo">
+</div>
+<div class="title">Figure 1. First</div>
+</div>
+<div class="imageblock">
+<div class="content">
+<img src="bar.png" alt="bar">
+</div>
+<div class="title">Second</div>
+</div>`
+			verify(GinkgoT(), expectedResult, actualContent)
+		})
+	})
+
+	Context("Images embedded as data URIs", func() {
+
+		resolver := mapImageResolver{
+			"docs/images/foo.png": "foo image content",
+			"docs/images/bar":     "GIF89a bar image content",
+		}
+
+		It("block image embedded as a data URI", func() {
+			actualContent := `:data-uri:
+:imagesdir: images
+
+image::foo.png[]`
+			expectedResult := `<div class="imageblock">
+<div class="content">
+<img src="data:image/png;base64,` + base64.StdEncoding.EncodeToString([]byte("foo image content")) + `" alt="foo">
+</div>
+</div>`
+			verify(GinkgoT(), expectedResult, actualContent, renderer.Filename("docs/report.adoc"), renderer.DataURIResolver(resolver))
+		})
+
+		It("inline image without extension embedded as a data URI", func() {
+			actualContent := `:data-uri:
+
+an image:images/bar[] here`
+			expectedResult := `<div class="paragraph">
+<p>an <span class="image"><img src="data:image/gif;base64,` + base64.StdEncoding.EncodeToString([]byte("GIF89a bar image content")) + `" alt="bar"></span> here</p>
+</div>`
+			verify(GinkgoT(), expectedResult, actualContent, renderer.Filename("docs/report.adoc"), renderer.DataURIResolver(resolver))
+		})
+
+		It("missing image not embedded", func() {
+			actualContent := `:data-uri:
+
+image::images/missing.png[]`
+			expectedResult := `<div class="imageblock">
+<div class="content">
+<img src="images/missing.png" alt="missing">
+</div>
+</div>`
+			verify(GinkgoT(), expectedResult, actualContent, renderer.Filename("docs/report.adoc"), renderer.DataURIResolver(resolver))
+		})
+
+		It("image exceeding the size limit not embedded", func() {
+			actualContent := `:data-uri:
+
+image::images/foo.png[]`
+			expectedResult := `<div class="imageblock">
+<div class="content">
+<img src="images/foo.png" alt="foo">
+</div>
+</div>`
+			verify(GinkgoT(), expectedResult, actualContent, renderer.Filename("docs/report.adoc"), renderer.DataURIResolver(resolver), renderer.DataURISizeLimit(10))
+		})
+
+		It("image exceeding the size limit not read", func() {
+			actualContent := `:data-uri:
+
+image::images/foo.png[]`
+			expectedResult := `<div class="imageblock">
+<div class="content">
+<img src="images/foo.png" alt="foo">
+</div>
+</div>`
+			verify(GinkgoT(), expectedResult, actualContent, renderer.Filename("docs/report.adoc"), renderer.DataURIResolver(unreadableImageResolver{resolver}), renderer.DataURISizeLimit(10))
+		})
+
+		It("remote image not embedded", func() {
+			actualContent := `:data-uri:
+
+image::https://example.com/foo.png[]`
+			expectedResult := `<div class="imageblock">
+<div class="content">
+<img src="https://example.com/foo.png" alt="foo">
+</div>
+</div>`
+			verify(GinkgoT(), expectedResult, actualContent, renderer.DataURIResolver(resolver))
+		})
+
+		It("image outside of the directory of the document not embedded", func() {
+			dir, err := ioutil.TempDir("", "libasciidoc")
+			require.NoError(GinkgoT(), err)
+			defer os.RemoveAll(dir)
+			require.NoError(GinkgoT(), os.Mkdir(filepath.Join(dir, "docs"), 0700))
+			require.NoError(GinkgoT(), ioutil.WriteFile(filepath.Join(dir, "docs", "inside.png"), []byte("inside image content"), 0600))
+			require.NoError(GinkgoT(), ioutil.WriteFile(filepath.Join(dir, "outside.png"), []byte("outside image content"), 0600))
+			actualContent := `:data-uri:
+
+image::inside.png[]
+
+image::../outside.png[]
+
+image::` + filepath.Join(dir, "outside.png") + `[outside]`
+			expectedResult := `<div class="imageblock">
+<div class="content">
+<img src="data:image/png;base64,` + base64.StdEncoding.EncodeToString([]byte("inside image content")) + `" alt="inside">
+</div>
+</div>
+<div class="imageblock">
+<div class="content">
+<img src="../outside.png" alt="outside">
+</div>
+</div>
+<div class="imageblock">
+<div class="content">
+<img src="` + filepath.Join(dir, "outside.png") + `" alt="outside">
+</div>
+</div>`
+			verify(GinkgoT(), expectedResult, actualContent, renderer.Filename(filepath.Join(dir, "docs", "report.adoc")))
+		})
+	})
+
 	Context("Inline Images", func() {
 		Context("Valid Inline Images", func() {
 
@@ -86,6 +317,27 @@ var _ = Describe("Images", func() {
 			})
 		})
 
+		Context("Inline Images with attributes", func() {
+
+			It("inline image with role, float, title and link", func() {
+				actualContent := "image:foo.png[foo image, 16, role=icon, float=left, title=Foo, link=http://foo.bar]"
+				expectedResult := `<div class="paragraph">
+<p><span class="image left icon"><a class="image" href="http://foo.bar"><img src="foo.png" alt="foo image" width="16" title="Foo"></a></span></p>
+</div>`
+				verify(GinkgoT(), expectedResult, actualContent)
+			})
+
+			It("inline image with imagesdir", func() {
+				actualContent := `:imagesdir: https://example.com/images/
+
+an image:foo.png[] here`
+				expectedResult := `<div class="paragraph">
+<p>an <span class="image"><img src="https://example.com/images/foo.png" alt="foo"></span> here</p>
+</div>`
+				verify(GinkgoT(), expectedResult, actualContent)
+			})
+		})
+
 		Context("Invalid Inline Images", func() {
 
 			It("paragraph with block image with alt and dimensions", func() {
@@ -98,3 +350,30 @@ var _ = Describe("Images", func() {
 		})
 	})
 })
+
+// mapImageResolver an image resolver which serves the content of the images from a map
+type mapImageResolver map[string]string
+
+func (r mapImageResolver) Size(path string) (int64, error) {
+	if content, found := r[path]; found {
+		return int64(len(content)), nil
+	}
+	return 0, os.ErrNotExist
+}
+
+func (r mapImageResolver) Resolve(path string) ([]byte, error) {
+	if content, found := r[path]; found {
+		return []byte(content), nil
+	}
+	return nil, os.ErrNotExist
+}
+
+// unreadableImageResolver an image resolver which fails the current test if the content of an image is read
+type unreadableImageResolver struct {
+	mapImageResolver
+}
+
+func (r unreadableImageResolver) Resolve(path string) ([]byte, error) {
+	Fail(fmt.Sprintf("unexpected read of image '%s'", path))
+	return nil, nil
+}
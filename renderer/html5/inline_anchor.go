@@ -0,0 +1,39 @@
+package html5
+
+import (
+	"bytes"
+	"html/template"
+
+	"github.com/bytesparadise/libasciidoc/renderer"
+	"github.com/bytesparadise/libasciidoc/types"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+)
+
+var inlineAnchorTmpl template.Template
+
+// initializes the templates
+func init() {
+	inlineAnchorTmpl = newHTMLTemplate("inline anchor", `<a id="{{ .ID }}"></a>{{ .Text }}`)
+}
+
+func renderInlineAnchor(ctx *renderer.Context, a types.InlineAnchor) ([]byte, error) {
+	log.Debugf("rendering inline anchor with ID: %s", a.ID)
+	result := bytes.NewBuffer(nil)
+	var text string
+	// bibliography anchors are followed by their reference text (eg: `[pp]`)
+	if a.Bibliography {
+		text = a.ReferenceText()
+	}
+	err := inlineAnchorTmpl.Execute(result, struct {
+		ID   string
+		Text string
+	}{
+		ID:   a.ID,
+		Text: text,
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to render inline anchor")
+	}
+	return result.Bytes(), nil
+}
@@ -0,0 +1,65 @@
+package html5_test
+
+import . "github.com/onsi/ginkgo"
+
+var _ = Describe("Inline Anchors", func() {
+
+	It("inline anchor and reference to it", func() {
+		actualContent := `a paragraph with [[an-anchor]]an anchor, and [[other-anchor,the other anchor]]another one.
+
+see <<an-anchor>> and <<other-anchor>>.`
+		expectedResult := `<div class="paragraph">
+<p>a paragraph with <a id="an-anchor"></a>an anchor, and <a id="other-anchor"></a>another one.</p>
+</div>
+<div class="paragraph">
+<p>see <a href="#an-anchor">[an-anchor]</a> and <a href="#other-anchor">the other anchor</a>.</p>
+</div>`
+		verify(GinkgoT(), expectedResult, actualContent)
+	})
+
+	It("anchor macro", func() {
+		actualContent := `a paragraph with anchor:an-anchor[the anchor]an anchor.
+
+see <<an-anchor>>.`
+		expectedResult := `<div class="paragraph">
+<p>a paragraph with <a id="an-anchor"></a>an anchor.</p>
+</div>
+<div class="paragraph">
+<p>see <a href="#an-anchor">the anchor</a>.</p>
+</div>`
+		verify(GinkgoT(), expectedResult, actualContent)
+	})
+
+	It("bibliography section", func() {
+		actualContent := `_The Pragmatic Programmer_ <<pp>> should be required reading, see also <<gof>>.
+
+[bibliography]
+== References
+
+* [[[pp]]] Andy Hunt & Dave Thomas. The Pragmatic Programmer.
+* [[[gof,GoF]]] Erich Gamma, et al. Design Patterns.`
+		expectedResult := `<div id="preamble">
+<div class="sectionbody">
+<div class="paragraph">
+<p><em>The Pragmatic Programmer</em> <a href="#pp">[pp]</a> should be required reading, see also <a href="#gof">[GoF]</a>.</p>
+</div>
+</div>
+</div>
+<div class="sect1">
+<h2 id="_references">References</h2>
+<div class="sectionbody">
+<div class="ulist bibliography">
+<ul class="bibliography">
+<li>
+<p><a id="pp"></a>[pp] Andy Hunt &amp; Dave Thomas. The Pragmatic Programmer.</p>
+</li>
+<li>
+<p><a id="gof"></a>[GoF] Erich Gamma, et al. Design Patterns.</p>
+</li>
+</ul>
+</div>
+</div>
+</div>`
+		verify(GinkgoT(), expectedResult, actualContent)
+	})
+})
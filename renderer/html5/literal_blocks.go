@@ -24,7 +24,30 @@ func init() {
 func renderLiteralBlock(ctx *renderer.Context, b types.LiteralBlock) ([]byte, error) {
 	log.Debugf("rendering delimited block with content: %s", b.Content)
 	result := bytes.NewBuffer(nil)
-	err := literalBlockTmpl.Execute(result, b)
+	var err error
+	if b.Lines != nil {
+		// the lines were parsed with the substitutions of the block, which also apply on the strings
+		previous := ctx.SetSubstitutions(b.Substitutions())
+		defer ctx.SetSubstitutions(previous)
+		content := bytes.NewBuffer(nil)
+		for i, line := range b.Lines {
+			renderedLine, err := renderInlineContent(ctx, line)
+			if err != nil {
+				return nil, errors.Wrapf(err, "unable to render delimited block")
+			}
+			content.Write(renderedLine)
+			if i < len(b.Lines)-1 {
+				content.WriteString("\n")
+			}
+		}
+		err = literalBlockTmpl.Execute(result, struct {
+			Content template.HTML
+		}{
+			Content: template.HTML(content.String()),
+		})
+	} else {
+		err = literalBlockTmpl.Execute(result, b)
+	}
 	if err != nil {
 		return nil, errors.Wrapf(err, "unable to render delimited block")
 	}
@@ -0,0 +1,183 @@
+package html5
+
+import (
+	"bytes"
+	"fmt"
+	"html"
+	"strings"
+	texttemplate "text/template"
+
+	"github.com/bytesparadise/libasciidoc/renderer"
+	"github.com/bytesparadise/libasciidoc/types"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+)
+
+var videoBlockTmpl texttemplate.Template
+var embeddedVideoBlockTmpl texttemplate.Template
+var audioBlockTmpl texttemplate.Template
+
+// initializes the templates
+func init() {
+	videoBlockTmpl = newTextTemplate("video block", `<div{{ if .ID }} id="{{ .ID }}"{{ end }} class="videoblock">{{ if .Title }}
+<div class="title">{{ escape .Title }}</div>{{ end }}
+<div class="content">
+<video src="{{ escape .Source }}"{{ if .Width }} width="{{ escape .Width }}"{{ end }}{{ if .Height }} height="{{ escape .Height }}"{{ end }}{{ if .Poster }} poster="{{ escape .Poster }}"{{ end }}{{ if .Autoplay }} autoplay{{ end }}{{ if .Controls }} controls{{ end }}{{ if .Loop }} loop{{ end }}>
+Your browser does not support the video tag.
+</video>
+</div>
+</div>`,
+		texttemplate.FuncMap{
+			"escape": html.EscapeString,
+		})
+	embeddedVideoBlockTmpl = newTextTemplate("embedded video block", `<div{{ if .ID }} id="{{ .ID }}"{{ end }} class="videoblock">{{ if .Title }}
+<div class="title">{{ escape .Title }}</div>{{ end }}
+<div class="content">
+<iframe{{ if .Width }} width="{{ escape .Width }}"{{ end }}{{ if .Height }} height="{{ escape .Height }}"{{ end }} src="{{ escape .Source }}" frameborder="0"{{ if .AllowFullscreen }} allowfullscreen{{ end }}></iframe>
+</div>
+</div>`,
+		texttemplate.FuncMap{
+			"escape": html.EscapeString,
+		})
+	audioBlockTmpl = newTextTemplate("audio block", `<div{{ if .ID }} id="{{ .ID }}"{{ end }} class="audioblock">{{ if .Title }}
+<div class="title">{{ escape .Title }}</div>{{ end }}
+<div class="content">
+<audio src="{{ escape .Source }}"{{ if .Autoplay }} autoplay{{ end }}{{ if .Controls }} controls{{ end }}{{ if .Loop }} loop{{ end }}>
+Your browser does not support the audio tag.
+</audio>
+</div>
+</div>`,
+		texttemplate.FuncMap{
+			"escape": html.EscapeString,
+		})
+}
+
+// mediaBlockData the data of the video and audio blocks, to use in their templates
+type mediaBlockData struct {
+	ID              string
+	Title           string
+	Source          string
+	Width           string
+	Height          string
+	Poster          string
+	Autoplay        bool
+	Controls        bool
+	Loop            bool
+	AllowFullscreen bool
+}
+
+func newMediaBlockData(attributes map[string]interface{}) mediaBlockData {
+	data := mediaBlockData{
+		Autoplay:        types.HasOption(attributes, "autoplay"),
+		Controls:        !types.HasOption(attributes, "nocontrols"),
+		Loop:            types.HasOption(attributes, "loop"),
+		AllowFullscreen: !types.HasOption(attributes, "nofullscreen"),
+	}
+	data.ID, _ = attributes[types.AttrID].(string)
+	data.Title, _ = attributes[types.AttrTitle].(string)
+	data.Width, _ = attributes[types.AttrWidth].(string)
+	data.Height, _ = attributes[types.AttrHeight].(string)
+	data.Poster, _ = attributes[types.AttrPoster].(string)
+	return data
+}
+
+// timeAnchor returns the media fragment for the optional start and end times of a video or an audio
+// (eg: `#t=60,120`)
+func timeAnchor(attributes map[string]interface{}) string {
+	start, _ := attributes[types.AttrStart].(string)
+	end, _ := attributes[types.AttrEnd].(string)
+	if start == "" && end == "" {
+		return ""
+	}
+	if end == "" {
+		return "#t=" + start
+	}
+	return "#t=" + start + "," + end
+}
+
+func renderVideoBlock(ctx *renderer.Context, b types.VideoBlock) ([]byte, error) {
+	data := newMediaBlockData(b.Attributes)
+	tmpl := embeddedVideoBlockTmpl
+	switch b.Provider {
+	case types.YouTube:
+		data.Source = youTubeSource(b, data)
+	case types.Vimeo:
+		data.Source = vimeoSource(b, data)
+	default:
+		tmpl = videoBlockTmpl
+		data.Source = b.Path + timeAnchor(b.Attributes)
+	}
+	result := bytes.NewBuffer(nil)
+	if err := tmpl.Execute(result, data); err != nil {
+		return nil, errors.Wrapf(err, "unable to render video block")
+	}
+	log.Debugf("rendered video block: %s", result.Bytes())
+	return result.Bytes(), nil
+}
+
+// youTubeSource returns the URL of the embedded YouTube player for the given video
+func youTubeSource(b types.VideoBlock, data mediaBlockData) string {
+	src := strings.Builder{}
+	src.WriteString("https://www.youtube.com/embed/")
+	src.WriteString(b.Path)
+	related := 0
+	if types.HasOption(b.Attributes, "related") {
+		related = 1
+	}
+	src.WriteString(fmt.Sprintf("?rel=%d", related))
+	if start, ok := b.Attributes[types.AttrStart].(string); ok {
+		src.WriteString("&start=" + start)
+	}
+	if end, ok := b.Attributes[types.AttrEnd].(string); ok {
+		src.WriteString("&end=" + end)
+	}
+	if data.Autoplay {
+		src.WriteString("&autoplay=1")
+	}
+	if data.Loop {
+		// looping a single video requires a playlist with this video only
+		src.WriteString("&loop=1&playlist=" + b.Path)
+	}
+	if !data.Controls {
+		src.WriteString("&controls=0")
+	}
+	if !data.AllowFullscreen {
+		src.WriteString("&fs=0")
+	}
+	if types.HasOption(b.Attributes, "modest") {
+		src.WriteString("&modestbranding=1")
+	}
+	return src.String()
+}
+
+// vimeoSource returns the URL of the embedded Vimeo player for the given video
+func vimeoSource(b types.VideoBlock, data mediaBlockData) string {
+	src := strings.Builder{}
+	src.WriteString("https://player.vimeo.com/video/")
+	src.WriteString(b.Path)
+	if start, ok := b.Attributes[types.AttrStart].(string); ok {
+		src.WriteString("#at=" + start)
+	}
+	params := make([]string, 0)
+	if data.Autoplay {
+		params = append(params, "autoplay=1")
+	}
+	if data.Loop {
+		params = append(params, "loop=1")
+	}
+	if len(params) > 0 {
+		src.WriteString("?" + strings.Join(params, "&"))
+	}
+	return src.String()
+}
+
+func renderAudioBlock(ctx *renderer.Context, b types.AudioBlock) ([]byte, error) {
+	data := newMediaBlockData(b.Attributes)
+	data.Source = b.Path + timeAnchor(b.Attributes)
+	result := bytes.NewBuffer(nil)
+	if err := audioBlockTmpl.Execute(result, data); err != nil {
+		return nil, errors.Wrapf(err, "unable to render audio block")
+	}
+	log.Debugf("rendered audio block: %s", result.Bytes())
+	return result.Bytes(), nil
+}
@@ -0,0 +1,97 @@
+package html5_test
+
+import . "github.com/onsi/ginkgo"
+
+var _ = Describe("Video and Audio", func() {
+
+	Context("Video Blocks", func() {
+
+		It("video block without attributes", func() {
+			actualContent := "video::videos/intro.mp4[]"
+			expectedResult := `<div class="videoblock">
+<div class="content">
+<video src="videos/intro.mp4" controls>
+Your browser does not support the video tag.
+</video>
+</div>
+</div>`
+			verify(GinkgoT(), expectedResult, actualContent)
+		})
+
+		It("video block with attributes", func() {
+			actualContent := `[#intro]
+.Introduction
+video::intro.mp4[poster.png,640,480,start=10,end=60,options="autoplay,loop"]`
+			expectedResult := `<div id="intro" class="videoblock">
+<div class="title">Introduction</div>
+<div class="content">
+<video src="intro.mp4#t=10,60" width="640" height="480" poster="poster.png" autoplay controls loop>
+Your browser does not support the video tag.
+</video>
+</div>
+</div>`
+			verify(GinkgoT(), expectedResult, actualContent)
+		})
+
+		It("video block without controls", func() {
+			actualContent := "video::intro.mp4[%nocontrols]"
+			expectedResult := `<div class="videoblock">
+<div class="content">
+<video src="intro.mp4">
+Your browser does not support the video tag.
+</video>
+</div>
+</div>`
+			verify(GinkgoT(), expectedResult, actualContent)
+		})
+
+		It("youtube video block", func() {
+			actualContent := "video::RvRhUHTV_8k[youtube,640,360,start=60,options=\"autoplay,loop\"]"
+			expectedResult := `<div class="videoblock">
+<div class="content">
+<iframe width="640" height="360" src="https://www.youtube.com/embed/RvRhUHTV_8k?rel=0&amp;start=60&amp;autoplay=1&amp;loop=1&amp;playlist=RvRhUHTV_8k" frameborder="0" allowfullscreen></iframe>
+</div>
+</div>`
+			verify(GinkgoT(), expectedResult, actualContent)
+		})
+
+		It("vimeo video block", func() {
+			actualContent := "video::67480300[vimeo,start=30,%autoplay,%nofullscreen]"
+			expectedResult := `<div class="videoblock">
+<div class="content">
+<iframe src="https://player.vimeo.com/video/67480300#at=30?autoplay=1" frameborder="0"></iframe>
+</div>
+</div>`
+			verify(GinkgoT(), expectedResult, actualContent)
+		})
+	})
+
+	Context("Audio Blocks", func() {
+
+		It("audio block without attributes", func() {
+			actualContent := "audio::ocean-waves.wav[]"
+			expectedResult := `<div class="audioblock">
+<div class="content">
+<audio src="ocean-waves.wav" controls>
+Your browser does not support the audio tag.
+</audio>
+</div>
+</div>`
+			verify(GinkgoT(), expectedResult, actualContent)
+		})
+
+		It("audio block with attributes", func() {
+			actualContent := `.Waves
+audio::ocean-waves.wav[start=30,end=90,options="autoplay,loop"]`
+			expectedResult := `<div class="audioblock">
+<div class="title">Waves</div>
+<div class="content">
+<audio src="ocean-waves.wav#t=30,90" autoplay controls loop>
+Your browser does not support the audio tag.
+</audio>
+</div>
+</div>`
+			verify(GinkgoT(), expectedResult, actualContent)
+		})
+	})
+})
@@ -2,6 +2,7 @@ package html5
 
 import (
 	"bytes"
+	"strings"
 	texttemplate "text/template"
 
 	"github.com/bytesparadise/libasciidoc/renderer"
@@ -36,6 +37,15 @@ func renderParagraph(ctx *renderer.Context, p types.Paragraph) ([]byte, error) {
 	if t, ok := p.Attributes[types.AttrTitle].(string); ok {
 		title = t
 	}
+	lines := p.Lines
+	if _, found := p.Attributes[types.AttrSubstitutions]; found {
+		// the lines were parsed with the substitutions of the paragraph, which also apply on the strings
+		previous := ctx.SetSubstitutions(p.Substitutions())
+		defer ctx.SetSubstitutions(previous)
+	}
+	if hasHardbreaks(ctx, p.Attributes) {
+		lines = withLineBreaks(lines)
+	}
 	err := paragraphTmpl.Execute(result, ContextualPipeline{
 		Context: ctx,
 		Data: struct {
@@ -45,7 +55,7 @@ func renderParagraph(ctx *renderer.Context, p types.Paragraph) ([]byte, error) {
 		}{
 			ID:    id,
 			Title: title,
-			Lines: p.Lines,
+			Lines: lines,
 		},
 	})
 	if err != nil {
@@ -53,3 +63,36 @@ func renderParagraph(ctx *renderer.Context, p types.Paragraph) ([]byte, error) {
 	}
 	return result.Bytes(), nil
 }
+
+// hasHardbreaks returns true if the line breaks of the paragraph must be preserved, ie, if the paragraph has the
+// `hardbreaks` option (eg: `[%hardbreaks]`) or if the `hardbreaks` document attribute is set
+func hasHardbreaks(ctx *renderer.Context, attributes map[string]interface{}) bool {
+	if _, found := attributes["%hardbreaks"]; found {
+		return true
+	}
+	if options, ok := attributes[types.AttrOptions].(string); ok {
+		for _, option := range strings.Split(options, ",") {
+			if strings.TrimSpace(option) == "hardbreaks" {
+				return true
+			}
+		}
+	}
+	_, found := ctx.Document.Attributes["hardbreaks"]
+	return found
+}
+
+// withLineBreaks returns a copy of the given lines in which all lines but the last one end with a line break
+func withLineBreaks(lines []types.InlineContent) []types.InlineContent {
+	result := make([]types.InlineContent, len(lines))
+	for i, line := range lines {
+		if i < len(lines)-1 && len(line.Elements) > 0 {
+			if _, ok := line.Elements[len(line.Elements)-1].(types.LineBreak); !ok {
+				elements := make([]types.InlineElement, len(line.Elements), len(line.Elements)+1)
+				copy(elements, line.Elements)
+				line = types.InlineContent{Elements: append(elements, types.LineBreak{})}
+			}
+		}
+		result[i] = line
+	}
+	return result
+}
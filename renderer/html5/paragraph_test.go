@@ -9,7 +9,7 @@ var _ = Describe("Paragraphs", func() {
 & more content afterwards...`
 		expectedResult := `<div class="paragraph">
 <p><strong>bold content</strong> 
-&amp; more content afterwards...</p>
+&amp; more content afterwards&#8230;&#8203;</p>
 </div>`
 		verify(GinkgoT(), expectedResult, actualContent)
 	})
@@ -20,7 +20,7 @@ var _ = Describe("Paragraphs", func() {
 *bold content* with more content afterwards...`
 		expectedResult := `<div id="foo" class="paragraph">
 <div class="doctitle">a title</div>
-<p><strong>bold content</strong> with more content afterwards...</p>
+<p><strong>bold content</strong> with more content afterwards&#8230;&#8203;</p>
 </div>`
 		verify(GinkgoT(), expectedResult, actualContent)
 	})
@@ -33,7 +33,7 @@ and here another paragraph
 
 `
 		expectedResult := `<div class="paragraph">
-<p><strong>bold content</strong> with more content afterwards...</p>
+<p><strong>bold content</strong> with more content afterwards&#8230;&#8203;</p>
 </div>
 <div class="paragraph">
 <p>and here another paragraph</p>
@@ -13,12 +13,24 @@ import (
 var boldTextTmpl template.Template
 var italicTextTmpl template.Template
 var monospaceTextTmpl template.Template
+var markedTextTmpl template.Template
+var spanTextTmpl template.Template
+var superscriptTextTmpl template.Template
+var subscriptTextTmpl template.Template
+var doubleQuotedTextTmpl template.Template
+var singleQuotedTextTmpl template.Template
 
 // initializes the templates
 func init() {
-	boldTextTmpl = newHTMLTemplate("bold text", "<strong>{{.}}</strong>")
-	italicTextTmpl = newHTMLTemplate("italic text", "<em>{{.}}</em>")
-	monospaceTextTmpl = newHTMLTemplate("monospace text", "<code>{{.}}</code>")
+	boldTextTmpl = newHTMLTemplate("bold text", "<strong{{ if .ID }} id=\"{{ .ID }}\"{{ end }}{{ if .Role }} class=\"{{ .Role }}\"{{ end }}>{{ .Content }}</strong>")
+	italicTextTmpl = newHTMLTemplate("italic text", "<em{{ if .ID }} id=\"{{ .ID }}\"{{ end }}{{ if .Role }} class=\"{{ .Role }}\"{{ end }}>{{ .Content }}</em>")
+	monospaceTextTmpl = newHTMLTemplate("monospace text", "<code{{ if .ID }} id=\"{{ .ID }}\"{{ end }}{{ if .Role }} class=\"{{ .Role }}\"{{ end }}>{{ .Content }}</code>")
+	markedTextTmpl = newHTMLTemplate("marked text", "<mark>{{ .Content }}</mark>")
+	spanTextTmpl = newHTMLTemplate("span text", "<span{{ if .ID }} id=\"{{ .ID }}\"{{ end }}{{ if .Role }} class=\"{{ .Role }}\"{{ end }}>{{ .Content }}</span>")
+	superscriptTextTmpl = newHTMLTemplate("superscript text", "<sup{{ if .ID }} id=\"{{ .ID }}\"{{ end }}{{ if .Role }} class=\"{{ .Role }}\"{{ end }}>{{ .Content }}</sup>")
+	subscriptTextTmpl = newHTMLTemplate("subscript text", "<sub{{ if .ID }} id=\"{{ .ID }}\"{{ end }}{{ if .Role }} class=\"{{ .Role }}\"{{ end }}>{{ .Content }}</sub>")
+	doubleQuotedTextTmpl = newHTMLTemplate("double quoted text", "&#8220;{{ .Content }}&#8221;")
+	singleQuotedTextTmpl = newHTMLTemplate("single quoted text", "&#8216;{{ .Content }}&#8217;")
 }
 
 func renderQuotedText(ctx *renderer.Context, t types.QuotedText) ([]byte, error) {
@@ -42,13 +54,43 @@ func renderQuotedText(ctx *renderer.Context, t types.QuotedText) ([]byte, error)
 		tmpl = italicTextTmpl
 	case types.Monospace:
 		tmpl = monospaceTextTmpl
+	case types.Marked:
+		// a marked text with an ID or a role is rendered as a span (eg: `[.underline]#text#`)
+		if len(t.Attributes) > 0 {
+			tmpl = spanTextTmpl
+		} else {
+			tmpl = markedTextTmpl
+		}
+	case types.Superscript:
+		tmpl = superscriptTextTmpl
+	case types.Subscript:
+		tmpl = subscriptTextTmpl
+	case types.DoubleQuoted:
+		tmpl = doubleQuotedTextTmpl
+	case types.SingleQuoted:
+		tmpl = singleQuotedTextTmpl
 	default:
 		return nil, errors.Errorf("unsupported quoted text kind: %v", t.Kind)
 	}
-	err := tmpl.Execute(result, template.HTML(elementsBuffer.String()))
+	var id, role string
+	if i, ok := t.Attributes[types.AttrID].(string); ok {
+		id = i
+	}
+	if r, ok := t.Attributes[types.AttrRole].(string); ok {
+		role = r
+	}
+	err := tmpl.Execute(result, struct {
+		ID      string
+		Role    string
+		Content template.HTML
+	}{
+		ID:      id,
+		Role:    role,
+		Content: template.HTML(elementsBuffer.String()),
+	})
 	if err != nil {
-		return nil, errors.Wrapf(err, "unable to render monospaced quote")
+		return nil, errors.Wrapf(err, "unable to render quoted text")
 	}
-	log.Debugf("rendered bold quote: %s", result.Bytes())
+	log.Debugf("rendered quoted text: %s", result.Bytes())
 	return result.Bytes(), nil
 }
@@ -120,4 +120,50 @@ var _ = Describe("Quoted Texts", func() {
 
 	})
 
+	Context("Superscript, subscript and marked content", func() {
+
+		It("superscript and subscript content", func() {
+			actualContent := "E=mc^2^ and H~2~O"
+			expectedResult := `<div class="paragraph">
+<p>E=mc<sup>2</sup> and H<sub>2</sub>O</p>
+</div>`
+			verify(GinkgoT(), expectedResult, actualContent)
+		})
+
+		It("marked content", func() {
+			actualContent := "some #marked content# and un##marked##text"
+			expectedResult := `<div class="paragraph">
+<p>some <mark>marked content</mark> and un<mark>marked</mark>text</p>
+</div>`
+			verify(GinkgoT(), expectedResult, actualContent)
+		})
+	})
+
+	Context("Content with roles", func() {
+
+		It("underline and line-through roles", func() {
+			actualContent := "[.underline]#underlined# and [.line-through]#deleted# content"
+			expectedResult := `<div class="paragraph">
+<p><span class="underline">underlined</span> and <span class="line-through">deleted</span> content</p>
+</div>`
+			verify(GinkgoT(), expectedResult, actualContent)
+		})
+
+		It("bold content with an ID and roles", func() {
+			actualContent := "some [#foo.big.red]*bold content*"
+			expectedResult := `<div class="paragraph">
+<p>some <strong id="foo" class="big red">bold content</strong></p>
+</div>`
+			verify(GinkgoT(), expectedResult, actualContent)
+		})
+
+		It("marked content with an ID", func() {
+			actualContent := "[#bar]#content#"
+			expectedResult := `<div class="paragraph">
+<p><span id="bar">content</span></p>
+</div>`
+			verify(GinkgoT(), expectedResult, actualContent)
+		})
+	})
+
 })
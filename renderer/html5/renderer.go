@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"io"
 	"reflect"
+	"strings"
 
 	"github.com/bytesparadise/libasciidoc/renderer"
 	"github.com/bytesparadise/libasciidoc/types"
@@ -13,6 +14,11 @@ import (
 
 // Render renders the given document in HTML and writes the result in the given `writer`
 func Render(ctx *renderer.Context, output io.Writer) (map[string]interface{}, error) {
+	// the sections are numbered once, so that their titles have the same number in the table of contents,
+	// in the body of the document and in the cross references
+	types.NumberSections(ctx.Document)
+	// likewise, the captions of the block images and tables are computed once, for the elements and the cross references
+	types.AddCaptions(ctx.Document)
 	return renderDocument(ctx, output)
 }
 
@@ -25,6 +31,14 @@ func renderElement(ctx *renderer.Context, element types.DocElement) ([]byte, err
 		return renderSection(ctx, e)
 	case types.Preamble:
 		return renderPreamble(ctx, e)
+	case types.ThematicBreak:
+		return renderThematicBreak(ctx, e)
+	case types.PageBreak:
+		return renderPageBreak(ctx, e)
+	case types.LineBreak:
+		return renderLineBreak(ctx, e)
+	case types.DiscreteHeading:
+		return renderDiscreteHeading(ctx, e)
 	case types.LabeledList:
 		return renderLabeledList(ctx, e)
 	case types.OrderedList:
@@ -35,12 +49,18 @@ func renderElement(ctx *renderer.Context, element types.DocElement) ([]byte, err
 		return renderParagraph(ctx, e)
 	case types.AdmonitionParagraph:
 		return renderAdmonitionParagraph(ctx, e)
+	case types.AdmonitionBlock:
+		return renderAdmonitionBlock(ctx, e)
 	case types.AdmonitionParagraphContent:
 		return renderAdmonitionParagraphContent(ctx, e)
 	case types.ListParagraph:
 		return renderListParagraph(ctx, e)
+	case types.Footnote:
+		return renderFootnote(ctx, e)
 	case types.CrossReference:
 		return renderCrossReference(ctx, e)
+	case types.InlineAnchor:
+		return renderInlineAnchor(ctx, e)
 	case types.QuotedText:
 		return renderQuotedText(ctx, e)
 	case types.Passthrough:
@@ -49,14 +69,28 @@ func renderElement(ctx *renderer.Context, element types.DocElement) ([]byte, err
 		return renderBlockImage(ctx, e)
 	case types.InlineImage:
 		return renderInlineImage(ctx, e)
+	case types.VideoBlock:
+		return renderVideoBlock(ctx, e)
+	case types.AudioBlock:
+		return renderAudioBlock(ctx, e)
 	case types.DelimitedBlock:
 		return renderDelimitedBlock(ctx, e)
+	case types.Table:
+		return renderTable(ctx, e)
 	case types.LiteralBlock:
 		return renderLiteralBlock(ctx, e)
 	case types.InlineContent:
 		return renderInlineContent(ctx, e)
 	case types.Link:
 		return renderLink(ctx, e)
+	case types.InlineStem:
+		return renderInlineStem(ctx, e)
+	case types.KeyboardShortcut:
+		return renderKeyboardShortcut(ctx, e)
+	case types.Button:
+		return renderButton(ctx, e)
+	case types.MenuSelection:
+		return renderMenuSelection(ctx, e)
 	case types.StringElement:
 		return renderStringElement(ctx, e)
 	case types.DocumentAttributeDeclaration:
@@ -67,6 +101,13 @@ func renderElement(ctx *renderer.Context, element types.DocElement) ([]byte, err
 		return nil, processAttributeReset(ctx, e)
 	case types.DocumentAttributeSubstitution:
 		return renderAttributeSubstitution(ctx, e)
+	case types.Callout:
+		return renderCallout(ctx, e)
+	case types.CalloutList:
+		return renderCalloutList(ctx, e)
+	case types.Comment:
+		// comments are retained in the document, but not rendered
+		return nil, nil
 	default:
 		log.Errorf("unsupported type of element: %T", element)
 		return nil, errors.Errorf("unsupported type of element: %T", element)
@@ -83,8 +124,23 @@ func renderPlainString(ctx *renderer.Context, element types.DocElement) ([]byte,
 		return []byte(element.Macro.Alt), nil
 	case types.Link:
 		return []byte(element.Text), nil
+	case types.InlineStem:
+		return []byte(element.Content), nil
+	case types.KeyboardShortcut:
+		return []byte(strings.Join(element.Keys, "+")), nil
+	case types.Button:
+		return []byte(element.Label), nil
+	case types.MenuSelection:
+		return []byte(strings.Join(append([]string{element.Menu}, element.Items...), " > ")), nil
 	case types.StringElement:
 		return []byte(element.Content), nil
+	case types.Footnote:
+		// footnotes are not rendered in plain strings (eg: in the document title)
+		return nil, nil
+	case types.InlineAnchor:
+		return []byte(element.ReferenceText()), nil
+	case types.LineBreak:
+		return nil, nil
 	default:
 		return nil, errors.Errorf("unexpectedResult type of element to process: %T", element)
 	}
@@ -0,0 +1,90 @@
+package html5
+
+import (
+	"regexp"
+	"strings"
+)
+
+// replacement a typographic replacement, applied on the HTML-escaped content of the string elements, as done by
+// Asciidoctor's `replacements` substitution
+type replacement struct {
+	// the pattern to match, with a first group for the (preserved) leading character and a second group
+	// for the optional backslash which prevents the replacement
+	pattern *regexp.Regexp
+	// the text to write instead of the match (without its leading character)
+	text string
+	// the optional condition on the rest of the content after the match, since Go regexps do not support lookaheads
+	followedBy func(rest string) bool
+	// true if the whole match, including the leading and trailing spaces, must be replaced
+	bounding bool
+}
+
+var replacements = []replacement{
+	// copyright, registered and trademark signs
+	{pattern: regexp.MustCompile(`()(\\?)\(C\)`), text: "&#169;"},
+	{pattern: regexp.MustCompile(`()(\\?)\(R\)`), text: "&#174;"},
+	{pattern: regexp.MustCompile(`()(\\?)\(TM\)`), text: "&#8482;"},
+	// em-dash surrounded by spaces
+	{pattern: regexp.MustCompile(`(^|\n| )(\\?)--( |\n|$)`), text: "&#8201;&#8212;&#8201;", bounding: true},
+	// em-dash between two words
+	{pattern: regexp.MustCompile(`(\w)(\\?)--`), text: "&#8212;&#8203;", followedBy: startsWithWordCharacter},
+	// ellipsis
+	{pattern: regexp.MustCompile(`()(\\?)\.\.\.`), text: "&#8230;&#8203;"},
+	// apostrophe between two letters (the single quote is already HTML-escaped)
+	{pattern: regexp.MustCompile(`([a-zA-Z0-9])(\\?)&#39;`), text: "&#8217;", followedBy: startsWithLetter},
+	// arrows (the `<` and `>` characters are already HTML-escaped)
+	{pattern: regexp.MustCompile(`()(\\?)-&gt;`), text: "&#8594;"},
+	{pattern: regexp.MustCompile(`()(\\?)=&gt;`), text: "&#8658;"},
+	{pattern: regexp.MustCompile(`()(\\?)&lt;-`), text: "&#8592;"},
+	{pattern: regexp.MustCompile(`()(\\?)&lt;=`), text: "&#8656;"},
+}
+
+// applyReplacements applies the typographic replacements on the given HTML-escaped content. A replacement can be
+// prevented with a leading backslash (eg: `\(C)`), in which case the backslash is removed.
+func applyReplacements(content string) string {
+	for _, r := range replacements {
+		content = r.apply(content)
+	}
+	return content
+}
+
+func (r replacement) apply(content string) string {
+	matches := r.pattern.FindAllStringSubmatchIndex(content, -1)
+	if len(matches) == 0 {
+		return content
+	}
+	result := strings.Builder{}
+	previous := 0
+	for _, m := range matches {
+		if r.followedBy != nil && !r.followedBy(content[m[1]:]) {
+			continue
+		}
+		if m[5] > m[4] {
+			// replacement prevented: write the original text, without the backslash
+			result.WriteString(content[previous:m[4]])
+			result.WriteString(content[m[5]:m[1]])
+		} else if r.bounding {
+			result.WriteString(content[previous:m[2]])
+			result.WriteString(r.text)
+		} else {
+			// preserve the leading character
+			result.WriteString(content[previous:m[3]])
+			result.WriteString(r.text)
+		}
+		previous = m[1]
+	}
+	result.WriteString(content[previous:])
+	return result.String()
+}
+
+func startsWithWordCharacter(s string) bool {
+	return len(s) > 0 && (isLetter(s[0]) || (s[0] >= '0' && s[0] <= '9') || s[0] == '_')
+}
+
+func startsWithLetter(s string) bool {
+	return len(s) > 0 && isLetter(s[0])
+}
+
+func isLetter(c byte) bool {
+	return (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z')
+}
@@ -0,0 +1,114 @@
+package html5_test
+
+import . "github.com/onsi/ginkgo"
+
+var _ = Describe("Typographic replacements", func() {
+
+	Context("Replacements", func() {
+
+		It("copyright, registered and trademark signs", func() {
+			actualContent := "Copyright (C) Acme(R), Acme(TM)"
+			expectedResult := `<div class="paragraph">
+<p>Copyright &#169; Acme&#174;, Acme&#8482;</p>
+</div>`
+			verify(GinkgoT(), expectedResult, actualContent)
+		})
+
+		It("em-dashes", func() {
+			actualContent := "a -- b and c--d"
+			expectedResult := `<div class="paragraph">
+<p>a&#8201;&#8212;&#8201;b and c&#8212;&#8203;d</p>
+</div>`
+			verify(GinkgoT(), expectedResult, actualContent)
+		})
+
+		It("ellipsis and apostrophe", func() {
+			actualContent := "Sam's words..."
+			expectedResult := `<div class="paragraph">
+<p>Sam&#8217;s words&#8230;&#8203;</p>
+</div>`
+			verify(GinkgoT(), expectedResult, actualContent)
+		})
+
+		It("arrows", func() {
+			actualContent := "a -> b => c <- d <= e"
+			expectedResult := `<div class="paragraph">
+<p>a &#8594; b &#8658; c &#8592; d &#8656; e</p>
+</div>`
+			verify(GinkgoT(), expectedResult, actualContent)
+		})
+
+		It("replacements prevented with a backslash", func() {
+			actualContent := `\(C) and a\--b and \...`
+			expectedResult := `<div class="paragraph">
+<p>(C) and a--b and ...</p>
+</div>`
+			verify(GinkgoT(), expectedResult, actualContent)
+		})
+
+		It("replacements within quoted text", func() {
+			actualContent := "*bold (C)*"
+			expectedResult := `<div class="paragraph">
+<p><strong>bold &#169;</strong></p>
+</div>`
+			verify(GinkgoT(), expectedResult, actualContent)
+		})
+
+		It("no replacement in a listing block", func() {
+			actualContent := `----
+a -> b (C) ...
+----`
+			expectedResult := `<div class="listingblock">
+<div class="content">
+<pre class="highlight"><code>a -&gt; b (C) ...</code></pre>
+</div>
+</div>`
+			verify(GinkgoT(), expectedResult, actualContent)
+		})
+
+		It("no replacement in a literal block", func() {
+			actualContent := `....
+a -> b (C) ...
+....`
+			expectedResult := `<div class="literalblock">
+<div class="content">
+<pre>a -&gt; b (C) ...</pre>
+</div>
+</div>`
+			verify(GinkgoT(), expectedResult, actualContent)
+		})
+
+		It("no replacement in a passthrough", func() {
+			actualContent := "+a -> b (C)+"
+			expectedResult := `<div class="paragraph">
+<p>a -&gt; b (C)</p>
+</div>`
+			verify(GinkgoT(), expectedResult, actualContent)
+		})
+	})
+
+	Context("Curved quotes", func() {
+
+		It("double and single curved quotes", func() {
+			actualContent := "\"`double quotes`\" and '`single quotes`'"
+			expectedResult := `<div class="paragraph">
+<p>&#8220;double quotes&#8221; and &#8216;single quotes&#8217;</p>
+</div>`
+			verify(GinkgoT(), expectedResult, actualContent)
+		})
+
+		It("curved quotes with nested bold text", func() {
+			actualContent := "\"`a *bold* word`\""
+			expectedResult := `<div class="paragraph">
+<p>&#8220;a <strong>bold</strong> word&#8221;</p>
+</div>`
+			verify(GinkgoT(), expectedResult, actualContent)
+		})
+
+		It("escaped curved quotes", func() {
+			actualContent := "\\\"`not curved`\""
+			expectedResult := "<div class=\"paragraph\">\n<p>&#34;`not curved`&#34;</p>\n</div>"
+			verify(GinkgoT(), expectedResult, actualContent)
+		})
+	})
+})
@@ -13,6 +13,8 @@ import (
 )
 
 var preambleTmpl template.Template
+var partTitleTmpl template.Template
+var discreteHeadingTmpl template.Template
 var sectionHeaderTmpl template.Template
 var section1ContentTmpl template.Template
 var otherSectionContentTmpl template.Template
@@ -37,8 +39,12 @@ func init() {
 {{.SectionTitle}}{{ if .Elements }}
 {{.Elements}}{{end}}
 </div>`)
+	partTitleTmpl = newHTMLTemplate("part title",
+		`<h1{{ if .ID }} id="{{.ID}}"{{ end }} class="sect0">{{.Number}}{{.Content}}</h1>`)
+	discreteHeadingTmpl = newHTMLTemplate("discrete heading",
+		`<h{{.Level}}{{ if .ID }} id="{{.ID}}"{{ end }} class="discrete">{{.Content}}</h{{.Level}}>`)
 	sectionHeaderTmpl = newHTMLTemplate("other sectionTitle",
-		`<h{{.Level}} id="{{.ID}}">{{.Content}}</h{{.Level}}>`)
+		`<h{{.Level}}{{ if .ID }} id="{{.ID}}"{{ end }}>{{.Number}}{{.Content}}</h{{.Level}}>`)
 }
 
 func renderPreamble(ctx *renderer.Context, p types.Preamble) ([]byte, error) {
@@ -65,6 +71,9 @@ func renderPreamble(ctx *renderer.Context, p types.Preamble) ([]byte, error) {
 
 func renderSection(ctx *renderer.Context, s types.Section) ([]byte, error) {
 	log.Debugf("Rendering section level %d", s.Level)
+	if s.Level == 0 {
+		return renderPart(ctx, s)
+	}
 	renderedSectionTitle, err := renderSectionTitle(ctx, s.Level, s.Title)
 	if err != nil {
 		return nil, errors.Wrapf(err, "error while rendering section")
@@ -99,6 +108,55 @@ func renderSection(ctx *renderer.Context, s types.Section) ([]byte, error) {
 	return result.Bytes(), nil
 }
 
+// renderPart renders a level-0 section (ie, a part in a book), whose title is followed by the part intro (ie, the elements
+// before the first section of the part) in an open block with the `partintro` style, then by the sections of the part
+func renderPart(ctx *renderer.Context, s types.Section) ([]byte, error) {
+	renderedTitle, err := renderSectionTitle(ctx, s.Level, s.Title)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error while rendering part")
+	}
+	elements := s.Elements
+	intro := make([]types.DocElement, 0)
+	for len(elements) > 0 {
+		if _, ok := elements[0].(types.Section); ok {
+			break
+		}
+		intro = append(intro, elements[0])
+		elements = elements[1:]
+	}
+	// wrap the intro in a `partintro` open block, unless it is already such a block
+	if len(intro) > 0 && !isPartIntro(intro) {
+		intro = []types.DocElement{
+			types.DelimitedBlock{
+				Kind: types.OpenBlock,
+				Attributes: map[string]interface{}{
+					types.AttrKind: types.PartIntro,
+				},
+				Elements: intro,
+			},
+		}
+	}
+	renderedElements, err := renderElements(ctx, append(intro, elements...))
+	if err != nil {
+		return nil, errors.Wrapf(err, "error while rendering part")
+	}
+	result := bytes.NewBuffer(renderedTitle)
+	if len(renderedElements) > 0 {
+		result.WriteString("\n")
+		result.Write(renderedElements)
+	}
+	return result.Bytes(), nil
+}
+
+// isPartIntro returns true if the given elements consist of a single open block with the `partintro` style
+func isPartIntro(elements []types.DocElement) bool {
+	if len(elements) != 1 {
+		return false
+	}
+	b, ok := elements[0].(types.DelimitedBlock)
+	return ok && b.Kind == types.OpenBlock && b.Attributes[types.AttrKind] == types.PartIntro
+}
+
 func renderSectionTitle(ctx *renderer.Context, level int, sectionTitle types.SectionTitle) ([]byte, error) {
 	result := bytes.NewBuffer(nil)
 	renderedContent, err := renderElement(ctx, sectionTitle.Content)
@@ -110,13 +168,19 @@ func renderSectionTitle(ctx *renderer.Context, level int, sectionTitle types.Sec
 	if i, ok := sectionTitle.Attributes[types.AttrID].(string); ok {
 		id = i
 	}
-	err = sectionHeaderTmpl.Execute(result, struct {
+	tmpl := sectionHeaderTmpl
+	if level == 0 {
+		tmpl = partTitleTmpl
+	}
+	err = tmpl.Execute(result, struct {
 		Level   int
 		ID      string
+		Number  string
 		Content template.HTML
 	}{
 		Level:   level + 1,
 		ID:      id,
+		Number:  sectionTitle.Number,
 		Content: content,
 	})
 	if err != nil {
@@ -140,3 +204,29 @@ func renderSectionElements(ctx *renderer.Context, elements []types.DocElement) (
 	}
 	return renderedElementsBuff.Bytes(), nil
 }
+
+func renderDiscreteHeading(ctx *renderer.Context, h types.DiscreteHeading) ([]byte, error) {
+	log.Debugf("Rendering discrete heading level %d", h.Level)
+	result := bytes.NewBuffer(nil)
+	renderedContent, err := renderElement(ctx, h.Content)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error while rendering discrete heading content")
+	}
+	var id string
+	if i, ok := h.Attributes[types.AttrID].(string); ok {
+		id = i
+	}
+	err = discreteHeadingTmpl.Execute(result, struct {
+		Level   int
+		ID      string
+		Content template.HTML
+	}{
+		Level:   h.Level + 1,
+		ID:      id,
+		Content: template.HTML(string(renderedContent)),
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "error while rendering discrete heading")
+	}
+	return result.Bytes(), nil
+}
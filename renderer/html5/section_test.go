@@ -181,4 +181,425 @@ with some text, too`
 		})
 
 	})
+
+	Context("Discrete headings", func() {
+
+		It("discrete headings in a section", func() {
+			actualContent := `= A title
+:toc:
+
+== Section A
+
+[discrete]
+=== A heading
+
+a paragraph
+
+[float]
+== Another heading`
+			expectedResult := `<div id="toc" class="toc">
+<div id="toctitle">Table of Contents</div>
+<ul class="sectlevel1">
+<li><a href="#_section_a">Section A</a></li>
+</ul>
+</div>
+<div class="sect1">
+<h2 id="_section_a">Section A</h2>
+<div class="sectionbody">
+<h3 id="_a_heading" class="discrete">A heading</h3>
+<div class="paragraph">
+<p>a paragraph</p>
+</div>
+<h2 id="_another_heading" class="discrete">Another heading</h2>
+</div>
+</div>`
+			verify(GinkgoT(), expectedResult, actualContent)
+		})
+	})
+
+	Context("Markdown-style sections", func() {
+
+		It("sections with hash markers", func() {
+			actualContent := `## Section A
+
+### Section A.a`
+			expectedResult := `<div class="sect1">
+<h2 id="_section_a">Section A</h2>
+<div class="sectionbody">
+<div class="sect2">
+<h3 id="_section_a_a">Section A.a</h3>
+</div>
+</div>
+</div>`
+			verify(GinkgoT(), expectedResult, actualContent)
+		})
+	})
+
+	Context("Parts", func() {
+
+		It("level 0 section in an article", func() {
+			actualContent := `= An article
+
+== Section A
+
+= Part 1
+
+== Section B`
+			expectedResult := `<div class="sect1">
+<h2 id="_section_a">Section A</h2>
+<div class="sectionbody">
+</div>
+</div>
+<div class="sect1">
+<h2 id="_part_1">Part 1</h2>
+<div class="sectionbody">
+</div>
+</div>
+<div class="sect1">
+<h2 id="_section_b">Section B</h2>
+<div class="sectionbody">
+</div>
+</div>`
+			verify(GinkgoT(), expectedResult, actualContent)
+		})
+
+		It("parts with intro, chapters and special sections", func() {
+			actualContent := `= A book
+:doctype: book
+:sectnums:
+
+[preface]
+== Preface
+
+= Part 1
+
+an intro
+
+== Chapter A
+
+= Part 2
+
+[partintro]
+--
+another intro
+--
+
+== Chapter B
+
+[appendix]
+== Extras
+
+[glossary]
+== Glossary
+
+[colophon]
+== Colophon`
+			expectedResult := `<div class="sect1">
+<h2 id="_preface">Preface</h2>
+<div class="sectionbody">
+</div>
+</div>
+<h1 id="_part_1" class="sect0">Part 1</h1>
+<div class="openblock partintro">
+<div class="content">
+<div class="paragraph">
+<p>an intro</p>
+</div>
+</div>
+</div>
+<div class="sect1">
+<h2 id="_chapter_a">1. Chapter A</h2>
+<div class="sectionbody">
+</div>
+</div>
+<h1 id="_part_2" class="sect0">Part 2</h1>
+<div class="openblock partintro">
+<div class="content">
+<div class="paragraph">
+<p>another intro</p>
+</div>
+</div>
+</div>
+<div class="sect1">
+<h2 id="_chapter_b">2. Chapter B</h2>
+<div class="sectionbody">
+</div>
+</div>
+<div class="sect1">
+<h2 id="_extras">Appendix A: Extras</h2>
+<div class="sectionbody">
+</div>
+</div>
+<div class="sect1">
+<h2 id="_glossary">Glossary</h2>
+<div class="sectionbody">
+</div>
+</div>
+<div class="sect1">
+<h2 id="_colophon">Colophon</h2>
+<div class="sectionbody">
+</div>
+</div>`
+			verify(GinkgoT(), expectedResult, actualContent)
+		})
+	})
+
+	Context("Section IDs", func() {
+
+		It("sections with custom idprefix and idseparator", func() {
+			actualContent := `= A title
+:idprefix: id_
+:idseparator: -
+
+== Section A
+
+=== Section A.a`
+			expectedResult := `<div class="sect1">
+<h2 id="id_section-a">Section A</h2>
+<div class="sectionbody">
+<div class="sect2">
+<h3 id="id_section-a-a">Section A.a</h3>
+</div>
+</div>
+</div>`
+			verify(GinkgoT(), expectedResult, actualContent)
+		})
+
+		It("sections with empty idprefix", func() {
+			actualContent := `= A title
+:idprefix:
+
+== Section A`
+			expectedResult := `<div class="sect1">
+<h2 id="section_a">Section A</h2>
+<div class="sectionbody">
+</div>
+</div>`
+			verify(GinkgoT(), expectedResult, actualContent)
+		})
+
+		It("sections with IDs disabled in the document body", func() {
+			actualContent := `= A title
+
+== Section A
+
+:sectids!:
+
+== Section B
+
+[[custom]]
+== Section C
+
+:sectids:
+
+== Section D`
+			expectedResult := `<div class="sect1">
+<h2 id="_section_a">Section A</h2>
+<div class="sectionbody">
+</div>
+</div>
+<div class="sect1">
+<h2>Section B</h2>
+<div class="sectionbody">
+</div>
+</div>
+<div class="sect1">
+<h2 id="custom">Section C</h2>
+<div class="sectionbody">
+</div>
+</div>
+<div class="sect1">
+<h2 id="_section_d">Section D</h2>
+<div class="sectionbody">
+</div>
+</div>`
+			verify(GinkgoT(), expectedResult, actualContent)
+		})
+
+		It("sections with duplicate titles", func() {
+			actualContent := `== Intro
+
+== Intro
+
+=== Intro`
+			expectedResult := `<div class="sect1">
+<h2 id="_intro">Intro</h2>
+<div class="sectionbody">
+</div>
+</div>
+<div class="sect1">
+<h2 id="_intro_2">Intro</h2>
+<div class="sectionbody">
+<div class="sect2">
+<h3 id="_intro_3">Intro</h3>
+</div>
+</div>
+</div>`
+			verify(GinkgoT(), expectedResult, actualContent)
+		})
+	})
+
+	Context("Section numbers", func() {
+
+		It("numbered sections up to the default level", func() {
+			actualContent := `= A title
+:sectnums:
+
+== Section A
+
+=== Section A.a
+
+==== Section A.a.a
+
+===== Section A.a.a.a
+
+== Section B`
+			expectedResult := `<div class="sect1">
+<h2 id="_section_a">1. Section A</h2>
+<div class="sectionbody">
+<div class="sect2">
+<h3 id="_section_a_a">1.1. Section A.a</h3>
+<div class="sect3">
+<h4 id="_section_a_a_a">1.1.1. Section A.a.a</h4>
+<div class="sect4">
+<h5 id="_section_a_a_a_a">Section A.a.a.a</h5>
+</div>
+</div>
+</div>
+</div>
+</div>
+<div class="sect1">
+<h2 id="_section_b">2. Section B</h2>
+<div class="sectionbody">
+</div>
+</div>`
+			verify(GinkgoT(), expectedResult, actualContent)
+		})
+
+		It("numbered sections with custom level", func() {
+			actualContent := `= A title
+:sectnums:
+:sectnumlevels: 1
+
+== Section A
+
+=== Section A.a`
+			expectedResult := `<div class="sect1">
+<h2 id="_section_a">1. Section A</h2>
+<div class="sectionbody">
+<div class="sect2">
+<h3 id="_section_a_a">Section A.a</h3>
+</div>
+</div>
+</div>`
+			verify(GinkgoT(), expectedResult, actualContent)
+		})
+
+		It("numbered sections without IDs", func() {
+			actualContent := `= A title
+:sectnums:
+
+== Section A
+
+:sectids!:
+
+== Section B
+
+=== Section B.a
+
+== Section C`
+			expectedResult := `<div class="sect1">
+<h2 id="_section_a">1. Section A</h2>
+<div class="sectionbody">
+</div>
+</div>
+<div class="sect1">
+<h2>2. Section B</h2>
+<div class="sectionbody">
+<div class="sect2">
+<h3>2.1. Section B.a</h3>
+</div>
+</div>
+</div>
+<div class="sect1">
+<h2>3. Section C</h2>
+<div class="sectionbody">
+</div>
+</div>`
+			verify(GinkgoT(), expectedResult, actualContent)
+		})
+
+		It("numbering toggled in the document body", func() {
+			actualContent := `= A title
+:sectnums:
+
+== Section A
+
+:sectnums!:
+
+== Section B
+
+:sectnums:
+
+== Section C`
+			expectedResult := `<div class="sect1">
+<h2 id="_section_a">1. Section A</h2>
+<div class="sectionbody">
+</div>
+</div>
+<div class="sect1">
+<h2 id="_section_b">Section B</h2>
+<div class="sectionbody">
+</div>
+</div>
+<div class="sect1">
+<h2 id="_section_c">2. Section C</h2>
+<div class="sectionbody">
+</div>
+</div>`
+			verify(GinkgoT(), expectedResult, actualContent)
+		})
+
+		It("appendix and special sections", func() {
+			actualContent := `= A title
+:sectnums:
+
+== Section A
+
+[appendix]
+== First Appendix
+
+=== Appendix Section
+
+[glossary]
+== Glossary
+
+[appendix]
+== Second Appendix`
+			expectedResult := `<div class="sect1">
+<h2 id="_section_a">1. Section A</h2>
+<div class="sectionbody">
+</div>
+</div>
+<div class="sect1">
+<h2 id="_first_appendix">Appendix A: First Appendix</h2>
+<div class="sectionbody">
+<div class="sect2">
+<h3 id="_appendix_section">A.1. Appendix Section</h3>
+</div>
+</div>
+</div>
+<div class="sect1">
+<h2 id="_glossary">Glossary</h2>
+<div class="sectionbody">
+</div>
+</div>
+<div class="sect1">
+<h2 id="_second_appendix">Appendix B: Second Appendix</h2>
+<div class="sectionbody">
+</div>
+</div>`
+			verify(GinkgoT(), expectedResult, actualContent)
+		})
+	})
 })
@@ -0,0 +1,94 @@
+package html5
+
+import (
+	"bytes"
+	"html"
+	texttemplate "text/template"
+
+	"github.com/bytesparadise/libasciidoc/renderer"
+	"github.com/bytesparadise/libasciidoc/types"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+)
+
+var stemBlockTmpl texttemplate.Template
+
+// initializes the templates
+func init() {
+	stemBlockTmpl = newTextTemplate("stem block", `<div{{ if .ID }} id="{{ .ID }}"{{ end }} class="stemblock">{{ if .Title }}
+<div class="title">{{ escape .Title }}</div>{{ end }}
+<div class="content">
+{{ .Open }}{{ escape .Content }}{{ .Close }}
+</div>
+</div>`,
+		texttemplate.FuncMap{
+			"escape": html.EscapeString,
+		})
+}
+
+// the delimiters of the inline and block STEM expressions, as expected by MathJax, indexed by notation
+var inlineStemDelimiters = map[string][2]string{
+	types.LatexMath: {`\(`, `\)`},
+	types.AsciiMath: {`\$`, `\$`},
+}
+
+var blockStemDelimiters = map[string][2]string{
+	types.LatexMath: {`\[`, `\]`},
+	types.AsciiMath: {`\$`, `\$`},
+}
+
+// stemNotation returns the notation of a STEM expression of the given kind. The notation of the `stem` kind is given
+// by the `stem` document attribute (`latexmath`, `latex` or `tex` for LaTeX, `asciimath` by default).
+func stemNotation(ctx *renderer.Context, kind string) string {
+	if kind != types.Stem {
+		return kind
+	}
+	if notation := ctx.Document.Attributes.GetAsString(types.Stem); notation != nil {
+		switch *notation {
+		case "latexmath", "latex", "tex":
+			return types.LatexMath
+		}
+	}
+	return types.AsciiMath
+}
+
+func renderInlineStem(ctx *renderer.Context, s types.InlineStem) ([]byte, error) {
+	delimiters := inlineStemDelimiters[stemNotation(ctx, s.Kind)]
+	result := bytes.NewBuffer(nil)
+	result.WriteString(delimiters[0])
+	result.WriteString(html.EscapeString(s.Content))
+	result.WriteString(delimiters[1])
+	log.Debugf("rendered inline STEM expression: %s", result.Bytes())
+	return result.Bytes(), nil
+}
+
+func renderStemBlock(ctx *renderer.Context, b types.DelimitedBlock) ([]byte, error) {
+	kind, _ := b.Attributes[types.AttrKind].(string)
+	delimiters := blockStemDelimiters[stemNotation(ctx, kind)]
+	content := bytes.NewBuffer(nil)
+	for _, element := range b.Elements {
+		if s, ok := element.(types.StringElement); ok {
+			content.WriteString(s.Content)
+		}
+	}
+	id, _ := b.Attributes[types.AttrID].(string)
+	title, _ := b.Attributes[types.AttrTitle].(string)
+	result := bytes.NewBuffer(nil)
+	err := stemBlockTmpl.Execute(result, struct {
+		ID      string
+		Title   string
+		Open    string
+		Close   string
+		Content string
+	}{
+		ID:      id,
+		Title:   title,
+		Open:    delimiters[0],
+		Close:   delimiters[1],
+		Content: content.String(),
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to render STEM block")
+	}
+	return result.Bytes(), nil
+}
@@ -0,0 +1,82 @@
+package html5_test
+
+import . "github.com/onsi/ginkgo"
+
+var _ = Describe("STEM", func() {
+
+	Context("inline STEM expressions", func() {
+
+		It("stem macro with the default notation", func() {
+			actualContent := `the answer is stem:[sqrt(4) < 3].`
+			expectedResult := `<div class="paragraph">
+<p>the answer is \$sqrt(4) &lt; 3\$.</p>
+</div>`
+			verify(GinkgoT(), expectedResult, actualContent)
+		})
+
+		It("stem macro with the latexmath notation", func() {
+			actualContent := `:stem: latexmath
+
+the answer is stem:[\sqrt{4} = 2].`
+			expectedResult := `<div class="paragraph">
+<p>the answer is \(\sqrt{4} = 2\).</p>
+</div>`
+			verify(GinkgoT(), expectedResult, actualContent)
+		})
+
+		It("latexmath and asciimath macros", func() {
+			actualContent := `latexmath:[a *b* c] and asciimath:[x...y]`
+			expectedResult := `<div class="paragraph">
+<p>\(a *b* c\) and \$x...y\$</p>
+</div>`
+			verify(GinkgoT(), expectedResult, actualContent)
+		})
+	})
+
+	Context("STEM blocks", func() {
+
+		It("stem block with the default notation", func() {
+			actualContent := `[stem]
+++++
+sqrt(4) = 2
+++++`
+			expectedResult := `<div class="stemblock">
+<div class="content">
+\$sqrt(4) = 2\$
+</div>
+</div>`
+			verify(GinkgoT(), expectedResult, actualContent)
+		})
+
+		It("stem block with the latexmath notation", func() {
+			actualContent := `:stem: latexmath
+
+[stem]
+++++
+\sqrt{4} = 2
+++++`
+			expectedResult := `<div class="stemblock">
+<div class="content">
+\[\sqrt{4} = 2\]
+</div>
+</div>`
+			verify(GinkgoT(), expectedResult, actualContent)
+		})
+
+		It("latexmath block with ID and title", func() {
+			actualContent := `[#equation]
+.An equation
+[latexmath]
+++++
+C = \alpha < \beta
+++++`
+			expectedResult := `<div id="equation" class="stemblock">
+<div class="title">An equation</div>
+<div class="content">
+\[C = \alpha &lt; \beta\]
+</div>
+</div>`
+			verify(GinkgoT(), expectedResult, actualContent)
+		})
+	})
+})
@@ -1,27 +1,23 @@
 package html5
 
 import (
-	"bytes"
-	"html/template"
+	"html"
 
 	"github.com/bytesparadise/libasciidoc/renderer"
 	"github.com/bytesparadise/libasciidoc/types"
-	"github.com/pkg/errors"
 )
 
-var stringElementTmpl template.Template
-
-// initializes the templates
-func init() {
-	stringElementTmpl = newHTMLTemplate("string element", "{{.}}")
-}
-
+// renderStringElement renders the given string element, with its special characters escaped and the typographic
+// replacements applied, unless those substitutions do not apply on the content being rendered
 func renderStringElement(ctx *renderer.Context, str types.StringElement) ([]byte, error) {
-	result := bytes.NewBuffer(nil)
-	err := stringElementTmpl.Execute(result, str.Content)
-	if err != nil {
-		return nil, errors.Wrapf(err, "unable to render string element")
+	result := str.Content
+	subs := ctx.Substitutions()
+	if types.HasSubstitution(subs, types.SpecialCharactersSubstitution) {
+		result = html.EscapeString(result)
+	}
+	if types.HasSubstitution(subs, types.ReplacementsSubstitution) {
+		result = applyReplacements(result)
 	}
-	// log.Debugf("rendered string: %s", result.Bytes())
-	return result.Bytes(), nil
+	// log.Debugf("rendered string: %s", result)
+	return []byte(result), nil
 }
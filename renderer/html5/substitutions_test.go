@@ -0,0 +1,244 @@
+package html5_test
+
+import . "github.com/onsi/ginkgo"
+
+var _ = Describe("Substitutions", func() {
+
+	Context("Listing blocks", func() {
+
+		It("listing block with the default substitutions", func() {
+			actualContent := `:name: value
+
+----
+*bold* <tag> {name} (C)
+----`
+			expectedResult := `<div class="listingblock">
+<div class="content">
+<pre class="highlight"><code>*bold* &lt;tag&gt; {name} (C)</code></pre>
+</div>
+</div>`
+			verify(GinkgoT(), expectedResult, actualContent)
+		})
+
+		It("source block with the attributes substitution appended", func() {
+			actualContent := `:version: 1.0
+
+[source,subs="attributes+"]
+----
+version := "{version}" // <tag>
+----`
+			expectedResult := `<div class="listingblock">
+<div class="content">
+<pre class="highlight"><code>version := &#34;1.0&#34; // &lt;tag&gt;</code></pre>
+</div>
+</div>`
+			verify(GinkgoT(), expectedResult, actualContent)
+		})
+
+		It("listing block with the normal substitutions", func() {
+			actualContent := `:name: value
+
+[subs=normal]
+----
+*bold* <tag> {name}
+(C) on a second line
+----`
+			expectedResult := `<div class="listingblock">
+<div class="content">
+<pre class="highlight"><code><strong>bold</strong> &lt;tag&gt; value
+&#169; on a second line</code></pre>
+</div>
+</div>`
+			verify(GinkgoT(), expectedResult, actualContent)
+		})
+
+		It("listing block with the quotes substitution only", func() {
+			actualContent := `[subs="quotes"]
+----
+*bold* <tag>
+----`
+			expectedResult := `<div class="listingblock">
+<div class="content">
+<pre class="highlight"><code><strong>bold</strong> <tag></code></pre>
+</div>
+</div>`
+			verify(GinkgoT(), expectedResult, actualContent)
+		})
+	})
+
+	Context("Source blocks with a highlighter", func() {
+
+		It("source block with the attributes substitution appended", func() {
+			actualContent := `:source-highlighter: builtin
+:v: 1.0
+
+[source,go,subs="attributes+"]
+----
+v := "{v}"
+----`
+			expectedResult := `<div class="listingblock">
+<div class="content">
+<pre class="builtin highlight"><code class="language-go" data-lang="go">v <span class="o">:=</span> <span class="s">&#34;1.0&#34;</span></code></pre>
+</div>
+</div>`
+			verify(GinkgoT(), expectedResult, actualContent)
+		})
+	})
+
+	Context("Literal blocks", func() {
+
+		It("literal block with the normal substitutions", func() {
+			actualContent := `:name: value
+
+[subs=normal]
+....
+*bold* <tag> {name}
+(C) on a second line
+....`
+			expectedResult := `<div class="literalblock">
+<div class="content">
+<pre><strong>bold</strong> &lt;tag&gt; value
+&#169; on a second line</pre>
+</div>
+</div>`
+			verify(GinkgoT(), expectedResult, actualContent)
+		})
+
+		It("literal block with the default substitutions", func() {
+			actualContent := `:name: value
+
+[#literal]
+....
+*bold* <tag> {name}
+....`
+			expectedResult := `<div class="literalblock">
+<div class="content">
+<pre>*bold* &lt;tag&gt; {name}</pre>
+</div>
+</div>`
+			verify(GinkgoT(), expectedResult, actualContent)
+		})
+	})
+
+	Context("Paragraphs", func() {
+
+		It("paragraph without substitution", func() {
+			actualContent := `:name: value
+
+[subs=none]
+*bold* <b>html</b> {name}`
+			expectedResult := `<div class="paragraph">
+<p>*bold* <b>html</b> {name}</p>
+</div>`
+			verify(GinkgoT(), expectedResult, actualContent)
+		})
+
+		It("paragraph without the replacements and the quotes", func() {
+			actualContent := `:name: value
+
+[subs="-replacements,-quotes"]
+*bold* (C) {name}`
+			expectedResult := `<div class="paragraph">
+<p>*bold* (C) value</p>
+</div>`
+			verify(GinkgoT(), expectedResult, actualContent)
+		})
+
+		It("paragraph with the verbatim substitutions", func() {
+			actualContent := `[subs=verbatim]
+*bold* <tag> (C) https://foo.com`
+			expectedResult := `<div class="paragraph">
+<p>*bold* &lt;tag&gt; (C) https://foo.com</p>
+</div>`
+			verify(GinkgoT(), expectedResult, actualContent)
+		})
+	})
+
+	Context("Order of the substitutions", func() {
+
+		It("listing block with the attributes substitution before the quotes substitution", func() {
+			actualContent := `:name: *bold* value
+
+[subs="attributes,quotes"]
+----
+{name} and *more*
+----`
+			expectedResult := `<div class="listingblock">
+<div class="content">
+<pre class="highlight"><code><strong>bold</strong> value and <strong>more</strong></code></pre>
+</div>
+</div>`
+			verify(GinkgoT(), expectedResult, actualContent)
+		})
+
+		It("listing block with the quotes substitution before the attributes substitution", func() {
+			actualContent := `:name: *bold* value
+
+[subs="quotes,attributes"]
+----
+{name} and *more*
+----`
+			expectedResult := `<div class="listingblock">
+<div class="content">
+<pre class="highlight"><code>*bold* value and <strong>more</strong></code></pre>
+</div>
+</div>`
+			verify(GinkgoT(), expectedResult, actualContent)
+		})
+
+		It("listing block with the attributes substitution before the special characters substitution", func() {
+			actualContent := `:tag: <b>
+
+[subs="attributes,specialcharacters"]
+----
+{tag} and <i>
+----`
+			expectedResult := `<div class="listingblock">
+<div class="content">
+<pre class="highlight"><code>&lt;b&gt; and &lt;i&gt;</code></pre>
+</div>
+</div>`
+			verify(GinkgoT(), expectedResult, actualContent)
+		})
+
+		It("listing block with the special characters substitution before the attributes substitution", func() {
+			actualContent := `:tag: <b>
+
+[subs="specialcharacters,attributes"]
+----
+{tag} and <i>
+----`
+			expectedResult := `<div class="listingblock">
+<div class="content">
+<pre class="highlight"><code><b> and &lt;i&gt;</code></pre>
+</div>
+</div>`
+			verify(GinkgoT(), expectedResult, actualContent)
+		})
+
+		It("paragraph with the attributes substitution before the macros substitution", func() {
+			actualContent := `:url: https://example.com[a link]
+
+[subs="attributes,macros"]
+see {url}`
+			expectedResult := `<div class="paragraph">
+<p>see <a href="https://example.com">a link</a></p>
+</div>`
+			verify(GinkgoT(), expectedResult, actualContent)
+		})
+	})
+
+	Context("Passthrough blocks", func() {
+
+		It("passthrough block with the attributes substitution", func() {
+			actualContent := `:name: value
+
+[subs=attributes]
+++++
+<p>{name}</p>
+++++`
+			expectedResult := `<p>value</p>`
+			verify(GinkgoT(), expectedResult, actualContent)
+		})
+	})
+})
@@ -0,0 +1,161 @@
+package html5
+
+import (
+	"bytes"
+	"fmt"
+	"html/template"
+	"strings"
+
+	"github.com/bytesparadise/libasciidoc/renderer"
+	"github.com/bytesparadise/libasciidoc/types"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+)
+
+var tableTmpl template.Template
+
+// initializes the templates
+func init() {
+	tableTmpl = newHTMLTemplate("table", `<table{{ if .ID }} id="{{ .ID }}"{{ end }} class="tableblock frame-{{ .Frame }} grid-{{ .Grid }} spread">{{ if .Title }}
+<caption class="title">{{ .Title }}</caption>{{ end }}{{ if .Columns }}
+<colgroup>{{ range .Columns }}
+<col style="width: {{ .Width }}%;">{{ end }}
+</colgroup>{{ end }}{{ if .Header }}
+<thead>
+<tr>{{ range .Header }}
+<th class="tableblock halign-{{ .HAlign }} valign-{{ .VAlign }}">{{ .Content }}</th>{{ end }}
+</tr>
+</thead>{{ end }}{{ if .Footer }}
+<tfoot>
+<tr>{{ range .Footer }}
+<td class="tableblock halign-{{ .HAlign }} valign-{{ .VAlign }}">{{ if .Content }}<p class="tableblock">{{ .Content }}</p>{{ end }}</td>{{ end }}
+</tr>
+</tfoot>{{ end }}{{ if .Rows }}
+<tbody>{{ range .Rows }}
+<tr>{{ range . }}
+<td class="tableblock halign-{{ .HAlign }} valign-{{ .VAlign }}">{{ if .Content }}<p class="tableblock">{{ .Content }}</p>{{ end }}</td>{{ end }}
+</tr>{{ end }}
+</tbody>{{ end }}
+</table>`)
+}
+
+type renderedTableColumn struct {
+	Width string
+}
+
+type renderedTableCell struct {
+	HAlign  types.HAlignment
+	VAlign  types.VAlignment
+	Content template.HTML
+}
+
+func renderTable(ctx *renderer.Context, t types.Table) ([]byte, error) {
+	log.Debugf("rendering table with %d row(s)", len(t.Rows))
+	result := bytes.NewBuffer(nil)
+	var id, title string
+	if i, ok := t.Attributes[types.AttrID].(string); ok {
+		id = i
+	}
+	if tt, ok := t.Attributes[types.AttrTitle].(string); ok {
+		title = tt
+		if caption, ok := t.Attributes[types.AttrCaption].(string); ok {
+			title = fmt.Sprintf("%s. %s", caption, tt)
+		}
+	}
+	frame := "all"
+	if f, ok := t.Attributes["frame"].(string); ok {
+		frame = f
+	}
+	grid := "all"
+	if g, ok := t.Attributes["grid"].(string); ok {
+		grid = g
+	}
+	var header, footer []renderedTableCell
+	var err error
+	if t.Header != nil {
+		header, err = renderTableCells(ctx, t.Columns, *t.Header)
+		if err != nil {
+			return nil, errors.Wrapf(err, "unable to render table")
+		}
+	}
+	if t.Footer != nil {
+		footer, err = renderTableCells(ctx, t.Columns, *t.Footer)
+		if err != nil {
+			return nil, errors.Wrapf(err, "unable to render table")
+		}
+	}
+	rows := make([][]renderedTableCell, len(t.Rows))
+	for i, row := range t.Rows {
+		rows[i], err = renderTableCells(ctx, t.Columns, row)
+		if err != nil {
+			return nil, errors.Wrapf(err, "unable to render table")
+		}
+	}
+	err = tableTmpl.Execute(result, struct {
+		ID      string
+		Title   string
+		Frame   string
+		Grid    string
+		Columns []renderedTableColumn
+		Header  []renderedTableCell
+		Footer  []renderedTableCell
+		Rows    [][]renderedTableCell
+	}{
+		ID:      id,
+		Title:   title,
+		Frame:   frame,
+		Grid:    grid,
+		Columns: renderTableColumns(t.Columns),
+		Header:  header,
+		Footer:  footer,
+		Rows:    rows,
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to render table")
+	}
+	log.Debugf("rendered table: %s", result.Bytes())
+	return result.Bytes(), nil
+}
+
+func renderTableCells(ctx *renderer.Context, columns []types.TableColumn, row types.TableRow) ([]renderedTableCell, error) {
+	result := make([]renderedTableCell, len(row.Cells))
+	for i, cell := range row.Cells {
+		renderedContent, err := renderInlineContent(ctx, types.InlineContent{Elements: cell.Elements})
+		if err != nil {
+			return nil, errors.Wrapf(err, "unable to render table cell")
+		}
+		result[i] = renderedTableCell{
+			HAlign:  columns[i].HAlign,
+			VAlign:  columns[i].VAlign,
+			Content: template.HTML(renderedContent),
+		}
+	}
+	return result, nil
+}
+
+// renderTableColumns computes the width of each column as a percentage of the total width,
+// truncated to 4 decimals. The last column takes the remainder, so that the total is 100%.
+func renderTableColumns(columns []types.TableColumn) []renderedTableColumn {
+	result := make([]renderedTableColumn, len(columns))
+	totalWeight := 0
+	for _, c := range columns {
+		totalWeight += c.Weight
+	}
+	if totalWeight == 0 {
+		return result
+	}
+	// widths are computed in 1/10000th of percent to avoid rounding errors
+	remaining := 100 * 10000
+	for i, c := range columns {
+		width := c.Weight * 100 * 10000 / totalWeight
+		if i == len(columns)-1 {
+			width = remaining
+		}
+		remaining -= width
+		w := fmt.Sprintf("%d.%04d", width/10000, width%10000)
+		result[i] = renderedTableColumn{
+			Width: strings.TrimSuffix(strings.TrimRight(w, "0"), "."),
+		}
+	}
+	return result
+}
@@ -46,7 +46,7 @@ A preamble...
 <div id="preamble">
 <div class="sectionbody">
 <div class="paragraph">
-<p>A preamble...</p>
+<p>A preamble&#8230;&#8203;</p>
 </div>
 </div>
 </div>
@@ -131,7 +131,7 @@ A preamble...
 <div id="preamble">
 <div class="sectionbody">
 <div class="paragraph">
-<p>A preamble...</p>
+<p>A preamble&#8230;&#8203;</p>
 </div>
 </div>
 </div>
@@ -190,7 +190,7 @@ A preamble...
 <div id="preamble">
 <div class="sectionbody">
 <div class="paragraph">
-<p>A preamble...</p>
+<p>A preamble&#8230;&#8203;</p>
 </div>
 </div>
 </div>
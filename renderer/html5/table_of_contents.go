@@ -19,7 +19,7 @@ func init() {
 {{.Content}}
 </div>`)
 	tableOfContentSectionSetTmpl = newHTMLTemplate("toc section", `<ul class="sectlevel{{.Level}}">
-{{ range .Elements }}<li><a href="#{{.Href}}">{{.Title}}</a>{{ if .Subelements }}
+{{ range .Elements }}<li><a href="#{{.Href}}">{{.Number}}{{.Title}}</a>{{ if .Subelements }}
 {{.Subelements}}
 </li>{{else}}</li>{{end}}
 {{end}}</ul>`)
@@ -35,13 +35,14 @@ type TableOfContentSectionGroup struct {
 type TableOfContentSection struct {
 	Level       int
 	Href        string
+	Number      string
 	Title       template.HTML
 	Subelements *template.HTML
 }
 
 func renderTableOfContent(ctx *renderer.Context, m types.TableOfContentsMacro) ([]byte, error) {
 	result := bytes.NewBuffer(nil)
-	renderedSections, err := renderTableOfContentSections(ctx, ctx.Document.Elements, 1)
+	renderedSections, err := renderTableOfContentSections(ctx, ctx.Document.Elements)
 	if err != nil {
 		return nil, errors.Wrapf(err, "error while rendering table of content")
 	}
@@ -55,7 +56,7 @@ func renderTableOfContent(ctx *renderer.Context, m types.TableOfContentsMacro) (
 	return result.Bytes(), nil
 }
 
-func renderTableOfContentSections(ctx *renderer.Context, elements []types.DocElement, currentLevel int) (*template.HTML, error) {
+func renderTableOfContentSections(ctx *renderer.Context, elements []types.DocElement) (*template.HTML, error) {
 	sections := make([]TableOfContentSection, 0)
 	for _, element := range elements {
 		log.Debugf("traversing document element of type %T", element)
@@ -70,8 +71,9 @@ func renderTableOfContentSections(ctx *renderer.Context, elements []types.DocEle
 				return nil, errors.Wrapf(err, "error while rendering table of content section")
 			}
 			var renderedChildSections *template.HTML
-			if currentLevel < *tocLevels {
-				renderedChildSections, err = renderTableOfContentSections(ctx, section.Elements, currentLevel+1)
+			// the TOC includes the sections up to the `toclevels` level (in addition to the parts, which are level-0 sections)
+			if section.Level < *tocLevels {
+				renderedChildSections, err = renderTableOfContentSections(ctx, section.Elements)
 				if err != nil {
 					return nil, errors.Wrapf(err, "error while rendering table of content section")
 				}
@@ -83,6 +85,7 @@ func renderTableOfContentSections(ctx *renderer.Context, elements []types.DocEle
 			sections = append(sections, TableOfContentSection{
 				Level:       section.Level,
 				Href:        id,
+				Number:      section.Title.Number,
 				Title:       template.HTML(string(renderedTitle)),
 				Subelements: renderedChildSections,
 			})
@@ -91,9 +94,16 @@ func renderTableOfContentSections(ctx *renderer.Context, elements []types.DocEle
 	if len(sections) == 0 {
 		return nil, nil
 	}
+	// the level of the group is the lowest level of its sections (eg: a preface before the parts of a book is in the `sectlevel0` group)
+	level := sections[0].Level
+	for _, section := range sections {
+		if section.Level < level {
+			level = section.Level
+		}
+	}
 	resultBuf := bytes.NewBuffer(nil)
 	tableOfContentSectionSetTmpl.Execute(resultBuf, TableOfContentSectionGroup{
-		Level:    sections[0].Level,
+		Level:    level,
 		Elements: sections,
 	})
 	log.Debugf("retrieved sections for TOC: %+v", sections)
@@ -8,6 +8,95 @@ var _ = Describe("Document TOC", func() {
 
 	Context("Document with TOC", func() {
 
+		It("TOC with parts", func() {
+			actualContent := `= A book
+:doctype: book
+:toc:
+
+= Part 1
+
+== Chapter A
+
+=== Section A.a
+
+= Part 2
+
+== Chapter B`
+
+			expectedResult := `<div id="toc" class="toc">
+<div id="toctitle">Table of Contents</div>
+<ul class="sectlevel0">
+<li><a href="#_part_1">Part 1</a>
+<ul class="sectlevel1">
+<li><a href="#_chapter_a">Chapter A</a>
+<ul class="sectlevel2">
+<li><a href="#_section_a_a">Section A.a</a></li>
+</ul>
+</li>
+</ul>
+</li>
+<li><a href="#_part_2">Part 2</a>
+<ul class="sectlevel1">
+<li><a href="#_chapter_b">Chapter B</a></li>
+</ul>
+</li>
+</ul>
+</div>
+<h1 id="_part_1" class="sect0">Part 1</h1>
+<div class="sect1">
+<h2 id="_chapter_a">Chapter A</h2>
+<div class="sectionbody">
+<div class="sect2">
+<h3 id="_section_a_a">Section A.a</h3>
+</div>
+</div>
+</div>
+<h1 id="_part_2" class="sect0">Part 2</h1>
+<div class="sect1">
+<h2 id="_chapter_b">Chapter B</h2>
+<div class="sectionbody">
+</div>
+</div>`
+			verify(GinkgoT(), expectedResult, actualContent)
+		})
+
+		It("TOC with a preface before the parts", func() {
+			actualContent := `= A book
+:doctype: book
+:toc:
+
+[preface]
+== Preface
+
+= Part 1
+
+== Chapter A`
+
+			expectedResult := `<div id="toc" class="toc">
+<div id="toctitle">Table of Contents</div>
+<ul class="sectlevel0">
+<li><a href="#_preface">Preface</a></li>
+<li><a href="#_part_1">Part 1</a>
+<ul class="sectlevel1">
+<li><a href="#_chapter_a">Chapter A</a></li>
+</ul>
+</li>
+</ul>
+</div>
+<div class="sect1">
+<h2 id="_preface">Preface</h2>
+<div class="sectionbody">
+</div>
+</div>
+<h1 id="_part_1" class="sect0">Part 1</h1>
+<div class="sect1">
+<h2 id="_chapter_a">Chapter A</h2>
+<div class="sectionbody">
+</div>
+</div>`
+			verify(GinkgoT(), expectedResult, actualContent)
+		})
+
 		It("TOC with default level", func() {
 			actualContent := `= A title
 :toc:
@@ -48,7 +137,7 @@ A preamble...
 <div id="preamble">
 <div class="sectionbody">
 <div class="paragraph">
-<p>A preamble...</p>
+<p>A preamble&#8230;&#8203;</p>
 </div>
 </div>
 </div>
@@ -133,7 +222,7 @@ A preamble...
 <div id="preamble">
 <div class="sectionbody">
 <div class="paragraph">
-<p>A preamble...</p>
+<p>A preamble&#8230;&#8203;</p>
 </div>
 </div>
 </div>
@@ -171,4 +260,44 @@ A preamble...
 		})
 
 	})
+
+	Context("Document with TOC and section numbers", func() {
+
+		It("TOC and headings with the same numbers", func() {
+			actualContent := `= A title
+:toc:
+:sectnums:
+
+== Section A
+
+=== Section A.a
+
+== Section B`
+			expectedResult := `<div id="toc" class="toc">
+<div id="toctitle">Table of Contents</div>
+<ul class="sectlevel1">
+<li><a href="#_section_a">1. Section A</a>
+<ul class="sectlevel2">
+<li><a href="#_section_a_a">1.1. Section A.a</a></li>
+</ul>
+</li>
+<li><a href="#_section_b">2. Section B</a></li>
+</ul>
+</div>
+<div class="sect1">
+<h2 id="_section_a">1. Section A</h2>
+<div class="sectionbody">
+<div class="sect2">
+<h3 id="_section_a_a">1.1. Section A.a</h3>
+</div>
+</div>
+</div>
+<div class="sect1">
+<h2 id="_section_b">2. Section B</h2>
+<div class="sectionbody">
+</div>
+</div>`
+			verify(GinkgoT(), expectedResult, actualContent)
+		})
+	})
 })
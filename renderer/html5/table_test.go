@@ -0,0 +1,110 @@
+package html5_test
+
+import . "github.com/onsi/ginkgo"
+
+var _ = Describe("Tables", func() {
+
+	It("1-line table with 2 cells", func() {
+		actualContent := `|===
+| *cookie* cake | pasta
+|===`
+		expectedResult := `<table class="tableblock frame-all grid-all spread">
+<colgroup>
+<col style="width: 50%;">
+<col style="width: 50%;">
+</colgroup>
+<tbody>
+<tr>
+<td class="tableblock halign-left valign-top"><p class="tableblock"><strong>cookie</strong> cake</p></td>
+<td class="tableblock halign-left valign-top"><p class="tableblock">pasta</p></td>
+</tr>
+</tbody>
+</table>`
+		verify(GinkgoT(), expectedResult, actualContent)
+	})
+
+	It("table with title, implicit header and empty cell", func() {
+		actualContent := `.table title
+|===
+|Column 1 |Column 2 |Column 3
+
+|a |b |
+|===`
+		expectedResult := `<table class="tableblock frame-all grid-all spread">
+<caption class="title">Table 1. table title</caption>
+<colgroup>
+<col style="width: 33.3333%;">
+<col style="width: 33.3333%;">
+<col style="width: 33.3334%;">
+</colgroup>
+<thead>
+<tr>
+<th class="tableblock halign-left valign-top">Column 1</th>
+<th class="tableblock halign-left valign-top">Column 2</th>
+<th class="tableblock halign-left valign-top">Column 3</th>
+</tr>
+</thead>
+<tbody>
+<tr>
+<td class="tableblock halign-left valign-top"><p class="tableblock">a</p></td>
+<td class="tableblock halign-left valign-top"><p class="tableblock">b</p></td>
+<td class="tableblock halign-left valign-top"></td>
+</tr>
+</tbody>
+</table>`
+		verify(GinkgoT(), expectedResult, actualContent)
+	})
+
+	It("tables with id, columns, header, footer and frame attributes", func() {
+		actualContent := `[[cookies]]
+.first table
+[cols="3,>1", options="header,footer", frame="topbot", grid="rows"]
+|===
+|Cookie |Quantity
+|chocolate |2
+|Total |2
+|===
+
+.second table
+|===
+|a
+|===`
+		expectedResult := `<table id="cookies" class="tableblock frame-topbot grid-rows spread">
+<caption class="title">Table 1. first table</caption>
+<colgroup>
+<col style="width: 75%;">
+<col style="width: 25%;">
+</colgroup>
+<thead>
+<tr>
+<th class="tableblock halign-left valign-top">Cookie</th>
+<th class="tableblock halign-right valign-top">Quantity</th>
+</tr>
+</thead>
+<tfoot>
+<tr>
+<td class="tableblock halign-left valign-top"><p class="tableblock">Total</p></td>
+<td class="tableblock halign-right valign-top"><p class="tableblock">2</p></td>
+</tr>
+</tfoot>
+<tbody>
+<tr>
+<td class="tableblock halign-left valign-top"><p class="tableblock">chocolate</p></td>
+<td class="tableblock halign-right valign-top"><p class="tableblock">2</p></td>
+</tr>
+</tbody>
+</table>
+<table class="tableblock frame-all grid-all spread">
+<caption class="title">Table 2. second table</caption>
+<colgroup>
+<col style="width: 100%;">
+</colgroup>
+<tbody>
+<tr>
+<td class="tableblock halign-left valign-top"><p class="tableblock">a</p></td>
+</tr>
+</tbody>
+</table>`
+		verify(GinkgoT(), expectedResult, actualContent)
+	})
+})
@@ -0,0 +1,56 @@
+package html5
+
+import (
+	"bytes"
+	"html/template"
+
+	"github.com/bytesparadise/libasciidoc/renderer"
+	"github.com/bytesparadise/libasciidoc/types"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+)
+
+var keyboardShortcutTmpl template.Template
+var buttonTmpl template.Template
+var menuSelectionTmpl template.Template
+
+// initializes the templates
+func init() {
+	keyboardShortcutTmpl = newHTMLTemplate("keyboard shortcut",
+		`{{ if eq (len .Keys) 1 }}<kbd>{{ index .Keys 0 }}</kbd>{{ else }}<span class="keyseq">{{ range $i, $key := .Keys }}{{ if $i }}+{{ end }}<kbd>{{ $key }}</kbd>{{ end }}</span>{{ end }}`)
+	buttonTmpl = newHTMLTemplate("button", `<b class="button">{{ .Label }}</b>`)
+	menuSelectionTmpl = newHTMLTemplate("menu selection",
+		`{{ $items := .Items }}{{ if eq (len $items) 0 }}<b class="menuref">{{ .Menu }}</b>{{ else }}<span class="menuseq"><b class="menu">{{ .Menu }}</b>&#160;<b class="caret">&#8250;</b> {{ range $i, $item := $items }}{{ if lastItem $i $items }}<b class="menuitem">{{ $item }}</b>{{ else }}<b class="submenu">{{ $item }}</b>&#160;<b class="caret">&#8250;</b> {{ end }}{{ end }}</span>{{ end }}`,
+		template.FuncMap{
+			"lastItem": func(i int, items []string) bool {
+				return i == len(items)-1
+			},
+		})
+}
+
+func renderKeyboardShortcut(ctx *renderer.Context, k types.KeyboardShortcut) ([]byte, error) {
+	result := bytes.NewBuffer(nil)
+	if err := keyboardShortcutTmpl.Execute(result, k); err != nil {
+		return nil, errors.Wrapf(err, "unable to render keyboard shortcut")
+	}
+	log.Debugf("rendered keyboard shortcut: %s", result.Bytes())
+	return result.Bytes(), nil
+}
+
+func renderButton(ctx *renderer.Context, b types.Button) ([]byte, error) {
+	result := bytes.NewBuffer(nil)
+	if err := buttonTmpl.Execute(result, b); err != nil {
+		return nil, errors.Wrapf(err, "unable to render button")
+	}
+	log.Debugf("rendered button: %s", result.Bytes())
+	return result.Bytes(), nil
+}
+
+func renderMenuSelection(ctx *renderer.Context, m types.MenuSelection) ([]byte, error) {
+	result := bytes.NewBuffer(nil)
+	if err := menuSelectionTmpl.Execute(result, m); err != nil {
+		return nil, errors.Wrapf(err, "unable to render menu selection")
+	}
+	log.Debugf("rendered menu selection: %s", result.Bytes())
+	return result.Bytes(), nil
+}
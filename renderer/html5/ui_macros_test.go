@@ -0,0 +1,104 @@
+package html5_test
+
+import . "github.com/onsi/ginkgo"
+
+var _ = Describe("UI Macros", func() {
+
+	Context("with the experimental attribute", func() {
+
+		It("keyboard macro with a single key", func() {
+			actualContent := `:experimental:
+
+press kbd:[F11] to toggle the full screen mode`
+			expectedResult := `<div class="paragraph">
+<p>press <kbd>F11</kbd> to toggle the full screen mode</p>
+</div>`
+			verify(GinkgoT(), expectedResult, actualContent)
+		})
+
+		It("keyboard macro with multiple keys", func() {
+			actualContent := `:experimental:
+
+press kbd:[Ctrl+Shift+T] to reopen the tab`
+			expectedResult := `<div class="paragraph">
+<p>press <span class="keyseq"><kbd>Ctrl</kbd>+<kbd>Shift</kbd>+<kbd>T</kbd></span> to reopen the tab</p>
+</div>`
+			verify(GinkgoT(), expectedResult, actualContent)
+		})
+
+		It("button macro", func() {
+			actualContent := `:experimental:
+
+click btn:[Save]`
+			expectedResult := `<div class="paragraph">
+<p>click <b class="button">Save</b></p>
+</div>`
+			verify(GinkgoT(), expectedResult, actualContent)
+		})
+
+		It("menu macro without item", func() {
+			actualContent := `:experimental:
+
+open the menu:File[] menu`
+			expectedResult := `<div class="paragraph">
+<p>open the <b class="menuref">File</b> menu</p>
+</div>`
+			verify(GinkgoT(), expectedResult, actualContent)
+		})
+
+		It("menu macro with item", func() {
+			actualContent := `:experimental:
+
+select menu:File[Save As]`
+			expectedResult := `<div class="paragraph">
+<p>select <span class="menuseq"><b class="menu">File</b>&#160;<b class="caret">&#8250;</b> <b class="menuitem">Save As</b></span></p>
+</div>`
+			verify(GinkgoT(), expectedResult, actualContent)
+		})
+
+		It("menu macro with submenu and item", func() {
+			actualContent := `:experimental:
+
+select menu:View[Zoom > Reset]`
+			expectedResult := `<div class="paragraph">
+<p>select <span class="menuseq"><b class="menu">View</b>&#160;<b class="caret">&#8250;</b> <b class="submenu">Zoom</b>&#160;<b class="caret">&#8250;</b> <b class="menuitem">Reset</b></span></p>
+</div>`
+			verify(GinkgoT(), expectedResult, actualContent)
+		})
+	})
+
+	Context("without the experimental attribute", func() {
+
+		It("macros rendered as text", func() {
+			actualContent := `press kbd:[Ctrl+T] and btn:[OK]`
+			expectedResult := `<div class="paragraph">
+<p>press kbd:[Ctrl+T] and btn:[OK]</p>
+</div>`
+			verify(GinkgoT(), expectedResult, actualContent)
+		})
+
+		It("keyboard macro kept as-is", func() {
+			actualContent := `press kbd:[Ctrl , T]`
+			expectedResult := `<div class="paragraph">
+<p>press kbd:[Ctrl , T]</p>
+</div>`
+			verify(GinkgoT(), expectedResult, actualContent)
+		})
+
+		It("menu macro kept as-is", func() {
+			actualContent := `select menu:File[Save As  >  Copy]`
+			expectedResult := `<div class="paragraph">
+<p>select menu:File[Save As  &gt;  Copy]</p>
+</div>`
+			verify(GinkgoT(), expectedResult, actualContent)
+		})
+
+		It("button macro with quoted text", func() {
+			actualContent := `click btn:[ *Save* ]`
+			expectedResult := `<div class="paragraph">
+<p>click btn:[ <strong>Save</strong> ]</p>
+</div>`
+			verify(GinkgoT(), expectedResult, actualContent)
+		})
+	})
+})
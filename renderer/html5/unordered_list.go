@@ -15,8 +15,8 @@ var unorderedListTmpl texttemplate.Template
 // initializes the templates
 func init() {
 	unorderedListTmpl = newTextTemplate("unordered list",
-		`{{ $ctx := .Context }}{{ with .Data }}<div{{ if index .Attributes "ID" }} id="{{ index .Attributes "ID" }}"{{ end }} class="ulist">
-<ul>
+		`{{ $ctx := .Context }}{{ with .Data }}<div{{ if index .Attributes "ID" }} id="{{ index .Attributes "ID" }}"{{ end }} class="ulist{{ if .Bibliography }} bibliography{{ end }}">
+<ul{{ if .Bibliography }} class="bibliography"{{ end }}>
 {{ $items := .Items }}{{ range $itemIndex, $item := $items }}<li>
 {{ $elements := $item.Elements }}{{ range $elementIndex, $element := $elements }}{{ renderElement $ctx $element | printf "%s" }}{{ if notLastItem $elementIndex $elements }}{{ print "\n" }}{{ end }}{{ end }}
 </li>
@@ -35,7 +35,13 @@ func renderUnorderedList(ctx *renderer.Context, l types.UnorderedList) ([]byte,
 	// here we must preserve the HTML tags
 	err := unorderedListTmpl.Execute(result, ContextualPipeline{
 		Context: ctx,
-		Data:    l,
+		Data: struct {
+			types.UnorderedList
+			Bibliography bool
+		}{
+			UnorderedList: l,
+			Bibliography:  l.Attributes[types.AttrKind] == types.Bibliography,
+		},
 	})
 	if err != nil {
 		return nil, errors.Wrapf(err, "unable to render unordered list")
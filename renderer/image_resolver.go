@@ -0,0 +1,71 @@
+package renderer
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// ImageResolver resolves the images which are embedded as data URIs (ie, when the `data-uri` attribute is set)
+type ImageResolver interface {
+	// Size returns the size (in bytes) of the image at the given path, so that the images which exceed the size
+	// limit of the data URIs are not read
+	Size(path string) (int64, error)
+	// Resolve returns the content of the image at the given path
+	Resolve(path string) ([]byte, error)
+}
+
+// FileImageResolver the default ImageResolver, which reads the images on the local filesystem.
+// Only the images in the base directory (or in its subdirectories) can be embedded, so that the conversion
+// of a document does not disclose the content of other files on the host.
+type FileImageResolver struct {
+	// BaseDir the directory of the images which can be embedded (the current directory if empty)
+	BaseDir string
+}
+
+// Size implements ImageResolver#Size(string)
+func (r FileImageResolver) Size(path string) (int64, error) {
+	if err := r.checkPath(path); err != nil {
+		return 0, err
+	}
+	info, err := os.Stat(path)
+	if err != nil {
+		return 0, err
+	}
+	return info.Size(), nil
+}
+
+// Resolve implements ImageResolver#Resolve(string)
+func (r FileImageResolver) Resolve(path string) ([]byte, error) {
+	if err := r.checkPath(path); err != nil {
+		return nil, err
+	}
+	return ioutil.ReadFile(path)
+}
+
+// checkPath returns an error if the given path is not in the base directory or in one of its subdirectories
+func (r FileImageResolver) checkPath(path string) error {
+	dir := r.BaseDir
+	if dir == "" {
+		dir = "."
+	}
+	absDir, err := filepath.Abs(dir)
+	if err != nil {
+		return errors.Wrapf(err, "unable to resolve '%s'", path)
+	}
+	absPath, err := filepath.Abs(path)
+	if err != nil {
+		return errors.Wrapf(err, "unable to resolve '%s'", path)
+	}
+	rel, err := filepath.Rel(absDir, absPath)
+	if err != nil {
+		return errors.Wrapf(err, "unable to resolve '%s'", path)
+	}
+	if rel == ".." || strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+		return errors.Errorf("'%s' is outside of the base directory", path)
+	}
+	return nil
+}
@@ -1,6 +1,13 @@
 package renderer
 
-import "time"
+import (
+	"path/filepath"
+	"time"
+
+	"github.com/bytesparadise/libasciidoc/parser"
+	"github.com/bytesparadise/libasciidoc/renderer/highlight"
+	"github.com/bytesparadise/libasciidoc/types"
+)
 
 //Option the options when rendering a document
 type Option func(ctx *Context)
@@ -11,8 +18,22 @@ const (
 	keyLastUpdated string = "LastUpdated"
 	//keyIncludeHeaderFooter a bool value to indicate if the header and footer should be rendered
 	keyIncludeHeaderFooter string = "IncludeHeaderFooter"
+	//keyIncludeMathJax a bool value to indicate if the MathJax script should be included in the header of a document with STEM content
+	keyIncludeMathJax string = "IncludeMathJax"
 	//keyEntrypoint a bool value to indicate if the entrypoint to start with when parsing the document
 	keyEntrypoint string = "Entrypoint"
+	//keyIncludeResolver the resolver to use to read the files included with the `include::` directive
+	keyIncludeResolver string = "IncludeResolver"
+	//keyDataURIResolver the resolver to use to read the images embedded as data URIs
+	keyDataURIResolver string = "DataURIResolver"
+	//keyFilename the name of the file being rendered, against which the paths of the embedded images are resolved
+	keyFilename string = "Filename"
+	//keyDataURISizeLimit the maximum size (in bytes) of the images embedded as data URIs
+	keyDataURISizeLimit string = "DataURISizeLimit"
+	//keyDefinedDocumentAttributes the document attributes defined via the API
+	keyDefinedDocumentAttributes string = "DefinedDocumentAttributes"
+	//keySourceHighlighters the custom syntax highlighters of the source blocks, indexed by name
+	keySourceHighlighters string = "SourceHighlighters"
 	// LastUpdatedFormat the time format for the `last updated` document attribute
 	LastUpdatedFormat string = "2006/01/02 15:04:05 MST"
 )
@@ -31,6 +52,14 @@ func IncludeHeaderFooter(value bool) Option {
 	}
 }
 
+// IncludeMathJax function to set the `include MathJax` option in the renderer context. When the header and footer
+// are included, the MathJax script is then included in the header of the documents in which the `stem` attribute is set.
+func IncludeMathJax(value bool) Option {
+	return func(ctx *Context) {
+		ctx.options[keyIncludeMathJax] = value
+	}
+}
+
 // Entrypoint function to set the `entrypoint` option in the renderer context
 func Entrypoint(entrypoint string) Option {
 	return func(ctx *Context) {
@@ -38,6 +67,59 @@ func Entrypoint(entrypoint string) Option {
 	}
 }
 
+// IncludeResolver function to set the resolver of the files included with the `include::` directive in the renderer context
+// (default is a resolver which reads the files in the directory of the document and its subdirectories on the local filesystem)
+func IncludeResolver(resolver parser.IncludeResolver) Option {
+	return func(ctx *Context) {
+		ctx.options[keyIncludeResolver] = resolver
+	}
+}
+
+// Filename function to set the name of the file being rendered in the renderer context. The paths of the images embedded
+// as data URIs (with the `data-uri` document attribute) are resolved relative to this file.
+func Filename(filename string) Option {
+	return func(ctx *Context) {
+		ctx.options[keyFilename] = filename
+	}
+}
+
+// DataURIResolver function to set the resolver of the images embedded as data URIs in the renderer context
+// (default is a resolver which reads the images in the directory of the document and its subdirectories on the local filesystem)
+func DataURIResolver(resolver ImageResolver) Option {
+	return func(ctx *Context) {
+		ctx.options[keyDataURIResolver] = resolver
+	}
+}
+
+// DataURISizeLimit function to set the maximum size (in bytes) of the images embedded as data URIs in the renderer context.
+// The images which exceed this size are not embedded (default is no limit).
+func DataURISizeLimit(limit int) Option {
+	return func(ctx *Context) {
+		ctx.options[keyDataURISizeLimit] = limit
+	}
+}
+
+// DefineDocumentAttributes function to set the document attributes in the renderer context. These attributes are
+// defined before the document is processed, and can be overridden by the attributes declared in the document.
+func DefineDocumentAttributes(attributes map[string]string) Option {
+	return func(ctx *Context) {
+		ctx.options[keyDefinedDocumentAttributes] = attributes
+	}
+}
+
+// SourceHighlighter function to register a custom syntax highlighter for the source blocks in the renderer context.
+// The highlighter is used when the `source-highlighter` document attribute matches the given name.
+func SourceHighlighter(name string, highlighter highlight.Highlighter) Option {
+	return func(ctx *Context) {
+		highlighters, ok := ctx.options[keySourceHighlighters].(map[string]highlight.Highlighter)
+		if !ok {
+			highlighters = make(map[string]highlight.Highlighter)
+			ctx.options[keySourceHighlighters] = highlighters
+		}
+		highlighters[name] = highlighter
+	}
+}
+
 // LastUpdated returns the value of the 'LastUpdated' Option if it was present,
 // otherwise it returns the current time using the `2006/01/02 15:04:05 MST` format
 func (ctx *Context) LastUpdated() string {
@@ -60,6 +142,17 @@ func (ctx *Context) IncludeHeaderFooter() bool {
 	return false
 }
 
+// IncludeMathJax returns the value of the 'IncludeMathJax' Option if it was present,
+// otherwise it returns `false`
+func (ctx *Context) IncludeMathJax() bool {
+	if includeMathJax, found := ctx.options[keyIncludeMathJax]; found {
+		if includeMathJax, typeMatch := includeMathJax.(bool); typeMatch {
+			return includeMathJax
+		}
+	}
+	return false
+}
+
 // Entrypoint returns the value of the 'Entrypoint' Option if it was present,
 // otherwise it returns `nil
 func (ctx *Context) Entrypoint() *string {
@@ -70,3 +163,87 @@ func (ctx *Context) Entrypoint() *string {
 	}
 	return nil
 }
+
+// IncludeResolver returns the value of the 'IncludeResolver' Option if it was present,
+// otherwise it returns a resolver which reads the included files in the directory of the document
+// (or in the current directory if the document has no filename) and its subdirectories on the local filesystem
+func (ctx *Context) IncludeResolver() parser.IncludeResolver {
+	if resolver, found := ctx.options[keyIncludeResolver]; found {
+		if resolver, typeMatch := resolver.(parser.IncludeResolver); typeMatch {
+			return resolver
+		}
+	}
+	return parser.FileIncludeResolver{
+		BaseDir: filepath.Dir(ctx.Filename()),
+	}
+}
+
+// Filename returns the value of the 'Filename' Option if it was present,
+// otherwise it returns an empty string
+func (ctx *Context) Filename() string {
+	if filename, found := ctx.options[keyFilename]; found {
+		if filename, typeMatch := filename.(string); typeMatch {
+			return filename
+		}
+	}
+	return ""
+}
+
+// DataURIResolver returns the value of the 'DataURIResolver' Option if it was present,
+// otherwise it returns a resolver which reads the images in the directory of the document
+// (or in the current directory if the document has no filename) and its subdirectories on the local filesystem
+func (ctx *Context) DataURIResolver() ImageResolver {
+	if resolver, found := ctx.options[keyDataURIResolver]; found {
+		if resolver, typeMatch := resolver.(ImageResolver); typeMatch {
+			return resolver
+		}
+	}
+	return FileImageResolver{
+		BaseDir: filepath.Dir(ctx.Filename()),
+	}
+}
+
+// DataURISizeLimit returns the value of the 'DataURISizeLimit' Option if it was present,
+// otherwise it returns `0` (ie, no limit)
+func (ctx *Context) DataURISizeLimit() int {
+	if limit, found := ctx.options[keyDataURISizeLimit]; found {
+		if limit, typeMatch := limit.(int); typeMatch {
+			return limit
+		}
+	}
+	return 0
+}
+
+// DefinedDocumentAttributes returns the value of the 'DefinedDocumentAttributes' Option if it was present,
+// otherwise it returns an empty set of attributes
+func (ctx *Context) DefinedDocumentAttributes() types.DocumentAttributes {
+	result := types.DocumentAttributes{}
+	if attributes, found := ctx.options[keyDefinedDocumentAttributes]; found {
+		if attributes, typeMatch := attributes.(map[string]string); typeMatch {
+			for k, v := range attributes {
+				result[k] = v
+			}
+		}
+	}
+	return result
+}
+
+// SourceHighlighter returns the syntax highlighter registered with the given name, or the built-in highlighter
+// if the name is `builtin` (with inline styles if the `builtin-css` document attribute is `style`).
+// Returns `false` if no highlighter matched the given name.
+func (ctx *Context) SourceHighlighter(name string) (highlight.Highlighter, bool) {
+	if highlighters, found := ctx.options[keySourceHighlighters]; found {
+		if highlighters, typeMatch := highlighters.(map[string]highlight.Highlighter); typeMatch {
+			if highlighter, found := highlighters[name]; found {
+				return highlighter, true
+			}
+		}
+	}
+	if name == highlight.BuiltinHighlighterName {
+		if css := ctx.Document.Attributes.GetAsString("builtin-css"); css != nil && *css == "style" {
+			return highlight.NewBuiltinHighlighter(highlight.StyleMode), true
+		}
+		return highlight.NewBuiltinHighlighter(highlight.ClassMode), true
+	}
+	return nil, false
+}
@@ -0,0 +1,77 @@
+package types
+
+import (
+	"fmt"
+)
+
+const (
+	// AttrFigureCaption the document attribute which specifies the label of the captions of the block images (`Figure` by default)
+	AttrFigureCaption string = "figure-caption"
+	// DefaultFigureCaption the default label of the captions of the block images
+	DefaultFigureCaption string = "Figure"
+	// AttrCaption the key to retrieve the caption of a titled block image or table in the element attributes (eg: `Figure 2`)
+	AttrCaption string = "elementCaption"
+)
+
+// AddCaptions computes the captions of the block images and tables of the given document (eg: `Figure 2` or `Table 1`).
+// Only the elements with a title are numbered. The label of the captions of the block images is defined by the
+// `figure-caption` attribute, and the block images are not numbered if this attribute is reset or set with an empty value.
+// Since the attribute can be declared and reset in the body of the document, the attribute declarations and resets
+// are processed in the document order.
+// Note: the captions are stored in the attributes of the elements, which are shared with the element references,
+// so the same captions are used when rendering the elements and the cross references to them.
+func AddCaptions(doc Document) {
+	c := &captionsCollector{
+		figureCaption: DefaultFigureCaption,
+	}
+	if figureCaption, found := doc.Attributes[AttrFigureCaption]; found {
+		c.figureCaption, _ = figureCaption.(string)
+	}
+	for _, e := range doc.Elements {
+		if v, ok := e.(Visitable); ok {
+			v.Accept(c)
+		}
+	}
+}
+
+type captionsCollector struct {
+	// the label of the captions of the block images, or an empty string if the block images are not numbered
+	figureCaption string
+	figures       int
+	tables        int
+}
+
+// BeforeVisit Implements Visitable#BeforeVisit()
+func (c *captionsCollector) BeforeVisit(element Visitable) error {
+	return nil
+}
+
+// Visit Implements Visitable#Visit()
+func (c *captionsCollector) Visit(element Visitable) error {
+	switch e := element.(type) {
+	case DocumentAttributeDeclaration:
+		if e.Name == AttrFigureCaption {
+			c.figureCaption = e.Value
+		}
+	case DocumentAttributeReset:
+		if e.Name == AttrFigureCaption {
+			c.figureCaption = ""
+		}
+	case BlockImage:
+		if _, found := e.Attributes[AttrTitle]; found && c.figureCaption != "" {
+			c.figures++
+			e.Attributes[AttrCaption] = fmt.Sprintf("%s %d", c.figureCaption, c.figures)
+		}
+	case Table:
+		if _, found := e.Attributes[AttrTitle]; found {
+			c.tables++
+			e.Attributes[AttrCaption] = fmt.Sprintf("Table %d", c.tables)
+		}
+	}
+	return nil
+}
+
+// AfterVisit Implements Visitable#AfterVisit()
+func (c *captionsCollector) AfterVisit(element Visitable) error {
+	return nil
+}
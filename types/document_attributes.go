@@ -42,13 +42,25 @@ func (m DocumentAttributes) HasAuthors() bool {
 	return exists
 }
 
-// GetTitle retrieves the document title in its metadata, or returns nil if the title was not specified
+// GetTitle retrieves the document title in its metadata, or returns nil if the title was not specified.
+// The title may be a `SectionTitle` (from the document header) or a string (when the `doctitle` attribute was declared)
 func (m DocumentAttributes) GetTitle() (SectionTitle, error) {
 	if t, found := m[title]; found {
-		if t, ok := t.(SectionTitle); ok {
+		switch t := t.(type) {
+		case SectionTitle:
 			return t, nil
+		case string:
+			return SectionTitle{
+				Attributes: map[string]interface{}{},
+				Content: InlineContent{
+					Elements: []InlineElement{
+						StringElement{Content: t},
+					},
+				},
+			}, nil
+		default:
+			return SectionTitle{}, errors.Errorf("document title type is not valid: %T", t)
 		}
-		return SectionTitle{}, errors.Errorf("document title type is not valid: %T", t)
 	}
 	return SectionTitle{}, nil
 }
@@ -0,0 +1,75 @@
+package types
+
+import (
+	log "github.com/sirupsen/logrus"
+)
+
+// Footnotes the footnotes of the document, in the order in which they are defined
+type Footnotes []Footnote
+
+// Number returns the number of the footnote with the given ID, or false if no such footnote was collected
+// (eg: the footnote is a reference to a named footnote)
+func (f Footnotes) Number(id int) (int, bool) {
+	for i, footnote := range f {
+		if footnote.ID == id {
+			return i + 1, true
+		}
+	}
+	return 0, false
+}
+
+// FootnoteReferences the numbers of the named footnotes of the document, indexed by their name
+type FootnoteReferences map[string]int
+
+// FootnotesCollector the visitor that traverses the whole document structure in search for footnotes
+type FootnotesCollector struct {
+	Footnotes          Footnotes
+	FootnoteReferences FootnoteReferences
+	// the names of the footnotes which are referenced in the document
+	references []string
+}
+
+// NewFootnotesCollector initializes a new FootnotesCollector. Its footnotes and references
+// remain nil as long as the document contains no footnote.
+func NewFootnotesCollector() *FootnotesCollector {
+	return &FootnotesCollector{}
+}
+
+// BeforeVisit Implements Visitable#BeforeVisit()
+func (c *FootnotesCollector) BeforeVisit(element Visitable) error {
+	return nil
+}
+
+// Visit Implements Visitable#Visit()
+func (c *FootnotesCollector) Visit(element Visitable) error {
+	if f, ok := element.(Footnote); ok {
+		// a named footnote which was already defined is a reference, even if it has a content
+		if _, found := c.FootnoteReferences[f.Ref]; f.IsReference() || (f.Ref != "" && found) {
+			c.references = append(c.references, f.Ref)
+			return nil
+		}
+		c.Footnotes = append(c.Footnotes, f)
+		if f.Ref != "" {
+			if c.FootnoteReferences == nil {
+				c.FootnoteReferences = FootnoteReferences{}
+			}
+			log.Debugf("Adding footnote reference: %v", f.Ref)
+			c.FootnoteReferences[f.Ref] = len(c.Footnotes)
+		}
+	}
+	return nil
+}
+
+// AfterVisit Implements Visitable#AfterVisit()
+func (c *FootnotesCollector) AfterVisit(element Visitable) error {
+	return nil
+}
+
+// CheckReferences logs a warning for each reference to a footnote which is not defined in the document
+func (c *FootnotesCollector) CheckReferences() {
+	for _, ref := range c.references {
+		if _, found := c.FootnoteReferences[ref]; !found {
+			log.Warnf("invalid footnote reference: no footnote defined with ID '%s'", ref)
+		}
+	}
+}
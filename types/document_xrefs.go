@@ -29,17 +29,62 @@ func (c *ElementReferencesCollector) BeforeVisit(element Visitable) error {
 func (c *ElementReferencesCollector) Visit(element Visitable) error {
 	switch e := element.(type) {
 	case Section:
-		elementID := e.Title.Attributes[AttrID]
+		elementID, found := e.Title.Attributes[AttrID]
+		if !found {
+			// section IDs may be disabled with the `sectids` attribute
+			return nil
+		}
 		if elementID, ok := elementID.(string); ok {
+			if _, exists := c.ElementReferences[elementID]; exists {
+				log.Warnf("duplicate element reference: '%s'", elementID)
+				return nil
+			}
 			log.Debugf("Adding element reference: %v", elementID)
 			c.ElementReferences[elementID] = e.Title
 		} else {
 			return errors.Errorf("unexpected type of element id: %T", elementID)
 		}
+	case InlineAnchor:
+		if _, exists := c.ElementReferences[e.ID]; exists {
+			log.Warnf("duplicate element reference: '%s'", e.ID)
+			return nil
+		}
+		log.Debugf("Adding inline anchor reference: %v", e.ID)
+		c.ElementReferences[e.ID] = e
+	case DiscreteHeading:
+		c.add(e.Attributes, e)
+	case BlockImage:
+		c.add(e.Attributes, e)
+	case Table:
+		c.add(e.Attributes, e)
+	case DelimitedBlock:
+		c.add(e.Attributes, e)
+	case Paragraph:
+		c.add(e.Attributes, e)
+	case OrderedList:
+		c.add(e.Attributes, e)
+	case UnorderedList:
+		c.add(e.Attributes, e)
+	case LabeledList:
+		c.add(e.Attributes, e)
 	}
 	return nil
 }
 
+// add registers the given element if its attributes contain an ID
+func (c *ElementReferencesCollector) add(attributes map[string]interface{}, element interface{}) {
+	elementID, ok := attributes[AttrID].(string)
+	if !ok {
+		return
+	}
+	if _, exists := c.ElementReferences[elementID]; exists {
+		log.Warnf("duplicate element reference: '%s'", elementID)
+		return
+	}
+	log.Debugf("Adding element reference: %v", elementID)
+	c.ElementReferences[elementID] = element
+}
+
 // AfterVisit Implements Visitable#AfterVisit()
 func (c *ElementReferencesCollector) AfterVisit(element Visitable) error {
 	return nil
@@ -1,8 +1,10 @@
 package types
 
 import (
+	"bytes"
 	"fmt"
 	"path/filepath"
+	"regexp"
 	"strconv"
 	"strings"
 
@@ -47,9 +49,11 @@ type Visitor interface {
 
 // Document the top-level structure for a document
 type Document struct {
-	Attributes        DocumentAttributes
-	Elements          []DocElement
-	ElementReferences ElementReferences
+	Attributes         DocumentAttributes
+	Elements           []DocElement
+	ElementReferences  ElementReferences
+	Footnotes          Footnotes
+	FootnoteReferences FootnoteReferences
 }
 
 // NewDocument initializes a new `Document` from the given lines
@@ -101,16 +105,30 @@ func NewDocument(frontmatter, header interface{}, blocks []interface{}) (Documen
 		}
 	}
 
+	err := resolveSectionIDs(attributes, elements)
+	if err != nil {
+		return Document{}, errors.Wrapf(err, "unable to initialize a new document")
+	}
+	checkCalloutLists(elements)
 	c := NewElementReferencesCollector()
+	f := NewFootnotesCollector()
+	// the footnotes of the document title come first
+	if title, ok := attributes["doctitle"].(SectionTitle); ok {
+		title.Content.Accept(f)
+	}
 	for _, e := range elements {
 		if v, ok := e.(Visitable); ok {
 			v.Accept(c)
+			v.Accept(f)
 		}
 	}
+	f.CheckReferences()
 	document := Document{
-		Attributes:        attributes,
-		Elements:          elements,
-		ElementReferences: c.ElementReferences,
+		Attributes:         attributes,
+		Elements:           elements,
+		ElementReferences:  c.ElementReferences,
+		Footnotes:          f.Footnotes,
+		FootnoteReferences: f.FootnoteReferences,
 	}
 
 	// visit all elements in the `AST` to retrieve their reference (ie, their ElementID if they have any)
@@ -410,6 +428,11 @@ func NewDocumentAttributeDeclaration(name []interface{}, value []interface{}) (D
 	}, nil
 }
 
+// Accept implements Visitable#Accept(Visitor)
+func (a DocumentAttributeDeclaration) Accept(v Visitor) error {
+	return acceptVisitor(v, a, "document attribute declaration")
+}
+
 // DocumentAttributeReset the type for DocumentAttributeReset
 type DocumentAttributeReset struct {
 	Name string
@@ -425,6 +448,11 @@ func NewDocumentAttributeReset(name []interface{}) (DocumentAttributeReset, erro
 	return DocumentAttributeReset{Name: attrName}, nil
 }
 
+// Accept implements Visitable#Accept(Visitor)
+func (a DocumentAttributeReset) Accept(v Visitor) error {
+	return acceptVisitor(v, a, "document attribute reset")
+}
+
 // DocumentAttributeSubstitution the type for DocumentAttributeSubstitution
 type DocumentAttributeSubstitution struct {
 	Name string
@@ -448,6 +476,28 @@ func NewDocumentAttributeSubstitution(name []interface{}) (DocumentAttributeSubs
 type TableOfContentsMacro struct {
 }
 
+// ------------------------------------------
+// Thematic and Page Breaks
+// ------------------------------------------
+
+// ThematicBreak the structure for the thematic breaks (eg: `'''`)
+type ThematicBreak struct {
+}
+
+// NewThematicBreak initializes a new `ThematicBreak`
+func NewThematicBreak() (ThematicBreak, error) {
+	return ThematicBreak{}, nil
+}
+
+// PageBreak the structure for the page breaks (ie, `<<<`)
+type PageBreak struct {
+}
+
+// NewPageBreak initializes a new `PageBreak`
+func NewPageBreak() (PageBreak, error) {
+	return PageBreak{}, nil
+}
+
 // ------------------------------------------
 // Preamble
 // ------------------------------------------
@@ -463,6 +513,11 @@ func NewPreamble(elements []interface{}) (Preamble, error) {
 	return Preamble{Elements: filterUnrelevantElements(elements)}, nil
 }
 
+// Accept implements Visitable#Accept(Visitor)
+func (p Preamble) Accept(v Visitor) error {
+	return acceptVisitor(v, p, "preamble", p.Elements...)
+}
+
 // ------------------------------------------
 // Front Matter
 // ------------------------------------------
@@ -498,6 +553,18 @@ type Section struct {
 func NewSection(level int, sectionTitle SectionTitle, blocks []interface{}) (Section, error) {
 	log.Debugf("Initializing a new Section with %d block(s)", len(blocks))
 	elements := filterUnrelevantElements(blocks)
+	// the unordered lists in a bibliography section are bibliography lists
+	if sectionTitle.Attributes[AttrKind] == Bibliography {
+		for i, element := range elements {
+			if l, ok := element.(UnorderedList); ok {
+				if l.Attributes == nil {
+					l.Attributes = map[string]interface{}{}
+				}
+				l.Attributes[AttrKind] = Bibliography
+				elements[i] = l
+			}
+		}
+	}
 	log.Debugf("Initialized a new Section of level %d with %d block(s)", level, len(blocks))
 	return Section{
 		Level:    level,
@@ -516,6 +583,10 @@ func (s Section) Accept(v Visitor) error {
 	if err != nil {
 		return errors.Wrapf(err, "error while visiting section")
 	}
+	err = s.Title.Content.Accept(v)
+	if err != nil {
+		return errors.Wrapf(err, "error while visiting section title")
+	}
 	for _, element := range s.Elements {
 		if visitable, ok := element.(Visitable); ok {
 			err = visitable.Accept(v)
@@ -540,6 +611,9 @@ func (s Section) Accept(v Visitor) error {
 type SectionTitle struct {
 	Attributes map[string]interface{}
 	Content    InlineContent
+	// Number the number of the section (eg: `1.2. ` or `Appendix A: `), set when the document is rendered
+	// with the `sectnums` attribute
+	Number string
 }
 
 // NewSectionTitle initializes a new `SectionTitle`` from the given level and content, with the optional attributes.
@@ -548,16 +622,13 @@ func NewSectionTitle(inlineContent InlineContent, attributes []interface{}) (Sec
 	// counting the lenght of the 'level' value (ie, the number of `=` chars)
 	attrbs := NewElementAttributes(attributes)
 	// make a default id from the sectionTitle's inline content
+	// (which may be regenerated later, depending on the `idprefix`, `idseparator` and `sectids` document attributes)
 	if _, found := attrbs[AttrID]; !found {
-		replacement, err := ReplaceNonAlphanumerics(inlineContent, "_")
-		if err != nil {
-			return SectionTitle{}, errors.Wrapf(err, "unable to generate default ID while instanciating a new SectionTitle element")
-		}
-		id, err := NewElementID(replacement)
+		id, err := defaultSectionID(inlineContent)
 		if err != nil {
 			return SectionTitle{}, errors.Wrapf(err, "unable to generate default ID while instanciating a new SectionTitle element")
 		}
-		attrbs[AttrID] = id.Value
+		attrbs[AttrID] = id
 	}
 	sectionTitle := SectionTitle{
 		Attributes: attrbs,
@@ -570,6 +641,51 @@ func NewSectionTitle(inlineContent InlineContent, attributes []interface{}) (Sec
 	return sectionTitle, nil
 }
 
+// defaultSectionID returns the default ID of a section with the given title content
+func defaultSectionID(content InlineContent) (string, error) {
+	replacement, err := ReplaceNonAlphanumerics(content, "_")
+	if err != nil {
+		return "", err
+	}
+	id, err := NewElementID(replacement)
+	if err != nil {
+		return "", err
+	}
+	return id.Value, nil
+}
+
+// ------------------------------------------
+// Discrete Headings
+// ------------------------------------------
+
+// DiscreteHeading the structure for the discrete (or floating) headings, which look like section titles,
+// but which do not open a new section
+type DiscreteHeading struct {
+	Level      int
+	Attributes map[string]interface{}
+	Content    InlineContent
+}
+
+// NewDiscreteHeading initializes a new `DiscreteHeading` from the given level and content, with the optional attributes
+func NewDiscreteHeading(level int, inlineContent InlineContent, attributes []interface{}) (DiscreteHeading, error) {
+	// discrete headings have the same kind of ID as section titles
+	title, err := NewSectionTitle(inlineContent, attributes)
+	if err != nil {
+		return DiscreteHeading{}, errors.Wrapf(err, "unable to initialize a new DiscreteHeading element")
+	}
+	log.Debugf("Initialized a new DiscreteHeading of level %d", level)
+	return DiscreteHeading{
+		Level:      level,
+		Attributes: title.Attributes,
+		Content:    title.Content,
+	}, nil
+}
+
+// Accept implements Visitable#Accept(Visitor)
+func (h DiscreteHeading) Accept(v Visitor) error {
+	return acceptVisitor(v, h, "discrete heading", h.Content)
+}
+
 // ------------------------------------------
 // Lists
 // ------------------------------------------
@@ -705,6 +821,11 @@ func NewListParagraph(lines []interface{}) (ListParagraph, error) {
 	}, nil
 }
 
+// Accept implements Visitable#Accept(Visitor)
+func (p ListParagraph) Accept(v Visitor) error {
+	return acceptVisitor(v, p, "list paragraph", inlineContentsAsElements(p.Lines)...)
+}
+
 // ------------------------------------------
 // Ordered Lists
 // ------------------------------------------
@@ -715,6 +836,11 @@ type OrderedList struct {
 	Items      []OrderedListItem
 }
 
+// Accept implements Visitable#Accept(Visitor)
+func (l OrderedList) Accept(v Visitor) error {
+	return acceptVisitor(v, l, "ordered list", orderedListItemsAsElements(l.Items)...)
+}
+
 // NumberingStyle the type of numbering for items in an ordered list
 type NumberingStyle string
 
@@ -869,6 +995,11 @@ func NewOrderedListItem(prefix OrderedListItemPrefix, elements []DocElement, att
 	}, nil
 }
 
+// Accept implements Visitable#Accept(Visitor)
+func (i OrderedListItem) Accept(v Visitor) error {
+	return acceptVisitor(v, i, "ordered list item", i.Elements...)
+}
+
 // AddChild appends the given item to the content of this OrderedListItem
 func (i *OrderedListItem) AddChild(item interface{}) {
 	log.Debugf("Adding item %v to %v", item, i.Elements)
@@ -1007,6 +1138,11 @@ func NewUnorderedList(elements []ListItem, attributes []interface{}) (UnorderedL
 	}, nil
 }
 
+// Accept implements Visitable#Accept(Visitor)
+func (l UnorderedList) Accept(v Visitor) error {
+	return acceptVisitor(v, l, "unordered list", unorderedListItemsAsElements(l.Items)...)
+}
+
 // UnorderedListItem the structure for the unordered list items
 type UnorderedListItem struct {
 	Level       int
@@ -1025,6 +1161,11 @@ func NewUnorderedListItem(prefix UnorderedListItemPrefix, elements []DocElement)
 	}, nil
 }
 
+// Accept implements Visitable#Accept(Visitor)
+func (i UnorderedListItem) Accept(v Visitor) error {
+	return acceptVisitor(v, i, "unordered list item", i.Elements...)
+}
+
 // AddChild appends the given item to the content of this UnorderedListItem
 func (i *UnorderedListItem) AddChild(item interface{}) {
 	i.Elements = append(i.Elements, item)
@@ -1159,6 +1300,11 @@ func NewLabeledList(elements []ListItem, attributes []interface{}) (LabeledList,
 	}, nil
 }
 
+// Accept implements Visitable#Accept(Visitor)
+func (l LabeledList) Accept(v Visitor) error {
+	return acceptVisitor(v, l, "labeled list", labeledListItemsAsElements(l.Items)...)
+}
+
 // LabeledListItem an item in a labeled
 type LabeledListItem struct {
 	Term     string
@@ -1178,6 +1324,11 @@ func NewLabeledListItem(term []interface{}, elements []DocElement) (LabeledListI
 	}, nil
 }
 
+// Accept implements Visitable#Accept(Visitor)
+func (i LabeledListItem) Accept(v Visitor) error {
+	return acceptVisitor(v, i, "labeled list item", i.Elements...)
+}
+
 // AddChild appends the given item to the content of this LabeledListItem
 func (i *LabeledListItem) AddChild(item interface{}) {
 	log.Debugf("Adding item %v to %v", item, i.Elements)
@@ -1219,6 +1370,11 @@ func NewParagraph(lines []interface{}, attributes []interface{}) (Paragraph, err
 	}, nil
 }
 
+// Accept implements Visitable#Accept(Visitor)
+func (p Paragraph) Accept(v Visitor) error {
+	return acceptVisitor(v, p, "paragraph", inlineContentsAsElements(p.Lines)...)
+}
+
 // ------------------------------------------
 // Admonition Paragraph
 // ------------------------------------------
@@ -1230,6 +1386,11 @@ type AdmonitionParagraph struct {
 	Content    DocElement
 }
 
+// Accept implements Visitable#Accept(Visitor)
+func (p AdmonitionParagraph) Accept(v Visitor) error {
+	return acceptVisitor(v, p, "admonition paragraph", p.Content)
+}
+
 // AdmonitionKind the type of admonition
 type AdmonitionKind string
 
@@ -1257,6 +1418,37 @@ func NewAdmonitionParagraph(kind AdmonitionKind, content DocElement, attributes
 	}, nil
 }
 
+// ------------------------------------------
+// Admonition Block
+// ------------------------------------------
+
+// AdmonitionBlock the structure for the admonitions on example or open blocks, which can contain other blocks
+type AdmonitionBlock struct {
+	Kind       AdmonitionKind
+	Attributes map[string]interface{}
+	Elements   []DocElement
+}
+
+// NewAdmonitionBlock initializes a new `AdmonitionBlock` of the given kind, with the elements and attributes
+// of the given delimited block, along with the attributes declared before the admonition marker
+func NewAdmonitionBlock(kind AdmonitionKind, block DelimitedBlock, attributes []interface{}) (AdmonitionBlock, error) {
+	log.Debugf("Initializing a new AdmonitionBlock with %d element(s)", len(block.Elements))
+	attrbs := NewElementAttributes(attributes)
+	for k, v := range block.Attributes {
+		attrbs[k] = v
+	}
+	return AdmonitionBlock{
+		Kind:       kind,
+		Attributes: attrbs,
+		Elements:   block.Elements,
+	}, nil
+}
+
+// Accept implements Visitable#Accept(Visitor)
+func (b AdmonitionBlock) Accept(v Visitor) error {
+	return acceptVisitor(v, b, "admonition block", b.Elements...)
+}
+
 // ------------------------------------------
 // Admonition Paragraph
 // ------------------------------------------
@@ -1286,6 +1478,11 @@ func NewAdmonitionParagraphContent(lines []interface{}) (AdmonitionParagraphCont
 	}, nil
 }
 
+// Accept implements Visitable#Accept(Visitor)
+func (c AdmonitionParagraphContent) Accept(v Visitor) error {
+	return acceptVisitor(v, c, "admonition paragraph content", inlineContentsAsElements(c.Lines)...)
+}
+
 // ------------------------------------------
 // InlineContent
 // ------------------------------------------
@@ -1302,6 +1499,14 @@ func NewInlineContent(elements []interface{}) (InlineContent, error) {
 	if err != nil {
 		return InlineContent{}, errors.Wrap(err, "unable to initialize a new InlineContent")
 	}
+	// remove the trailing spaces before a line break (eg: `some content +`)
+	for i, element := range inlineElements {
+		if _, ok := element.(LineBreak); ok && i > 0 {
+			if s, ok := inlineElements[i-1].(StringElement); ok {
+				inlineElements[i-1] = StringElement{Content: strings.TrimRight(s.Content, " \t")}
+			}
+		}
+	}
 	return InlineContent{Elements: inlineElements}, nil
 }
 
@@ -1330,19 +1535,143 @@ func (c InlineContent) Accept(v Visitor) error {
 	return nil
 }
 
+// ------------------------------------------
+// Line Breaks
+// ------------------------------------------
+
+// LineBreak an explicit line break in an inline content (ie, a `+` at the end of a line)
+type LineBreak struct {
+}
+
+// NewLineBreak initializes a new `LineBreak`
+func NewLineBreak() (LineBreak, error) {
+	return LineBreak{}, nil
+}
+
+// ------------------------------------------
+// Footnotes
+// ------------------------------------------
+
+// Footnote a footnote, which can be named (eg: `footnote:disclaimer[content]`) in order to be referenced
+// later in the document with an empty content (eg: `footnote:disclaimer[]`)
+type Footnote struct {
+	// ID the position of the footnote in the source document, which identifies it when it is rendered
+	ID int
+	// Ref the optional name of the footnote
+	Ref      string
+	Elements InlineContent
+}
+
+// NewFootnote initializes a new `Footnote` at the given position, with the given (optional) name and content
+func NewFootnote(id int, ref string, elements InlineContent) (Footnote, error) {
+	log.Debugf("Initialized a new Footnote with id=%d and ref='%s'", id, ref)
+	return Footnote{
+		ID:       id,
+		Ref:      ref,
+		Elements: elements,
+	}, nil
+}
+
+// IsReference returns true if the footnote is a reference to a named footnote, ie, it has a name but no content
+func (f Footnote) IsReference() bool {
+	return f.Ref != "" && len(f.Elements.Elements) == 0
+}
+
+// Accept implements Visitable#Accept(Visitor)
+func (f Footnote) Accept(v Visitor) error {
+	return acceptVisitor(v, f, "footnote", f.Elements)
+}
+
 // ------------------------------------------
 // Cross References
 // ------------------------------------------
 
 // CrossReference the struct for Cross References
 type CrossReference struct {
-	ID string
+	// Location the path to the target document, in the case of an inter-document cross reference (eg: `other.adoc`)
+	Location string
+	ID       string
+	// Label the custom text of the cross reference (optional)
+	Label string
 }
 
-// NewCrossReference initializes a new `CrossReference` from the given ID
-func NewCrossReference(id string) (CrossReference, error) {
+// NewCrossReference initializes a new `CrossReference` from the given ID and optional label
+func NewCrossReference(id string, label interface{}) (CrossReference, error) {
 	log.Debugf("Initializing a new CrossReference with ID=%s", id)
-	return CrossReference{ID: id}, nil
+	result := CrossReference{ID: id}
+	if label, ok := label.(string); ok {
+		result.Label = strings.TrimSpace(label)
+	}
+	return result, nil
+}
+
+// NewInterDocumentCrossReference initializes a new `CrossReference` to the document at the given location,
+// with an optional ID (of an element in the target document) and an optional label
+func NewInterDocumentCrossReference(location string, id, label interface{}) (CrossReference, error) {
+	log.Debugf("Initializing a new CrossReference to document '%s' with ID=%v", location, id)
+	result := CrossReference{Location: location}
+	if id, ok := id.(string); ok {
+		result.ID = id
+	}
+	if label, ok := label.(string); ok {
+		result.Label = strings.TrimSpace(label)
+	}
+	return result, nil
+}
+
+// IsInterDocument returns true if this cross reference targets another document
+func (x CrossReference) IsInterDocument() bool {
+	return x.Location != ""
+}
+
+// ------------------------------------------
+// Inline Anchors
+// ------------------------------------------
+
+// InlineAnchor the struct for the anchors in an inline content (eg: `[[id]]`, `anchor:id[text]` or `[[[id]]]` in a bibliography)
+type InlineAnchor struct {
+	ID string
+	// Label the text to use in the cross references to this anchor (optional)
+	Label string
+	// Bibliography true if this anchor is a bibliography entry (ie, `[[[id]]]`)
+	Bibliography bool
+}
+
+// NewInlineAnchor initializes a new `InlineAnchor` from the given ID and optional label
+func NewInlineAnchor(id string, label interface{}) (InlineAnchor, error) {
+	log.Debugf("Initializing a new InlineAnchor with ID=%s", id)
+	result := InlineAnchor{ID: id}
+	if label, ok := label.(string); ok {
+		result.Label = strings.TrimSpace(label)
+	}
+	return result, nil
+}
+
+// NewBibliographyAnchor initializes a new bibliography `InlineAnchor` from the given ID and optional label
+func NewBibliographyAnchor(id string, label interface{}) (InlineAnchor, error) {
+	result, err := NewInlineAnchor(id, label)
+	if err != nil {
+		return InlineAnchor{}, errors.Wrapf(err, "unable to initialize a new bibliography anchor")
+	}
+	result.Bibliography = true
+	return result, nil
+}
+
+// Accept implements Visitable#Accept(Visitor)
+func (a InlineAnchor) Accept(v Visitor) error {
+	return acceptVisitor(v, a, "inline anchor")
+}
+
+// ReferenceText returns the text to use in the cross references to this anchor, or an empty value if the anchor
+// has no label. Bibliography anchors are referenced by their label (or by their ID) in brackets (eg: `[pp]`)
+func (a InlineAnchor) ReferenceText() string {
+	if a.Bibliography {
+		if a.Label != "" {
+			return fmt.Sprintf("[%s]", a.Label)
+		}
+		return fmt.Sprintf("[%s]", a.ID)
+	}
+	return a.Label
 }
 
 // ------------------------------------------
@@ -1355,14 +1684,25 @@ type BlockImage struct {
 	Attributes map[string]interface{}
 }
 
-// NewBlockImage initializes a new `BlockImage`
+// NewBlockImage initializes a new `BlockImage`. The named attributes of the image macro (eg: `role=thumb`) are moved into
+// the attributes of the block, where they override the element attributes (eg: `[role=thumb]`).
 func NewBlockImage(imageMacro ImageMacro, attributes []interface{}) (BlockImage, error) {
+	attrbs := NewElementAttributes(attributes)
+	for k, v := range imageMacro.Attributes {
+		attrbs[k] = v
+	}
+	imageMacro.Attributes = nil
 	return BlockImage{
 		Macro:      imageMacro,
-		Attributes: NewElementAttributes(attributes),
+		Attributes: attrbs,
 	}, nil
 }
 
+// Accept implements Visitable#Accept(Visitor)
+func (i BlockImage) Accept(v Visitor) error {
+	return acceptVisitor(v, i, "block image")
+}
+
 // InlineImage the structure for the inline image macros
 type InlineImage struct {
 	Macro ImageMacro
@@ -1381,46 +1721,168 @@ type ImageMacro struct {
 	Alt    string
 	Width  *string
 	Height *string
+	// Attributes the other named attributes of the macro (eg: `role`, `align`, `float`, `link` or `title`), or nil if there is none
+	Attributes map[string]interface{}
 }
 
-// NewImageMacro initializes a new `ImageMacro`
-func NewImageMacro(path string, attributes interface{}) (ImageMacro, error) {
-	var alt string
-	var width, height *string
-	if attributes != nil {
-		// optionally, the width and height can be specified in the alt text, using `,` as a separator
-		// eg: `image::foo.png[a title,200,100]`
-		splittedAttributes := strings.Split(attributes.(string), ",")
-		// naively assume that if the splitted 'alt' contains more than 3 elements, the 2 last ones are for the width and height
-		splitCount := len(splittedAttributes)
-		alt = splittedAttributes[0]
-		if splitCount > 1 {
-			w := strings.Trim(splittedAttributes[1], " ")
-			width = &w
-		}
-		if splitCount > 2 {
-			h := strings.Trim(splittedAttributes[2], " ")
-			height = &h
-		}
-	} else {
-		dir := filepath.Dir(path)
-		extension := filepath.Ext(path)
-		var offset int
-		if dir == "." {
-			offset = 0
-		} else {
-			offset = len(dir) + 1
+// NewImageMacro initializes a new `ImageMacro` with the given path and attributes, in which the positional attributes
+// are the alt text, the width and the height (eg: `image::foo.png[a title,200,100]`), and the other attributes are named
+// (eg: `image::foo.png[a title,width=200,role=thumb]`). The alt text defaults to the name of the file, without its extension.
+func NewImageMacro(path string, attributes []interface{}) (ImageMacro, error) {
+	result := ImageMacro{
+		Path: path,
+	}
+	others := make([]interface{}, 0, len(attributes))
+	position := 0
+	for _, attr := range attributes {
+		switch attr := attr.(type) {
+		case GenericAttribute:
+			others = append(others, attr)
+			continue
+		case string:
+			value := strings.TrimSpace(attr)
+			switch position {
+			case 0:
+				result.Alt = value
+			case 1:
+				result.Width = &value
+			case 2:
+				result.Height = &value
+			default:
+				log.Warnf("ignoring unexpected positional attribute '%s' in image macro", value)
+			}
 		}
-		alt = path[offset : len(path)-len(extension)]
+		// empty positional attributes (eg: `[,200]`) are nil
+		position++
+	}
+	named, err := NewAttributeGroup(others)
+	if err != nil {
+		return ImageMacro{}, errors.Wrapf(err, "failed to initialize a new image macro")
+	}
+	if alt, ok := named["alt"].(string); ok {
+		result.Alt = alt
+		delete(named, "alt")
+	}
+	if width, ok := named[AttrWidth].(string); ok {
+		result.Width = &width
+		delete(named, AttrWidth)
 	}
-	return ImageMacro{
-		Path:   path,
-		Alt:    alt,
-		Width:  width,
-		Height: height,
+	if height, ok := named[AttrHeight].(string); ok {
+		result.Height = &height
+		delete(named, AttrHeight)
+	}
+	if id, ok := named["id"]; ok {
+		named[AttrID] = id
+		delete(named, "id")
+	}
+	if len(named) > 0 {
+		result.Attributes = named
+	}
+	if result.Alt == "" {
+		result.Alt = strings.TrimSuffix(filepath.Base(path), filepath.Ext(path))
+	}
+	return result, nil
+}
+
+// ------------------------------------------
+// Video and Audio
+// ------------------------------------------
+
+const (
+	// AttrWidth the key to retrieve the width of a media element in the element attributes
+	AttrWidth string = "width"
+	// AttrHeight the key to retrieve the height of a media element in the element attributes
+	AttrHeight string = "height"
+	// AttrPoster the key to retrieve the poster image of a video in the element attributes
+	AttrPoster string = "poster"
+	// AttrStart the key to retrieve the start time (in seconds) of a video or an audio in the element attributes
+	AttrStart string = "start"
+	// AttrEnd the key to retrieve the end time (in seconds) of a video or an audio in the element attributes
+	AttrEnd string = "end"
+	// YouTube the provider of the videos hosted on YouTube
+	YouTube string = "youtube"
+	// Vimeo the provider of the videos hosted on Vimeo
+	Vimeo string = "vimeo"
+)
+
+// VideoBlock the structure for the video block macros (eg: `video::video.mp4[]` or `video::RvRhUHTV_8k[youtube]`)
+type VideoBlock struct {
+	// Path the path to the video file, or the ID of the video if it is hosted on YouTube or Vimeo
+	Path string
+	// Provider the optional provider of the video (`youtube` or `vimeo`)
+	Provider   string
+	Attributes map[string]interface{}
+}
+
+// NewVideoBlock initializes a new `VideoBlock` with the given path, macro attributes and element attributes.
+// The positional macro attributes are the poster image (or the provider, if it is `youtube` or `vimeo`),
+// the width and the height of the video (eg: `video::video.mp4[poster.png,640,480]`).
+func NewVideoBlock(path string, macroAttributes []interface{}, attributes []interface{}) (VideoBlock, error) {
+	attrbs := newMediaAttributes(macroAttributes, attributes, AttrPoster, AttrWidth, AttrHeight)
+	var provider string
+	if poster, ok := attrbs[AttrPoster].(string); ok && (poster == YouTube || poster == Vimeo) {
+		provider = poster
+		delete(attrbs, AttrPoster)
+	}
+	log.Debugf("initialized a new VideoBlock with path '%s' and provider '%s'", path, provider)
+	return VideoBlock{
+		Path:       path,
+		Provider:   provider,
+		Attributes: attrbs,
+	}, nil
+}
+
+// Accept implements Visitable#Accept(Visitor)
+func (b VideoBlock) Accept(v Visitor) error {
+	return acceptVisitor(v, b, "video block")
+}
+
+// AudioBlock the structure for the audio block macros (eg: `audio::audio.mp3[]`)
+type AudioBlock struct {
+	Path       string
+	Attributes map[string]interface{}
+}
+
+// NewAudioBlock initializes a new `AudioBlock` with the given path, macro attributes and element attributes
+func NewAudioBlock(path string, macroAttributes []interface{}, attributes []interface{}) (AudioBlock, error) {
+	attrbs := newMediaAttributes(macroAttributes, attributes)
+	log.Debugf("initialized a new AudioBlock with path '%s'", path)
+	return AudioBlock{
+		Path:       path,
+		Attributes: attrbs,
 	}, nil
 }
 
+// Accept implements Visitable#Accept(Visitor)
+func (b AudioBlock) Accept(v Visitor) error {
+	return acceptVisitor(v, b, "audio block")
+}
+
+// newMediaAttributes merges the given element attributes and macro attributes of a video or an audio block.
+// The macro attributes without a value (except the options with the `%` shorthand) are positional,
+// and their value is assigned to the given keys, in order.
+func newMediaAttributes(macroAttributes []interface{}, attributes []interface{}, positionalKeys ...string) map[string]interface{} {
+	result := NewElementAttributes(attributes)
+	position := 0
+	for _, a := range macroAttributes {
+		if a, ok := a.(GenericAttribute); ok {
+			for k, v := range a {
+				if v == nil && !strings.HasPrefix(k, "%") {
+					if position < len(positionalKeys) {
+						result[positionalKeys[position]] = k
+						position++
+					} else {
+						log.Warnf("ignoring unexpected positional attribute '%s' in media macro", k)
+					}
+					continue
+				}
+				result[k] = v
+			}
+		}
+	}
+	return result
+}
+
 // ------------------------------------------
 // Delimited blocks
 // ------------------------------------------
@@ -1435,6 +1897,18 @@ const (
 	ListingBlock
 	// ExampleBlock an example block
 	ExampleBlock
+	// SidebarBlock a sidebar block
+	SidebarBlock
+	// QuoteBlock a quote block
+	QuoteBlock
+	// VerseBlock a verse block
+	VerseBlock
+	// OpenBlock an open block
+	OpenBlock
+	// PassthroughBlock a passthrough block
+	PassthroughBlock
+	// StemBlock a passthrough block with the `stem`, `latexmath` or `asciimath` style
+	StemBlock
 )
 
 // DelimitedBlock the structure for the delimited blocks
@@ -1460,8 +1934,21 @@ func NewDelimitedBlock(kind DelimitedBlockKind, content []interface{}, attribute
 		if err != nil {
 			return DelimitedBlock{}, errors.Wrapf(err, "unable to initialize a new delimited block")
 		}
-		elements = make([]DocElement, 1)
-		elements[0] = NewStringElement(s)
+		elements = splitCallouts(s)
+	case PassthroughBlock, StemBlock:
+		s, err := stringify(content,
+			// remove "\n" or "\r\n", depending on the OS.
+			func(s string) (string, error) {
+				return strings.TrimRight(s, "\n"), nil
+			}, func(s string) (string, error) {
+				return strings.TrimRight(s, "\r"), nil
+			})
+		if err != nil {
+			return DelimitedBlock{}, errors.Wrapf(err, "unable to initialize a new delimited block")
+		}
+		elements = []DocElement{StringElement{Content: s}}
+	case VerseBlock:
+		elements = trimBlankLines(filterUnrelevantElements(content))
 	default:
 		elements = filterUnrelevantElements(content)
 	}
@@ -1473,18 +1960,407 @@ func NewDelimitedBlock(kind DelimitedBlockKind, content []interface{}, attribute
 	}, nil
 }
 
+// Accept implements Visitable#Accept(Visitor)
+func (b DelimitedBlock) Accept(v Visitor) error {
+	return acceptVisitor(v, b, "delimited block", b.Elements...)
+}
+
+// trimBlankLines removes the leading and trailing empty lines of a verse block
+func trimBlankLines(lines []DocElement) []DocElement {
+	isBlank := func(line DocElement) bool {
+		l, ok := line.(InlineContent)
+		return ok && len(l.Elements) == 0
+	}
+	for len(lines) > 0 && isBlank(lines[0]) {
+		lines = lines[1:]
+	}
+	for len(lines) > 0 && isBlank(lines[len(lines)-1]) {
+		lines = lines[:len(lines)-1]
+	}
+	return lines
+}
+
+// calloutRegexp the regexp to detect a callout marker at the end of a line in a listing block
+var calloutRegexp = regexp.MustCompile(`\s*<(\d+)>\s*$`)
+
+// calloutCommentPrefixes the line comment prefixes which can precede the callout markers, and which are removed
+var calloutCommentPrefixes = []string{"//", "#", "--", ";;"}
+
+// splitCallouts splits the given content of a listing block into `StringElement` and `Callout` elements,
+// where callouts are detected at the end of the lines (eg: `fmt.Println("hello") // <1>`)
+func splitCallouts(content string) []DocElement {
+	elements := make([]DocElement, 0)
+	buff := bytes.NewBuffer(nil)
+	for i, line := range strings.Split(content, "\n") {
+		if i > 0 {
+			buff.WriteString("\n")
+		}
+		refs := make([]int, 0)
+		for {
+			loc := calloutRegexp.FindStringSubmatchIndex(line)
+			if loc == nil {
+				break
+			}
+			ref, _ := strconv.Atoi(line[loc[2]:loc[3]])
+			refs = append([]int{ref}, refs...)
+			line = line[:loc[0]]
+		}
+		if len(refs) == 0 {
+			buff.WriteString(line)
+			continue
+		}
+		for _, prefix := range calloutCommentPrefixes {
+			if strings.HasSuffix(line, prefix) {
+				line = strings.TrimRight(strings.TrimSuffix(line, prefix), " \t")
+				break
+			}
+		}
+		if line != "" {
+			buff.WriteString(line + " ")
+		}
+		for j, ref := range refs {
+			if j > 0 {
+				buff.WriteString(" ")
+			}
+			if buff.Len() > 0 {
+				elements = append(elements, NewStringElement(buff.String()))
+				buff.Reset()
+			}
+			elements = append(elements, Callout{Ref: ref})
+		}
+	}
+	if buff.Len() > 0 || len(elements) == 0 {
+		elements = append(elements, NewStringElement(buff.String()))
+	}
+	return elements
+}
+
+// NewFencedBlockWithLanguage initializes a new fenced `DelimitedBlock` with the given content, whose language
+// is specified after the opening delimiter (eg: "```go")
+func NewFencedBlockWithLanguage(language string, content []interface{}, attributes []interface{}) (DelimitedBlock, error) {
+	sourceAttributes, err := NewSourceAttributes(language)
+	if err != nil {
+		return DelimitedBlock{}, errors.Wrapf(err, "unable to initialize a new fenced block")
+	}
+	return NewDelimitedBlock(FencedBlock, content, append(attributes, sourceAttributes))
+}
+
+// ------------------------------------------
+// Callouts
+// ------------------------------------------
+
+// Callout the structure for the callout markers in the listing blocks (eg: `<1>`)
+type Callout struct {
+	Ref int
+}
+
+// CalloutList the structure for the lists of callout explanations, following a listing block
+type CalloutList struct {
+	Attributes map[string]interface{}
+	Items      []CalloutListItem
+}
+
+// NewCalloutList initializes a new `CalloutList` with the given items
+func NewCalloutList(items []interface{}, attributes []interface{}) (CalloutList, error) {
+	result := make([]CalloutListItem, 0, len(items))
+	for _, item := range items {
+		if item, ok := item.(CalloutListItem); ok {
+			result = append(result, item)
+		}
+	}
+	log.Debugf("Initialized a new CalloutList with %d item(s)", len(result))
+	return CalloutList{
+		Attributes: NewElementAttributes(attributes),
+		Items:      result,
+	}, nil
+}
+
+// Accept implements Visitable#Accept(Visitor)
+func (l CalloutList) Accept(v Visitor) error {
+	return acceptVisitor(v, l, "callout list", calloutListItemsAsElements(l.Items)...)
+}
+
+// CalloutListItem the structure for the items of a callout list
+type CalloutListItem struct {
+	Ref      int
+	Elements []DocElement
+}
+
+// NewCalloutListItem initializes a new `CalloutListItem` with the given reference and elements
+func NewCalloutListItem(ref []interface{}, elements []DocElement) (CalloutListItem, error) {
+	r, err := stringify(ref)
+	if err != nil {
+		return CalloutListItem{}, errors.Wrapf(err, "unable to initialize a new callout list item")
+	}
+	n, err := strconv.Atoi(r)
+	if err != nil {
+		return CalloutListItem{}, errors.Wrapf(err, "unable to initialize a new callout list item")
+	}
+	return CalloutListItem{
+		Ref:      n,
+		Elements: elements,
+	}, nil
+}
+
+// Accept implements Visitable#Accept(Visitor)
+func (i CalloutListItem) Accept(v Visitor) error {
+	return acceptVisitor(v, i, "callout list item", i.Elements...)
+}
+
+// ------------------------------------------
+// Tables
+// ------------------------------------------
+
+// Table the structure for the tables
+type Table struct {
+	Attributes map[string]interface{}
+	Columns    []TableColumn
+	Header     *TableRow
+	Rows       []TableRow
+	Footer     *TableRow
+}
+
+// Accept implements Visitable#Accept(Visitor)
+func (t Table) Accept(v Visitor) error {
+	return acceptVisitor(v, t, "table", tableCellsAsElements(t)...)
+}
+
+// TableRow a row in a table
+type TableRow struct {
+	Cells []TableCell
+}
+
+// TableCell a cell in a table row
+type TableCell struct {
+	Elements []InlineElement
+}
+
+// TableColumn the specification of a column in a table (relative width and alignments)
+type TableColumn struct {
+	Weight int
+	HAlign HAlignment
+	VAlign VAlignment
+}
+
+// HAlignment the horizontal alignment of the cells in a table column
+type HAlignment string
+
+const (
+	// HAlignLeft the cells are aligned on the left (default)
+	HAlignLeft HAlignment = "left"
+	// HAlignCenter the cells are centered
+	HAlignCenter HAlignment = "center"
+	// HAlignRight the cells are aligned on the right
+	HAlignRight HAlignment = "right"
+)
+
+// VAlignment the vertical alignment of the cells in a table column
+type VAlignment string
+
+const (
+	// VAlignTop the cells are aligned on the top (default)
+	VAlignTop VAlignment = "top"
+	// VAlignMiddle the cells are aligned in the middle
+	VAlignMiddle VAlignment = "middle"
+	// VAlignBottom the cells are aligned on the bottom
+	VAlignBottom VAlignment = "bottom"
+)
+
+const (
+	// AttrCols the key to retrieve the columns specification of a table
+	AttrCols string = "cols"
+	// AttrOptions the key to retrieve the options of an element
+	AttrOptions string = "options"
+)
+
+// NewTable initializes a new `Table` from the given optional header, lines and attributes.
+// The cells are (re)arranged in rows according to the number of columns, which is determined by
+// the `cols` attribute if it was specified, or by the number of cells in the first line otherwise.
+func NewTable(header interface{}, lines []interface{}, attributes []interface{}) (Table, error) {
+	attrbs := NewElementAttributes(attributes)
+	cells := make([]TableCell, 0)
+	firstLineSize := 0
+	if header, ok := header.(TableRow); ok {
+		cells = append(cells, header.Cells...)
+		firstLineSize = len(header.Cells)
+	}
+	for _, line := range lines {
+		if line, ok := line.(TableRow); ok {
+			if firstLineSize == 0 {
+				firstLineSize = len(line.Cells)
+			}
+			cells = append(cells, line.Cells...)
+		}
+	}
+	var columns []TableColumn
+	if cols, ok := attrbs[AttrCols].(string); ok {
+		columns = NewTableColumns(cols)
+	}
+	if len(columns) == 0 {
+		columns = make([]TableColumn, firstLineSize)
+		for i := range columns {
+			columns[i] = TableColumn{Weight: 1, HAlign: HAlignLeft, VAlign: VAlignTop}
+		}
+	}
+	rows := make([]TableRow, 0)
+	if len(columns) > 0 {
+		// the cells of an incomplete last row are dropped
+		for i := 0; i+len(columns) <= len(cells); i += len(columns) {
+			rows = append(rows, TableRow{Cells: cells[i : i+len(columns)]})
+		}
+	}
+	result := Table{
+		Attributes: attrbs,
+		Columns:    columns,
+		Rows:       rows,
+	}
+	options := elementOptions(attrbs)
+	// the header row is implicit if the first line is followed by a blank line,
+	// but it can also be enforced or disabled with the `header` and `noheader` options
+	if (header != nil || options["header"]) && !options["noheader"] && len(result.Rows) > 0 {
+		result.Header = &result.Rows[0]
+		result.Rows = result.Rows[1:]
+	}
+	if options["footer"] && len(result.Rows) > 0 {
+		result.Footer = &result.Rows[len(result.Rows)-1]
+		result.Rows = result.Rows[:len(result.Rows)-1]
+	}
+	log.Debugf("Initialized a new Table with %d column(s) and %d row(s)", len(result.Columns), len(result.Rows))
+	return result, nil
+}
+
+// elementOptions returns the options set in the given attributes, either with the `options`
+// attribute (eg: `options="header,footer"`) or with the `%` shorthand (eg: `[%header]`)
+func elementOptions(attributes map[string]interface{}) map[string]bool {
+	result := make(map[string]bool)
+	if options, ok := attributes[AttrOptions].(string); ok {
+		for _, option := range strings.Split(options, ",") {
+			result[strings.TrimSpace(option)] = true
+		}
+	}
+	for k := range attributes {
+		if strings.HasPrefix(k, "%") {
+			result[strings.TrimPrefix(k, "%")] = true
+		}
+	}
+	return result
+}
+
+// HasOption returns true if the given option is set in the given attributes, either with the `options`
+// attribute (eg: `options="autoplay,loop"`) or with the `%` shorthand (eg: `[%autoplay]`)
+func HasOption(attributes map[string]interface{}, option string) bool {
+	return elementOptions(attributes)[option]
+}
+
+var tableColumnSpecRegexp = regexp.MustCompile(`^(?:(\d+)\*)?([<^>])?(?:\.([<^>]))?(\d+)?%?([adehlmsv])?$`)
+
+// NewTableColumns initializes the columns of a table from the given `cols` attribute value.
+// A single number (eg: `cols="3"`) is the number of columns, otherwise each column specification
+// is separated by a `,` or a `;` and may include a multiplier (`2*`), a horizontal alignment
+// (`<`, `^` or `>`), a vertical alignment (`.<`, `.^` or `.>`), a relative width and a style.
+// An empty result means that the columns should be inferred from the first line of the table, which is also
+// the case when the specification is invalid.
+func NewTableColumns(cols string) []TableColumn {
+	cols = strings.TrimSpace(cols)
+	if count, err := strconv.Atoi(cols); err == nil {
+		if count <= 0 {
+			log.Warnf("invalid number of columns in table: '%s', using the number of cells in the first line instead", cols)
+			return []TableColumn{}
+		}
+		result := make([]TableColumn, count)
+		for i := range result {
+			result[i] = TableColumn{Weight: 1, HAlign: HAlignLeft, VAlign: VAlignTop}
+		}
+		return result
+	}
+	result := make([]TableColumn, 0)
+	for _, spec := range strings.FieldsFunc(cols, func(r rune) bool { return r == ',' || r == ';' }) {
+		spec = strings.TrimSpace(spec)
+		column, multiplier, err := newTableColumn(spec)
+		if err != nil {
+			log.Warnf("invalid column specification in table: %v, using the number of cells in the first line instead", err)
+			return []TableColumn{}
+		}
+		for i := 0; i < multiplier; i++ {
+			result = append(result, column)
+		}
+	}
+	return result
+}
+
+// newTableColumn initializes a column from the given specification, along with its multiplier
+func newTableColumn(spec string) (TableColumn, int, error) {
+	groups := tableColumnSpecRegexp.FindStringSubmatch(spec)
+	if groups == nil {
+		return TableColumn{}, 0, errors.Errorf("invalid column specification: '%s'", spec)
+	}
+	column := TableColumn{Weight: 1, HAlign: HAlignLeft, VAlign: VAlignTop}
+	switch groups[2] {
+	case "^":
+		column.HAlign = HAlignCenter
+	case ">":
+		column.HAlign = HAlignRight
+	}
+	switch groups[3] {
+	case "^":
+		column.VAlign = VAlignMiddle
+	case ">":
+		column.VAlign = VAlignBottom
+	}
+	if groups[4] != "" {
+		weight, err := strconv.Atoi(groups[4])
+		if err != nil {
+			return TableColumn{}, 0, errors.Wrapf(err, "invalid column width: '%s'", spec)
+		}
+		column.Weight = weight
+	}
+	multiplier := 1
+	if groups[1] != "" {
+		m, err := strconv.Atoi(groups[1])
+		if err != nil {
+			return TableColumn{}, 0, errors.Wrapf(err, "invalid column multiplier: '%s'", spec)
+		}
+		multiplier = m
+	}
+	return column, multiplier, nil
+}
+
+// NewTableRow initializes a new `TableRow` from the given cells
+func NewTableRow(cells []interface{}) (TableRow, error) {
+	result := TableRow{
+		Cells: make([]TableCell, 0, len(cells)),
+	}
+	for _, cell := range cells {
+		if cell, ok := cell.(TableCell); ok {
+			result.Cells = append(result.Cells, cell)
+		}
+	}
+	return result, nil
+}
+
+// NewTableCell initializes a new `TableCell` from the given content
+func NewTableCell(content []interface{}) (TableCell, error) {
+	elements, err := toInlineElements(content)
+	if err != nil {
+		return TableCell{}, errors.Wrapf(err, "unable to initialize a new TableCell")
+	}
+	return TableCell{Elements: elements}, nil
+}
+
 // ------------------------------------------
 // Literal blocks
 // ------------------------------------------
 
 // LiteralBlock the structure for the literal blocks
 type LiteralBlock struct {
-	Content string
+	Attributes map[string]interface{}
+	Content    string
+	Lines      []InlineContent // the lines of the content, when the block has its own substitutions (`subs` attribute)
 }
 
 // NewLiteralBlock initializes a new `DelimitedBlock` of the given kind with the given content,
 // along with the given sectionTitle spaces
-func NewLiteralBlock(spaces, content []interface{}) (LiteralBlock, error) {
+func NewLiteralBlock(spaces, content []interface{}, attributes []interface{}) (LiteralBlock, error) {
 	// concatenates the spaces with the actual content in a single 'stringified' value
 	// log.Debugf("Initializing a new LiteralBlock with spaces='%v' and content=`%v`", spaces, content)
 	c, err := stringify(append(spaces, content...))
@@ -1495,7 +2371,60 @@ func NewLiteralBlock(spaces, content []interface{}) (LiteralBlock, error) {
 	blockContent := strings.TrimRight(strings.TrimRight(c, "\n"), "\r")
 	log.Debugf("Initialized a new LiteralBlock with content=`%s`", blockContent)
 	return LiteralBlock{
-		Content: blockContent,
+		Attributes: NewElementAttributes(attributes),
+		Content:    blockContent,
+	}, nil
+}
+
+// ------------------------------------------
+// Comments
+// ------------------------------------------
+
+// CommentKind the type of comment
+type CommentKind int
+
+const (
+	// SingleLineComment a comment on a single line, starting with `//`
+	SingleLineComment CommentKind = iota
+	// CommentBlock a comment on multiple lines, delimited with `////`
+	CommentBlock
+)
+
+// Comment the structure for the comments, which are retained in the document but not rendered
+type Comment struct {
+	Kind    CommentKind
+	Content string
+}
+
+// NewSingleLineComment initializes a new single line `Comment` with the given content
+func NewSingleLineComment(content []interface{}) (Comment, error) {
+	c, err := stringify(content)
+	if err != nil {
+		return Comment{}, errors.Wrapf(err, "unable to initialize a new single line comment")
+	}
+	log.Debugf("Initialized a new single line Comment with content=`%s`", c)
+	return Comment{
+		Kind:    SingleLineComment,
+		Content: c,
+	}, nil
+}
+
+// NewCommentBlock initializes a new `Comment` block with the given content
+func NewCommentBlock(content []interface{}) (Comment, error) {
+	c, err := stringify(content,
+		// remove "\n" or "\r\n", depending on the OS.
+		func(s string) (string, error) {
+			return strings.TrimRight(s, "\n"), nil
+		}, func(s string) (string, error) {
+			return strings.TrimRight(s, "\r"), nil
+		})
+	if err != nil {
+		return Comment{}, errors.Wrapf(err, "unable to initialize a new comment block")
+	}
+	log.Debugf("Initialized a new Comment block with content=`%s`", c)
+	return Comment{
+		Kind:    CommentBlock,
+		Content: c,
 	}, nil
 }
 
@@ -1508,10 +2437,101 @@ const (
 	AttrID string = "elementID"
 	// AttrTitle the key to retrieve the title in the element attributes
 	AttrTitle string = "title"
+	// AttrRole the key to retrieve the role(s) in the element attributes
+	AttrRole string = "role"
 	// AttrLink the key to retrieve the link in the element attributes
 	AttrLink string = "link"
+	// AttrKind the key to retrieve the kind of block (eg: `source`) in the element attributes
+	AttrKind string = "kind"
+	// AttrLanguage the key to retrieve the language of a source block in the element attributes
+	AttrLanguage string = "language"
+	// Source the kind of the source blocks
+	Source string = "source"
+	// Quote the kind of the quote blocks
+	Quote string = "quote"
+	// Verse the kind of the verse blocks
+	Verse string = "verse"
+	// Abstract the kind of the open blocks with the `abstract` style
+	Abstract string = "abstract"
+	// PartIntro the kind of the open blocks with the `partintro` style
+	PartIntro string = "partintro"
+	// Appendix the kind of the appendix sections
+	Appendix string = "appendix"
+	// Bibliography the kind of the bibliography sections and lists
+	Bibliography string = "bibliography"
+	// Stem the kind of the STEM blocks and inline macros whose notation is given by the `stem` document attribute
+	Stem string = "stem"
+	// LatexMath the kind of the STEM blocks and inline macros in the LaTeX notation
+	LatexMath string = "latexmath"
+	// AsciiMath the kind of the STEM blocks and inline macros in the AsciiMath notation
+	AsciiMath string = "asciimath"
+	// AttrAttribution the key to retrieve the attribution of a quote or verse block in the element attributes
+	AttrAttribution string = "attribution"
+	// AttrCiteTitle the key to retrieve the citation title of a quote or verse block in the element attributes
+	AttrCiteTitle string = "citetitle"
 )
 
+// NewSourceAttributesWithOthers initializes the attributes of a source block, with the given (optional) language
+// and the other given attributes (eg: `subs="attributes+"`)
+func NewSourceAttributesWithOthers(language string, others []interface{}) (map[string]interface{}, error) {
+	result, err := NewAttributeGroup(others)
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to initialize the attributes of a source block")
+	}
+	sourceAttributes, err := NewSourceAttributes(language)
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to initialize the attributes of a source block")
+	}
+	for k, v := range sourceAttributes {
+		result[k] = v
+	}
+	return result, nil
+}
+
+// NewSourceAttributes initializes the attributes of a source block, with the given (optional) language
+func NewSourceAttributes(language string) (map[string]interface{}, error) {
+	result := map[string]interface{}{
+		AttrKind: Source,
+	}
+	if language != "" {
+		result[AttrLanguage] = language
+	}
+	return result, nil
+}
+
+// NewQuoteAttributes initializes the attributes of a quote or verse block, with the given (optional)
+// attribution and citation title
+func NewQuoteAttributes(kind string, attribution, citeTitle interface{}) (map[string]interface{}, error) {
+	result := map[string]interface{}{
+		AttrKind: kind,
+	}
+	if attribution, ok := attribution.(string); ok && attribution != "" {
+		result[AttrAttribution] = attribution
+	}
+	if citeTitle, ok := citeTitle.(string); ok && citeTitle != "" {
+		result[AttrCiteTitle] = citeTitle
+	}
+	return result, nil
+}
+
+// NewQuoteAttribute initializes the attribution or citation title of a quote or verse block from the given value
+func NewQuoteAttribute(value []interface{}) (string, error) {
+	v, err := stringify(value, func(s string) (string, error) {
+		return strings.TrimSpace(s), nil
+	})
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to initialize a new quote attribute")
+	}
+	return v, nil
+}
+
+// NewBlockStyleAttributes initializes the attributes of a block with the given style (eg: `abstract`)
+func NewBlockStyleAttributes(kind string) (map[string]interface{}, error) {
+	return map[string]interface{}{
+		AttrKind: kind,
+	}, nil
+}
+
 // NewElementAttributes retrieves the ElementID, ElementTitle and ElementLink from the given slice of attributes
 func NewElementAttributes(attributes []interface{}) map[string]interface{} {
 	attrbs := make(map[string]interface{})
@@ -1658,8 +2678,9 @@ func (s StringElement) Accept(v Visitor) error {
 
 // QuotedText the structure for quoted text
 type QuotedText struct {
-	Kind     QuotedTextKind
-	Elements []InlineElement
+	Kind       QuotedTextKind
+	Attributes map[string]interface{}
+	Elements   []InlineElement
 }
 
 // QuotedTextKind the type for
@@ -1672,6 +2693,16 @@ const (
 	Italic
 	// Monospace monospace quoted text
 	Monospace
+	// Marked marked (highlighted) quoted text
+	Marked
+	// Superscript superscript quoted text
+	Superscript
+	// Subscript subscript quoted text
+	Subscript
+	// DoubleQuoted text in curved double quotes
+	DoubleQuoted
+	// SingleQuoted text in curved single quotes
+	SingleQuoted
 )
 
 // NewQuotedText initializes a new `QuotedText` from the given kind and content
@@ -1687,6 +2718,33 @@ func NewQuotedText(kind QuotedTextKind, content []interface{}) (QuotedText, erro
 	return QuotedText{Kind: kind, Elements: elements}, nil
 }
 
+// NewQuotedTextAttributes initializes the attributes of a quoted text, with the given (optional) ID and roles
+func NewQuotedTextAttributes(id interface{}, roles []interface{}) (map[string]interface{}, error) {
+	result := map[string]interface{}{}
+	if id, ok := id.(string); ok {
+		result[AttrID] = id
+	}
+	r := make([]string, 0, len(roles))
+	for _, role := range roles {
+		if role, ok := role.(string); ok {
+			r = append(r, role)
+		}
+	}
+	if len(r) > 0 {
+		result[AttrRole] = strings.Join(r, " ")
+	}
+	log.Debugf("Initialized new quoted text attributes: %v", result)
+	return result, nil
+}
+
+// NewQuotedTextWithAttributes returns the given quoted text with the given (optional) attributes
+func NewQuotedTextWithAttributes(text QuotedText, attributes interface{}) (QuotedText, error) {
+	if attributes, ok := attributes.(map[string]interface{}); ok && len(attributes) > 0 {
+		text.Attributes = attributes
+	}
+	return text, nil
+}
+
 // Accept implements Visitable#Accept(Visitor)
 func (t QuotedText) Accept(v Visitor) error {
 	err := v.BeforeVisit(t)
@@ -1718,18 +2776,28 @@ func (t QuotedText) Accept(v Visitor) error {
 
 // NewEscapedQuotedText returns a new InlineContent where the nested elements are preserved (ie, substituted as expected)
 func NewEscapedQuotedText(backslashes []interface{}, punctuation string, content []interface{}) (InlineContent, error) {
+	return newEscapedQuotedText(backslashes, punctuation, punctuation, content)
+}
+
+// NewEscapedCurvedQuotedText returns a new InlineContent for an escaped text in curved quotes (eg: \"`text`"),
+// where the nested elements are preserved (ie, substituted as expected)
+func NewEscapedCurvedQuotedText(backslashes []interface{}, opening, closing string, content []interface{}) (InlineContent, error) {
+	return newEscapedQuotedText(backslashes, opening, closing, content)
+}
+
+func newEscapedQuotedText(backslashes []interface{}, opening, closing string, content []interface{}) (InlineContent, error) {
 	backslashesStr, err := stringify(backslashes,
 		func(s string) (string, error) {
 			// remove the number of back-slashes that match the length of the punctuation. Eg: `\*` or `\\**`, but keep extra back-slashes
-			if len(s) > len(punctuation) {
-				return s[len(punctuation):], nil
+			if len(s) > len(opening) {
+				return s[len(opening):], nil
 			}
 			return "", nil
 		})
 	if err != nil {
 		return InlineContent{}, errors.Wrapf(err, "error while initializing quoted text with substitution prevention")
 	}
-	return NewInlineContent([]interface{}{backslashesStr, punctuation, content, punctuation})
+	return NewInlineContent([]interface{}{backslashesStr, opening, content, closing})
 }
 
 // ------------------------------------------
@@ -1768,6 +2836,34 @@ func NewPassthrough(kind PassthroughKind, elements []interface{}) (Passthrough,
 
 }
 
+// ------------------------------------------
+// STEM
+// ------------------------------------------
+
+// InlineStem a STEM (Science, Technology, Engineering and Math) expression in an inline content
+// (eg: `stem:[sqrt(4) = 2]` or `latexmath:[C = \alpha + \beta Y^{\gamma} + \epsilon]`)
+type InlineStem struct {
+	// Kind the notation of the expression (`stem`, `latexmath` or `asciimath`)
+	Kind    string
+	Content string
+}
+
+// NewInlineStem initializes a new `InlineStem` of the given kind with the given raw content, in which
+// the escaped closing brackets (`\]`) are unescaped
+func NewInlineStem(kind string, content []interface{}) (InlineStem, error) {
+	c, err := stringify(content, func(s string) (string, error) {
+		return strings.Replace(s, `\]`, "]", -1), nil
+	})
+	if err != nil {
+		return InlineStem{}, errors.Wrapf(err, "failed to initialize a new inline STEM expression")
+	}
+	log.Debugf("initialized a new InlineStem of kind '%s' with content '%s'", kind, c)
+	return InlineStem{
+		Kind:    kind,
+		Content: c,
+	}, nil
+}
+
 // ------------------------------------------
 // BlankLine
 // ------------------------------------------
@@ -1811,3 +2907,76 @@ func NewLink(url, text []interface{}) (Link, error) {
 	}
 	return Link{URL: urlStr, Text: textStr}, nil
 }
+
+// ------------------------------------------
+// UI Macros
+// ------------------------------------------
+
+// KeyboardShortcut a keyboard shortcut, with one or more keys (eg: `kbd:[Ctrl+Shift+T]`)
+type KeyboardShortcut struct {
+	Keys []string
+}
+
+// NewKeyboardShortcut initializes a new `KeyboardShortcut` from the given content, in which the keys are separated
+// by a `,` if there is any, or by a `+` otherwise. A trailing double delimiter (eg: `Ctrl++`) is a key itself.
+func NewKeyboardShortcut(content string) (KeyboardShortcut, error) {
+	content = strings.TrimSpace(content)
+	delimiter := "+"
+	if strings.Contains(content, ",") {
+		delimiter = ","
+	}
+	keys := make([]string, 0)
+	if content == delimiter {
+		keys = append(keys, content)
+	} else {
+		trailingDelimiter := strings.HasSuffix(content, delimiter+delimiter)
+		if trailingDelimiter {
+			content = strings.TrimSuffix(content, delimiter+delimiter)
+		}
+		for _, key := range strings.Split(content, delimiter) {
+			if key = strings.TrimSpace(key); key != "" {
+				keys = append(keys, key)
+			}
+		}
+		if trailingDelimiter {
+			keys = append(keys, delimiter)
+		}
+	}
+	log.Debugf("initialized a new KeyboardShortcut with keys %v", keys)
+	return KeyboardShortcut{Keys: keys}, nil
+}
+
+// Button a button in a user interface (eg: `btn:[Save]`)
+type Button struct {
+	Label string
+}
+
+// NewButton initializes a new `Button` with the given label
+func NewButton(label string) (Button, error) {
+	log.Debugf("initialized a new Button with label '%s'", label)
+	return Button{Label: strings.TrimSpace(label)}, nil
+}
+
+// MenuSelection a selection in the menu of a user interface, with its optional submenus and item, separated
+// by `>` (eg: `menu:View[Zoom > Reset]`)
+type MenuSelection struct {
+	Menu  string
+	Items []string
+}
+
+// NewMenuSelection initializes a new `MenuSelection` with the given menu and its optional submenus and item
+func NewMenuSelection(menu string, items interface{}) (MenuSelection, error) {
+	result := MenuSelection{
+		Menu:  strings.TrimSpace(menu),
+		Items: make([]string, 0),
+	}
+	if items, ok := items.(string); ok {
+		for _, item := range strings.Split(items, ">") {
+			if item = strings.TrimSpace(item); item != "" {
+				result.Items = append(result.Items, item)
+			}
+		}
+	}
+	log.Debugf("initialized a new MenuSelection with menu '%s' and items %v", result.Menu, result.Items)
+	return result, nil
+}
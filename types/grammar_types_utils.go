@@ -61,6 +61,53 @@ func filterUnrelevantElements(blocks []interface{}) []DocElement {
 	return elements
 }
 
+// checkCalloutLists logs a warning for each item of a callout list whose reference
+// is not present in the listing block that precedes the list, in the given elements and their nested elements.
+// Since the elements can be initialized several times while the document is parsed, the check is performed once
+// on the whole document
+func checkCalloutLists(elements []DocElement) {
+	for i, element := range elements {
+		switch e := element.(type) {
+		case CalloutList:
+			refs := make(map[int]bool)
+			if i > 0 {
+				if b, ok := elements[i-1].(DelimitedBlock); ok {
+					for _, e := range b.Elements {
+						if c, ok := e.(Callout); ok {
+							refs[c.Ref] = true
+						}
+					}
+				}
+			}
+			for _, item := range e.Items {
+				if !refs[item.Ref] {
+					log.Warnf("no callout found for item <%d> of the callout list", item.Ref)
+				}
+			}
+		case Preamble:
+			checkCalloutLists(e.Elements)
+		case Section:
+			checkCalloutLists(e.Elements)
+		case DelimitedBlock:
+			checkCalloutLists(e.Elements)
+		case AdmonitionBlock:
+			checkCalloutLists(e.Elements)
+		case OrderedList:
+			for _, item := range e.Items {
+				checkCalloutLists(item.Elements)
+			}
+		case UnorderedList:
+			for _, item := range e.Items {
+				checkCalloutLists(item.Elements)
+			}
+		case LabeledList:
+			for _, item := range e.Items {
+				checkCalloutLists(item.Elements)
+			}
+		}
+	}
+}
+
 func mergeElements(elements []interface{}, extraElements ...interface{}) []interface{} {
 	result := make([]interface{}, 0)
 	allElements := append(elements, extraElements...)
@@ -195,3 +242,89 @@ func toPtr(element interface{}) interface{} {
 	// log.Debugf("Returning pointer of type %T", ptr.Interface())
 	return ptr.Interface()
 }
+
+// acceptVisitor makes the given visitor visit the given element, then its children (when they are visitable)
+func acceptVisitor(v Visitor, element Visitable, name string, children ...DocElement) error {
+	err := v.BeforeVisit(element)
+	if err != nil {
+		return errors.Wrapf(err, "error while pre-visiting %s", name)
+	}
+	err = v.Visit(element)
+	if err != nil {
+		return errors.Wrapf(err, "error while visiting %s", name)
+	}
+	for _, child := range children {
+		if visitable, ok := child.(Visitable); ok {
+			err = visitable.Accept(v)
+			if err != nil {
+				return errors.Wrapf(err, "error while visiting %s element", name)
+			}
+		}
+	}
+	err = v.AfterVisit(element)
+	if err != nil {
+		return errors.Wrapf(err, "error while post-visiting %s", name)
+	}
+	return nil
+}
+
+func inlineContentsAsElements(lines []InlineContent) []DocElement {
+	result := make([]DocElement, len(lines))
+	for i, line := range lines {
+		result[i] = line
+	}
+	return result
+}
+
+func orderedListItemsAsElements(items []OrderedListItem) []DocElement {
+	result := make([]DocElement, len(items))
+	for i, item := range items {
+		result[i] = item
+	}
+	return result
+}
+
+func unorderedListItemsAsElements(items []UnorderedListItem) []DocElement {
+	result := make([]DocElement, len(items))
+	for i, item := range items {
+		result[i] = item
+	}
+	return result
+}
+
+func labeledListItemsAsElements(items []LabeledListItem) []DocElement {
+	result := make([]DocElement, len(items))
+	for i, item := range items {
+		result[i] = item
+	}
+	return result
+}
+
+func calloutListItemsAsElements(items []CalloutListItem) []DocElement {
+	result := make([]DocElement, len(items))
+	for i, item := range items {
+		result[i] = item
+	}
+	return result
+}
+
+// tableCellsAsElements returns the elements of all the cells of the given table, from the header to the footer
+func tableCellsAsElements(t Table) []DocElement {
+	result := make([]DocElement, 0)
+	rows := make([]TableRow, 0, len(t.Rows)+2)
+	if t.Header != nil {
+		rows = append(rows, *t.Header)
+	}
+	rows = append(rows, t.Rows...)
+	if t.Footer != nil {
+		rows = append(rows, *t.Footer)
+	}
+	for _, row := range rows {
+		for _, cell := range row.Cells {
+			for _, element := range cell.Elements {
+				result = append(result, element)
+			}
+		}
+	}
+	return result
+}
@@ -70,6 +70,81 @@ var _ = Describe("Normalizing String", func() {
 		}
 		verify(GinkgoT(), `_a_section_title_with_strong_bold_content_strong`, source)
 	})
+
+	It("content with superscript", func() {
+		// == E=mc^2^ title
+		source := InlineContent{
+			Elements: []InlineElement{
+				StringElement{Content: "E=mc"},
+				QuotedText{
+					Kind: Superscript,
+					Elements: []InlineElement{
+						StringElement{Content: "2"},
+					},
+				},
+				StringElement{Content: " title"},
+			},
+		}
+		verify(GinkgoT(), `_emc2_title`, source)
+	})
+
+	It("content with marked text", func() {
+		// == A #marked# title
+		source := InlineContent{
+			Elements: []InlineElement{
+				StringElement{Content: "A "},
+				QuotedText{
+					Kind: Marked,
+					Elements: []InlineElement{
+						StringElement{Content: "marked"},
+					},
+				},
+				StringElement{Content: " title"},
+			},
+		}
+		verify(GinkgoT(), `_a_marked_title`, source)
+	})
+
+	It("content with curved quotes", func() {
+		// == The "`quoted`" title
+		source := InlineContent{
+			Elements: []InlineElement{
+				StringElement{Content: "The "},
+				QuotedText{
+					Kind: DoubleQuoted,
+					Elements: []InlineElement{
+						StringElement{Content: "quoted"},
+					},
+				},
+				StringElement{Content: " title"},
+			},
+		}
+		verify(GinkgoT(), `_the_quoted_title`, source)
+	})
+
+	It("content with footnote", func() {
+		// == A title footnote:[a *note*] here
+		source := InlineContent{
+			Elements: []InlineElement{
+				StringElement{Content: "A title "},
+				Footnote{
+					Elements: InlineContent{
+						Elements: []InlineElement{
+							StringElement{Content: "a "},
+							QuotedText{
+								Kind: Bold,
+								Elements: []InlineElement{
+									StringElement{Content: "note"},
+								},
+							},
+						},
+					},
+				},
+				StringElement{Content: " here"},
+			},
+		}
+		verify(GinkgoT(), `_a_title_here`, source)
+	})
 })
 
 func verify(t GinkgoTInterface, expected string, inlineContent InlineContent) {
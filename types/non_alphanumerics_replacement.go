@@ -44,7 +44,7 @@ func NewReplaceNonAlphanumericsFunc(replacement string) NormalizationFunc {
 
 // ReplaceNonAlphanumerics replace all non alpha numeric characters with the given `replacement`
 func ReplaceNonAlphanumerics(source InlineContent, replacement string) (string, error) {
-	v := NewReplaceNonAlphanumericsVisitor()
+	v := NewReplaceNonAlphanumericsVisitor(replacement)
 	err := source.Accept(&v)
 	if err != nil {
 		return "", err
@@ -53,34 +53,40 @@ func ReplaceNonAlphanumerics(source InlineContent, replacement string) (string,
 }
 
 //ReplaceNonAlphanumericsVisitor a visitor that builds a string representation of the visited elements,
-// in which all non-alphanumeric characters have been replaced with a "_"
+// in which all non-alphanumeric characters have been replaced with the given replacement (eg: "_").
+// The content of the footnotes is ignored.
 type ReplaceNonAlphanumericsVisitor struct {
-	buf       bytes.Buffer
-	normalize NormalizationFunc
+	buf         bytes.Buffer
+	replacement string
+	normalize   NormalizationFunc
+	// the content of the consecutive string elements, which is normalized at once
+	pending bytes.Buffer
+	// the depth of the footnotes being visited, whose content is ignored
+	footnotes int
 }
 
 // NewReplaceNonAlphanumericsVisitor returns a new ReplaceNonAlphanumericsVisitor
-func NewReplaceNonAlphanumericsVisitor() ReplaceNonAlphanumericsVisitor {
+func NewReplaceNonAlphanumericsVisitor(replacement string) ReplaceNonAlphanumericsVisitor {
 	buf := bytes.NewBuffer(nil)
 	return ReplaceNonAlphanumericsVisitor{
-		buf:       *buf,
-		normalize: NewReplaceNonAlphanumericsFunc("_"),
+		buf:         *buf,
+		replacement: replacement,
+		normalize:   NewReplaceNonAlphanumericsFunc(replacement),
 	}
 }
 
 // Visit method called when an element is visited
 func (v *ReplaceNonAlphanumericsVisitor) Visit(element Visitable) error {
 	log.Debugf("visiting element of type '%T'", element)
+	if v.footnotes > 0 {
+		return nil
+	}
 	switch element := element.(type) {
 	case InlineContent:
 		// log.Debugf("Prefixing with '_' while processing '%T'", element)
-		v.buf.WriteString("_")
+		return v.flush(v.replacement)
 	case StringElement:
-		normalized, err := v.normalize(element.Content)
-		if err != nil {
-			return errors.Wrapf(err, "error while normalizing String Element")
-		}
-		v.buf.Write(normalized)
+		v.pending.WriteString(element.Content)
 	default:
 		// ignore
 	}
@@ -90,18 +96,24 @@ func (v *ReplaceNonAlphanumericsVisitor) Visit(element Visitable) error {
 // BeforeVisit method called before visiting an element. Allows for performing "pre-actions"
 func (v *ReplaceNonAlphanumericsVisitor) BeforeVisit(element Visitable) error {
 	log.Debugf("Before visiting element of type '%T'...", element)
+	if _, ok := element.(Footnote); ok {
+		v.footnotes++
+	}
+	if v.footnotes > 0 {
+		return nil
+	}
 	switch element := element.(type) {
 	case QuotedText:
 		// log.Debugf("Before visiting quoted element...")
 		switch element.Kind {
 		case Bold:
-			v.buf.WriteString("_strong_")
+			return v.flush(v.replacement + "strong" + v.replacement)
 		case Italic:
-			v.buf.WriteString("_italic_")
+			return v.flush(v.replacement + "italic" + v.replacement)
 		case Monospace:
-			v.buf.WriteString("_monospace_")
-		default:
-			return errors.Errorf("unsupported kind of quoted text: %d", element.Kind)
+			return v.flush(v.replacement + "monospace" + v.replacement)
+		case Marked, Superscript, Subscript, DoubleQuoted, SingleQuoted:
+			// only the content is retained
 		}
 	default:
 		// ignore
@@ -112,17 +124,26 @@ func (v *ReplaceNonAlphanumericsVisitor) BeforeVisit(element Visitable) error {
 // AfterVisit method called before visiting an element. Allows for performing "post-actions"
 func (v *ReplaceNonAlphanumericsVisitor) AfterVisit(element Visitable) error {
 	log.Debugf("After visiting element of type '%T'...", element)
+	if _, ok := element.(Footnote); ok {
+		v.footnotes--
+		return nil
+	}
+	if v.footnotes > 0 {
+		return nil
+	}
 	switch element := element.(type) {
+	case InlineContent:
+		return v.flush("")
 	case QuotedText:
 		switch element.Kind {
 		case Bold:
-			v.buf.WriteString("_strong")
+			return v.flush(v.replacement + "strong")
 		case Italic:
-			v.buf.WriteString("_italic")
+			return v.flush(v.replacement + "italic")
 		case Monospace:
-			v.buf.WriteString("_monospace")
-		default:
-			return errors.Errorf("unsupported kind of quoted text: %d", element.Kind)
+			return v.flush(v.replacement + "monospace")
+		case Marked, Superscript, Subscript, DoubleQuoted, SingleQuoted:
+			// only the content is retained
 		}
 	default:
 		// ignore
@@ -130,6 +151,18 @@ func (v *ReplaceNonAlphanumericsVisitor) AfterVisit(element Visitable) error {
 	return nil
 }
 
+// flush normalizes the pending content, then writes the given suffix
+func (v *ReplaceNonAlphanumericsVisitor) flush(suffix string) error {
+	normalized, err := v.normalize(v.pending.String())
+	if err != nil {
+		return errors.Wrapf(err, "error while normalizing String Element")
+	}
+	v.pending.Reset()
+	v.buf.Write(normalized)
+	v.buf.WriteString(suffix)
+	return nil
+}
+
 // NormalizedContent returns the normalized content
 func (v *ReplaceNonAlphanumericsVisitor) NormalizedContent() string {
 	return v.buf.String()
@@ -0,0 +1,171 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// resolveSectionIDs (re)generates the IDs of the sections (and discrete headings) which have no explicit ID, using the `idprefix` (`_` by default)
+// and `idseparator` (`_` by default) document attributes. Since these attributes (and `sectids`, which can be reset to disable
+// the generation of IDs) can be set and reset in the body of the document, the attribute declarations and resets are processed
+// in the document order.
+// Generated IDs are unique in the whole document: in case of collision with a previous section or with an explicit ID,
+// a numeric suffix is appended (eg: `_intro_2`).
+// An ID is considered as explicit when it differs from the default ID set when the section title was parsed.
+// Note: the section titles attributes are updated in place.
+func resolveSectionIDs(attributes DocumentAttributes, elements []DocElement) error {
+	r := sectionIDResolver{
+		attributes: DocumentAttributes{},
+		ids:        map[string]bool{},
+	}
+	// `sectids` is set by default
+	r.attributes["sectids"] = ""
+	for k, v := range attributes {
+		r.attributes[k] = v
+	}
+	// explicit IDs are reserved first, so that generated IDs never collide with them, even if they are declared further in the document
+	if err := r.reserveCustomIDs(elements); err != nil {
+		return err
+	}
+	return r.process(elements)
+}
+
+type sectionIDResolver struct {
+	// the attributes in effect at the current element
+	attributes DocumentAttributes
+	// the IDs already in use
+	ids map[string]bool
+}
+
+func (r *sectionIDResolver) reserveCustomIDs(elements []DocElement) error {
+	for _, element := range elements {
+		switch e := element.(type) {
+		case Preamble:
+			if err := r.reserveCustomIDs(e.Elements); err != nil {
+				return err
+			}
+		case Section:
+			if err := r.reserveCustomID(e.Title.Attributes, e.Title.Content); err != nil {
+				return err
+			}
+			if err := r.reserveCustomIDs(e.Elements); err != nil {
+				return err
+			}
+		case DiscreteHeading:
+			if err := r.reserveCustomID(e.Attributes, e.Content); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// reserveCustomID reserves the ID in the given attributes if it is explicit.
+// Duplicate explicit IDs are reported by the `ElementReferencesCollector`
+func (r *sectionIDResolver) reserveCustomID(attributes map[string]interface{}, content InlineContent) error {
+	custom, err := isCustomID(attributes, content)
+	if err != nil {
+		return errors.Wrapf(err, "unable to reserve section ID")
+	}
+	if custom {
+		r.ids[attributes[AttrID].(string)] = true
+	}
+	return nil
+}
+
+// isCustomID returns true if the ID in the given attributes is explicit, ie, if it is not the default ID of the given content
+func isCustomID(attributes map[string]interface{}, content InlineContent) (bool, error) {
+	id, ok := attributes[AttrID].(string)
+	if !ok {
+		return false, nil
+	}
+	defaultID, err := defaultSectionID(content)
+	if err != nil {
+		return false, err
+	}
+	return id != defaultID, nil
+}
+
+func (r *sectionIDResolver) process(elements []DocElement) error {
+	for _, element := range elements {
+		switch e := element.(type) {
+		case DocumentAttributeDeclaration:
+			r.attributes.AddAttribute(e)
+		case DocumentAttributeReset:
+			r.attributes.Reset(e)
+		case Preamble:
+			if err := r.process(e.Elements); err != nil {
+				return err
+			}
+		case Section:
+			if err := r.resolveID(e.Title.Attributes, e.Title.Content); err != nil {
+				return err
+			}
+			if err := r.process(e.Elements); err != nil {
+				return err
+			}
+		case DiscreteHeading:
+			if err := r.resolveID(e.Attributes, e.Content); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// resolveID (re)generates the ID in the given attributes from the given content, unless the ID is explicit
+func (r *sectionIDResolver) resolveID(attributes map[string]interface{}, content InlineContent) error {
+	if attributes == nil {
+		// eg: the title could not be initialized
+		return nil
+	}
+	if custom, err := isCustomID(attributes, content); err != nil {
+		return errors.Wrapf(err, "unable to generate section ID")
+	} else if custom {
+		return nil
+	}
+	if r.disabled() {
+		delete(attributes, AttrID)
+		return nil
+	}
+	id, err := r.newID(content)
+	if err != nil {
+		return errors.Wrapf(err, "unable to generate section ID")
+	}
+	attributes[AttrID] = id
+	return nil
+}
+
+// disabled returns true if the `sectids` attribute was reset at the current element
+func (r *sectionIDResolver) disabled() bool {
+	_, found := r.attributes["sectids"]
+	return !found
+}
+
+// newID returns a new, unique ID for the given section title content
+func (r *sectionIDResolver) newID(content InlineContent) (string, error) {
+	prefix := r.attribute("idprefix", "_")
+	separator := r.attribute("idseparator", "_")
+	normalized, err := ReplaceNonAlphanumerics(content, separator)
+	if err != nil {
+		return "", err
+	}
+	// `ReplaceNonAlphanumerics` prefixes the result with the separator, which is replaced with the `idprefix` here
+	id := prefix + strings.TrimPrefix(normalized, separator)
+	result := id
+	for i := 2; r.ids[result]; i++ {
+		result = fmt.Sprintf("%s%s%d", id, separator, i)
+	}
+	r.ids[result] = true
+	return result, nil
+}
+
+// attribute returns the value of the attribute with the given name, or the given default value if the attribute is not set
+func (r *sectionIDResolver) attribute(name, defaultValue string) string {
+	if value, found := r.attributes[name]; found {
+		return fmt.Sprintf("%v", value)
+	}
+	return defaultValue
+}
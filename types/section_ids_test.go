@@ -0,0 +1,28 @@
+package types_test
+
+import (
+	"github.com/bytesparadise/libasciidoc/types"
+	. "github.com/onsi/ginkgo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var _ = Describe("section IDs", func() {
+
+	It("section title without attributes", func() {
+		section := types.Section{
+			Level: 1,
+			Title: types.SectionTitle{
+				Content: types.InlineContent{
+					Elements: []types.InlineElement{
+						types.StringElement{Content: "a title"},
+					},
+				},
+			},
+			Elements: []types.DocElement{},
+		}
+		doc, err := types.NewDocument(nil, nil, []interface{}{section})
+		require.NoError(GinkgoT(), err)
+		assert.Nil(GinkgoT(), doc.Elements[0].(types.Section).Title.Attributes)
+	})
+})
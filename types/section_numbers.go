@@ -0,0 +1,127 @@
+package types
+
+import (
+	"fmt"
+	"strconv"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// specialSections the styles of the special sections, which are not numbered (appendices excepted)
+var specialSections = map[string]bool{
+	Abstract:          true,
+	Bibliography:      true,
+	"glossary":        true,
+	"index":           true,
+	"preface":         true,
+	"colophon":        true,
+	"dedication":      true,
+	"acknowledgments": true,
+}
+
+// NumberSections computes the numbers of the sections of the given document. The sections are numbered when
+// the `sectnums` attribute is set, up to the level defined by the `sectnumlevels` attribute (3 by default).
+// Since the `sectnums` attribute can be set and reset in the body of the document, the attribute declarations
+// and resets are processed in the document order.
+// The appendices are lettered (`Appendix A: `), while the other special sections (eg: bibliography) are not numbered.
+// Note: the section titles are updated in place, in the document elements as well as in the element references.
+func NumberSections(doc Document) {
+	n := sectionNumbering{
+		attributes: DocumentAttributes{},
+		references: doc.ElementReferences,
+		updated:    map[string]bool{},
+	}
+	for k, v := range doc.Attributes {
+		n.attributes[k] = v
+	}
+	n.process(doc.Elements, "", true)
+}
+
+type sectionNumbering struct {
+	// the attributes in effect at the current element
+	attributes DocumentAttributes
+	// the number of appendices so far
+	appendices int
+	// the number of numbered level-1 sections so far (which may be spread across several parts)
+	chapters int
+	// the references to the elements of the document, in which the section titles are also updated
+	references ElementReferences
+	// the IDs of the references which were already updated
+	updated map[string]bool
+}
+
+// process computes the numbers of the sections in the given elements, which are prefixed with the given
+// parent number (eg: `1.2.`). Sections are not numbered if `numbered` is false (eg: in special sections)
+func (n *sectionNumbering) process(elements []DocElement, parent string, numbered bool) {
+	count := 0
+	for i, element := range elements {
+		switch e := element.(type) {
+		case DocumentAttributeDeclaration:
+			n.attributes.AddAttribute(e)
+		case DocumentAttributeReset:
+			n.attributes.Reset(e)
+		case Preamble:
+			n.process(e.Elements, parent, numbered)
+		case Section:
+			style, _ := e.Title.Attributes[AttrKind].(string)
+			e.Title.Number = ""
+			switch {
+			case e.Level == 0:
+				// parts are not numbered, but their chapters are numbered continuously across the parts
+				n.process(e.Elements, parent, numbered)
+			case e.Level == 1 && style == Appendix:
+				letter := string(rune('A' + n.appendices))
+				n.appendices++
+				e.Title.Number = fmt.Sprintf("Appendix %s: ", letter)
+				n.process(e.Elements, letter+".", numbered)
+			case specialSections[style]:
+				n.process(e.Elements, "", false)
+			case numbered && n.enabled() && e.Level <= n.levels():
+				if e.Level == 1 {
+					n.chapters++
+					count = n.chapters
+				} else {
+					count++
+				}
+				number := fmt.Sprintf("%s%d.", parent, count)
+				e.Title.Number = number + " "
+				n.process(e.Elements, number, true)
+			default:
+				n.process(e.Elements, "", false)
+			}
+			elements[i] = e
+			n.updateReference(e.Title)
+		}
+	}
+}
+
+// updateReference updates the reference to the given section title, if any. Only the first section with a given ID
+// is referenced, as in the `ElementReferencesCollector`
+func (n *sectionNumbering) updateReference(title SectionTitle) {
+	id, ok := title.Attributes[AttrID].(string)
+	if !ok || n.updated[id] {
+		return
+	}
+	n.updated[id] = true
+	if _, ok := n.references[id].(SectionTitle); ok {
+		n.references[id] = title
+	}
+}
+
+// enabled returns true if the `sectnums` attribute is set at the current element
+func (n *sectionNumbering) enabled() bool {
+	_, found := n.attributes["sectnums"]
+	return found
+}
+
+// levels returns the value of the `sectnumlevels` attribute, or `3` as the default value
+func (n *sectionNumbering) levels() int {
+	if levels, found := n.attributes["sectnumlevels"]; found {
+		l, err := strconv.Atoi(fmt.Sprintf("%v", levels))
+		if err == nil {
+			return l
+		}
+		log.Warnf("the value of the 'sectnumlevels' attribute is not an integer: '%v'", levels)
+	}
+	return 3
+}
@@ -0,0 +1,115 @@
+package types_test
+
+import (
+	"github.com/bytesparadise/libasciidoc/types"
+	. "github.com/onsi/ginkgo"
+	"github.com/stretchr/testify/assert"
+)
+
+var _ = Describe("section numbers", func() {
+
+	section := func(level int, id string, attributes map[string]interface{}, elements ...types.DocElement) types.Section {
+		attrs := map[string]interface{}{
+			types.AttrID: id,
+		}
+		for k, v := range attributes {
+			attrs[k] = v
+		}
+		return types.Section{
+			Level: level,
+			Title: types.SectionTitle{
+				Attributes: attrs,
+			},
+			Elements: elements,
+		}
+	}
+
+	// numbers returns the numbers of the section titles of the given elements, in the document order
+	var numbers func(elements []types.DocElement) []string
+	numbers = func(elements []types.DocElement) []string {
+		result := []string{}
+		for _, e := range elements {
+			if s, ok := e.(types.Section); ok {
+				result = append(result, s.Title.Number)
+				result = append(result, numbers(s.Elements)...)
+			}
+		}
+		return result
+	}
+
+	It("no numbers when sectnums is not set", func() {
+		doc := types.Document{
+			Attributes: types.DocumentAttributes{},
+			Elements: []types.DocElement{
+				section(1, "a", nil),
+			},
+		}
+		types.NumberSections(doc)
+		assert.Equal(GinkgoT(), []string{""}, numbers(doc.Elements))
+	})
+
+	It("numbers with sectnums toggled and appendix", func() {
+		doc := types.Document{
+			Attributes: types.DocumentAttributes{
+				"sectnums":      "",
+				"sectnumlevels": "2",
+			},
+			Elements: []types.DocElement{
+				section(1, "a", nil,
+					section(2, "a_a", nil,
+						section(3, "a_a_a", nil)),
+					section(2, "a_b", nil)),
+				types.DocumentAttributeReset{Name: "sectnums"},
+				section(1, "b", nil,
+					section(2, "b_a", nil)),
+				types.DocumentAttributeDeclaration{Name: "sectnums"},
+				section(1, "c", nil),
+				section(1, "d", map[string]interface{}{types.AttrKind: types.Appendix},
+					section(2, "d_a", nil)),
+				section(1, "e", map[string]interface{}{types.AttrKind: "bibliography"},
+					section(2, "e_a", nil)),
+			},
+		}
+		types.NumberSections(doc)
+		assert.Equal(GinkgoT(), []string{
+			"1. ", "1.1. ", "", "1.2. ",
+			"", "",
+			"2. ",
+			"Appendix A: ", "A.1. ",
+			"", "",
+		}, numbers(doc.Elements))
+	})
+
+	It("numbers of sections without IDs", func() {
+		doc := types.Document{
+			Attributes: types.DocumentAttributes{
+				"sectnums": "",
+			},
+			Elements: []types.DocElement{
+				types.Section{Level: 1, Title: types.SectionTitle{Attributes: map[string]interface{}{}}},
+				types.Section{Level: 1, Title: types.SectionTitle{Attributes: map[string]interface{}{}},
+					Elements: []types.DocElement{
+						types.Section{Level: 2, Title: types.SectionTitle{Attributes: map[string]interface{}{}}},
+					}},
+			},
+		}
+		types.NumberSections(doc)
+		assert.Equal(GinkgoT(), []string{"1. ", "2. ", "2.1. "}, numbers(doc.Elements))
+	})
+
+	It("numbers in the element references", func() {
+		doc := types.Document{
+			Attributes: types.DocumentAttributes{
+				"sectnums": "",
+			},
+			Elements: []types.DocElement{
+				section(1, "a", nil),
+			},
+			ElementReferences: types.ElementReferences{
+				"a": types.SectionTitle{Attributes: map[string]interface{}{types.AttrID: "a"}},
+			},
+		}
+		types.NumberSections(doc)
+		assert.Equal(GinkgoT(), "1. ", doc.ElementReferences["a"].(types.SectionTitle).Number)
+	})
+})
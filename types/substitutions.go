@@ -0,0 +1,168 @@
+package types
+
+import (
+	"strings"
+
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+)
+
+// Substitution a substitution which is applied on the content of a block (eg: `quotes` or `attributes`)
+type Substitution string
+
+const (
+	// SpecialCharactersSubstitution the substitution of the `<`, `>` and `&` characters
+	SpecialCharactersSubstitution Substitution = "specialcharacters"
+	// QuotesSubstitution the substitution of the quoted text (eg: `*bold*`)
+	QuotesSubstitution Substitution = "quotes"
+	// AttributesSubstitution the substitution of the document attributes (eg: `{name}`)
+	AttributesSubstitution Substitution = "attributes"
+	// ReplacementsSubstitution the typographic replacements (eg: `(C)` or `...`)
+	ReplacementsSubstitution Substitution = "replacements"
+	// MacrosSubstitution the substitution of the macros (eg: links, images, cross references, passthroughs, etc.)
+	MacrosSubstitution Substitution = "macros"
+	// PostReplacementsSubstitution the substitution of the hard line breaks (eg: `line +`)
+	PostReplacementsSubstitution Substitution = "post_replacements"
+)
+
+// AttrSubstitutions the key to retrieve the substitutions of a block in the element attributes (eg: `[subs="attributes+"]`)
+const AttrSubstitutions string = "subs"
+
+// NormalSubstitutions the substitutions which apply on the paragraphs, in order
+var NormalSubstitutions = []Substitution{
+	SpecialCharactersSubstitution,
+	QuotesSubstitution,
+	AttributesSubstitution,
+	ReplacementsSubstitution,
+	MacrosSubstitution,
+	PostReplacementsSubstitution,
+}
+
+// VerbatimSubstitutions the substitutions which apply on the listing and literal blocks
+var VerbatimSubstitutions = []Substitution{
+	SpecialCharactersSubstitution,
+}
+
+// NoSubstitutions the substitutions which apply on the passthrough blocks
+var NoSubstitutions = []Substitution{}
+
+// substitutionGroups the substitutions (or groups of substitutions), indexed by their name and short name
+var substitutionGroups = map[string][]Substitution{
+	"none":              NoSubstitutions,
+	"normal":            NormalSubstitutions,
+	"n":                 NormalSubstitutions,
+	"verbatim":          VerbatimSubstitutions,
+	"v":                 VerbatimSubstitutions,
+	"specialchars":      {SpecialCharactersSubstitution},
+	"specialcharacters": {SpecialCharactersSubstitution},
+	"c":                 {SpecialCharactersSubstitution},
+	"quotes":            {QuotesSubstitution},
+	"q":                 {QuotesSubstitution},
+	"attributes":        {AttributesSubstitution},
+	"a":                 {AttributesSubstitution},
+	"replacements":      {ReplacementsSubstitution},
+	"r":                 {ReplacementsSubstitution},
+	"macros":            {MacrosSubstitution},
+	"m":                 {MacrosSubstitution},
+	"post_replacements": {PostReplacementsSubstitution},
+	"p":                 {PostReplacementsSubstitution},
+}
+
+// NewSubstitutions returns the substitutions to apply on a block, given the value of its `subs` attribute and its default
+// substitutions. The value is a comma-separated list of substitutions or groups of substitutions (eg: `quotes,macros` or
+// `normal`), which replace the default substitutions, unless they are incremental: `+name` prepends the substitution(s),
+// `name+` appends the substitution(s) and `-name` removes the substitution(s) from the default substitutions.
+// The order of the substitutions is retained, so that the values of the document attributes are only subject to the
+// substitutions which follow the `attributes` substitution (see LIMITATIONS.adoc).
+func NewSubstitutions(value string, defaults []Substitution) ([]Substitution, error) {
+	entries := strings.Split(value, ",")
+	for i, entry := range entries {
+		entries[i] = strings.TrimSpace(entry)
+	}
+	// incremental substitutions apply on the default ones
+	result := make([]Substitution, 0)
+	for _, entry := range entries {
+		if strings.HasPrefix(entry, "+") || strings.HasSuffix(entry, "+") || strings.HasPrefix(entry, "-") {
+			result = append(result, defaults...)
+			break
+		}
+	}
+	for _, entry := range entries {
+		if entry == "" {
+			continue
+		}
+		name := strings.TrimSuffix(strings.TrimPrefix(strings.TrimPrefix(entry, "+"), "-"), "+")
+		subs, found := substitutionGroups[name]
+		if !found {
+			return nil, errors.Errorf("unknown substitution: '%s'", name)
+		}
+		switch {
+		case strings.HasPrefix(entry, "+"):
+			result = append(append([]Substitution{}, subs...), removeSubstitutions(result, subs)...)
+		case strings.HasPrefix(entry, "-"):
+			result = removeSubstitutions(result, subs)
+		default:
+			result = append(removeSubstitutions(result, subs), subs...)
+		}
+	}
+	log.Debugf("substitutions for '%s': %v", value, result)
+	return result, nil
+}
+
+// HasSubstitution returns true if the given substitution is part of the given substitutions
+func HasSubstitution(substitutions []Substitution, s Substitution) bool {
+	for _, sub := range substitutions {
+		if sub == s {
+			return true
+		}
+	}
+	return false
+}
+
+func removeSubstitutions(substitutions []Substitution, removed []Substitution) []Substitution {
+	result := make([]Substitution, 0, len(substitutions))
+	for _, s := range substitutions {
+		if !HasSubstitution(removed, s) {
+			result = append(result, s)
+		}
+	}
+	return result
+}
+
+// NewElementSubstitutions returns the substitutions which apply on the content of an element, given its `subs`
+// attribute (if any) and its default substitutions. The default substitutions apply if the `subs` attribute is invalid.
+func NewElementSubstitutions(attributes map[string]interface{}, defaults []Substitution) []Substitution {
+	if subs, ok := attributes[AttrSubstitutions].(string); ok {
+		result, err := NewSubstitutions(subs, defaults)
+		if err != nil {
+			log.Warnf("invalid value for 'subs' attribute: %v", err)
+			return defaults
+		}
+		return result
+	}
+	return defaults
+}
+
+// Substitutions returns the substitutions which apply on the lines of the paragraph
+func (p Paragraph) Substitutions() []Substitution {
+	return NewElementSubstitutions(p.Attributes, NormalSubstitutions)
+}
+
+// Substitutions returns the substitutions which apply on the content of the delimited block. By default, the content
+// of the listing and fenced blocks is only escaped, the content of the passthrough and STEM blocks is retained as-is
+// and the other blocks contain elements which have their own substitutions.
+func (b DelimitedBlock) Substitutions() []Substitution {
+	switch b.Kind {
+	case FencedBlock, ListingBlock:
+		return NewElementSubstitutions(b.Attributes, VerbatimSubstitutions)
+	case PassthroughBlock, StemBlock:
+		return NewElementSubstitutions(b.Attributes, NoSubstitutions)
+	default:
+		return NewElementSubstitutions(b.Attributes, NormalSubstitutions)
+	}
+}
+
+// Substitutions returns the substitutions which apply on the content of the literal block
+func (b LiteralBlock) Substitutions() []Substitution {
+	return NewElementSubstitutions(b.Attributes, VerbatimSubstitutions)
+}
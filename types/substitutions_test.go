@@ -0,0 +1,66 @@
+package types_test
+
+import (
+	. "github.com/bytesparadise/libasciidoc/types"
+	. "github.com/onsi/ginkgo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var _ = Describe("Substitutions", func() {
+
+	It("substitution group", func() {
+		// when
+		subs, err := NewSubstitutions("normal", VerbatimSubstitutions)
+		// then
+		require.NoError(GinkgoT(), err)
+		assert.Equal(GinkgoT(), NormalSubstitutions, subs)
+	})
+
+	It("no substitution", func() {
+		// when
+		subs, err := NewSubstitutions("none", NormalSubstitutions)
+		// then
+		require.NoError(GinkgoT(), err)
+		assert.Empty(GinkgoT(), subs)
+	})
+
+	It("explicit substitutions", func() {
+		// when
+		subs, err := NewSubstitutions("quotes, macros", VerbatimSubstitutions)
+		// then
+		require.NoError(GinkgoT(), err)
+		assert.Equal(GinkgoT(), []Substitution{QuotesSubstitution, MacrosSubstitution}, subs)
+	})
+
+	It("appended substitution", func() {
+		// when
+		subs, err := NewSubstitutions("attributes+", VerbatimSubstitutions)
+		// then
+		require.NoError(GinkgoT(), err)
+		assert.Equal(GinkgoT(), []Substitution{SpecialCharactersSubstitution, AttributesSubstitution}, subs)
+	})
+
+	It("prepended substitution", func() {
+		// when
+		subs, err := NewSubstitutions("+quotes", VerbatimSubstitutions)
+		// then
+		require.NoError(GinkgoT(), err)
+		assert.Equal(GinkgoT(), []Substitution{QuotesSubstitution, SpecialCharactersSubstitution}, subs)
+	})
+
+	It("removed substitutions", func() {
+		// when
+		subs, err := NewSubstitutions("-replacements,-p", NormalSubstitutions)
+		// then
+		require.NoError(GinkgoT(), err)
+		assert.Equal(GinkgoT(), []Substitution{SpecialCharactersSubstitution, QuotesSubstitution, AttributesSubstitution, MacrosSubstitution}, subs)
+	})
+
+	It("unknown substitution", func() {
+		// when
+		_, err := NewSubstitutions("foo", NormalSubstitutions)
+		// then
+		assert.Error(GinkgoT(), err)
+	})
+})